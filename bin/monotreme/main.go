@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 	"github.com/bshort/monotreme/server"
 	"github.com/bshort/monotreme/server/common"
 	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/server/service/ingestion"
 	"github.com/bshort/monotreme/store"
 	"github.com/bshort/monotreme/store/db"
 )
@@ -35,6 +37,14 @@ var (
 				DSN:     viper.GetString("dsn"),
 				Driver:  viper.GetString("driver"),
 				Version: common.GetCurrentVersion(viper.GetString("mode")),
+
+				ResolverCacheSize: viper.GetInt("resolver-cache-size"),
+				ResolverCacheTTL:  viper.GetDuration("resolver-cache-ttl"),
+
+				ActivityBufferSize:    viper.GetInt("activity-buffer-size"),
+				ActivityBatchSize:     viper.GetInt("activity-batch-size"),
+				ActivityFlushInterval: viper.GetDuration("activity-flush-interval"),
+				ActivityBufferPolicy:  viper.GetString("activity-buffer-policy"),
 			}
 			if err := serverProfile.Validate(); err != nil {
 				panic(err)
@@ -92,6 +102,12 @@ func init() {
 	viper.SetDefault("mode", "dev")
 	viper.SetDefault("driver", "sqlite")
 	viper.SetDefault("port", 8082)
+	viper.SetDefault("resolver-cache-size", store.DefaultResolverCacheSize)
+	viper.SetDefault("resolver-cache-ttl", store.DefaultResolverCacheTTL)
+	viper.SetDefault("activity-buffer-size", ingestion.DefaultBufferSize)
+	viper.SetDefault("activity-batch-size", ingestion.DefaultBatchSize)
+	viper.SetDefault("activity-flush-interval", ingestion.DefaultFlushInterval)
+	viper.SetDefault("activity-buffer-policy", string(ingestion.PolicyDrop))
 
 	rootCmd.PersistentFlags().String("mode", "dev", `mode of server, can be "prod" or "dev"`)
 	rootCmd.PersistentFlags().String("addr", "", "address of server")
@@ -99,6 +115,12 @@ func init() {
 	rootCmd.PersistentFlags().String("data", "", "data directory")
 	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
+	rootCmd.PersistentFlags().Int("resolver-cache-size", store.DefaultResolverCacheSize, "number of resolved shortcut paths kept in memory, 0 to disable")
+	rootCmd.PersistentFlags().Duration("resolver-cache-ttl", store.DefaultResolverCacheTTL, "how long a resolved shortcut path is kept in memory")
+	rootCmd.PersistentFlags().Int("activity-buffer-size", ingestion.DefaultBufferSize, "number of click events buffered before they are written")
+	rootCmd.PersistentFlags().Int("activity-batch-size", ingestion.DefaultBatchSize, "number of click events written at once")
+	rootCmd.PersistentFlags().Duration("activity-flush-interval", ingestion.DefaultFlushInterval, "how often buffered click events are written")
+	rootCmd.PersistentFlags().String("activity-buffer-policy", string(ingestion.PolicyDrop), `what happens to click events when the buffer is full, "drop" or "block"`)
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -115,8 +137,20 @@ func init() {
 	if err := viper.BindPFlag("dsn", rootCmd.PersistentFlags().Lookup("dsn")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("resolver-cache-size", rootCmd.PersistentFlags().Lookup("resolver-cache-size")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("resolver-cache-ttl", rootCmd.PersistentFlags().Lookup("resolver-cache-ttl")); err != nil {
+		panic(err)
+	}
+	for _, name := range []string{"activity-buffer-size", "activity-batch-size", "activity-flush-interval", "activity-buffer-policy"} {
+		if err := viper.BindPFlag(name, rootCmd.PersistentFlags().Lookup(name)); err != nil {
+			panic(err)
+		}
+	}
 
 	viper.SetEnvPrefix("monotreme")
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 	viper.AutomaticEnv()
 }
 
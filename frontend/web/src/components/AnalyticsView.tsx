@@ -138,6 +138,49 @@ const AnalyticsView: React.FC<Props> = (props: Props) => {
               )}
             </div>
           </div>
+
+          {analytics.variants.length > 0 && (
+            <div className="w-full">
+              <p className="w-full h-8 px-2 dark:text-gray-500">{t("analytics.variants")}</p>
+              <div className="w-full mt-1 overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
+                <div className="w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                  <div className="w-full flex flex-row justify-between items-center">
+                    <span className="py-2 px-2 text-left font-semibold text-sm text-gray-500">{t("analytics.variant")}</span>
+                    <span className="py-2 pr-2 text-right font-semibold text-sm text-gray-500">{t("analytics.visitors")}</span>
+                  </div>
+                  <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+                    {analytics.variants.map((variant) => (
+                      <div key={variant.name} className="w-full flex flex-row justify-between items-center">
+                        <span className="whitespace-nowrap py-2 px-2 text-sm text-gray-900 truncate dark:text-gray-500">{variant.name}</span>
+                        <span className="whitespace-nowrap py-2 pr-2 text-sm text-gray-500 text-right shrink-0">{variant.count}</span>
+                      </div>
+                    ))}
+                  </div>
+                </div>
+              </div>
+            </div>
+          )}
+          {analytics.bots.length > 0 && (
+            <div className="w-full">
+              <p className="w-full h-8 px-2 dark:text-gray-500">{t("analytics.bots")}</p>
+              <div className="w-full mt-1 overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
+                <div className="w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                  <div className="w-full flex flex-row justify-between items-center">
+                    <span className="py-2 px-2 text-left font-semibold text-sm text-gray-500">{t("analytics.bot")}</span>
+                    <span className="py-2 pr-2 text-right font-semibold text-sm text-gray-500">{t("analytics.fetches")}</span>
+                  </div>
+                  <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+                    {analytics.bots.map((bot) => (
+                      <div key={bot.name} className="w-full flex flex-row justify-between items-center">
+                        <span className="whitespace-nowrap py-2 px-2 text-sm text-gray-900 truncate dark:text-gray-500">{bot.name}</span>
+                        <span className="whitespace-nowrap py-2 pr-2 text-sm text-gray-500 text-right shrink-0">{bot.count}</span>
+                      </div>
+                    ))}
+                  </div>
+                </div>
+              </div>
+            </div>
+          )}
         </>
       ) : (
         <div className="absolute py-12 w-full flex flex-row justify-center items-center opacity-80">
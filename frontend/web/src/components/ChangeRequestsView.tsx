@@ -0,0 +1,117 @@
+import { Button } from "@mui/joy";
+import classNames from "classnames";
+import dayjs from "dayjs";
+import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { shortcutServiceClient } from "@/grpcweb";
+import { useShortcutStore, useUserStore } from "@/stores";
+import { ShortcutChangeRequest, ShortcutChangeRequest_Status } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcutId: number;
+  className?: string;
+}
+
+const ChangeRequestsView: React.FC<Props> = (props: Props) => {
+  const { shortcutId, className } = props;
+  const { t } = useTranslation();
+  const shortcutStore = useShortcutStore();
+  const userStore = useUserStore();
+  const currentUser = userStore.getCurrentUser();
+  const shortcut = shortcutStore.getShortcutById(shortcutId);
+  const [changeRequests, setChangeRequests] = useState<ShortcutChangeRequest[]>([]);
+
+  const fetchChangeRequests = async () => {
+    const { changeRequests } = await shortcutServiceClient.listShortcutChangeRequests({ shortcutId });
+    const userIds = new Set(
+      changeRequests.flatMap((changeRequest) => [changeRequest.creatorId, changeRequest.reviewerId]).filter((id) => id > 0),
+    );
+    await Promise.all([...userIds].map((id) => userStore.getOrFetchUserById(id).catch(() => undefined)));
+    setChangeRequests(changeRequests);
+  };
+
+  useEffect(() => {
+    // Only editors and approvers may list change requests; everyone else sees an empty list.
+    fetchChangeRequests().catch(() => setChangeRequests([]));
+  }, [shortcutId, shortcut.updatedTime?.getTime(), shortcut.pendingChangeRequestCount]);
+
+  const getStatusLabel = (changeRequest: ShortcutChangeRequest) => {
+    switch (changeRequest.status) {
+      case ShortcutChangeRequest_Status.APPROVED:
+        return t("shortcut.change-request.approved-by", { user: userStore.getUserById(changeRequest.reviewerId).nickname });
+      case ShortcutChangeRequest_Status.REJECTED:
+        return t("shortcut.change-request.rejected-by", { user: userStore.getUserById(changeRequest.reviewerId).nickname });
+      default:
+        return t("shortcut.change-request.pending");
+    }
+  };
+
+  const handleApproveButtonClick = async (changeRequest: ShortcutChangeRequest) => {
+    try {
+      await shortcutStore.approveShortcutChangeRequest(shortcutId, changeRequest.id);
+      toast.success(t("shortcut.change-request.approved"));
+      await fetchChangeRequests();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  const handleRejectButtonClick = async (changeRequest: ShortcutChangeRequest) => {
+    try {
+      await shortcutStore.rejectShortcutChangeRequest(shortcutId, changeRequest.id);
+      toast.success(t("shortcut.change-request.rejected"));
+      await fetchChangeRequests();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className={classNames("w-full overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800", className)}>
+      <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+        {changeRequests.length === 0 && (
+          <div className="w-full flex flex-row justify-center items-center py-6 text-gray-400">
+            <Icon.PackageOpen className="w-6 h-auto" />
+            <p className="ml-2">{t("shortcut.change-request.no-change-requests")}</p>
+          </div>
+        )}
+        {changeRequests.map((changeRequest) => (
+          <div key={changeRequest.id} className="w-full flex flex-col justify-start items-start gap-1 px-2 py-2 text-sm">
+            <div className="w-full flex flex-row justify-between items-center gap-2">
+              <span className="truncate text-gray-500">
+                {dayjs(changeRequest.createdTime).format("YYYY-MM-DD HH:mm")} · {userStore.getUserById(changeRequest.creatorId).nickname} ·{" "}
+                {getStatusLabel(changeRequest)}
+              </span>
+              {changeRequest.status === ShortcutChangeRequest_Status.PENDING && changeRequest.creatorId !== currentUser.id && (
+                <div className="flex flex-row justify-end items-center">
+                  <Button size="sm" variant="plain" color="success" onClick={() => handleApproveButtonClick(changeRequest)}>
+                    <Icon.Check className="w-4 h-auto mr-1" />
+                    {t("shortcut.change-request.approve")}
+                  </Button>
+                  <Button size="sm" variant="plain" color="danger" onClick={() => handleRejectButtonClick(changeRequest)}>
+                    <Icon.X className="w-4 h-auto mr-1" />
+                    {t("shortcut.change-request.reject")}
+                  </Button>
+                </div>
+              )}
+            </div>
+            {changeRequest.changes.map((change) => (
+              <div key={change.field} className="w-full flex flex-row justify-start items-baseline gap-2">
+                <span className="shrink-0 w-28 text-gray-500">{change.field}</span>
+                <span className="truncate line-through text-red-600 dark:text-red-500">{change.previousValue || "—"}</span>
+                <Icon.ArrowRight className="shrink-0 w-3 h-auto text-gray-400" />
+                <span className="truncate text-green-700 dark:text-green-500">{change.value || "—"}</span>
+              </div>
+            ))}
+          </div>
+        ))}
+      </div>
+    </div>
+  );
+};
+
+export default ChangeRequestsView;
@@ -6,11 +6,10 @@ import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { Link } from "react-router-dom";
 import { userServiceClient } from "@/grpcweb";
-import { absolutifyLink } from "@/helpers/utils";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import useResponsiveWidth from "@/hooks/useResponsiveWidth";
 import { useCollectionStore, useShortcutStore, useUserStore } from "@/stores";
-import { getShortcutUrl } from "@/utils/shortcut";
+import { getCollectionAbsoluteUrl, getCollectionUrl, getShortcutUrl } from "@/utils/shortcut";
 import { Collection } from "@/types/proto/api/v1/collection_service";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import { showCommonDialog } from "./Alert";
@@ -40,14 +39,14 @@ const CollectionView = (props: Props) => {
   const showAdminActions = currentUser.id === collection.creatorId;
 
   const handleCopyCollectionLink = () => {
-    copy(absolutifyLink(`/c/${collection.name}`));
+    copy(getCollectionAbsoluteUrl(collection.name));
     toast.success("Collection link copied to clipboard.");
   };
 
   const handleDeleteCollectionButtonClick = () => {
     showCommonDialog({
       title: "Delete Collection",
-      content: `Are you sure to delete collection \`${collection.name}\`? You cannot undo this action.`,
+      content: `Are you sure to delete collection \`${collection.name}\`? It will be moved to the trash, where you can restore it until it is purged.`,
       style: "danger",
       onConfirm: async () => {
         await collectionStore.deleteCollection(collection.id);
@@ -93,7 +92,7 @@ const CollectionView = (props: Props) => {
             )}
             <div className="flex flex-col justify-start items-start">
               <div className="w-full truncate">
-              <Link className="leading-6 font-medium dark:text-gray-400" to={`/c/${collection.name}`} viewTransition>
+              <Link className="leading-6 font-medium dark:text-gray-400" to={getCollectionUrl(collection.name)} viewTransition>
                 {collection.title}
               </Link>
               <span className="ml-1 leading-6 text-gray-500 dark:text-gray-400" onClick={handleCopyCollectionLink}>
@@ -105,7 +104,7 @@ const CollectionView = (props: Props) => {
           </div>
           <div className="flex flex-row justify-end items-center shrink-0 gap-2">
             <Tooltip title="Share" placement="top" arrow>
-              <Link className="w-auto text-gray-400 cursor-pointer hover:text-gray-500" to={`/c/${collection.name}`} target="_blank">
+              <Link className="w-auto text-gray-400 cursor-pointer hover:text-gray-500" to={getCollectionUrl(collection.name)} target="_blank">
                 <Icon.Share className="w-4 h-auto" />
               </Link>
             </Tooltip>
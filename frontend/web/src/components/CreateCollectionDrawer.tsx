@@ -236,6 +236,18 @@ const CreateCollectionDrawer: React.FC<Props> = (props: Props) => {
                 })
               }
             />
+            <Checkbox
+              className="w-full mt-2 dark:text-gray-400"
+              checked={state.collectionCreate.visibility === Visibility.PRIVATE}
+              label={t(`shortcut.visibility.private.description`)}
+              onChange={(e) =>
+                setPartialState({
+                  collectionCreate: Object.assign(state.collectionCreate, {
+                    visibility: e.target.checked ? Visibility.PRIVATE : Visibility.WORKSPACE,
+                  }),
+                })
+              }
+            />
           </div>
           <Divider className="text-gray-500" />
           <div className="w-full flex flex-col justify-start items-start mt-3 mb-3">
@@ -1,5 +1,19 @@
-import { Button, Checkbox, DialogActions, DialogContent, DialogTitle, Divider, Drawer, Input, ModalClose, Textarea } from "@mui/joy";
+import {
+  Button,
+  Checkbox,
+  DialogActions,
+  DialogContent,
+  DialogTitle,
+  Divider,
+  Drawer,
+  Input,
+  ModalClose,
+  Option,
+  Select,
+  Textarea,
+} from "@mui/joy";
 import classnames from "classnames";
+import dayjs from "dayjs";
 import { isUndefined, uniq } from "lodash-es";
 import { useEffect, useState, useCallback } from "react";
 import { toast } from "react-hot-toast";
@@ -7,11 +21,13 @@ import { useTranslation } from "react-i18next";
 import useLoading from "@/hooks/useLoading";
 import { useShortcutStore, useWorkspaceStore, useUserStore } from "@/stores";
 import { getShortcutUpdateMask } from "@/stores/shortcut";
-import { Visibility } from "@/types/proto/api/v1/common";
-import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
+import { RedirectMode, Visibility } from "@/types/proto/api/v1/common";
+import { Shortcut, Shortcut_Variant } from "@/types/proto/api/v1/shortcut_service";
+import { redirectModeOptions } from "@/utils/shortcut";
 import { fetchPageTitle, debounce, generateUrlFriendlyName } from "@/utils/urlMetadata";
 import Icon from "./Icon";
 import IconUpload from "./IconUpload";
+import RoutingRulesEditor from "./RoutingRulesEditor";
 
 interface Props {
   shortcutId?: number;
@@ -24,6 +40,10 @@ interface State {
   shortcutCreate: Shortcut;
 }
 
+const formatDateTimeInput = (date?: Date) => (date ? dayjs(date).format("YYYY-MM-DDTHH:mm") : "");
+
+const parseDateTimeInput = (value: string) => (value ? dayjs(value).toDate() : undefined);
+
 const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
   const { onClose, onConfirm, shortcutId, initialShortcut } = props;
   const { t } = useTranslation();
@@ -53,6 +73,8 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
   const [isFetchingTitle, setIsFetchingTitle] = useState<boolean>(false);
   const [titleWasManuallyEdited, setTitleWasManuallyEdited] = useState<boolean>(false);
   const [nameWasManuallyEdited, setNameWasManuallyEdited] = useState<boolean>(false);
+  const [keepOldNameAsAlias, setKeepOldNameAsAlias] = useState<boolean>(true);
+  const originalName = shortcutId ? shortcutStore.getShortcutById(shortcutId).name : undefined;
 
   const setPartialState = (partialState: Partial<State>) => {
     setState({
@@ -146,6 +168,12 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
             visibility: shortcut.visibility,
             ogMetadata: shortcut.ogMetadata,
             customIcon: shortcut.customIcon,
+            template: shortcut.template,
+            forwardPath: shortcut.forwardPath,
+            protected: shortcut.protected,
+            redirectMode: shortcut.redirectMode,
+            validFrom: shortcut.validFrom,
+            validUntil: shortcut.validUntil,
           }),
         });
         setTag(shortcut.tags.join(" "));
@@ -257,6 +285,30 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
     }
   };
 
+  const handleVariantChange = (index: number, variant: Partial<Shortcut_Variant>) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        variants: state.shortcutCreate.variants.map((v, i) => (i === index ? { ...v, ...variant } : v)),
+      }),
+    });
+  };
+
+  const handleAddVariant = () => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        variants: [...state.shortcutCreate.variants, Shortcut_Variant.fromPartial({ weight: 1 })],
+      }),
+    });
+  };
+
+  const handleRemoveVariant = (index: number) => {
+    setPartialState({
+      shortcutCreate: Object.assign(state.shortcutCreate, {
+        variants: state.shortcutCreate.variants.filter((_, i) => i !== index),
+      }),
+    });
+  };
+
   const handleCustomIconChange = (iconData: string) => {
     setPartialState({
       shortcutCreate: Object.assign(state.shortcutCreate, {
@@ -285,7 +337,14 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
           id: shortcutId,
           tags,
         };
-        await shortcutStore.updateShortcut(updatingShortcut, getShortcutUpdateMask(originShortcut, updatingShortcut));
+        const updatedShortcut = await shortcutStore.updateShortcut(
+          updatingShortcut,
+          getShortcutUpdateMask(originShortcut, updatingShortcut),
+          keepOldNameAsAlias,
+        );
+        if (updatedShortcut.pendingChangeRequestCount > originShortcut.pendingChangeRequestCount) {
+          toast.success(t("shortcut.change-request.submitted"));
+        }
       } else {
         await shortcutStore.createShortcut({
           ...state.shortcutCreate,
@@ -341,6 +400,36 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
               value={state.shortcutCreate.name}
               onChange={handleNameInputChange}
             />
+            {!isCreating && originalName && state.shortcutCreate.name !== originalName && (
+              <Checkbox
+                className="mt-2 dark:text-gray-400"
+                size="sm"
+                checked={keepOldNameAsAlias}
+                label={t("shortcut.aliases.keep-old-name", { name: originalName })}
+                onChange={(e) => setKeepOldNameAsAlias(e.target.checked)}
+              />
+            )}
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">{t("shortcut.aliases.self")}</span>
+            <Textarea
+              className="w-full"
+              placeholder={t("shortcut.aliases.placeholder")}
+              minRows={1}
+              maxRows={5}
+              defaultValue={state.shortcutCreate.aliases.join("\n")}
+              onBlur={(e) =>
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    aliases: e.target.value
+                      .split("\n")
+                      .map((alias) => alias.trim())
+                      .filter((alias) => alias !== ""),
+                  }),
+                })
+              }
+            />
+            <p className="text-xs text-gray-500 dark:text-gray-400 mt-1">{t("shortcut.aliases.description")}</p>
           </div>
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">Title</span>
@@ -373,6 +462,118 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
               onChange={handleDescriptionInputChange}
             />
           </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Available</span>
+            <div className="w-full flex flex-row justify-start items-center gap-2">
+              <Input
+                className="w-full"
+                type="datetime-local"
+                value={formatDateTimeInput(state.shortcutCreate.validFrom)}
+                onChange={(e) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      validFrom: parseDateTimeInput(e.target.value),
+                    }),
+                  })
+                }
+              />
+              <span className="text-gray-500">to</span>
+              <Input
+                className="w-full"
+                type="datetime-local"
+                value={formatDateTimeInput(state.shortcutCreate.validUntil)}
+                onChange={(e) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      validUntil: parseDateTimeInput(e.target.value),
+                    }),
+                  })
+                }
+              />
+            </div>
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">Variants</span>
+            {state.shortcutCreate.variants.map((variant, index) => (
+              <div key={index} className="w-full flex flex-row justify-start items-center gap-2 mb-2">
+                <Input
+                  className="w-24 shrink-0"
+                  placeholder="Name"
+                  value={variant.name}
+                  onChange={(e) => handleVariantChange(index, { name: e.target.value })}
+                />
+                <Input
+                  className="w-full"
+                  placeholder="https://"
+                  value={variant.link}
+                  onChange={(e) => handleVariantChange(index, { link: e.target.value })}
+                />
+                <Input
+                  className="w-20 shrink-0"
+                  type="number"
+                  placeholder="Weight"
+                  value={variant.weight}
+                  onChange={(e) => handleVariantChange(index, { weight: Number(e.target.value) })}
+                />
+                <Button variant="plain" color="neutral" onClick={() => handleRemoveVariant(index)}>
+                  <Icon.X className="w-4 h-auto" />
+                </Button>
+              </div>
+            ))}
+            <div className="w-full flex flex-row justify-between items-center">
+              <Checkbox
+                className="dark:text-gray-400"
+                checked={state.shortcutCreate.stickyVariants}
+                label={t("shortcut.variants.sticky")}
+                onChange={(e) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      stickyVariants: e.target.checked,
+                    }),
+                  })
+                }
+              />
+              <Button size="sm" variant="plain" startDecorator={<Icon.Plus className="w-4 h-auto" />} onClick={handleAddVariant}>
+                {t("shortcut.variants.add")}
+              </Button>
+            </div>
+            <p className="text-xs text-gray-500 dark:text-gray-400 mt-1">{t("shortcut.variants.description")}</p>
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">{t("shortcut.routing-rules.self")}</span>
+            <RoutingRulesEditor
+              rules={state.shortcutCreate.routingRules}
+              onChange={(routingRules) =>
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    routingRules,
+                  }),
+                })
+              }
+            />
+            <p className="text-xs text-gray-500 dark:text-gray-400 mt-1">{t("shortcut.routing-rules.description")}</p>
+          </div>
+          <div className="w-full flex flex-col justify-start items-start mb-3">
+            <span className="mb-2">{t("shortcut.failover.backup-links")}</span>
+            <Textarea
+              className="w-full"
+              placeholder="https://"
+              minRows={2}
+              maxRows={5}
+              defaultValue={state.shortcutCreate.backupLinks.join("\n")}
+              onBlur={(e) =>
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    backupLinks: e.target.value
+                      .split("\n")
+                      .map((link) => link.trim())
+                      .filter((link) => link !== ""),
+                  }),
+                })
+              }
+            />
+            <p className="text-xs text-gray-500 dark:text-gray-400 mt-1">{t("shortcut.failover.description")}</p>
+          </div>
           <div className="w-full flex flex-col justify-start items-start mb-3">
             <span className="mb-2">Custom Icon</span>
             <IconUpload
@@ -422,6 +623,80 @@ const CreateShortcutDrawer: React.FC<Props> = (props: Props) => {
                 });
               }}
             />
+            <Checkbox
+              className="w-full mt-2 dark:text-gray-400"
+              checked={state.shortcutCreate.visibility === Visibility.PRIVATE}
+              label={t(`shortcut.visibility.private.description`)}
+              onChange={(e) => {
+                e.stopPropagation();
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    visibility: e.target.checked ? Visibility.PRIVATE : Visibility.WORKSPACE,
+                  }),
+                });
+              }}
+            />
+            <Checkbox
+              className="w-full mt-2 dark:text-gray-400"
+              checked={state.shortcutCreate.template}
+              label={t(`shortcut.template.description`)}
+              onChange={(e) => {
+                e.stopPropagation();
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    template: e.target.checked,
+                  }),
+                });
+              }}
+            />
+            <Checkbox
+              className="w-full mt-2 dark:text-gray-400"
+              checked={state.shortcutCreate.forwardPath}
+              label={t(`shortcut.forward-path.description`)}
+              onChange={(e) => {
+                e.stopPropagation();
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    forwardPath: e.target.checked,
+                  }),
+                });
+              }}
+            />
+            <Checkbox
+              className="w-full mt-2 dark:text-gray-400"
+              checked={state.shortcutCreate.protected}
+              label={t(`shortcut.protected.description`)}
+              onChange={(e) => {
+                e.stopPropagation();
+                setPartialState({
+                  shortcutCreate: Object.assign(state.shortcutCreate, {
+                    protected: e.target.checked,
+                  }),
+                });
+              }}
+            />
+            <div className="w-full flex flex-row justify-between items-center mt-2">
+              <span className="text-sm dark:text-gray-400">Redirect mode</span>
+              <Select
+                size="sm"
+                className="w-60"
+                value={state.shortcutCreate.redirectMode}
+                onChange={(_, value) =>
+                  setPartialState({
+                    shortcutCreate: Object.assign(state.shortcutCreate, {
+                      redirectMode: value as RedirectMode,
+                    }),
+                  })
+                }
+              >
+                <Option value={RedirectMode.REDIRECT_MODE_UNSPECIFIED}>Workspace default</Option>
+                {redirectModeOptions.map((option) => (
+                  <Option key={option.value} value={option.value}>
+                    {option.label}
+                  </Option>
+                ))}
+              </Select>
+            </div>
           </div>
           <Divider className="text-gray-500">More</Divider>
           <div className="w-full flex flex-col justify-start items-start border rounded-md mt-3 overflow-hidden dark:border-zinc-800">
@@ -0,0 +1,136 @@
+import { Button, Modal, ModalDialog, Option, Radio, RadioGroup, Select } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { groupServiceClient, userServiceClient } from "@/grpcweb";
+import useLoading from "@/hooks/useLoading";
+import { useUserStore } from "@/stores";
+import { State } from "@/types/proto/api/v1/common";
+import { Group } from "@/types/proto/api/v1/group_service";
+import { DeleteUserRequest_ContentDisposition, PreviewDeleteUserResponse, User } from "@/types/proto/api/v1/user_service";
+import Icon from "./Icon";
+
+interface Props {
+  user: User;
+  onClose: () => void;
+}
+
+const DeleteUserDialog: React.FC<Props> = (props: Props) => {
+  const { user, onClose } = props;
+  const { t } = useTranslation();
+  const userStore = useUserStore();
+  const [preview, setPreview] = useState<PreviewDeleteUserResponse>();
+  const [groups, setGroups] = useState<Group[]>([]);
+  const [disposition, setDisposition] = useState<DeleteUserRequest_ContentDisposition>(DeleteUserRequest_ContentDisposition.TRANSFER);
+  // Either "user:<id>" or "group:<id>".
+  const [transferTarget, setTransferTarget] = useState<string>("");
+  const requestState = useLoading(false);
+  const transferUsers = Object.values(userStore.userMapById).filter((u) => u.id !== user.id && u.state !== State.INACTIVE);
+  // Groups are shared with their members, so they can be transferred but not deleted.
+  const ownsGroups = preview !== undefined && preview.groups.length > 0;
+  const hasContent = preview !== undefined && (preview.shortcuts.length > 0 || preview.collections.length > 0 || ownsGroups);
+
+  useEffect(() => {
+    (async () => {
+      try {
+        const [preview, { groups }] = await Promise.all([
+          userServiceClient.previewDeleteUser({ id: user.id }),
+          groupServiceClient.listGroups({}),
+        ]);
+        setPreview(preview);
+        setGroups(groups.filter((group) => group.creatorId !== user.id));
+      } catch (error: any) {
+        toast.error(error.details);
+      }
+      requestState.setFinish();
+    })();
+  }, [user.id]);
+
+  const handleConfirmBtnClick = async () => {
+    const [targetType, targetId] = transferTarget.split(":");
+    if (hasContent && disposition === DeleteUserRequest_ContentDisposition.TRANSFER && !targetId) {
+      toast.error(t("user.delete.transfer-target-required"));
+      return;
+    }
+
+    requestState.setLoading();
+    try {
+      await userStore.deleteUser(user.id, {
+        contentDisposition: hasContent ? disposition : DeleteUserRequest_ContentDisposition.DELETE,
+        transferUserId: targetType === "user" ? Number(targetId) : 0,
+        transferGroupId: targetType === "group" ? Number(targetId) : 0,
+      });
+      toast.success(t("user.delete.success", { nickname: user.nickname }));
+      onClose();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+    requestState.setFinish();
+  };
+
+  return (
+    <Modal open={true}>
+      <ModalDialog>
+        <div className="flex flex-row justify-between items-center w-80 sm:w-96">
+          <span className="text-lg font-medium">{t("user.delete.self")}</span>
+          <Button variant="plain" onClick={onClose}>
+            <Icon.X className="w-5 h-auto text-gray-600" />
+          </Button>
+        </div>
+        <div className="w-80 sm:w-96">
+          {preview && (
+            <p className="mb-3 text-gray-600 dark:text-gray-400">
+              {t("user.delete.preview", {
+                nickname: user.nickname,
+                shortcuts: preview.shortcuts.length,
+                collections: preview.collections.length,
+                groups: preview.groups.length,
+              })}
+            </p>
+          )}
+          {hasContent && (
+            <div className="w-full flex flex-col justify-start items-start mb-3">
+              <span className="mb-2">{t("user.delete.disposition.self")}</span>
+              <RadioGroup value={disposition} onChange={(e) => setDisposition(e.target.value as DeleteUserRequest_ContentDisposition)}>
+                <Radio value={DeleteUserRequest_ContentDisposition.TRANSFER} label={t("user.delete.disposition.transfer")} />
+                <Radio value={DeleteUserRequest_ContentDisposition.ARCHIVE} label={t("user.delete.disposition.archive")} />
+                {!ownsGroups && <Radio value={DeleteUserRequest_ContentDisposition.DELETE} label={t("user.delete.disposition.delete")} />}
+              </RadioGroup>
+            </div>
+          )}
+          {hasContent && disposition === DeleteUserRequest_ContentDisposition.TRANSFER && (
+            <Select
+              className="w-full"
+              placeholder={t("user.delete.transfer-target")}
+              value={transferTarget || null}
+              onChange={(_, value) => setTransferTarget(value || "")}
+            >
+              {transferUsers.map((u) => (
+                <Option key={`user:${u.id}`} value={`user:${u.id}`}>
+                  {u.nickname}
+                </Option>
+              ))}
+              {groups.map((group) => (
+                <Option key={`group:${group.id}`} value={`group:${group.id}`}>
+                  <Icon.Users className="w-4 h-auto" />
+                  {group.name}
+                </Option>
+              ))}
+            </Select>
+          )}
+          <div className="w-full flex flex-row justify-end items-center mt-4 space-x-2">
+            <Button color="neutral" variant="plain" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={onClose}>
+              {t("common.cancel")}
+            </Button>
+            <Button color="danger" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={handleConfirmBtnClick}>
+              {t("common.delete")}
+            </Button>
+          </div>
+        </div>
+      </ModalDialog>
+    </Modal>
+  );
+};
+
+export default DeleteUserDialog;
@@ -0,0 +1,100 @@
+import { Button, Input } from "@mui/joy";
+import classNames from "classnames";
+import dayjs from "dayjs";
+import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { shortcutServiceClient } from "@/grpcweb";
+import { ShortcutLinkChange } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcutId: number;
+  className?: string;
+}
+
+const LinkChangesView: React.FC<Props> = (props: Props) => {
+  const { shortcutId, className } = props;
+  const { t } = useTranslation();
+  const [linkChanges, setLinkChanges] = useState<ShortcutLinkChange[]>([]);
+  const [effectiveTime, setEffectiveTime] = useState<string>("");
+  const [link, setLink] = useState<string>("");
+
+  const fetchLinkChanges = async () => {
+    const { linkChanges } = await shortcutServiceClient.listShortcutLinkChanges({ shortcutId });
+    setLinkChanges(linkChanges);
+  };
+
+  useEffect(() => {
+    fetchLinkChanges();
+  }, [shortcutId]);
+
+  const handleScheduleButtonClick = async () => {
+    if (!effectiveTime || !link) {
+      toast.error("Please fill in required fields.");
+      return;
+    }
+    try {
+      await shortcutServiceClient.createShortcutLinkChange({
+        linkChange: {
+          shortcutId,
+          effectiveTime: dayjs(effectiveTime).toDate(),
+          link,
+        },
+      });
+      setEffectiveTime("");
+      setLink("");
+      await fetchLinkChanges();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  const handleCancelButtonClick = async (linkChange: ShortcutLinkChange) => {
+    try {
+      await shortcutServiceClient.cancelShortcutLinkChange({ shortcutId, id: linkChange.id });
+      await fetchLinkChanges();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className={classNames("w-full flex flex-col justify-start items-start gap-2", className)}>
+      <div className="w-full overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
+        <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+          {linkChanges.length === 0 && (
+            <div className="w-full flex flex-row justify-center items-center py-6 text-gray-400">
+              <Icon.PackageOpen className="w-6 h-auto" />
+              <p className="ml-2">No scheduled changes.</p>
+            </div>
+          )}
+          {linkChanges.map((linkChange) => (
+            <div key={linkChange.id} className="w-full flex flex-row justify-between items-center gap-2 px-2 py-2 text-sm">
+              <span className="shrink-0 text-gray-500">{dayjs(linkChange.effectiveTime).format("YYYY-MM-DD HH:mm")}</span>
+              <span className="w-full truncate text-gray-900 dark:text-gray-500">{linkChange.link}</span>
+              {linkChange.appliedTime ? (
+                <span className="shrink-0 text-gray-400">{t("shortcut.link-change.applied")}</span>
+              ) : (
+                <Button size="sm" variant="plain" color="neutral" onClick={() => handleCancelButtonClick(linkChange)}>
+                  {t("common.cancel")}
+                </Button>
+              )}
+            </div>
+          ))}
+        </div>
+      </div>
+      <div className="w-full flex flex-row justify-start items-center gap-2">
+        <Input className="shrink-0" type="datetime-local" value={effectiveTime} onChange={(e) => setEffectiveTime(e.target.value)} />
+        <Input className="w-full" placeholder="https://" value={link} onChange={(e) => setLink(e.target.value)} />
+        <Button className="shrink-0" onClick={handleScheduleButtonClick}>
+          {t("shortcut.link-change.schedule")}
+        </Button>
+      </div>
+    </div>
+  );
+};
+
+export default LinkChangesView;
@@ -3,11 +3,17 @@ import { FormEvent, useState } from "react";
 import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { authServiceClient } from "@/grpcweb";
+import { getContinueLink } from "@/helpers/utils";
 import useLoading from "@/hooks/useLoading";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import { useUserStore } from "@/stores";
 
-const PasswordAuthForm = () => {
+interface Props {
+  // The signed target to return to after signing in.
+  redirect?: string | null;
+}
+
+const PasswordAuthForm = ({ redirect }: Props) => {
   const { t } = useTranslation();
   const navigateTo = useNavigateTo();
   const userStore = useUserStore();
@@ -38,6 +44,10 @@ const PasswordAuthForm = () => {
       if (user) {
         userStore.setCurrentUserId(user.id);
         await userStore.fetchCurrentUser();
+        if (redirect) {
+          window.location.href = getContinueLink(redirect);
+          return;
+        }
         navigateTo("/");
       } else {
         toast.error("Signin failed");
@@ -0,0 +1,102 @@
+import { Button } from "@mui/joy";
+import classNames from "classnames";
+import dayjs from "dayjs";
+import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { shortcutServiceClient } from "@/grpcweb";
+import { useShortcutStore, useUserStore } from "@/stores";
+import { ShortcutRevision } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  shortcutId: number;
+  className?: string;
+}
+
+const RevisionHistoryView: React.FC<Props> = (props: Props) => {
+  const { shortcutId, className } = props;
+  const { t } = useTranslation();
+  const shortcutStore = useShortcutStore();
+  const userStore = useUserStore();
+  const shortcut = shortcutStore.getShortcutById(shortcutId);
+  const [revisions, setRevisions] = useState<ShortcutRevision[]>([]);
+
+  const fetchRevisions = async () => {
+    const { revisions } = await shortcutServiceClient.listShortcutRevisions({ shortcutId });
+    const creatorIds = new Set(revisions.map((revision) => revision.creatorId).filter((id) => id > 0));
+    await Promise.all([...creatorIds].map((id) => userStore.getOrFetchUserById(id).catch(() => undefined)));
+    setRevisions(revisions);
+  };
+
+  useEffect(() => {
+    fetchRevisions();
+  }, [shortcutId, shortcut.updatedTime?.getTime()]);
+
+  const getCreatorName = (creatorId: number) => {
+    if (creatorId === 0) {
+      return t("shortcut.revision.system");
+    }
+    return userStore.getUserById(creatorId).nickname;
+  };
+
+  const getRevisionTime = (id: number) => {
+    const revision = revisions.find((revision) => revision.id === id);
+    return revision ? dayjs(revision.createdTime).format("YYYY-MM-DD HH:mm") : `#${id}`;
+  };
+
+  const handleRestoreButtonClick = async (revision: ShortcutRevision) => {
+    try {
+      const restoredRevision = await shortcutStore.restoreShortcutRevision(shortcutId, revision.id);
+      if (!restoredRevision) {
+        toast(t("shortcut.revision.already-current"));
+        return;
+      }
+      toast.success(t("shortcut.revision.restored"));
+      await fetchRevisions();
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className={classNames("w-full overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800", className)}>
+      <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+        {revisions.length === 0 && (
+          <div className="w-full flex flex-row justify-center items-center py-6 text-gray-400">
+            <Icon.PackageOpen className="w-6 h-auto" />
+            <p className="ml-2">{t("shortcut.revision.no-revisions")}</p>
+          </div>
+        )}
+        {revisions.map((revision, index) => (
+          <div key={revision.id} className="w-full flex flex-col justify-start items-start gap-1 px-2 py-2 text-sm">
+            <div className="w-full flex flex-row justify-between items-center gap-2">
+              <span className="truncate text-gray-500">
+                {dayjs(revision.createdTime).format("YYYY-MM-DD HH:mm")} · {getCreatorName(revision.creatorId)}
+                {revision.restoredRevisionId > 0 && ` · ${t("shortcut.revision.rollback", { time: getRevisionTime(revision.restoredRevisionId) })}`}
+                {revision.changes.length === 0 && ` · ${t("shortcut.revision.baseline")}`}
+              </span>
+              {index > 0 && (
+                <Button size="sm" variant="plain" color="neutral" onClick={() => handleRestoreButtonClick(revision)}>
+                  <Icon.RotateCcw className="w-4 h-auto mr-1" />
+                  {t("shortcut.revision.restore")}
+                </Button>
+              )}
+            </div>
+            {revision.changes.map((change) => (
+              <div key={change.field} className="w-full flex flex-row justify-start items-baseline gap-2">
+                <span className="shrink-0 w-28 text-gray-500">{change.field}</span>
+                <span className="truncate line-through text-red-600 dark:text-red-500">{change.previousValue || "—"}</span>
+                <Icon.ArrowRight className="shrink-0 w-3 h-auto text-gray-400" />
+                <span className="truncate text-green-700 dark:text-green-500">{change.value || "—"}</span>
+              </div>
+            ))}
+          </div>
+        ))}
+      </div>
+    </div>
+  );
+};
+
+export default RevisionHistoryView;
@@ -0,0 +1,68 @@
+import { Button, Input } from "@mui/joy";
+import classNames from "classnames";
+import { useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { shortcutServiceClient } from "@/grpcweb";
+import { DryRunShortcutRoutingResponse } from "@/types/proto/api/v1/shortcut_service";
+
+interface Props {
+  shortcutId: number;
+  className?: string;
+}
+
+const RoutingDryRunView: React.FC<Props> = (props: Props) => {
+  const { shortcutId, className } = props;
+  const { t } = useTranslation();
+  const [userAgent, setUserAgent] = useState<string>(navigator.userAgent);
+  const [acceptLanguage, setAcceptLanguage] = useState<string>(navigator.languages.join(","));
+  const [result, setResult] = useState<DryRunShortcutRoutingResponse>();
+
+  const handleDryRunButtonClick = async () => {
+    try {
+      const response = await shortcutServiceClient.dryRunShortcutRouting({
+        id: shortcutId,
+        headers: {
+          "User-Agent": userAgent,
+          "Accept-Language": acceptLanguage,
+        },
+      });
+      setResult(response);
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className={classNames("w-full flex flex-col justify-start items-start gap-2", className)}>
+      <Input className="w-full" placeholder="User-Agent" value={userAgent} onChange={(e) => setUserAgent(e.target.value)} />
+      <div className="w-full flex flex-row justify-start items-center gap-2">
+        <Input
+          className="w-full"
+          placeholder="Accept-Language"
+          value={acceptLanguage}
+          onChange={(e) => setAcceptLanguage(e.target.value)}
+        />
+        <Button className="shrink-0" onClick={handleDryRunButtonClick}>
+          {t("shortcut.routing-rules.dry-run")}
+        </Button>
+      </div>
+      {result && (
+        <div className="w-full flex flex-col justify-start items-start gap-1 px-2 py-2 text-sm">
+          <span className="text-gray-500">
+            {[result.device, result.os, result.browser, result.language].filter((property) => property !== "").join(" · ")}
+          </span>
+          <span className="text-gray-500">
+            {result.matchedRule >= 0
+              ? t("shortcut.routing-rules.matched-rule", { index: result.matchedRule + 1 })
+              : t("shortcut.routing-rules.fallback")}
+          </span>
+          <span className="w-full truncate text-gray-900 dark:text-gray-500">{result.link}</span>
+        </div>
+      )}
+    </div>
+  );
+};
+
+export default RoutingDryRunView;
@@ -0,0 +1,142 @@
+import { Button, Input, Option, Select } from "@mui/joy";
+import { useTranslation } from "react-i18next";
+import { Shortcut_RoutingCondition, Shortcut_RoutingCondition_Field, Shortcut_RoutingRule } from "@/types/proto/api/v1/shortcut_service";
+import Icon from "./Icon";
+
+interface Props {
+  rules: Shortcut_RoutingRule[];
+  onChange: (rules: Shortcut_RoutingRule[]) => void;
+}
+
+const conditionFields = [
+  Shortcut_RoutingCondition_Field.DEVICE,
+  Shortcut_RoutingCondition_Field.OS,
+  Shortcut_RoutingCondition_Field.BROWSER,
+  Shortcut_RoutingCondition_Field.LANGUAGE,
+  Shortcut_RoutingCondition_Field.HEADER,
+];
+
+const valuePlaceholders: Partial<Record<Shortcut_RoutingCondition_Field, string>> = {
+  [Shortcut_RoutingCondition_Field.DEVICE]: "mobile, tablet, desktop, bot",
+  [Shortcut_RoutingCondition_Field.OS]: "ios, android, windows, macos, linux",
+  [Shortcut_RoutingCondition_Field.BROWSER]: "chrome, firefox, safari",
+  [Shortcut_RoutingCondition_Field.LANGUAGE]: "de, fr-CA",
+  [Shortcut_RoutingCondition_Field.HEADER]: "Any value",
+};
+
+const splitValues = (value: string) => (value.trim() === "" ? [] : value.split(",").map((v) => v.trim()));
+
+const RoutingRulesEditor: React.FC<Props> = (props: Props) => {
+  const { rules, onChange } = props;
+  const { t } = useTranslation();
+
+  const handleRuleChange = (index: number, rule: Partial<Shortcut_RoutingRule>) => {
+    onChange(rules.map((r, i) => (i === index ? { ...r, ...rule } : r)));
+  };
+
+  const handleConditionChange = (ruleIndex: number, conditionIndex: number, condition: Partial<Shortcut_RoutingCondition>) => {
+    const rule = rules[ruleIndex];
+    handleRuleChange(ruleIndex, {
+      conditions: rule.conditions.map((c, i) => (i === conditionIndex ? { ...c, ...condition } : c)),
+    });
+  };
+
+  const handleAddCondition = (ruleIndex: number) => {
+    const rule = rules[ruleIndex];
+    handleRuleChange(ruleIndex, {
+      conditions: [...rule.conditions, Shortcut_RoutingCondition.fromPartial({ field: Shortcut_RoutingCondition_Field.DEVICE })],
+    });
+  };
+
+  const handleRemoveCondition = (ruleIndex: number, conditionIndex: number) => {
+    const rule = rules[ruleIndex];
+    handleRuleChange(ruleIndex, {
+      conditions: rule.conditions.filter((_, i) => i !== conditionIndex),
+    });
+  };
+
+  const handleAddRule = () => {
+    onChange([
+      ...rules,
+      Shortcut_RoutingRule.fromPartial({
+        conditions: [Shortcut_RoutingCondition.fromPartial({ field: Shortcut_RoutingCondition_Field.DEVICE })],
+      }),
+    ]);
+  };
+
+  const handleRemoveRule = (index: number) => {
+    onChange(rules.filter((_, i) => i !== index));
+  };
+
+  return (
+    <div className="w-full flex flex-col justify-start items-start gap-2">
+      {rules.map((rule, ruleIndex) => (
+        <div key={ruleIndex} className="w-full flex flex-col justify-start items-start gap-2 p-2 border rounded-lg dark:border-zinc-800">
+          {rule.conditions.map((condition, conditionIndex) => (
+            <div key={conditionIndex} className="w-full flex flex-row justify-start items-center gap-2">
+              <Select
+                className="w-32 shrink-0"
+                size="sm"
+                value={condition.field}
+                onChange={(_, value) => handleConditionChange(ruleIndex, conditionIndex, { field: value as Shortcut_RoutingCondition_Field })}
+              >
+                {conditionFields.map((field) => (
+                  <Option key={field} value={field}>
+                    {t(`shortcut.routing-rules.fields.${field.toLowerCase()}`)}
+                  </Option>
+                ))}
+              </Select>
+              {condition.field === Shortcut_RoutingCondition_Field.HEADER && (
+                <Input
+                  className="w-32 shrink-0"
+                  size="sm"
+                  placeholder="X-Header"
+                  value={condition.header}
+                  onChange={(e) => handleConditionChange(ruleIndex, conditionIndex, { header: e.target.value })}
+                />
+              )}
+              <Input
+                className="w-full"
+                size="sm"
+                placeholder={valuePlaceholders[condition.field]}
+                value={condition.values.join(", ")}
+                onChange={(e) => handleConditionChange(ruleIndex, conditionIndex, { values: splitValues(e.target.value) })}
+              />
+              <Button size="sm" variant="plain" color="neutral" onClick={() => handleRemoveCondition(ruleIndex, conditionIndex)}>
+                <Icon.X className="w-4 h-auto" />
+              </Button>
+            </div>
+          ))}
+          <div className="w-full flex flex-row justify-start items-center gap-2">
+            <Icon.CornerDownRight className="w-4 h-auto shrink-0 text-gray-400" />
+            <Input
+              className="w-full"
+              size="sm"
+              placeholder="https://"
+              value={rule.link}
+              onChange={(e) => handleRuleChange(ruleIndex, { link: e.target.value })}
+            />
+          </div>
+          <div className="w-full flex flex-row justify-between items-center">
+            <Button
+              size="sm"
+              variant="plain"
+              startDecorator={<Icon.Plus className="w-4 h-auto" />}
+              onClick={() => handleAddCondition(ruleIndex)}
+            >
+              {t("shortcut.routing-rules.add-condition")}
+            </Button>
+            <Button size="sm" variant="plain" color="danger" onClick={() => handleRemoveRule(ruleIndex)}>
+              {t("common.delete")}
+            </Button>
+          </div>
+        </div>
+      ))}
+      <Button size="sm" variant="plain" startDecorator={<Icon.Plus className="w-4 h-auto" />} onClick={handleAddRule}>
+        {t("shortcut.routing-rules.add-rule")}
+      </Button>
+    </div>
+  );
+};
+
+export default RoutingRulesEditor;
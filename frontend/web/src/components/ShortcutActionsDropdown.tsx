@@ -27,7 +27,7 @@ const ShortcutActionsDropdown = (props: Props) => {
   const handleDeleteShortcutButtonClick = (shortcut: Shortcut) => {
     showCommonDialog({
       title: "Delete Shortcut",
-      content: `Are you sure to delete shortcut \`${shortcut.name}\`? You cannot undo this action.`,
+      content: `Are you sure to delete shortcut \`${shortcut.name}\`? It will be moved to the trash, where you can restore it until it is purged.`,
       style: "danger",
       onConfirm: async () => {
         await shortcutStore.deleteShortcut(shortcut.id);
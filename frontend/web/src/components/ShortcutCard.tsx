@@ -5,9 +5,8 @@ import { useEffect } from "react";
 import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { Link } from "react-router-dom";
-import { absolutifyLink } from "@/helpers/utils";
 import { useUserStore, useViewStore } from "@/stores";
-import { getShortcutUrl } from "@/utils/shortcut";
+import { getShortcutAbsoluteUrl } from "@/utils/shortcut";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import CustomIcon from "./CustomIcon";
 import Icon from "./Icon";
@@ -25,7 +24,7 @@ const ShortcutCard = (props: Props) => {
   const userStore = useUserStore();
   const viewStore = useViewStore();
   const creator = userStore.getUserById(shortcut.creatorId);
-  const shortcutLink = absolutifyLink(getShortcutUrl(shortcut.name));
+  const shortcutLink = getShortcutAbsoluteUrl(shortcut.name);
 
   useEffect(() => {
     userStore.getOrFetchUserById(shortcut.creatorId);
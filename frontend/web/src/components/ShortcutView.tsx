@@ -2,9 +2,8 @@ import classNames from "classnames";
 import copy from "copy-to-clipboard";
 import toast from "react-hot-toast";
 import { Link } from "react-router-dom";
-import { absolutifyLink } from "@/helpers/utils";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
-import { getShortcutUrl } from "@/utils/shortcut";
+import { getShortcutAbsoluteUrl, getShortcutUrl } from "@/utils/shortcut";
 import CustomIcon from "./CustomIcon";
 import Icon from "./Icon";
 import ShortcutActionsDropdown from "./ShortcutActionsDropdown";
@@ -19,7 +18,7 @@ interface Props {
 
 const ShortcutView = (props: Props) => {
   const { shortcut, className, showActions, alwaysShowLink, onClick } = props;
-  const shortcutLink = absolutifyLink(getShortcutUrl(shortcut.name));
+  const shortcutLink = getShortcutAbsoluteUrl(shortcut.name);
 
   const handleCopyButtonClick = (e: React.MouseEvent) => {
     e.preventDefault();
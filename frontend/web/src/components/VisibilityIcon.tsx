@@ -12,6 +12,10 @@ const VisibilityIcon = (props: Props) => {
     return <Icon.Building2 className={className || ""} />;
   } else if (visibility === Visibility.PUBLIC) {
     return <Icon.Globe2 className={className || ""} />;
+  } else if (visibility === Visibility.PRIVATE) {
+    return <Icon.Lock className={className || ""} />;
+  } else if (visibility === Visibility.GROUP) {
+    return <Icon.Users className={className || ""} />;
   }
   return null;
 };
@@ -0,0 +1,151 @@
+import { Button } from "@mui/joy";
+import dayjs from "dayjs";
+import { useEffect, useState } from "react";
+import { toast } from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import { showCommonDialog } from "@/components/Alert";
+import Icon from "@/components/Icon";
+import { collectionServiceClient, shortcutServiceClient } from "@/grpcweb";
+import { useCollectionStore, useShortcutStore } from "@/stores";
+import { Collection } from "@/types/proto/api/v1/collection_service";
+import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
+
+interface TrashItem {
+  kind: "shortcut" | "collection";
+  id: number;
+  name: string;
+  title: string;
+  deletedTime?: Date;
+  purgeTime?: Date;
+}
+
+const listTrashItems = async (): Promise<TrashItem[]> => {
+  const [{ shortcuts }, { collections }] = await Promise.all([
+    shortcutServiceClient.listTrashedShortcuts({}),
+    collectionServiceClient.listTrashedCollections({}),
+  ]);
+  const items: TrashItem[] = [
+    ...shortcuts.map((shortcut: Shortcut) => ({ kind: "shortcut" as const, ...shortcut })),
+    ...collections.map((collection: Collection) => ({ kind: "collection" as const, ...collection })),
+  ];
+  return items.sort((a, b) => (b.deletedTime?.getTime() ?? 0) - (a.deletedTime?.getTime() ?? 0));
+};
+
+const TrashSection = () => {
+  const { t } = useTranslation();
+  const shortcutStore = useShortcutStore();
+  const collectionStore = useCollectionStore();
+  const [items, setItems] = useState<TrashItem[]>([]);
+
+  useEffect(() => {
+    listTrashItems().then((items) => {
+      setItems(items);
+    });
+  }, []);
+
+  const removeItem = (item: TrashItem) => {
+    setItems(items.filter((i) => i.kind !== item.kind || i.id !== item.id));
+  };
+
+  const handleRestore = async (item: TrashItem) => {
+    try {
+      if (item.kind === "shortcut") {
+        await shortcutStore.restoreShortcut(item.id);
+      } else {
+        await collectionStore.restoreCollection(item.id);
+      }
+      removeItem(item);
+      toast.success(t("trash.restored", { name: item.name }));
+    } catch (error: any) {
+      console.error(error);
+      toast.error(error.details);
+    }
+  };
+
+  const handlePurge = (item: TrashItem) => {
+    showCommonDialog({
+      title: t("trash.purge"),
+      content: t("trash.purge-confirm", { name: item.name }),
+      style: "danger",
+      onConfirm: async () => {
+        if (item.kind === "shortcut") {
+          await shortcutServiceClient.purgeShortcut({ id: item.id });
+        } else {
+          await collectionServiceClient.purgeCollection({ id: item.id });
+        }
+        removeItem(item);
+      },
+    });
+  };
+
+  return (
+    <div className="w-full flex flex-col justify-start items-start space-y-4">
+      <div className="w-full">
+        <p className="text-2xl shrink-0 font-semibold text-gray-900 dark:text-gray-500">{t("trash.self")}</p>
+        <p className="mt-2 text-sm text-gray-700 dark:text-gray-600">{t("trash.description")}</p>
+        <div className="mt-2 flow-root">
+          <div className="overflow-x-auto">
+            <div className="inline-block min-w-full py-2 align-middle">
+              <table className="min-w-full divide-y divide-gray-300 dark:divide-zinc-700">
+                <thead>
+                  <tr>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      {t("trash.name")}
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      {t("trash.deleted-at")}
+                    </th>
+                    <th scope="col" className="px-3 py-3.5 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                      {t("trash.purged-at")}
+                    </th>
+                    <th scope="col" className="relative py-3.5 pl-3 pr-4">
+                      <span className="sr-only">{t("trash.restore")}</span>
+                    </th>
+                  </tr>
+                </thead>
+                <tbody className="divide-y divide-gray-200 dark:divide-zinc-800">
+                  {items.length === 0 && (
+                    <tr>
+                      <td colSpan={4} className="px-3 py-4 text-sm text-center text-gray-400">
+                        {t("trash.empty")}
+                      </td>
+                    </tr>
+                  )}
+                  {items.map((item) => (
+                    <tr key={`${item.kind}-${item.id}`}>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-900 dark:text-gray-500">
+                        <span className="flex flex-row justify-start items-center gap-x-1">
+                          {item.kind === "shortcut" ? (
+                            <Icon.Link className="w-4 h-auto text-gray-500" />
+                          ) : (
+                            <Icon.FolderOpen className="w-4 h-auto text-gray-500" />
+                          )}
+                          <span className="font-mono">{item.name}</span>
+                          {item.title && <span className="text-gray-500 truncate">({item.title})</span>}
+                        </span>
+                      </td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">{dayjs(item.deletedTime).format("YYYY-MM-DD HH:mm")}</td>
+                      <td className="whitespace-nowrap px-3 py-4 text-sm text-gray-500">{dayjs(item.purgeTime).format("YYYY-MM-DD HH:mm")}</td>
+                      <td className="relative whitespace-nowrap py-4 pl-3 pr-4 text-right text-sm">
+                        <Button size="sm" variant="plain" color="neutral" onClick={() => handleRestore(item)}>
+                          <Icon.RotateCcw className="w-4 h-auto mr-1" />
+                          {t("trash.restore")}
+                        </Button>
+                        <Button size="sm" variant="plain" color="danger" onClick={() => handlePurge(item)}>
+                          <Icon.Trash className="w-4 h-auto mr-1" />
+                          {t("trash.purge")}
+                        </Button>
+                      </td>
+                    </tr>
+                  ))}
+                </tbody>
+              </table>
+            </div>
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default TrashSection;
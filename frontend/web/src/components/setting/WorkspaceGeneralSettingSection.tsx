@@ -1,4 +1,4 @@
-import { Button, Input, Option, Select, Textarea } from "@mui/joy";
+import { Button, Input, Option, Select, Switch, Textarea } from "@mui/joy";
 import { head, isEqual } from "lodash-es";
 import { useRef, useState } from "react";
 import toast from "react-hot-toast";
@@ -6,8 +6,9 @@ import { useTranslation } from "react-i18next";
 import { workspaceServiceClient } from "@/grpcweb";
 import { useWorkspaceStore } from "@/stores";
 import { FeatureType } from "@/stores/workspace";
-import { Visibility } from "@/types/proto/api/v1/common";
-import { WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+import { RedirectMode, Visibility } from "@/types/proto/api/v1/common";
+import { ShortcutNamePolicy, WorkspaceSetting } from "@/types/proto/api/v1/workspace_service";
+import { redirectModeOptions } from "@/utils/shortcut";
 import FeatureBadge from "../FeatureBadge";
 import Icon from "../Icon";
 import monotremeLogo from "@/images/monotreme.png";
@@ -62,6 +63,13 @@ const WorkspaceGeneralSettingSection = () => {
     });
   };
 
+  const handleDefaultRedirectModeChange = async (value: RedirectMode) => {
+    setWorkspaceSetting({
+      ...workspaceSetting,
+      defaultRedirectMode: value,
+    });
+  };
+
   const validateShortcutPrefix = (prefix: string): string | null => {
     if (!prefix || prefix.trim() === "") {
       return "Prefix cannot be empty";
@@ -75,7 +83,25 @@ const WorkspaceGeneralSettingSection = () => {
     }
 
     // Check for conflicts with existing routes
-    const reservedPaths = ["api", "auth", "setting", "admin", "c", "shortcuts", "collections", "tags"];
+    const reservedPaths = [
+      "about",
+      "admin",
+      "api",
+      "api-docs",
+      "assets",
+      "auth",
+      "collections",
+      "export",
+      "healthz",
+      "landing",
+      "quick-save",
+      "rss",
+      "setting",
+      "shortcut",
+      "shortcuts",
+      "stats",
+      "tags",
+    ];
     if (reservedPaths.includes(trimmedPrefix.toLowerCase())) {
       return "This prefix conflicts with an existing route";
     }
@@ -91,19 +117,38 @@ const WorkspaceGeneralSettingSection = () => {
     });
   };
 
+  const handleShortcutNamePolicyChange = (policy: Partial<ShortcutNamePolicy>) => {
+    setWorkspaceSetting({
+      ...workspaceSetting,
+      shortcutNamePolicy: ShortcutNamePolicy.fromPartial({ ...workspaceSetting.shortcutNamePolicy, ...policy }),
+    });
+  };
+
+  const splitPrefixes = (value: string): string[] => {
+    return value
+      .split(/[\s,]+/)
+      .map((prefix) => prefix.trim())
+      .filter((prefix) => prefix !== "");
+  };
+
   const handleSaveWorkspaceSetting = async () => {
-    // Validate shortcut prefix before saving
+    // Validate the prefixes before saving
     const prefix = workspaceSetting.shortcutPrefix || "s";
-    const prefixError = validateShortcutPrefix(prefix);
-    if (prefixError) {
-      toast.error(prefixError);
-      return;
+    const collectionPrefix = workspaceSetting.collectionPrefix || "c";
+    for (const p of [prefix, collectionPrefix, ...workspaceSetting.legacyShortcutPrefixes, ...workspaceSetting.legacyCollectionPrefixes]) {
+      const prefixError = validateShortcutPrefix(p);
+      if (prefixError) {
+        toast.error(`${p}: ${prefixError}`);
+        return;
+      }
     }
 
-    // Ensure we save the actual prefix (defaulting to "s" if empty)
+    // Ensure we save the actual prefixes (defaulting to "s" and "c" if empty)
     const settingToSave = {
       ...workspaceSetting,
-      shortcutPrefix: prefix
+      shortcutPrefix: prefix,
+      collectionPrefix: collectionPrefix,
+      redirectHosts: workspaceSetting.redirectHosts.map((host) => host.trim()).filter((host) => host !== ""),
     };
 
     const updateMask: string[] = [];
@@ -116,9 +161,46 @@ const WorkspaceGeneralSettingSection = () => {
     if (!isEqual(originalWorkspaceSetting.current.defaultVisibility, settingToSave.defaultVisibility)) {
       updateMask.push("default_visibility");
     }
+    // Legacy prefixes go first, so that a changed canonical prefix is kept as a legacy one.
+    if (!isEqual(originalWorkspaceSetting.current.legacyShortcutPrefixes, settingToSave.legacyShortcutPrefixes)) {
+      updateMask.push("legacy_shortcut_prefixes");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.legacyCollectionPrefixes, settingToSave.legacyCollectionPrefixes)) {
+      updateMask.push("legacy_collection_prefixes");
+    }
     if (!isEqual(originalWorkspaceSetting.current.shortcutPrefix, settingToSave.shortcutPrefix)) {
       updateMask.push("shortcut_prefix");
     }
+    if (!isEqual(originalWorkspaceSetting.current.collectionPrefix, settingToSave.collectionPrefix)) {
+      updateMask.push("collection_prefix");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.defaultRedirectMode, settingToSave.defaultRedirectMode)) {
+      updateMask.push("default_redirect_mode");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.shortcutPendingMessage, settingToSave.shortcutPendingMessage)) {
+      updateMask.push("shortcut_pending_message");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.shortcutExpiredMessage, settingToSave.shortcutExpiredMessage)) {
+      updateMask.push("shortcut_expired_message");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.expiredShortcutArchiveDays, settingToSave.expiredShortcutArchiveDays)) {
+      updateMask.push("expired_shortcut_archive_days");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.trashRetentionDays, settingToSave.trashRetentionDays)) {
+      updateMask.push("trash_retention_days");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.autoRedirectNotFound, settingToSave.autoRedirectNotFound)) {
+      updateMask.push("auto_redirect_not_found");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.shortcutNamePolicy, settingToSave.shortcutNamePolicy)) {
+      updateMask.push("shortcut_name_policy");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.instanceUrl, settingToSave.instanceUrl)) {
+      updateMask.push("instance_url");
+    }
+    if (!isEqual(originalWorkspaceSetting.current.redirectHosts, settingToSave.redirectHosts)) {
+      updateMask.push("redirect_hosts");
+    }
     if (updateMask.length === 0) {
       toast.error("No changes made");
       return;
@@ -181,6 +263,7 @@ const WorkspaceGeneralSettingSection = () => {
             defaultValue={getDefaultVisibility(workspaceSetting.defaultVisibility)}
             onChange={(_, value) => handleDefaultVisibilityChange(value as Visibility)}
           >
+            <Option value={Visibility.PRIVATE}>{t(`shortcut.visibility.private.self`)}</Option>
             <Option value={Visibility.WORKSPACE}>{t(`shortcut.visibility.workspace.self`)}</Option>
             <Option value={Visibility.PUBLIC}>{t(`shortcut.visibility.public.self`)}</Option>
           </Select>
@@ -197,6 +280,182 @@ const WorkspaceGeneralSettingSection = () => {
             onChange={handleShortcutPrefixChange}
           />
         </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Collection URL prefix</p>
+            <p className="text-sm text-gray-500 leading-tight">The prefix used in collection URLs (e.g., "c" for "/c/collection-name").</p>
+          </div>
+          <Input
+            className="w-36"
+            placeholder="c"
+            value={workspaceSetting.collectionPrefix || ""}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, collectionPrefix: event.target.value })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Legacy shortcut prefixes</p>
+            <p className="text-sm text-gray-500 leading-tight">Old prefixes that still open shortcuts and redirect to the current prefix.</p>
+          </div>
+          <Input
+            className="w-36 shrink-0"
+            placeholder="go, l"
+            defaultValue={workspaceSetting.legacyShortcutPrefixes.join(", ")}
+            key={`legacy-shortcut-${originalWorkspaceSetting.current.legacyShortcutPrefixes.join(",")}`}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, legacyShortcutPrefixes: splitPrefixes(event.target.value) })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Legacy collection prefixes</p>
+            <p className="text-sm text-gray-500 leading-tight">Old prefixes that still open collections and redirect to the current prefix.</p>
+          </div>
+          <Input
+            className="w-36 shrink-0"
+            placeholder="col"
+            defaultValue={workspaceSetting.legacyCollectionPrefixes.join(", ")}
+            key={`legacy-collection-${originalWorkspaceSetting.current.legacyCollectionPrefixes.join(",")}`}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, legacyCollectionPrefixes: splitPrefixes(event.target.value) })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Instance URL</p>
+            <p className="text-sm text-gray-500 leading-tight">The URL of the app, where visitors of a redirect host sign in.</p>
+          </div>
+          <Input
+            className="w-64 shrink-0"
+            placeholder="https://links.example.com"
+            value={workspaceSetting.instanceUrl}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, instanceUrl: event.target.value })}
+          />
+        </div>
+        <div className="w-full flex flex-col justify-start items-start">
+          <p className="font-medium dark:text-gray-400">Redirect hosts</p>
+          <p className="text-sm text-gray-500 leading-tight">
+            One host per line, like go or go.example.com. On these hosts /standup opens the shortcut without the prefix, while the app
+            stays on the instance URL.
+          </p>
+          <Textarea
+            className="w-full mt-2"
+            placeholder="go.example.com"
+            minRows={2}
+            maxRows={5}
+            value={workspaceSetting.redirectHosts.join("\n")}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, redirectHosts: event.target.value.split("\n") })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Default redirect mode</p>
+            <p className="text-sm text-gray-500 leading-tight">How visitors are sent to the link of shortcuts that do not set one.</p>
+          </div>
+          <Select
+            className="w-36 shrink-0"
+            value={
+              workspaceSetting.defaultRedirectMode === RedirectMode.REDIRECT_MODE_UNSPECIFIED
+                ? RedirectMode.FOUND
+                : workspaceSetting.defaultRedirectMode
+            }
+            onChange={(_, value) => handleDefaultRedirectModeChange(value as RedirectMode)}
+          >
+            {redirectModeOptions.map((option) => (
+              <Option key={option.value} value={option.value}>
+                {option.label}
+              </Option>
+            ))}
+          </Select>
+        </div>
+        <div className="w-full flex flex-col justify-start items-start">
+          <p className="font-medium dark:text-gray-400">Scheduled shortcuts</p>
+          <p className="text-sm text-gray-500 leading-tight">Messages shown outside a shortcut's availability window.</p>
+          <Input
+            className="w-full mt-2"
+            placeholder="This link is not available yet."
+            value={workspaceSetting.shortcutPendingMessage}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, shortcutPendingMessage: event.target.value })}
+          />
+          <Input
+            className="w-full mt-2"
+            placeholder="This link has expired."
+            value={workspaceSetting.shortcutExpiredMessage}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, shortcutExpiredMessage: event.target.value })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Archive expired shortcuts after</p>
+            <p className="text-sm text-gray-500 leading-tight">Number of days after expiry. Leave 0 for the default of 30 days.</p>
+          </div>
+          <Input
+            className="w-36 shrink-0"
+            type="number"
+            endDecorator="days"
+            slotProps={{ input: { min: 0 } }}
+            value={workspaceSetting.expiredShortcutArchiveDays}
+            onChange={(event) =>
+              setWorkspaceSetting({ ...workspaceSetting, expiredShortcutArchiveDays: Number(event.target.value) || 0 })
+            }
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Keep deleted items in the trash for</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              Deleted shortcuts and collections are purged after this many days. Leave 0 for the default of 30 days.
+            </p>
+          </div>
+          <Input
+            className="w-36 shrink-0"
+            type="number"
+            endDecorator="days"
+            slotProps={{ input: { min: 0 } }}
+            value={workspaceSetting.trashRetentionDays}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, trashRetentionDays: Number(event.target.value) || 0 })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Redirect unknown shortcuts</p>
+            <p className="text-sm text-gray-500 leading-tight">
+              Send visitors of an unknown name to the only close match instead of showing suggestions.
+            </p>
+          </div>
+          <Switch
+            checked={workspaceSetting.autoRedirectNotFound}
+            onChange={(event) => setWorkspaceSetting({ ...workspaceSetting, autoRedirectNotFound: event.target.checked })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Case-insensitive names</p>
+            <p className="text-sm text-gray-500 leading-tight">Treat names that differ only in case, like Docs and docs, as the same shortcut.</p>
+          </div>
+          <Switch
+            checked={workspaceSetting.shortcutNamePolicy?.caseInsensitive}
+            onChange={(event) => handleShortcutNamePolicyChange({ caseInsensitive: event.target.checked })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Unicode normalization</p>
+            <p className="text-sm text-gray-500 leading-tight">Treat names that look the same, like full-width and regular letters, as the same shortcut.</p>
+          </div>
+          <Switch
+            checked={workspaceSetting.shortcutNamePolicy?.unicodeNormalization}
+            onChange={(event) => handleShortcutNamePolicyChange({ unicodeNormalization: event.target.checked })}
+          />
+        </div>
+        <div className="w-full flex flex-row justify-between items-center">
+          <div className="w-full flex flex-col justify-start items-start">
+            <p className="font-medium dark:text-gray-400">Equivalent separators</p>
+            <p className="text-sm text-gray-500 leading-tight">Treat "-", "_" and "." in names as the same character.</p>
+          </div>
+          <Switch
+            checked={workspaceSetting.shortcutNamePolicy?.equivalentSeparators}
+            onChange={(event) => handleShortcutNamePolicyChange({ equivalentSeparators: event.target.checked })}
+          />
+        </div>
         <div className="w-full flex flex-col justify-start items-start">
           <p className="mt-2 font-medium dark:text-gray-400">{t("settings.workspace.custom-style")}</p>
           <Textarea
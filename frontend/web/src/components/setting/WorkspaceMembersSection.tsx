@@ -1,9 +1,8 @@
 import { Button, IconButton } from "@mui/joy";
 import { useEffect, useState } from "react";
-import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
-import { showCommonDialog } from "@/components/Alert";
 import CreateUserDialog from "@/components/CreateUserDialog";
+import DeleteUserDialog from "@/components/DeleteUserDialog";
 import Icon from "@/components/Icon";
 import { useUserStore } from "@/stores";
 import { User } from "@/types/proto/api/v1/user_service";
@@ -13,6 +12,7 @@ const WorkspaceMembersSection = () => {
   const userStore = useUserStore();
   const [showCreateUserDialog, setShowCreateUserDialog] = useState<boolean>(false);
   const [currentEditingUser, setCurrentEditingUser] = useState<User | undefined>(undefined);
+  const [deletingUser, setDeletingUser] = useState<User | undefined>(undefined);
   const userList = Object.values(userStore.userMapById);
 
   useEffect(() => {
@@ -24,22 +24,6 @@ const WorkspaceMembersSection = () => {
     setCurrentEditingUser(undefined);
   };
 
-  const handleDeleteUser = async (user: User) => {
-    showCommonDialog({
-      title: "Delete User",
-      content: `Are you sure to delete user \`${user.nickname}\`? You cannot undo this action.`,
-      style: "danger",
-      onConfirm: async () => {
-        try {
-          await userStore.deleteUser(user.id);
-          toast.success(`User \`${user.nickname}\` deleted successfully`);
-        } catch (error: any) {
-          toast.error(`Failed to delete user \`${user.nickname}\`: ${error.details}`);
-        }
-      },
-    });
-  };
-
   return (
     <>
       <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
@@ -94,7 +78,7 @@ const WorkspaceMembersSection = () => {
                           >
                             <Icon.PenBox className="w-4 h-auto" />
                           </IconButton>
-                          <IconButton size="sm" color="danger" variant="plain" onClick={() => handleDeleteUser(user)}>
+                          <IconButton size="sm" color="danger" variant="plain" onClick={() => setDeletingUser(user)}>
                             <Icon.Trash className="w-4 h-auto" />
                           </IconButton>
                         </td>
@@ -109,6 +93,7 @@ const WorkspaceMembersSection = () => {
       </div>
 
       {showCreateUserDialog && <CreateUserDialog user={currentEditingUser} onClose={handleCreateUserDialogClose} />}
+      {deletingUser && <DeleteUserDialog user={deletingUser} onClose={() => setDeletingUser(undefined)} />}
     </>
   );
 };
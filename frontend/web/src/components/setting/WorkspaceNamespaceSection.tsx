@@ -0,0 +1,156 @@
+import { Button, Checkbox, IconButton, Input, Option, Select } from "@mui/joy";
+import { useEffect, useState } from "react";
+import toast from "react-hot-toast";
+import { useTranslation } from "react-i18next";
+import Icon from "@/components/Icon";
+import { groupServiceClient, namespaceServiceClient } from "@/grpcweb";
+import { Group } from "@/types/proto/api/v1/group_service";
+import { NamespaceConflict, NamespaceRule } from "@/types/proto/api/v1/namespace_service";
+
+const WorkspaceNamespaceSection = () => {
+  const { t } = useTranslation();
+  const [rules, setRules] = useState<NamespaceRule[]>([]);
+  const [groups, setGroups] = useState<Group[]>([]);
+  const [conflicts, setConflicts] = useState<NamespaceConflict[]>([]);
+  const [ruleCreate, setRuleCreate] = useState<Pick<NamespaceRule, "pattern" | "groupId" | "requireApproval">>({
+    pattern: "",
+    groupId: 0,
+    requireApproval: false,
+  });
+  const groupMap = Object.fromEntries(groups.map((group) => [group.id, group]));
+
+  useEffect(() => {
+    (async () => {
+      const [{ groups }] = await Promise.all([groupServiceClient.listGroups({}), fetchRules()]);
+      setGroups(groups);
+    })();
+  }, []);
+
+  const fetchRules = async () => {
+    const [{ rules }, { conflicts }] = await Promise.all([
+      namespaceServiceClient.listNamespaceRules({}),
+      namespaceServiceClient.listNamespaceConflicts({}),
+    ]);
+    setRules(rules);
+    setConflicts(conflicts);
+  };
+
+  const handleCreateRule = async () => {
+    if (!ruleCreate.pattern || !ruleCreate.groupId) {
+      toast.error(t("settings.workspace.namespace.rule-required"));
+      return;
+    }
+
+    try {
+      await namespaceServiceClient.createNamespaceRule({
+        rule: ruleCreate,
+      });
+      setRuleCreate({ pattern: "", groupId: 0, requireApproval: false });
+      await fetchRules();
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  const handleDeleteRule = async (rule: NamespaceRule) => {
+    try {
+      await namespaceServiceClient.deleteNamespaceRule({
+        id: rule.id,
+      });
+      await fetchRules();
+    } catch (error: any) {
+      toast.error(error.details);
+    }
+  };
+
+  return (
+    <div className="w-full flex flex-col sm:flex-row justify-start items-start gap-4 sm:gap-x-16">
+      <div className="sm:w-1/4 flex flex-col shrink-0">
+        <p className="text-2xl font-semibold text-gray-900 dark:text-gray-500">{t("settings.workspace.namespace.self")}</p>
+        <p className="mt-2 text-sm text-gray-500">{t("settings.workspace.namespace.description")}</p>
+      </div>
+      <div className="w-full sm:w-auto grow flex flex-col justify-start items-start gap-4">
+        <div className="w-full flex flex-row flex-wrap justify-start items-center gap-2">
+          <Input
+            className="grow"
+            placeholder={t("settings.workspace.namespace.pattern")}
+            value={ruleCreate.pattern}
+            onChange={(e) => setRuleCreate({ ...ruleCreate, pattern: e.target.value })}
+          />
+          <Select
+            className="w-40"
+            placeholder={t("settings.workspace.namespace.group")}
+            value={ruleCreate.groupId || null}
+            onChange={(_, value) => setRuleCreate({ ...ruleCreate, groupId: value || 0 })}
+          >
+            {groups.map((group) => (
+              <Option key={group.id} value={group.id}>
+                {group.name}
+              </Option>
+            ))}
+          </Select>
+          <Checkbox
+            label={t("settings.workspace.namespace.require-approval")}
+            checked={ruleCreate.requireApproval}
+            onChange={(e) => setRuleCreate({ ...ruleCreate, requireApproval: e.target.checked })}
+          />
+          <Button variant="outlined" color="neutral" onClick={handleCreateRule}>
+            {t("common.create")}
+          </Button>
+        </div>
+        {rules.length > 0 && (
+          <div className="w-full inline-block border rounded-lg border-gray-300 dark:border-zinc-700 min-w-full align-middle">
+            <table className="min-w-full divide-y divide-gray-300 dark:divide-zinc-700">
+              <thead>
+                <tr>
+                  <th scope="col" className="py-3 pl-4 pr-3 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                    {t("settings.workspace.namespace.pattern")}
+                  </th>
+                  <th scope="col" className="px-3 py-3 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                    {t("settings.workspace.namespace.group")}
+                  </th>
+                  <th scope="col" className="px-3 py-3 text-left text-sm font-semibold text-gray-900 dark:text-gray-500">
+                    {t("settings.workspace.namespace.require-approval")}
+                  </th>
+                  <th scope="col" className="relative py-3 pl-3 pr-4">
+                    <span className="sr-only">{t("common.delete")}</span>
+                  </th>
+                </tr>
+              </thead>
+              <tbody className="divide-y divide-gray-200 dark:divide-zinc-800">
+                {rules.map((rule) => (
+                  <tr key={rule.id}>
+                    <td className="whitespace-nowrap py-2 pl-4 pr-3 text-sm font-mono text-gray-900 dark:text-gray-500">{rule.pattern}</td>
+                    <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500">{groupMap[rule.groupId]?.name ?? rule.groupId}</td>
+                    <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500">
+                      {rule.requireApproval && <Icon.Check className="w-4 h-auto" />}
+                    </td>
+                    <td className="relative whitespace-nowrap py-2 pl-3 pr-4 text-right text-sm">
+                      <IconButton size="sm" color="danger" variant="plain" onClick={() => handleDeleteRule(rule)}>
+                        <Icon.Trash className="w-4 h-auto" />
+                      </IconButton>
+                    </td>
+                  </tr>
+                ))}
+              </tbody>
+            </table>
+          </div>
+        )}
+        {conflicts.length > 0 && (
+          <div className="w-full flex flex-col justify-start items-start gap-1">
+            <p className="font-medium text-amber-600">{t("settings.workspace.namespace.conflicts", { count: conflicts.length })}</p>
+            {conflicts.map((conflict) => (
+              <p key={conflict.shortcut?.id} className="text-sm text-gray-500">
+                <span className="font-mono">{conflict.shortcut?.name}</span>
+                <span className="mx-1">·</span>
+                <span className="font-mono">{conflict.rule?.pattern}</span>
+              </p>
+            ))}
+          </div>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default WorkspaceNamespaceSection;
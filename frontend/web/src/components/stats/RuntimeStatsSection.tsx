@@ -0,0 +1,82 @@
+import { Card, Typography } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { workspaceServiceClient } from "@/grpcweb";
+import { WorkspaceRuntimeStats } from "@/types/proto/api/v1/workspace_service";
+
+const RuntimeStatsSection = () => {
+  const [stats, setStats] = useState<WorkspaceRuntimeStats | null>(null);
+
+  useEffect(() => {
+    (async () => {
+      try {
+        setStats(await workspaceServiceClient.getWorkspaceRuntimeStats({}));
+      } catch (error) {
+        console.error("Failed to fetch runtime stats:", error);
+      }
+    })();
+  }, []);
+
+  const resolverCache = stats?.resolverCache;
+  const activityIngestion = stats?.activityIngestion;
+  if (!resolverCache || !activityIngestion) {
+    return null;
+  }
+
+  const lookups = resolverCache.hits + resolverCache.misses;
+  const hitRate = lookups > 0 ? Math.round((resolverCache.hits / lookups) * 1000) / 10 : 0;
+  const groups = [
+    {
+      title: "Resolver cache",
+      description: "Shortcut lookups on redirects since the server started.",
+      items: [
+        { label: "Hit rate", value: resolverCache.capacity > 0 ? `${hitRate}%` : "Disabled" },
+        { label: "Hits", value: `${resolverCache.hits} (${resolverCache.negativeHits} unknown names)` },
+        { label: "Misses", value: resolverCache.misses },
+        { label: "Cached paths", value: `${resolverCache.entries} / ${resolverCache.capacity}` },
+        { label: "Evictions", value: resolverCache.evictions },
+      ],
+    },
+    {
+      title: "Click ingestion",
+      description: `Click events written in the background since the server started. When the buffer is full they are ${
+        activityIngestion.policy === "block" ? "held back until there is room" : "dropped"
+      }.`,
+      items: [
+        { label: "Buffered", value: `${activityIngestion.buffered} / ${activityIngestion.capacity}` },
+        { label: "Enqueued", value: activityIngestion.enqueued },
+        { label: "Written", value: activityIngestion.written },
+        { label: "Dropped", value: activityIngestion.dropped },
+        { label: "Failed", value: activityIngestion.failed },
+      ],
+    },
+  ];
+
+  return (
+    <div className="w-full flex flex-col gap-y-6">
+      {groups.map((group) => (
+        <div key={group.title} className="w-full">
+          <Typography level="title-lg" className="mb-1">
+            {group.title}
+          </Typography>
+          <Typography level="body-sm" color="neutral" className="mb-4">
+            {group.description}
+          </Typography>
+          <div className="grid grid-cols-1 md:grid-cols-5 gap-4">
+            {group.items.map((item) => (
+              <Card key={item.label} className="p-4">
+                <Typography level="body-sm" color="neutral">
+                  {item.label}
+                </Typography>
+                <Typography level="title-lg" className="mt-2">
+                  {item.value}
+                </Typography>
+              </Card>
+            ))}
+          </div>
+        </div>
+      ))}
+    </div>
+  );
+};
+
+export default RuntimeStatsSection;
@@ -2,6 +2,8 @@ import { createChannel, createClientFactory, FetchTransport } from "nice-grpc-we
 import { ActivityServiceDefinition } from "./types/proto/api/v1/activity_service";
 import { AuthServiceDefinition } from "./types/proto/api/v1/auth_service";
 import { CollectionServiceDefinition } from "./types/proto/api/v1/collection_service";
+import { GroupServiceDefinition } from "./types/proto/api/v1/group_service";
+import { NamespaceServiceDefinition } from "./types/proto/api/v1/namespace_service";
 import { ShortcutServiceDefinition } from "./types/proto/api/v1/shortcut_service";
 import { SubscriptionServiceDefinition } from "./types/proto/api/v1/subscription_service";
 import { UserServiceDefinition } from "./types/proto/api/v1/user_service";
@@ -34,3 +36,7 @@ export const shortcutServiceClient = clientFactory.create(ShortcutServiceDefinit
 export const collectionServiceClient = clientFactory.create(CollectionServiceDefinition, channel);
 
 export const activityServiceClient = clientFactory.create(ActivityServiceDefinition, channel);
+
+export const groupServiceClient = clientFactory.create(GroupServiceDefinition, channel);
+
+export const namespaceServiceClient = clientFactory.create(NamespaceServiceDefinition, channel);
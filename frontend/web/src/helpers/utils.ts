@@ -4,6 +4,14 @@ export const absolutifyLink = (rel: string): string => {
   return anchor.href;
 };
 
+// signInRedirectStorageKey keeps the signed redirect target across the SSO round trip.
+export const signInRedirectStorageKey = "monotreme.sign-in-redirect";
+
+// getContinueLink returns the server endpoint that sends a signed-in visitor back to the signed redirect target.
+export const getContinueLink = (redirect: string): string => {
+  return `/auth/continue?redirect=${encodeURIComponent(redirect)}`;
+};
+
 export const isURL = (str: string): boolean => {
   const urlRegex = /^(https?|ftp):\/\/[^\s/$.?#].[^\s]*$/i;
   return urlRegex.test(str);
@@ -3,7 +3,7 @@ import { useEffect, useState } from "react";
 import { useSearchParams } from "react-router-dom";
 import Icon from "@/components/Icon";
 import { authServiceClient } from "@/grpcweb";
-import { absolutifyLink } from "@/helpers/utils";
+import { absolutifyLink, getContinueLink, signInRedirectStorageKey } from "@/helpers/utils";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import { useUserStore } from "@/stores";
 
@@ -55,6 +55,12 @@ const AuthCallback = () => {
           errorMessage: "",
         });
         await userStore.fetchCurrentUser();
+        const redirect = sessionStorage.getItem(signInRedirectStorageKey);
+        if (redirect) {
+          sessionStorage.removeItem(signInRedirectStorageKey);
+          window.location.href = getContinueLink(redirect);
+          return;
+        }
         navigateTo("/");
       } catch (error: any) {
         console.error(error);
@@ -0,0 +1,17 @@
+import { useParams } from "react-router-dom";
+import CollectionSpace from "@/pages/CollectionSpace";
+import ShortcutSpace from "@/pages/ShortcutSpace";
+import { useWorkspaceStore } from "@/stores";
+
+// PrefixSpace serves /:prefix/* as a collection when the prefix is the collection prefix of the workspace, or as a shortcut otherwise.
+const PrefixSpace = () => {
+  const params = useParams();
+  const workspaceStore = useWorkspaceStore();
+
+  if (params["prefix"] === workspaceStore.getCollectionPrefix()) {
+    return <CollectionSpace />;
+  }
+  return <ShortcutSpace />;
+};
+
+export default PrefixSpace;
@@ -6,6 +6,7 @@ import useShortcutStore from "@/stores/shortcut";
 import { useUserStore } from "@/stores";
 import { Visibility } from "@/types/proto/api/v1/common";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
+import { getShortcutUrl } from "@/utils/shortcut";
 
 const QuickSave = () => {
   const [searchParams] = useSearchParams();
@@ -45,6 +46,12 @@ const QuickSave = () => {
         .substring(0, 32);
       setName(generatedName);
     }
+
+    // A name given explicitly, e.g. from the shortcut not found page, wins over the generated one.
+    const nameParam = searchParams.get("name");
+    if (nameParam) {
+      setName(nameParam);
+    }
   }, [searchParams]);
 
   // Redirect to sign-in if not authenticated
@@ -108,7 +115,7 @@ const QuickSave = () => {
 
   const handleViewShortcut = () => {
     if (savedShortcut) {
-      navigate(`/s/${savedShortcut.name}`);
+      navigate(getShortcutUrl(savedShortcut.name));
     }
   };
 
@@ -176,7 +183,7 @@ const QuickSave = () => {
                 size="sm"
               />
               <Typography level="body-xs" className="mt-1 text-gray-600">
-                URL: /s/{name}
+                URL: {getShortcutUrl(name)}
               </Typography>
             </div>
 
@@ -226,7 +233,7 @@ const QuickSave = () => {
                 Shortcut URL:
               </Typography>
               <Typography level="body-xs" className="font-mono text-blue-700 dark:text-blue-300 break-all">
-                /s/{savedShortcut?.name}
+                {getShortcutUrl(savedShortcut?.name || "")}
               </Typography>
             </div>
 
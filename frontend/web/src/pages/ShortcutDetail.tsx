@@ -1,23 +1,27 @@
 import { Tooltip } from "@mui/joy";
 import classNames from "classnames";
 import copy from "copy-to-clipboard";
+import dayjs from "dayjs";
 import { useEffect, useState } from "react";
 import toast from "react-hot-toast";
 import { useTranslation } from "react-i18next";
 import { useParams } from "react-router-dom";
 import { showCommonDialog } from "@/components/Alert";
 import AnalyticsView from "@/components/AnalyticsView";
+import ChangeRequestsView from "@/components/ChangeRequestsView";
 import CreateShortcutDrawer from "@/components/CreateShortcutDrawer";
 import GenerateQRCodeDialog from "@/components/GenerateQRCodeDialog";
 import Icon from "@/components/Icon";
+import LinkChangesView from "@/components/LinkChangesView";
+import RevisionHistoryView from "@/components/RevisionHistoryView";
 import LinkFavicon from "@/components/LinkFavicon";
+import RoutingDryRunView from "@/components/RoutingDryRunView";
 import VisibilityIcon from "@/components/VisibilityIcon";
 import Dropdown from "@/components/common/Dropdown";
-import { absolutifyLink } from "@/helpers/utils";
 import useLoading from "@/hooks/useLoading";
 import useNavigateTo from "@/hooks/useNavigateTo";
 import { useUserStore, useShortcutStore } from "@/stores";
-import { getShortcutUrl } from "@/utils/shortcut";
+import { getShortcutAbsoluteUrl } from "@/utils/shortcut";
 import { Shortcut } from "@/types/proto/api/v1/shortcut_service";
 import { Role } from "@/types/proto/api/v1/user_service";
 
@@ -41,7 +45,7 @@ const ShortcutDetail = () => {
   const loadingState = useLoading(true);
   const creator = userStore.getUserById(shortcut.creatorId);
   const havePermission = currentUser.role === Role.ADMIN || shortcut.creatorId === currentUser.id;
-  const shortcutLink = absolutifyLink(getShortcutUrl(shortcut.name));
+  const shortcutLink = getShortcutAbsoluteUrl(shortcut.name);
 
   useEffect(() => {
     (async () => {
@@ -63,7 +67,7 @@ const ShortcutDetail = () => {
   const handleDeleteShortcutButtonClick = (shortcut: Shortcut) => {
     showCommonDialog({
       title: "Delete Shortcut",
-      content: `Are you sure to delete shortcut \`${shortcut.name}\`? You cannot undo this action.`,
+      content: `Are you sure to delete shortcut \`${shortcut.name}\`? It will be moved to the trash, where you can restore it until it is purged.`,
       style: "danger",
       onConfirm: async () => {
         await shortcutStore.deleteShortcut(shortcut.id);
@@ -190,6 +194,77 @@ const ShortcutDetail = () => {
           </h3>
           <AnalyticsView className="mt-4 w-full grid grid-cols-1 sm:grid-cols-2 gap-2 sm:gap-4" shortcutId={shortcut.id} />
         </div>
+
+        {havePermission && (
+          <div className="w-full flex flex-col mt-8">
+            <h3 id="link-changes" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.CalendarClock className="w-6 h-auto mr-1" />
+              {t("shortcut.link-change.self")}
+            </h3>
+            <LinkChangesView className="mt-4" shortcutId={shortcut.id} />
+          </div>
+        )}
+
+        {havePermission && (
+          <div className="w-full flex flex-col mt-8">
+            <h3 id="revisions" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.History className="w-6 h-auto mr-1" />
+              {t("shortcut.revision.self")}
+            </h3>
+            <RevisionHistoryView className="mt-4" shortcutId={shortcut.id} />
+          </div>
+        )}
+
+        {shortcut.requiresApproval && (
+          <div className="w-full flex flex-col mt-8">
+            <h3 id="change-requests" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.ShieldCheck className="w-6 h-auto mr-1" />
+              {t("shortcut.change-request.self")}
+            </h3>
+            <ChangeRequestsView className="mt-4" shortcutId={shortcut.id} />
+          </div>
+        )}
+
+        {havePermission && shortcut.routingRules.length > 0 && (
+          <div className="w-full flex flex-col mt-8">
+            <h3 id="routing-rules" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.Split className="w-6 h-auto mr-1" />
+              {t("shortcut.routing-rules.self")}
+            </h3>
+            <RoutingDryRunView className="mt-4" shortcutId={shortcut.id} />
+          </div>
+        )}
+
+        {shortcut.backupLinks.length > 0 && (
+          <div className="w-full flex flex-col mt-8">
+            <h3 id="failover" className="pl-1 font-medium text-lg flex flex-row justify-start items-center dark:text-gray-400">
+              <Icon.HeartPulse className="w-6 h-auto mr-1" />
+              {t("shortcut.failover.self")}
+            </h3>
+            <div className="mt-4 w-full overflow-hidden shadow ring-1 ring-black ring-opacity-5 rounded-lg dark:ring-zinc-800">
+              <div className="w-full divide-y divide-gray-200 dark:divide-zinc-800">
+                {[shortcut.link, ...shortcut.backupLinks].map((link) => {
+                  const health = shortcut.targetHealth.find((health) => health.link === link);
+                  return (
+                    <div key={link} className="w-full flex flex-row justify-between items-center gap-2 px-2 py-2 text-sm">
+                      {!health ? (
+                        <Icon.CircleDashed className="w-4 h-auto shrink-0 text-gray-400" />
+                      ) : health.healthy ? (
+                        <Icon.CircleCheck className="w-4 h-auto shrink-0 text-green-600" />
+                      ) : (
+                        <Icon.CircleX className="w-4 h-auto shrink-0 text-red-600" />
+                      )}
+                      <span className="w-full truncate text-gray-900 dark:text-gray-500">{link}</span>
+                      <span className="shrink-0 text-gray-500">
+                        {health ? `${health.error || health.statusCode} · ${dayjs(health.checkedTime).format("YYYY-MM-DD HH:mm")}` : t("shortcut.failover.unchecked")}
+                      </span>
+                    </div>
+                  );
+                })}
+              </div>
+            </div>
+          </div>
+        )}
       </div>
 
       {showQRCodeDialog && <GenerateQRCodeDialog shortcut={shortcut} onClose={() => setShowQRCodeDialog(false)} />}
@@ -22,7 +22,7 @@ const ShortcutSpace = () => {
   const [showCreateShortcutDrawer, setShowCreateShortcutDrawer] = useState(false);
 
   // Check if the current route matches the workspace shortcut prefix
-  const requestedPrefix = params["prefix"] || "s"; // "s" for legacy route, or the dynamic prefix
+  const requestedPrefix = params["prefix"] || "s";
   const currentShortcutPrefix = workspaceStore.getShortcutPrefix();
 
   useEffect(() => {
@@ -1,20 +1,35 @@
 import { Button, Divider } from "@mui/joy";
-import React from "react";
+import React, { useEffect } from "react";
 import { toast } from "react-hot-toast";
 import { useTranslation } from "react-i18next";
-import { Link } from "react-router-dom";
+import { Link, useSearchParams } from "react-router-dom";
 import Logo from "@/components/Logo";
 import PasswordAuthForm from "@/components/PasswordAuthForm";
-import { absolutifyLink } from "@/helpers/utils";
-import { useWorkspaceStore } from "@/stores";
+import { absolutifyLink, getContinueLink, signInRedirectStorageKey } from "@/helpers/utils";
+import { useUserStore, useWorkspaceStore } from "@/stores";
 import { IdentityProvider, IdentityProvider_Type } from "@/types/proto/api/v1/workspace_service";
 
 const SignIn: React.FC = () => {
   const { t } = useTranslation();
   const workspaceStore = useWorkspaceStore();
+  const userStore = useUserStore();
+  const [searchParams] = useSearchParams();
+  const redirect = searchParams.get("redirect");
+
+  useEffect(() => {
+    // Visitors that are already signed in go straight back to where they came from.
+    if (redirect && userStore.getCurrentUser()) {
+      window.location.replace(getContinueLink(redirect));
+    }
+  }, [redirect]);
 
   const handleSignInWithIdentityProvider = async (identityProvider: IdentityProvider) => {
     const stateQueryParameter = identityProvider.id;
+    if (redirect) {
+      sessionStorage.setItem(signInRedirectStorageKey, redirect);
+    } else {
+      sessionStorage.removeItem(signInRedirectStorageKey);
+    }
     if (identityProvider.type === IdentityProvider_Type.OAUTH2) {
       const redirectUri = absolutifyLink("/auth/callback");
       const oauth2Config = identityProvider.config?.oauth2;
@@ -40,7 +55,7 @@ const SignIn: React.FC = () => {
             <span className="text-3xl opacity-80 dark:text-gray-500">Monotreme</span>
           </div>
           {!workspaceStore.setting.disallowPasswordAuth ? (
-            <PasswordAuthForm />
+            <PasswordAuthForm redirect={redirect} />
           ) : (
             <p className="w-full text-2xl mt-2 dark:text-gray-500">Password auth is not allowed.</p>
           )}
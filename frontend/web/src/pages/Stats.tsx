@@ -7,6 +7,7 @@ import UsersStatsSection from "@/components/stats/UsersStatsSection";
 import ShortcutsStatsSection from "@/components/stats/ShortcutsStatsSection";
 import CollectionsStatsSection from "@/components/stats/CollectionsStatsSection";
 import SiteMetricsStatsSection from "@/components/stats/SiteMetricsStatsSection";
+import RuntimeStatsSection from "@/components/stats/RuntimeStatsSection";
 
 const Stats = () => {
   const { t } = useTranslation();
@@ -44,6 +45,9 @@ const Stats = () => {
       <Divider />
 
       <SiteMetricsStatsSection />
+      <Divider />
+
+      <RuntimeStatsSection />
     </div>
   );
 };
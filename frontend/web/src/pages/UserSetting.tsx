@@ -6,6 +6,7 @@ import AccountSection from "@/components/setting/AccountSection";
 import PreferenceSection from "@/components/setting/PreferenceSection";
 import UserSummarySection from "@/components/setting/UserSummarySection";
 import RecentActivitySection from "@/components/setting/RecentActivitySection";
+import TrashSection from "@/components/setting/TrashSection";
 import Icon from "@/components/Icon";
 import { userServiceClient } from "@/grpcweb";
 import { useUserStore } from "@/stores";
@@ -42,6 +43,7 @@ const Setting: React.FC = () => {
       <RecentActivitySection />
       <AccessTokenSection />
       <PreferenceSection />
+      <TrashSection />
 
       <Card className="w-full p-6">
         <Typography level="title-md" className="mb-3">
@@ -3,6 +3,7 @@ import { useEffect } from "react";
 import Icon from "@/components/Icon";
 import WorkspaceGeneralSettingSection from "@/components/setting/WorkspaceGeneralSettingSection";
 import WorkspaceMembersSection from "@/components/setting/WorkspaceMembersSection";
+import WorkspaceNamespaceSection from "@/components/setting/WorkspaceNamespaceSection";
 import WorkspaceSecuritySection from "@/components/setting/WorkspaceSecuritySection";
 import { useUserStore, useWorkspaceStore } from "@/stores";
 import { Role } from "@/types/proto/api/v1/user_service";
@@ -26,6 +27,8 @@ const WorkspaceSetting = () => {
     <div className="mx-auto max-w-8xl w-full px-4 sm:px-6 md:px-12 py-6 flex flex-col justify-start items-start gap-y-12">
       <WorkspaceMembersSection />
       <Divider />
+      <WorkspaceNamespaceSection />
+      <Divider />
       <WorkspaceGeneralSettingSection />
       <Divider />
       <WorkspaceSecuritySection />
@@ -6,14 +6,13 @@ import AdminSignIn from "@/pages/AdminSignIn";
 import AuthCallback from "@/pages/AuthCallback";
 import BookmarkImport from "@/pages/BookmarkImport";
 import CollectionDashboard from "@/pages/CollectionDashboard";
-import CollectionSpace from "@/pages/CollectionSpace";
 import Home from "@/pages/Home";
 import Landing from "@/pages/Landing";
 import NotFound from "@/pages/NotFound";
+import PrefixSpace from "@/pages/PrefixSpace";
 import QuickSave from "@/pages/QuickSave";
 import ShortcutDashboard from "@/pages/ShortcutDashboard";
 import ShortcutDetail from "@/pages/ShortcutDetail";
-import ShortcutSpace from "@/pages/ShortcutSpace";
 import SignIn from "@/pages/SignIn";
 import SignUp from "@/pages/SignUp";
 import Stats from "@/pages/Stats";
@@ -102,17 +101,9 @@ const router = createBrowserRouter([
         path: "/quick-save",
         element: <QuickSave />,
       },
-      {
-        path: "s/*",
-        element: <ShortcutSpace />,
-      },
       {
         path: ":prefix/*",
-        element: <ShortcutSpace />,
-      },
-      {
-        path: "c/*",
-        element: <CollectionSpace />,
+        element: <PrefixSpace />,
       },
       {
         path: "*",
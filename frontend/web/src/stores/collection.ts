@@ -12,6 +12,7 @@ interface CollectionState {
   createCollection: (collection: Collection) => Promise<Collection>;
   updateCollection: (collection: Partial<Collection>, updateMask: string[]) => Promise<Collection>;
   deleteCollection: (id: number) => Promise<void>;
+  restoreCollection: (id: number) => Promise<Collection>;
   importBookmarks: (htmlContent: string) => Promise<ImportBookmarksResponse>;
 }
 
@@ -82,6 +83,15 @@ const useCollectionStore = create<CollectionState>()((set, get) => ({
     delete collectionMap[id];
     set(collectionMap);
   },
+  restoreCollection: async (id: number) => {
+    const collection = await collectionServiceClient.restoreCollection({
+      id,
+    });
+    const collectionMap = get().collectionMapById;
+    collectionMap[collection.id] = collection;
+    set(collectionMap);
+    return collection;
+  },
   importBookmarks: async (htmlContent: string) => {
     const response = await collectionServiceClient.importBookmarks({
       htmlContent,
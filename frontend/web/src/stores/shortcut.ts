@@ -60,16 +60,55 @@ const useShortcutStore = create(
       set({ shortcutMapById: shortcutMap });
       return createdShortcut;
     },
-    updateShortcut: async (shortcut: Partial<Shortcut>, updateMask: string[]) => {
+    updateShortcut: async (shortcut: Partial<Shortcut>, updateMask: string[], keepOldNameAsAlias = false) => {
       const updatedShortcut = await shortcutServiceClient.updateShortcut({
         shortcut: shortcut,
         updateMask,
+        keepOldNameAsAlias,
       });
       const shortcutMap = get().shortcutMapById;
       shortcutMap[updatedShortcut.id] = updatedShortcut;
       set({ shortcutMapById: shortcutMap });
       return updatedShortcut;
     },
+    restoreShortcutRevision: async (shortcutId: number, id: number) => {
+      const { shortcut, revision } = await shortcutServiceClient.restoreShortcutRevision({
+        shortcutId,
+        id,
+      });
+      if (shortcut) {
+        const shortcutMap = get().shortcutMapById;
+        shortcutMap[shortcut.id] = shortcut;
+        set({ shortcutMapById: shortcutMap });
+      }
+      return revision;
+    },
+    approveShortcutChangeRequest: async (shortcutId: number, id: number) => {
+      const changeRequest = await shortcutServiceClient.approveShortcutChangeRequest({
+        shortcutId,
+        id,
+      });
+      const shortcut = await shortcutServiceClient.getShortcut({
+        id: shortcutId,
+      });
+      const shortcutMap = get().shortcutMapById;
+      shortcutMap[shortcut.id] = shortcut;
+      set({ shortcutMapById: shortcutMap });
+      return changeRequest;
+    },
+    rejectShortcutChangeRequest: async (shortcutId: number, id: number) => {
+      const changeRequest = await shortcutServiceClient.rejectShortcutChangeRequest({
+        shortcutId,
+        id,
+      });
+      const shortcut = await shortcutServiceClient.getShortcut({
+        id: shortcutId,
+      });
+      const shortcutMap = get().shortcutMapById;
+      shortcutMap[shortcut.id] = shortcut;
+      set({ shortcutMapById: shortcutMap });
+      return changeRequest;
+    },
     deleteShortcut: async (id: number) => {
       await shortcutServiceClient.deleteShortcut({
         id,
@@ -78,6 +117,15 @@ const useShortcutStore = create(
       delete shortcutMap[id];
       set({ shortcutMapById: shortcutMap });
     },
+    restoreShortcut: async (id: number) => {
+      const shortcut = await shortcutServiceClient.restoreShortcut({
+        id,
+      });
+      const shortcutMap = get().shortcutMapById;
+      shortcutMap[shortcut.id] = shortcut;
+      set({ shortcutMapById: shortcutMap });
+      return shortcut;
+    },
   })),
 );
 
@@ -109,6 +157,39 @@ export const getShortcutUpdateMask = (shortcut: Shortcut, updatingShortcut: Shor
   if (!isEqual(shortcut.ogMetadata, updatingShortcut.ogMetadata)) {
     updateMask.push("og_metadata");
   }
+  if (!isEqual(shortcut.template, updatingShortcut.template)) {
+    updateMask.push("template");
+  }
+  if (!isEqual(shortcut.forwardPath, updatingShortcut.forwardPath)) {
+    updateMask.push("forward_path");
+  }
+  if (!isEqual(shortcut.protected, updatingShortcut.protected)) {
+    updateMask.push("protected");
+  }
+  if (!isEqual(shortcut.redirectMode, updatingShortcut.redirectMode)) {
+    updateMask.push("redirect_mode");
+  }
+  if (!isEqual(shortcut.validFrom, updatingShortcut.validFrom)) {
+    updateMask.push("valid_from");
+  }
+  if (!isEqual(shortcut.validUntil, updatingShortcut.validUntil)) {
+    updateMask.push("valid_until");
+  }
+  if (!isEqual(shortcut.variants, updatingShortcut.variants)) {
+    updateMask.push("variants");
+  }
+  if (!isEqual(shortcut.stickyVariants, updatingShortcut.stickyVariants)) {
+    updateMask.push("sticky_variants");
+  }
+  if (!isEqual(shortcut.routingRules, updatingShortcut.routingRules)) {
+    updateMask.push("routing_rules");
+  }
+  if (!isEqual(shortcut.backupLinks, updatingShortcut.backupLinks)) {
+    updateMask.push("backup_links");
+  }
+  if (!isEqual(shortcut.aliases, updatingShortcut.aliases)) {
+    updateMask.push("aliases");
+  }
   return updateMask;
 };
 
@@ -1,6 +1,6 @@
 import { create } from "zustand";
 import { authServiceClient, userServiceClient, userSettingServiceClient } from "@/grpcweb";
-import { User } from "@/types/proto/api/v1/user_service";
+import { DeleteUserRequest, DeleteUserRequest_ContentDisposition, User } from "@/types/proto/api/v1/user_service";
 import { UserSetting } from "@/types/proto/api/v1/user_setting_service";
 
 interface UserState {
@@ -17,7 +17,7 @@ interface UserState {
   setCurrentUserId: (id: number) => void;
   createUser: (create: Partial<User>) => Promise<User>;
   patchUser: (userPatch: Partial<User>, updateMask: string[]) => Promise<void>;
-  deleteUser: (id: number) => Promise<void>;
+  deleteUser: (id: number, disposition: Omit<DeleteUserRequest, "id">) => Promise<void>;
 
   // User setting related actions.
   fetchUserSetting: (userId: number) => Promise<UserSetting>;
@@ -78,12 +78,18 @@ const useUserStore = create<UserState>()((set, get) => ({
     userMap[user.id] = user;
     set({ userMapById: userMap });
   },
-  deleteUser: async (userId: number) => {
+  deleteUser: async (userId: number, disposition: Omit<DeleteUserRequest, "id">) => {
     await userServiceClient.deleteUser({
       id: userId,
+      ...disposition,
     });
     const userMap = get().userMapById;
-    delete userMap[userId];
+    // Archived users are kept, only deactivated.
+    if (disposition.contentDisposition === DeleteUserRequest_ContentDisposition.ARCHIVE) {
+      userMap[userId] = await userServiceClient.getUser({ id: userId });
+    } else {
+      delete userMap[userId];
+    }
     set({ userMapById: userMap });
   },
   getUserById: (id: number) => {
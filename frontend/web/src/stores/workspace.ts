@@ -22,6 +22,7 @@ interface WorkspaceState {
   getSubscription: () => Subscription;
   checkFeatureAvailable: (feature: FeatureType) => boolean;
   getShortcutPrefix: () => string;
+  getCollectionPrefix: () => string;
 }
 
 const useWorkspaceStore = create<WorkspaceState>()((set, get) => ({
@@ -44,6 +45,9 @@ const useWorkspaceStore = create<WorkspaceState>()((set, get) => ({
   getShortcutPrefix: (): string => {
     return get().setting.shortcutPrefix || "s";
   },
+  getCollectionPrefix: (): string => {
+    return get().setting.collectionPrefix || "c";
+  },
 }));
 
 export default useWorkspaceStore;
@@ -19,6 +19,14 @@ export enum ActivityType {
   SHORTCUT_VIEWED = "SHORTCUT_VIEWED",
   COLLECTION_CREATED = "COLLECTION_CREATED",
   COLLECTION_VIEWED = "COLLECTION_VIEWED",
+  SHORTCUT_LINK_CHANGED = "SHORTCUT_LINK_CHANGED",
+  SHORTCUT_FAILED_OVER = "SHORTCUT_FAILED_OVER",
+  /** SHORTCUT_BOT_VIEWED - A shortcut fetched by a known bot, which is not counted as a view. */
+  SHORTCUT_BOT_VIEWED = "SHORTCUT_BOT_VIEWED",
+  /** SHORTCUT_CHANGE_REQUESTED - An edit of a protected shortcut submitted for approval. */
+  SHORTCUT_CHANGE_REQUESTED = "SHORTCUT_CHANGE_REQUESTED",
+  SHORTCUT_CHANGE_APPROVED = "SHORTCUT_CHANGE_APPROVED",
+  SHORTCUT_CHANGE_REJECTED = "SHORTCUT_CHANGE_REJECTED",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -42,6 +50,24 @@ export function activityTypeFromJSON(object: any): ActivityType {
     case 5:
     case "COLLECTION_VIEWED":
       return ActivityType.COLLECTION_VIEWED;
+    case 6:
+    case "SHORTCUT_LINK_CHANGED":
+      return ActivityType.SHORTCUT_LINK_CHANGED;
+    case 7:
+    case "SHORTCUT_FAILED_OVER":
+      return ActivityType.SHORTCUT_FAILED_OVER;
+    case 8:
+    case "SHORTCUT_BOT_VIEWED":
+      return ActivityType.SHORTCUT_BOT_VIEWED;
+    case 9:
+    case "SHORTCUT_CHANGE_REQUESTED":
+      return ActivityType.SHORTCUT_CHANGE_REQUESTED;
+    case 10:
+    case "SHORTCUT_CHANGE_APPROVED":
+      return ActivityType.SHORTCUT_CHANGE_APPROVED;
+    case 11:
+    case "SHORTCUT_CHANGE_REJECTED":
+      return ActivityType.SHORTCUT_CHANGE_REJECTED;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -63,6 +89,18 @@ export function activityTypeToNumber(object: ActivityType): number {
       return 4;
     case ActivityType.COLLECTION_VIEWED:
       return 5;
+    case ActivityType.SHORTCUT_LINK_CHANGED:
+      return 6;
+    case ActivityType.SHORTCUT_FAILED_OVER:
+      return 7;
+    case ActivityType.SHORTCUT_BOT_VIEWED:
+      return 8;
+    case ActivityType.SHORTCUT_CHANGE_REQUESTED:
+      return 9;
+    case ActivityType.SHORTCUT_CHANGE_APPROVED:
+      return 10;
+    case ActivityType.SHORTCUT_CHANGE_REJECTED:
+      return 11;
     case ActivityType.UNRECOGNIZED:
     default:
       return -1;
@@ -191,6 +229,9 @@ export interface ActivityItem {
   shortcutViewed?: ShortcutViewedData | undefined;
   collectionCreated?: CollectionCreatedData | undefined;
   collectionViewed?: CollectionViewedData | undefined;
+  shortcutLinkChanged?: ShortcutLinkChangedData | undefined;
+  shortcutFailedOver?: ShortcutFailedOverData | undefined;
+  shortcutChangeRequest?: ShortcutChangeRequestData | undefined;
 }
 
 export interface UserCreatedData {
@@ -213,6 +254,39 @@ export interface ShortcutViewedData {
   title: string;
   userAgent: string;
   referer: string;
+  /** The bot signature that matched the user agent, for bot views. */
+  bot: string;
+}
+
+export interface ShortcutLinkChangedData {
+  shortcutId: number;
+  name: string;
+  title: string;
+  previousLink: string;
+  link: string;
+}
+
+export interface ShortcutFailedOverData {
+  shortcutId: number;
+  name: string;
+  title: string;
+  previousLink: string;
+  link: string;
+  /** Whether the link now served is a backup rather than the primary link. */
+  backup: boolean;
+}
+
+/**
+ * ShortcutChangeRequestData is the data of a change request of a protected shortcut being
+ * requested, approved or rejected.
+ */
+export interface ShortcutChangeRequestData {
+  shortcutId: number;
+  name: string;
+  title: string;
+  changeRequestId: number;
+  /** The fields changed by the request, named as in the UpdateShortcut update mask. */
+  fields: string[];
 }
 
 export interface CollectionCreatedData {
@@ -1425,6 +1499,9 @@ function createBaseActivityItem(): ActivityItem {
     shortcutViewed: undefined,
     collectionCreated: undefined,
     collectionViewed: undefined,
+    shortcutLinkChanged: undefined,
+    shortcutFailedOver: undefined,
+    shortcutChangeRequest: undefined,
   };
 }
 
@@ -1460,6 +1537,15 @@ export const ActivityItem: MessageFns<ActivityItem> = {
     if (message.collectionViewed !== undefined) {
       CollectionViewedData.encode(message.collectionViewed, writer.uint32(114).fork()).join();
     }
+    if (message.shortcutLinkChanged !== undefined) {
+      ShortcutLinkChangedData.encode(message.shortcutLinkChanged, writer.uint32(122).fork()).join();
+    }
+    if (message.shortcutFailedOver !== undefined) {
+      ShortcutFailedOverData.encode(message.shortcutFailedOver, writer.uint32(130).fork()).join();
+    }
+    if (message.shortcutChangeRequest !== undefined) {
+      ShortcutChangeRequestData.encode(message.shortcutChangeRequest, writer.uint32(138).fork()).join();
+    }
     return writer;
   },
 
@@ -1550,6 +1636,30 @@ export const ActivityItem: MessageFns<ActivityItem> = {
           message.collectionViewed = CollectionViewedData.decode(reader, reader.uint32());
           continue;
         }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.shortcutLinkChanged = ShortcutLinkChangedData.decode(reader, reader.uint32());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.shortcutFailedOver = ShortcutFailedOverData.decode(reader, reader.uint32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.shortcutChangeRequest = ShortcutChangeRequestData.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1584,6 +1694,15 @@ export const ActivityItem: MessageFns<ActivityItem> = {
     message.collectionViewed = (object.collectionViewed !== undefined && object.collectionViewed !== null)
       ? CollectionViewedData.fromPartial(object.collectionViewed)
       : undefined;
+    message.shortcutLinkChanged = (object.shortcutLinkChanged !== undefined && object.shortcutLinkChanged !== null)
+      ? ShortcutLinkChangedData.fromPartial(object.shortcutLinkChanged)
+      : undefined;
+    message.shortcutFailedOver = (object.shortcutFailedOver !== undefined && object.shortcutFailedOver !== null)
+      ? ShortcutFailedOverData.fromPartial(object.shortcutFailedOver)
+      : undefined;
+    message.shortcutChangeRequest = (object.shortcutChangeRequest !== undefined && object.shortcutChangeRequest !== null)
+      ? ShortcutChangeRequestData.fromPartial(object.shortcutChangeRequest)
+      : undefined;
     return message;
   },
 };
@@ -1753,7 +1872,7 @@ export const ShortcutCreatedData: MessageFns<ShortcutCreatedData> = {
 };
 
 function createBaseShortcutViewedData(): ShortcutViewedData {
-  return { shortcutId: 0, name: "", title: "", userAgent: "", referer: "" };
+  return { shortcutId: 0, name: "", title: "", userAgent: "", referer: "", bot: "" };
 }
 
 export const ShortcutViewedData: MessageFns<ShortcutViewedData> = {
@@ -1773,6 +1892,9 @@ export const ShortcutViewedData: MessageFns<ShortcutViewedData> = {
     if (message.referer !== "") {
       writer.uint32(42).string(message.referer);
     }
+    if (message.bot !== "") {
+      writer.uint32(50).string(message.bot);
+    }
     return writer;
   },
 
@@ -1823,6 +1945,14 @@ export const ShortcutViewedData: MessageFns<ShortcutViewedData> = {
           message.referer = reader.string();
           continue;
         }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.bot = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1842,6 +1972,301 @@ export const ShortcutViewedData: MessageFns<ShortcutViewedData> = {
     message.title = object.title ?? "";
     message.userAgent = object.userAgent ?? "";
     message.referer = object.referer ?? "";
+    message.bot = object.bot ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcutLinkChangedData(): ShortcutLinkChangedData {
+  return { shortcutId: 0, name: "", title: "", previousLink: "", link: "" };
+}
+
+export const ShortcutLinkChangedData: MessageFns<ShortcutLinkChangedData> = {
+  encode(message: ShortcutLinkChangedData, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.previousLink !== "") {
+      writer.uint32(34).string(message.previousLink);
+    }
+    if (message.link !== "") {
+      writer.uint32(42).string(message.link);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutLinkChangedData {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutLinkChangedData();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.previousLink = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutLinkChangedData>): ShortcutLinkChangedData {
+    return ShortcutLinkChangedData.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutLinkChangedData>): ShortcutLinkChangedData {
+    const message = createBaseShortcutLinkChangedData();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.previousLink = object.previousLink ?? "";
+    message.link = object.link ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcutFailedOverData(): ShortcutFailedOverData {
+  return { shortcutId: 0, name: "", title: "", previousLink: "", link: "", backup: false };
+}
+
+export const ShortcutFailedOverData: MessageFns<ShortcutFailedOverData> = {
+  encode(message: ShortcutFailedOverData, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.previousLink !== "") {
+      writer.uint32(34).string(message.previousLink);
+    }
+    if (message.link !== "") {
+      writer.uint32(42).string(message.link);
+    }
+    if (message.backup !== false) {
+      writer.uint32(48).bool(message.backup);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutFailedOverData {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutFailedOverData();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.previousLink = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.backup = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutFailedOverData>): ShortcutFailedOverData {
+    return ShortcutFailedOverData.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutFailedOverData>): ShortcutFailedOverData {
+    const message = createBaseShortcutFailedOverData();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.previousLink = object.previousLink ?? "";
+    message.link = object.link ?? "";
+    message.backup = object.backup ?? false;
+    return message;
+  },
+};
+
+function createBaseShortcutChangeRequestData(): ShortcutChangeRequestData {
+  return { shortcutId: 0, name: "", title: "", changeRequestId: 0, fields: [] };
+}
+
+export const ShortcutChangeRequestData: MessageFns<ShortcutChangeRequestData> = {
+  encode(message: ShortcutChangeRequestData, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.changeRequestId !== 0) {
+      writer.uint32(32).int32(message.changeRequestId);
+    }
+    for (const v of message.fields) {
+      writer.uint32(42).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutChangeRequestData {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutChangeRequestData();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.changeRequestId = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.fields.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutChangeRequestData>): ShortcutChangeRequestData {
+    return ShortcutChangeRequestData.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutChangeRequestData>): ShortcutChangeRequestData {
+    const message = createBaseShortcutChangeRequestData();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.changeRequestId = object.changeRequestId ?? 0;
+    message.fields = object.fields?.map((e) => e) || [];
     return message;
   },
 };
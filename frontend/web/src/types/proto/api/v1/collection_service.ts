@@ -9,7 +9,7 @@ import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
 import { FieldMask } from "../../google/protobuf/field_mask";
 import { Timestamp } from "../../google/protobuf/timestamp";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import { Permission, Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
 
 export const protobufPackage = "monotreme.api.v1";
 
@@ -23,6 +23,16 @@ export interface Collection {
   description: string;
   shortcutIds: number[];
   visibility: Visibility;
+  /** The time the collection was moved to the trash, unset if it is not in the trash. */
+  deletedTime?:
+    | Date
+    | undefined;
+  /** The time the collection will be purged from the trash. */
+  purgeTime?:
+    | Date
+    | undefined;
+  /** The groups a GROUP collection is visible to. */
+  groupIds: number[];
 }
 
 export interface ListCollectionsRequest {
@@ -53,6 +63,21 @@ export interface DeleteCollectionRequest {
   id: number;
 }
 
+export interface ListTrashedCollectionsRequest {
+}
+
+export interface ListTrashedCollectionsResponse {
+  collections: Collection[];
+}
+
+export interface RestoreCollectionRequest {
+  id: number;
+}
+
+export interface PurgeCollectionRequest {
+  id: number;
+}
+
 export interface ImportBookmarksRequest {
   htmlContent: string;
 }
@@ -67,6 +92,33 @@ export interface ImportBookmarksResponse {
   collectionsUpdated: number;
 }
 
+export interface ListCollectionPermissionsRequest {
+  id: number;
+}
+
+export interface ListCollectionPermissionsResponse {
+  permissions: Permission[];
+}
+
+export interface GrantCollectionPermissionRequest {
+  id: number;
+  permission?: Permission | undefined;
+}
+
+export interface RevokeCollectionPermissionRequest {
+  id: number;
+  /** The user whose role is revoked. Exactly one of user_id and group_id is set. */
+  userId: number;
+  /** The group whose role is revoked. */
+  groupId: number;
+}
+
+export interface TransferCollectionOwnershipRequest {
+  id: number;
+  /** The user who becomes the creator. */
+  userId: number;
+}
+
 function createBaseCollection(): Collection {
   return {
     id: 0,
@@ -78,6 +130,9 @@ function createBaseCollection(): Collection {
     description: "",
     shortcutIds: [],
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
+    deletedTime: undefined,
+    purgeTime: undefined,
+    groupIds: [],
   };
 }
 
@@ -112,6 +167,17 @@ export const Collection: MessageFns<Collection> = {
     if (message.visibility !== Visibility.VISIBILITY_UNSPECIFIED) {
       writer.uint32(80).int32(visibilityToNumber(message.visibility));
     }
+    if (message.deletedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.deletedTime), writer.uint32(90).fork()).join();
+    }
+    if (message.purgeTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.purgeTime), writer.uint32(98).fork()).join();
+    }
+    writer.uint32(106).fork();
+    for (const v of message.groupIds) {
+      writer.int32(v);
+    }
+    writer.join();
     return writer;
   },
 
@@ -204,6 +270,40 @@ export const Collection: MessageFns<Collection> = {
           message.visibility = visibilityFromJSON(reader.int32());
           continue;
         }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.deletedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.purgeTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 13: {
+          if (tag === 104) {
+            message.groupIds.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 106) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.groupIds.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -227,6 +327,9 @@ export const Collection: MessageFns<Collection> = {
     message.description = object.description ?? "";
     message.shortcutIds = object.shortcutIds?.map((e) => e) || [];
     message.visibility = object.visibility ?? Visibility.VISIBILITY_UNSPECIFIED;
+    message.deletedTime = object.deletedTime ?? undefined;
+    message.purgeTime = object.purgeTime ?? undefined;
+    message.groupIds = object.groupIds?.map((e) => e) || [];
     return message;
   },
 };
@@ -557,6 +660,178 @@ export const DeleteCollectionRequest: MessageFns<DeleteCollectionRequest> = {
   },
 };
 
+function createBaseListTrashedCollectionsRequest(): ListTrashedCollectionsRequest {
+  return {};
+}
+
+export const ListTrashedCollectionsRequest: MessageFns<ListTrashedCollectionsRequest> = {
+  encode(_: ListTrashedCollectionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListTrashedCollectionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListTrashedCollectionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListTrashedCollectionsRequest>): ListTrashedCollectionsRequest {
+    return ListTrashedCollectionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListTrashedCollectionsRequest>): ListTrashedCollectionsRequest {
+    const message = createBaseListTrashedCollectionsRequest();
+    return message;
+  },
+};
+
+function createBaseListTrashedCollectionsResponse(): ListTrashedCollectionsResponse {
+  return { collections: [] };
+}
+
+export const ListTrashedCollectionsResponse: MessageFns<ListTrashedCollectionsResponse> = {
+  encode(message: ListTrashedCollectionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.collections) {
+      Collection.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListTrashedCollectionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListTrashedCollectionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.collections.push(Collection.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListTrashedCollectionsResponse>): ListTrashedCollectionsResponse {
+    return ListTrashedCollectionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListTrashedCollectionsResponse>): ListTrashedCollectionsResponse {
+    const message = createBaseListTrashedCollectionsResponse();
+    message.collections = object.collections?.map((e) => Collection.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRestoreCollectionRequest(): RestoreCollectionRequest {
+  return { id: 0 };
+}
+
+export const RestoreCollectionRequest: MessageFns<RestoreCollectionRequest> = {
+  encode(message: RestoreCollectionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RestoreCollectionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRestoreCollectionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RestoreCollectionRequest>): RestoreCollectionRequest {
+    return RestoreCollectionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RestoreCollectionRequest>): RestoreCollectionRequest {
+    const message = createBaseRestoreCollectionRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBasePurgeCollectionRequest(): PurgeCollectionRequest {
+  return { id: 0 };
+}
+
+export const PurgeCollectionRequest: MessageFns<PurgeCollectionRequest> = {
+  encode(message: PurgeCollectionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PurgeCollectionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePurgeCollectionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<PurgeCollectionRequest>): PurgeCollectionRequest {
+    return PurgeCollectionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PurgeCollectionRequest>): PurgeCollectionRequest {
+    const message = createBasePurgeCollectionRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
 function createBaseImportBookmarksRequest(): ImportBookmarksRequest {
   return { htmlContent: "" };
 }
@@ -729,73 +1004,641 @@ export const ImportBookmarksResponse: MessageFns<ImportBookmarksResponse> = {
   },
 };
 
-export type CollectionServiceDefinition = typeof CollectionServiceDefinition;
-export const CollectionServiceDefinition = {
-  name: "CollectionService",
-  fullName: "monotreme.api.v1.CollectionService",
-  methods: {
-    /** ListCollections returns a list of collections. */
-    listCollections: {
-      name: "ListCollections",
-      requestType: ListCollectionsRequest,
-      requestStream: false,
-      responseType: ListCollectionsResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          578365826: [
-            new Uint8Array([
-              21,
-              18,
-              19,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              99,
-              111,
-              108,
-              108,
-              101,
-              99,
-              116,
-              105,
-              111,
-              110,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    /** GetCollection returns a collection by id. */
-    getCollection: {
-      name: "GetCollection",
-      requestType: GetCollectionRequest,
-      requestStream: false,
-      responseType: Collection,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
-          578365826: [
-            new Uint8Array([
-              26,
-              18,
-              24,
-              47,
-              97,
-              112,
-              105,
-              47,
-              118,
-              49,
-              47,
-              99,
+function createBaseListCollectionPermissionsRequest(): ListCollectionPermissionsRequest {
+  return { id: 0 };
+}
+
+export const ListCollectionPermissionsRequest: MessageFns<ListCollectionPermissionsRequest> = {
+  encode(message: ListCollectionPermissionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListCollectionPermissionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListCollectionPermissionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListCollectionPermissionsRequest>): ListCollectionPermissionsRequest {
+    return ListCollectionPermissionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListCollectionPermissionsRequest>): ListCollectionPermissionsRequest {
+    const message = createBaseListCollectionPermissionsRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseListCollectionPermissionsResponse(): ListCollectionPermissionsResponse {
+  return { permissions: [] };
+}
+
+export const ListCollectionPermissionsResponse: MessageFns<ListCollectionPermissionsResponse> = {
+  encode(message: ListCollectionPermissionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.permissions) {
+      Permission.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListCollectionPermissionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListCollectionPermissionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.permissions.push(Permission.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListCollectionPermissionsResponse>): ListCollectionPermissionsResponse {
+    return ListCollectionPermissionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListCollectionPermissionsResponse>): ListCollectionPermissionsResponse {
+    const message = createBaseListCollectionPermissionsResponse();
+    message.permissions = object.permissions?.map((e) => Permission.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGrantCollectionPermissionRequest(): GrantCollectionPermissionRequest {
+  return { id: 0, permission: undefined };
+}
+
+export const GrantCollectionPermissionRequest: MessageFns<GrantCollectionPermissionRequest> = {
+  encode(message: GrantCollectionPermissionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.permission !== undefined) {
+      Permission.encode(message.permission, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GrantCollectionPermissionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGrantCollectionPermissionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.permission = Permission.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GrantCollectionPermissionRequest>): GrantCollectionPermissionRequest {
+    return GrantCollectionPermissionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GrantCollectionPermissionRequest>): GrantCollectionPermissionRequest {
+    const message = createBaseGrantCollectionPermissionRequest();
+    message.id = object.id ?? 0;
+    message.permission = (object.permission !== undefined && object.permission !== null)
+      ? Permission.fromPartial(object.permission)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseRevokeCollectionPermissionRequest(): RevokeCollectionPermissionRequest {
+  return { id: 0, userId: 0, groupId: 0 };
+}
+
+export const RevokeCollectionPermissionRequest: MessageFns<RevokeCollectionPermissionRequest> = {
+  encode(message: RevokeCollectionPermissionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    if (message.groupId !== 0) {
+      writer.uint32(24).int32(message.groupId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RevokeCollectionPermissionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRevokeCollectionPermissionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.groupId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RevokeCollectionPermissionRequest>): RevokeCollectionPermissionRequest {
+    return RevokeCollectionPermissionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RevokeCollectionPermissionRequest>): RevokeCollectionPermissionRequest {
+    const message = createBaseRevokeCollectionPermissionRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    message.groupId = object.groupId ?? 0;
+    return message;
+  },
+};
+
+function createBaseTransferCollectionOwnershipRequest(): TransferCollectionOwnershipRequest {
+  return { id: 0, userId: 0 };
+}
+
+export const TransferCollectionOwnershipRequest: MessageFns<TransferCollectionOwnershipRequest> = {
+  encode(message: TransferCollectionOwnershipRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TransferCollectionOwnershipRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTransferCollectionOwnershipRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TransferCollectionOwnershipRequest>): TransferCollectionOwnershipRequest {
+    return TransferCollectionOwnershipRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TransferCollectionOwnershipRequest>): TransferCollectionOwnershipRequest {
+    const message = createBaseTransferCollectionOwnershipRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+export type CollectionServiceDefinition = typeof CollectionServiceDefinition;
+export const CollectionServiceDefinition = {
+  name: "CollectionService",
+  fullName: "monotreme.api.v1.CollectionService",
+  methods: {
+    /** ListCollections returns a list of collections. */
+    listCollections: {
+      name: "ListCollections",
+      requestType: ListCollectionsRequest,
+      requestStream: false,
+      responseType: ListCollectionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              21,
+              18,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetCollection returns a collection by id. */
+    getCollection: {
+      name: "GetCollection",
+      requestType: GetCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              26,
+              18,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetCollectionByName returns a collection by name. */
+    getCollectionByName: {
+      name: "GetCollectionByName",
+      requestType: GetCollectionByNameRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {},
+    },
+    /** CreateCollection creates a collection. */
+    createCollection: {
+      name: "CreateCollection",
+      requestType: CreateCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              33,
+              58,
+              10,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              34,
+              19,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** UpdateCollection updates a collection. */
+    updateCollection: {
+      name: "UpdateCollection",
+      requestType: UpdateCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              22,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          578365826: [
+            new Uint8Array([
+              49,
+              58,
+              10,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              26,
+              35,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              46,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteCollection deletes a collection by id. */
+    deleteCollection: {
+      name: "DeleteCollection",
+      requestType: DeleteCollectionRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              26,
+              42,
+              24,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    listTrashedCollections: {
+      name: "ListTrashedCollections",
+      requestType: ListTrashedCollectionsRequest,
+      requestStream: false,
+      responseType: ListTrashedCollectionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              27,
+              18,
+              25,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              97,
+              115,
+              104,
+              47,
+              99,
+              111,
+              108,
+              108,
+              101,
+              99,
+              116,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    restoreCollection: {
+      name: "RestoreCollection",
+      requestType: RestoreCollectionRequest,
+      requestStream: false,
+      responseType: Collection,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              40,
+              34,
+              38,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              97,
+              115,
+              104,
+              47,
+              99,
               111,
               108,
               108,
@@ -811,34 +1654,47 @@ export const CollectionServiceDefinition = {
               105,
               100,
               125,
+              58,
+              114,
+              101,
+              115,
+              116,
+              111,
+              114,
+              101,
             ]),
           ],
         },
       },
     },
-    /** GetCollectionByName returns a collection by name. */
-    getCollectionByName: {
-      name: "GetCollectionByName",
-      requestType: GetCollectionByNameRequest,
-      requestStream: false,
-      responseType: Collection,
-      responseStream: false,
-      options: {},
-    },
-    /** CreateCollection creates a collection. */
-    createCollection: {
-      name: "CreateCollection",
-      requestType: CreateCollectionRequest,
+    purgeCollection: {
+      name: "PurgeCollection",
+      requestType: PurgeCollectionRequest,
       requestStream: false,
-      responseType: Collection,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              33,
-              58,
-              10,
+              32,
+              42,
+              30,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              97,
+              115,
+              104,
+              47,
               99,
               111,
               108,
@@ -849,8 +1705,34 @@ export const CollectionServiceDefinition = {
               105,
               111,
               110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ImportBookmarks imports bookmarks from an HTML file and creates collections and shortcuts. */
+    importBookmarks: {
+      name: "ImportBookmarks",
+      requestType: ImportBookmarksRequest,
+      requestStream: false,
+      responseType: ImportBookmarksResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              31,
+              58,
+              1,
+              42,
               34,
-              19,
+              26,
               47,
               97,
               112,
@@ -870,23 +1752,41 @@ export const CollectionServiceDefinition = {
               111,
               110,
               115,
+              47,
+              105,
+              109,
+              112,
+              111,
+              114,
+              116,
             ]),
           ],
         },
       },
     },
-    /** UpdateCollection updates a collection. */
-    updateCollection: {
-      name: "UpdateCollection",
-      requestType: UpdateCollectionRequest,
+    /** ListCollectionPermissions returns the roles granted on a collection. */
+    listCollectionPermissions: {
+      name: "ListCollectionPermissions",
+      requestType: ListCollectionPermissionsRequest,
       requestStream: false,
-      responseType: Collection,
+      responseType: ListCollectionPermissionsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
             new Uint8Array([
-              22,
+              38,
+              18,
+              36,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
               99,
               111,
               108,
@@ -897,37 +1797,59 @@ export const CollectionServiceDefinition = {
               105,
               111,
               110,
-              44,
-              117,
-              112,
+              115,
+              47,
+              123,
+              105,
               100,
-              97,
-              116,
+              125,
+              47,
+              112,
               101,
-              95,
+              114,
               109,
-              97,
+              105,
+              115,
+              115,
+              105,
+              111,
+              110,
               115,
-              107,
             ]),
           ],
+        },
+      },
+    },
+    /**
+     * GrantCollectionPermission grants a role on a collection to a user or group, replacing the role they had.
+     * Only owners can grant roles.
+     */
+    grantCollectionPermission: {
+      name: "GrantCollectionPermission",
+      requestType: GrantCollectionPermissionRequest,
+      requestStream: false,
+      responseType: Permission,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([13, 105, 100, 44, 112, 101, 114, 109, 105, 115, 115, 105, 111, 110])],
           578365826: [
             new Uint8Array([
-              49,
+              50,
               58,
               10,
-              99,
-              111,
-              108,
-              108,
+              112,
               101,
-              99,
-              116,
+              114,
+              109,
+              105,
+              115,
+              115,
               105,
               111,
               110,
-              26,
-              35,
+              34,
+              36,
               47,
               97,
               112,
@@ -949,40 +1871,40 @@ export const CollectionServiceDefinition = {
               115,
               47,
               123,
-              99,
-              111,
-              108,
-              108,
+              105,
+              100,
+              125,
+              47,
+              112,
               101,
-              99,
-              116,
+              114,
+              109,
+              105,
+              115,
+              115,
               105,
               111,
               110,
-              46,
-              105,
-              100,
-              125,
+              115,
             ]),
           ],
         },
       },
     },
-    /** DeleteCollection deletes a collection by id. */
-    deleteCollection: {
-      name: "DeleteCollection",
-      requestType: DeleteCollectionRequest,
+    /** RevokeCollectionPermission revokes the role of a user or group on a collection. Only owners can revoke roles. */
+    revokeCollectionPermission: {
+      name: "RevokeCollectionPermission",
+      requestType: RevokeCollectionPermissionRequest,
       requestStream: false,
       responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
           578365826: [
             new Uint8Array([
-              26,
+              38,
               42,
-              24,
+              36,
               47,
               97,
               112,
@@ -1007,28 +1929,41 @@ export const CollectionServiceDefinition = {
               105,
               100,
               125,
+              47,
+              112,
+              101,
+              114,
+              109,
+              105,
+              115,
+              115,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
         },
       },
     },
-    /** ImportBookmarks imports bookmarks from an HTML file and creates collections and shortcuts. */
-    importBookmarks: {
-      name: "ImportBookmarks",
-      requestType: ImportBookmarksRequest,
+    /** TransferCollectionOwnership makes another user the creator of a collection. Only owners can transfer it. */
+    transferCollectionOwnership: {
+      name: "TransferCollectionOwnership",
+      requestType: TransferCollectionOwnershipRequest,
       requestStream: false,
-      responseType: ImportBookmarksResponse,
+      responseType: Collection,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([10, 105, 100, 44, 117, 115, 101, 114, 95, 105, 100])],
           578365826: [
             new Uint8Array([
-              31,
+              38,
               58,
               1,
               42,
               34,
-              26,
+              33,
               47,
               97,
               112,
@@ -1049,12 +1984,19 @@ export const CollectionServiceDefinition = {
               110,
               115,
               47,
+              123,
               105,
-              109,
-              112,
-              111,
-              114,
+              100,
+              125,
+              58,
               116,
+              114,
+              97,
+              110,
+              115,
+              102,
+              101,
+              114,
             ]),
           ],
         },
@@ -5,6 +5,8 @@
 // source: api/v1/common.proto
 
 /* eslint-disable */
+import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
+import { Timestamp } from "../../google/protobuf/timestamp";
 
 export const protobufPackage = "monotreme.api.v1";
 
@@ -51,6 +53,10 @@ export enum Visibility {
   VISIBILITY_UNSPECIFIED = "VISIBILITY_UNSPECIFIED",
   WORKSPACE = "WORKSPACE",
   PUBLIC = "PUBLIC",
+  /** PRIVATE - Only visible to the creator. */
+  PRIVATE = "PRIVATE",
+  /** GROUP - Only visible to the creator and the members of the groups it is shared with. */
+  GROUP = "GROUP",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -65,6 +71,12 @@ export function visibilityFromJSON(object: any): Visibility {
     case 2:
     case "PUBLIC":
       return Visibility.PUBLIC;
+    case 3:
+    case "PRIVATE":
+      return Visibility.PRIVATE;
+    case 4:
+    case "GROUP":
+      return Visibility.GROUP;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -80,8 +92,252 @@ export function visibilityToNumber(object: Visibility): number {
       return 1;
     case Visibility.PUBLIC:
       return 2;
+    case Visibility.PRIVATE:
+      return 3;
+    case Visibility.GROUP:
+      return 4;
     case Visibility.UNRECOGNIZED:
     default:
       return -1;
   }
 }
+
+export enum RedirectMode {
+  REDIRECT_MODE_UNSPECIFIED = "REDIRECT_MODE_UNSPECIFIED",
+  /** FOUND - HTTP 302. */
+  FOUND = "FOUND",
+  /** MOVED_PERMANENTLY - HTTP 301. */
+  MOVED_PERMANENTLY = "MOVED_PERMANENTLY",
+  /** TEMPORARY_REDIRECT - HTTP 307. */
+  TEMPORARY_REDIRECT = "TEMPORARY_REDIRECT",
+  /** PERMANENT_REDIRECT - HTTP 308. */
+  PERMANENT_REDIRECT = "PERMANENT_REDIRECT",
+  /** META_REFRESH - An HTML page that refreshes to the link without sending a referrer. */
+  META_REFRESH = "META_REFRESH",
+  /** INTERSTITIAL - A preview page that shows the destination before continuing. */
+  INTERSTITIAL = "INTERSTITIAL",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function redirectModeFromJSON(object: any): RedirectMode {
+  switch (object) {
+    case 0:
+    case "REDIRECT_MODE_UNSPECIFIED":
+      return RedirectMode.REDIRECT_MODE_UNSPECIFIED;
+    case 1:
+    case "FOUND":
+      return RedirectMode.FOUND;
+    case 2:
+    case "MOVED_PERMANENTLY":
+      return RedirectMode.MOVED_PERMANENTLY;
+    case 3:
+    case "TEMPORARY_REDIRECT":
+      return RedirectMode.TEMPORARY_REDIRECT;
+    case 4:
+    case "PERMANENT_REDIRECT":
+      return RedirectMode.PERMANENT_REDIRECT;
+    case 5:
+    case "META_REFRESH":
+      return RedirectMode.META_REFRESH;
+    case 6:
+    case "INTERSTITIAL":
+      return RedirectMode.INTERSTITIAL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return RedirectMode.UNRECOGNIZED;
+  }
+}
+
+export function redirectModeToNumber(object: RedirectMode): number {
+  switch (object) {
+    case RedirectMode.REDIRECT_MODE_UNSPECIFIED:
+      return 0;
+    case RedirectMode.FOUND:
+      return 1;
+    case RedirectMode.MOVED_PERMANENTLY:
+      return 2;
+    case RedirectMode.TEMPORARY_REDIRECT:
+      return 3;
+    case RedirectMode.PERMANENT_REDIRECT:
+      return 4;
+    case RedirectMode.META_REFRESH:
+      return 5;
+    case RedirectMode.INTERSTITIAL:
+      return 6;
+    case RedirectMode.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/**
+ * PermissionRole is the role a permission grants on a shortcut or collection.
+ * Each role includes the ones before it.
+ */
+export enum PermissionRole {
+  PERMISSION_ROLE_UNSPECIFIED = "PERMISSION_ROLE_UNSPECIFIED",
+  /** VIEWER - Can see the resource whatever its visibility. */
+  VIEWER = "VIEWER",
+  /** EDITOR - Can also change the resource, except for who it is shared with. */
+  EDITOR = "EDITOR",
+  /** OWNER - Can do everything the creator of the resource can. */
+  OWNER = "OWNER",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function permissionRoleFromJSON(object: any): PermissionRole {
+  switch (object) {
+    case 0:
+    case "PERMISSION_ROLE_UNSPECIFIED":
+      return PermissionRole.PERMISSION_ROLE_UNSPECIFIED;
+    case 1:
+    case "VIEWER":
+      return PermissionRole.VIEWER;
+    case 2:
+    case "EDITOR":
+      return PermissionRole.EDITOR;
+    case 3:
+    case "OWNER":
+      return PermissionRole.OWNER;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PermissionRole.UNRECOGNIZED;
+  }
+}
+
+export function permissionRoleToNumber(object: PermissionRole): number {
+  switch (object) {
+    case PermissionRole.PERMISSION_ROLE_UNSPECIFIED:
+      return 0;
+    case PermissionRole.VIEWER:
+      return 1;
+    case PermissionRole.EDITOR:
+      return 2;
+    case PermissionRole.OWNER:
+      return 3;
+    case PermissionRole.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** Permission is a role granted to a user, or to the members of a group, on a shortcut or collection. */
+export interface Permission {
+  /** The user the role is granted to. Exactly one of user_id and group_id is set. */
+  userId: number;
+  /** The group whose members the role is granted to. */
+  groupId: number;
+  role: PermissionRole;
+  createdTime?: Date | undefined;
+}
+
+function createBasePermission(): Permission {
+  return { userId: 0, groupId: 0, role: PermissionRole.PERMISSION_ROLE_UNSPECIFIED, createdTime: undefined };
+}
+
+export const Permission: MessageFns<Permission> = {
+  encode(message: Permission, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.userId !== 0) {
+      writer.uint32(8).int32(message.userId);
+    }
+    if (message.groupId !== 0) {
+      writer.uint32(16).int32(message.groupId);
+    }
+    if (message.role !== PermissionRole.PERMISSION_ROLE_UNSPECIFIED) {
+      writer.uint32(24).int32(permissionRoleToNumber(message.role));
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Permission {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePermission();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.groupId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.role = permissionRoleFromJSON(reader.int32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Permission>): Permission {
+    return Permission.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Permission>): Permission {
+    const message = createBasePermission();
+    message.userId = object.userId ?? 0;
+    message.groupId = object.groupId ?? 0;
+    message.role = object.role ?? PermissionRole.PERMISSION_ROLE_UNSPECIFIED;
+    message.createdTime = object.createdTime ?? undefined;
+    return message;
+  },
+};
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+export interface MessageFns<T> {
+  encode(message: T, writer?: BinaryWriter): BinaryWriter;
+  decode(input: BinaryReader | Uint8Array, length?: number): T;
+  create(base?: DeepPartial<T>): T;
+  fromPartial(object: DeepPartial<T>): T;
+}
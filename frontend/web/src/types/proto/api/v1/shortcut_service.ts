@@ -9,7 +9,18 @@ import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
 import { FieldMask } from "../../google/protobuf/field_mask";
 import { Timestamp } from "../../google/protobuf/timestamp";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import {
+  Permission,
+  RedirectMode,
+  redirectModeFromJSON,
+  redirectModeToNumber,
+  State,
+  stateFromJSON,
+  stateToNumber,
+  Visibility,
+  visibilityFromJSON,
+  visibilityToNumber,
+} from "./common";
 
 export const protobufPackage = "monotreme.api.v1";
 
@@ -26,7 +37,56 @@ export interface Shortcut {
   description: string;
   visibility: Visibility;
   viewCount: number;
-  ogMetadata?: Shortcut_OpenGraphMetadata | undefined;
+  ogMetadata?:
+    | Shortcut_OpenGraphMetadata
+    | undefined;
+  /** Whether the link is a template whose {placeholders} are filled from the visited path and query. */
+  template: boolean;
+  /** Whether path segments after the shortcut name are appended to the link. */
+  forwardPath: boolean;
+  /** How visitors are sent to the link. Unspecified uses the workspace default. */
+  redirectMode: RedirectMode;
+  /** The time from which the shortcut resolves. Unset means no start. */
+  validFrom?:
+    | Date
+    | undefined;
+  /** The time at which the shortcut expires. Unset means no expiry. */
+  validUntil?:
+    | Date
+    | undefined;
+  /** Expired shortcuts become inactive when they are archived. */
+  state: State;
+  /** Links to split the traffic across by weight. When set, they are served instead of the link. */
+  variants: Shortcut_Variant[];
+  /** Whether a visitor keeps being served the same variant, keyed on a cookie. */
+  stickyVariants: boolean;
+  /** Rules that send matching requests elsewhere. The first matching rule wins; otherwise the link is used. */
+  routingRules: Shortcut_RoutingRule[];
+  /** Links served in order when the link is unhealthy. */
+  backupLinks: string[];
+  /** The last health check of the link and each backup link, if any has been checked. */
+  targetHealth: Shortcut_TargetHealth[];
+  /** Other names that resolve to this shortcut. Visits through an alias count towards the shortcut. */
+  aliases: string[];
+  /** The time the shortcut was moved to the trash, unset if it is not in the trash. */
+  deletedTime?:
+    | Date
+    | undefined;
+  /** The time the shortcut will be purged from the trash. */
+  purgeTime?:
+    | Date
+    | undefined;
+  /** The groups a GROUP shortcut is visible to. */
+  groupIds: number[];
+  /** Whether edits to the shortcut must be approved by another person. Only owners can change it. */
+  protected: boolean;
+  /**
+   * Whether edits to the shortcut create a change request instead of being applied, because the
+   * shortcut is protected or its name is in a namespace that requires approval.
+   */
+  requiresApproval: boolean;
+  /** The number of change requests waiting for review. */
+  pendingChangeRequestCount: number;
 }
 
 export interface Shortcut_OpenGraphMetadata {
@@ -35,7 +95,105 @@ export interface Shortcut_OpenGraphMetadata {
   image: string;
 }
 
+export interface Shortcut_Variant {
+  name: string;
+  link: string;
+  /** The relative share of traffic served by this variant. */
+  weight: number;
+}
+
+export interface Shortcut_RoutingRule {
+  /** All conditions must match for the rule to apply. */
+  conditions: Shortcut_RoutingCondition[];
+  link: string;
+}
+
+export interface Shortcut_RoutingCondition {
+  field: Shortcut_RoutingCondition_Field;
+  /** The header name, for HEADER conditions. */
+  header: string;
+  /**
+   * The condition matches when the request has any of the values, compared case-insensitively.
+   * A HEADER condition without values matches when the header is present.
+   */
+  values: string[];
+}
+
+export enum Shortcut_RoutingCondition_Field {
+  FIELD_UNSPECIFIED = "FIELD_UNSPECIFIED",
+  /** DEVICE - One of mobile, tablet, desktop or bot. */
+  DEVICE = "DEVICE",
+  /** OS - One of ios, android, windows, macos, linux or chromeos. */
+  OS = "OS",
+  /** BROWSER - The browser name, such as chrome, firefox, safari or edge. */
+  BROWSER = "BROWSER",
+  /** LANGUAGE - A language tag matched against the most preferred Accept-Language, such as de or de-AT. */
+  LANGUAGE = "LANGUAGE",
+  /** HEADER - The request header named by header. */
+  HEADER = "HEADER",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function shortcut_RoutingCondition_FieldFromJSON(object: any): Shortcut_RoutingCondition_Field {
+  switch (object) {
+    case 0:
+    case "FIELD_UNSPECIFIED":
+      return Shortcut_RoutingCondition_Field.FIELD_UNSPECIFIED;
+    case 1:
+    case "DEVICE":
+      return Shortcut_RoutingCondition_Field.DEVICE;
+    case 2:
+    case "OS":
+      return Shortcut_RoutingCondition_Field.OS;
+    case 3:
+    case "BROWSER":
+      return Shortcut_RoutingCondition_Field.BROWSER;
+    case 4:
+    case "LANGUAGE":
+      return Shortcut_RoutingCondition_Field.LANGUAGE;
+    case 5:
+    case "HEADER":
+      return Shortcut_RoutingCondition_Field.HEADER;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Shortcut_RoutingCondition_Field.UNRECOGNIZED;
+  }
+}
+
+export function shortcut_RoutingCondition_FieldToNumber(object: Shortcut_RoutingCondition_Field): number {
+  switch (object) {
+    case Shortcut_RoutingCondition_Field.FIELD_UNSPECIFIED:
+      return 0;
+    case Shortcut_RoutingCondition_Field.DEVICE:
+      return 1;
+    case Shortcut_RoutingCondition_Field.OS:
+      return 2;
+    case Shortcut_RoutingCondition_Field.BROWSER:
+      return 3;
+    case Shortcut_RoutingCondition_Field.LANGUAGE:
+      return 4;
+    case Shortcut_RoutingCondition_Field.HEADER:
+      return 5;
+    case Shortcut_RoutingCondition_Field.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface Shortcut_TargetHealth {
+  link: string;
+  healthy: boolean;
+  /** The HTTP status of the last check, or 0 if the request failed. */
+  statusCode: number;
+  /** Why the last check failed, if it did. */
+  error: string;
+  checkedTime?: Date | undefined;
+}
+
 export interface ListShortcutsRequest {
+  /** Whether to leave out shortcuts that have expired. */
+  excludeExpired: boolean;
 }
 
 export interface ListShortcutsResponse {
@@ -57,12 +215,29 @@ export interface CreateShortcutRequest {
 export interface UpdateShortcutRequest {
   shortcut?: Shortcut | undefined;
   updateMask?: string[] | undefined;
+  /** Whether the current name is kept as an alias when the name is changed. */
+  keepOldNameAsAlias: boolean;
 }
 
 export interface DeleteShortcutRequest {
   id: number;
 }
 
+export interface ListTrashedShortcutsRequest {
+}
+
+export interface ListTrashedShortcutsResponse {
+  shortcuts: Shortcut[];
+}
+
+export interface RestoreShortcutRequest {
+  id: number;
+}
+
+export interface PurgeShortcutRequest {
+  id: number;
+}
+
 export interface GetShortcutAnalyticsRequest {
   id: number;
 }
@@ -71,6 +246,10 @@ export interface GetShortcutAnalyticsResponse {
   references: GetShortcutAnalyticsResponse_AnalyticsItem[];
   devices: GetShortcutAnalyticsResponse_AnalyticsItem[];
   browsers: GetShortcutAnalyticsResponse_AnalyticsItem[];
+  /** Clicks per variant served, for shortcuts with variants. */
+  variants: GetShortcutAnalyticsResponse_AnalyticsItem[];
+  /** Fetches by known bots, which are left out of the other counts. */
+  bots: GetShortcutAnalyticsResponse_AnalyticsItem[];
 }
 
 export interface GetShortcutAnalyticsResponse_AnalyticsItem {
@@ -78,6 +257,211 @@ export interface GetShortcutAnalyticsResponse_AnalyticsItem {
   count: number;
 }
 
+export interface ShortcutLinkChange {
+  id: number;
+  shortcutId: number;
+  creatorId: number;
+  createdTime?:
+    | Date
+    | undefined;
+  /** The time from which the link is in effect. */
+  effectiveTime?: Date | undefined;
+  link: string;
+  /** The time the change was applied to the shortcut, unset while pending. */
+  appliedTime?: Date | undefined;
+}
+
+export interface ListShortcutLinkChangesRequest {
+  shortcutId: number;
+  /** Whether to only return changes that have not been applied yet. */
+  pendingOnly: boolean;
+}
+
+export interface ListShortcutLinkChangesResponse {
+  linkChanges: ShortcutLinkChange[];
+}
+
+export interface CreateShortcutLinkChangeRequest {
+  linkChange?: ShortcutLinkChange | undefined;
+}
+
+export interface CancelShortcutLinkChangeRequest {
+  shortcutId: number;
+  id: number;
+}
+
+export interface DryRunShortcutRoutingRequest {
+  id: number;
+  /** The request headers to route, such as User-Agent and Accept-Language. */
+  headers: { [key: string]: string };
+}
+
+export interface DryRunShortcutRoutingRequest_HeadersEntry {
+  key: string;
+  value: string;
+}
+
+export interface DryRunShortcutRoutingResponse {
+  /** The link the request would be sent to, before any template or path forwarding. */
+  link: string;
+  /** The 0-based index of the matching rule, or -1 when the shortcut link is used. */
+  matchedRule: number;
+  /** The request properties the rules were matched against. */
+  device: string;
+  os: string;
+  browser: string;
+  language: string;
+}
+
+/** ShortcutRevision is a recorded change of a shortcut. */
+export interface ShortcutRevision {
+  id: number;
+  shortcutId: number;
+  /** The user who made the change, or 0 for changes made by the system. */
+  creatorId: number;
+  createdTime?:
+    | Date
+    | undefined;
+  /**
+   * The field-level diff of the change. It is empty for the baseline revision,
+   * which records the shortcut as it was before its first recorded change.
+   */
+  changes: ShortcutRevision_FieldChange[];
+  /** The revision that was restored by this one, if it is a rollback. */
+  restoredRevisionId: number;
+}
+
+export interface ShortcutRevision_FieldChange {
+  /** The field name, as in the UpdateShortcut update mask. */
+  field: string;
+  previousValue: string;
+  value: string;
+}
+
+export interface ListShortcutRevisionsRequest {
+  shortcutId: number;
+}
+
+export interface ListShortcutRevisionsResponse {
+  revisions: ShortcutRevision[];
+}
+
+export interface RestoreShortcutRevisionRequest {
+  shortcutId: number;
+  id: number;
+}
+
+export interface RestoreShortcutRevisionResponse {
+  shortcut?: Shortcut | undefined;
+  /** The revision recording the rollback, unset if the shortcut already matched. */
+  revision?: ShortcutRevision | undefined;
+}
+
+export interface ListShortcutPermissionsRequest {
+  id: number;
+}
+
+export interface ListShortcutPermissionsResponse {
+  permissions: Permission[];
+}
+
+export interface GrantShortcutPermissionRequest {
+  id: number;
+  permission?: Permission | undefined;
+}
+
+export interface RevokeShortcutPermissionRequest {
+  id: number;
+  /** The user whose role is revoked. Exactly one of user_id and group_id is set. */
+  userId: number;
+  /** The group whose role is revoked. */
+  groupId: number;
+}
+
+export interface TransferShortcutOwnershipRequest {
+  id: number;
+  /** The user who becomes the creator. */
+  userId: number;
+}
+
+/** ShortcutChangeRequest is an edit of a protected shortcut waiting for, or after, review. */
+export interface ShortcutChangeRequest {
+  id: number;
+  shortcutId: number;
+  /** The user who requested the change. */
+  creatorId: number;
+  createdTime?: Date | undefined;
+  updatedTime?: Date | undefined;
+  status: ShortcutChangeRequest_Status;
+  /** The user who approved or rejected the change, or 0 while pending. */
+  reviewerId: number;
+  /** The field-level diff of the change, against the shortcut when the change was requested. */
+  changes: ShortcutRevision_FieldChange[];
+}
+
+export enum ShortcutChangeRequest_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  PENDING = "PENDING",
+  APPROVED = "APPROVED",
+  REJECTED = "REJECTED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function shortcutChangeRequest_StatusFromJSON(object: any): ShortcutChangeRequest_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return ShortcutChangeRequest_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return ShortcutChangeRequest_Status.PENDING;
+    case 2:
+    case "APPROVED":
+      return ShortcutChangeRequest_Status.APPROVED;
+    case 3:
+    case "REJECTED":
+      return ShortcutChangeRequest_Status.REJECTED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ShortcutChangeRequest_Status.UNRECOGNIZED;
+  }
+}
+
+export function shortcutChangeRequest_StatusToNumber(object: ShortcutChangeRequest_Status): number {
+  switch (object) {
+    case ShortcutChangeRequest_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case ShortcutChangeRequest_Status.PENDING:
+      return 1;
+    case ShortcutChangeRequest_Status.APPROVED:
+      return 2;
+    case ShortcutChangeRequest_Status.REJECTED:
+      return 3;
+    case ShortcutChangeRequest_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ListShortcutChangeRequestsRequest {
+  shortcutId: number;
+}
+
+export interface ListShortcutChangeRequestsResponse {
+  changeRequests: ShortcutChangeRequest[];
+}
+
+export interface ApproveShortcutChangeRequestRequest {
+  shortcutId: number;
+  id: number;
+}
+
+export interface RejectShortcutChangeRequestRequest {
+  shortcutId: number;
+  id: number;
+}
+
 function createBaseShortcut(): Shortcut {
   return {
     id: 0,
@@ -93,6 +477,24 @@ function createBaseShortcut(): Shortcut {
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
     viewCount: 0,
     ogMetadata: undefined,
+    template: false,
+    forwardPath: false,
+    redirectMode: RedirectMode.REDIRECT_MODE_UNSPECIFIED,
+    validFrom: undefined,
+    validUntil: undefined,
+    state: State.STATE_UNSPECIFIED,
+    variants: [],
+    stickyVariants: false,
+    routingRules: [],
+    backupLinks: [],
+    targetHealth: [],
+    aliases: [],
+    deletedTime: undefined,
+    purgeTime: undefined,
+    groupIds: [],
+    protected: false,
+    requiresApproval: false,
+    pendingChangeRequestCount: 0,
   };
 }
 
@@ -137,6 +539,62 @@ export const Shortcut: MessageFns<Shortcut> = {
     if (message.ogMetadata !== undefined) {
       Shortcut_OpenGraphMetadata.encode(message.ogMetadata, writer.uint32(106).fork()).join();
     }
+    if (message.template !== false) {
+      writer.uint32(112).bool(message.template);
+    }
+    if (message.forwardPath !== false) {
+      writer.uint32(120).bool(message.forwardPath);
+    }
+    if (message.redirectMode !== RedirectMode.REDIRECT_MODE_UNSPECIFIED) {
+      writer.uint32(128).int32(redirectModeToNumber(message.redirectMode));
+    }
+    if (message.validFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.validFrom), writer.uint32(138).fork()).join();
+    }
+    if (message.validUntil !== undefined) {
+      Timestamp.encode(toTimestamp(message.validUntil), writer.uint32(146).fork()).join();
+    }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      writer.uint32(152).int32(stateToNumber(message.state));
+    }
+    for (const v of message.variants) {
+      Shortcut_Variant.encode(v!, writer.uint32(162).fork()).join();
+    }
+    if (message.stickyVariants !== false) {
+      writer.uint32(168).bool(message.stickyVariants);
+    }
+    for (const v of message.routingRules) {
+      Shortcut_RoutingRule.encode(v!, writer.uint32(178).fork()).join();
+    }
+    for (const v of message.backupLinks) {
+      writer.uint32(186).string(v!);
+    }
+    for (const v of message.targetHealth) {
+      Shortcut_TargetHealth.encode(v!, writer.uint32(194).fork()).join();
+    }
+    for (const v of message.aliases) {
+      writer.uint32(202).string(v!);
+    }
+    if (message.deletedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.deletedTime), writer.uint32(210).fork()).join();
+    }
+    if (message.purgeTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.purgeTime), writer.uint32(218).fork()).join();
+    }
+    writer.uint32(226).fork();
+    for (const v of message.groupIds) {
+      writer.int32(v);
+    }
+    writer.join();
+    if (message.protected !== false) {
+      writer.uint32(232).bool(message.protected);
+    }
+    if (message.requiresApproval !== false) {
+      writer.uint32(240).bool(message.requiresApproval);
+    }
+    if (message.pendingChangeRequestCount !== 0) {
+      writer.uint32(248).int32(message.pendingChangeRequestCount);
+    }
     return writer;
   },
 
@@ -251,6 +709,160 @@ export const Shortcut: MessageFns<Shortcut> = {
           message.ogMetadata = Shortcut_OpenGraphMetadata.decode(reader, reader.uint32());
           continue;
         }
+        case 14: {
+          if (tag !== 112) {
+            break;
+          }
+
+          message.template = reader.bool();
+          continue;
+        }
+        case 15: {
+          if (tag !== 120) {
+            break;
+          }
+
+          message.forwardPath = reader.bool();
+          continue;
+        }
+        case 16: {
+          if (tag !== 128) {
+            break;
+          }
+
+          message.redirectMode = redirectModeFromJSON(reader.int32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.validFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.validUntil = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 19: {
+          if (tag !== 152) {
+            break;
+          }
+
+          message.state = stateFromJSON(reader.int32());
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.variants.push(Shortcut_Variant.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 21: {
+          if (tag !== 168) {
+            break;
+          }
+
+          message.stickyVariants = reader.bool();
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.routingRules.push(Shortcut_RoutingRule.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 23: {
+          if (tag !== 186) {
+            break;
+          }
+
+          message.backupLinks.push(reader.string());
+          continue;
+        }
+        case 24: {
+          if (tag !== 194) {
+            break;
+          }
+
+          message.targetHealth.push(Shortcut_TargetHealth.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 25: {
+          if (tag !== 202) {
+            break;
+          }
+
+          message.aliases.push(reader.string());
+          continue;
+        }
+        case 26: {
+          if (tag !== 210) {
+            break;
+          }
+
+          message.deletedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 27: {
+          if (tag !== 218) {
+            break;
+          }
+
+          message.purgeTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 28: {
+          if (tag === 224) {
+            message.groupIds.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 226) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.groupIds.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 29: {
+          if (tag !== 232) {
+            break;
+          }
+
+          message.protected = reader.bool();
+          continue;
+        }
+        case 30: {
+          if (tag !== 240) {
+            break;
+          }
+
+          message.requiresApproval = reader.bool();
+          continue;
+        }
+        case 31: {
+          if (tag !== 248) {
+            break;
+          }
+
+          message.pendingChangeRequestCount = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -280,6 +892,24 @@ export const Shortcut: MessageFns<Shortcut> = {
     message.ogMetadata = (object.ogMetadata !== undefined && object.ogMetadata !== null)
       ? Shortcut_OpenGraphMetadata.fromPartial(object.ogMetadata)
       : undefined;
+    message.template = object.template ?? false;
+    message.forwardPath = object.forwardPath ?? false;
+    message.redirectMode = object.redirectMode ?? RedirectMode.REDIRECT_MODE_UNSPECIFIED;
+    message.validFrom = object.validFrom ?? undefined;
+    message.validUntil = object.validUntil ?? undefined;
+    message.state = object.state ?? State.STATE_UNSPECIFIED;
+    message.variants = object.variants?.map((e) => Shortcut_Variant.fromPartial(e)) || [];
+    message.stickyVariants = object.stickyVariants ?? false;
+    message.routingRules = object.routingRules?.map((e) => Shortcut_RoutingRule.fromPartial(e)) || [];
+    message.backupLinks = object.backupLinks?.map((e) => e) || [];
+    message.targetHealth = object.targetHealth?.map((e) => Shortcut_TargetHealth.fromPartial(e)) || [];
+    message.aliases = object.aliases?.map((e) => e) || [];
+    message.deletedTime = object.deletedTime ?? undefined;
+    message.purgeTime = object.purgeTime ?? undefined;
+    message.groupIds = object.groupIds?.map((e) => e) || [];
+    message.protected = object.protected ?? false;
+    message.requiresApproval = object.requiresApproval ?? false;
+    message.pendingChangeRequestCount = object.pendingChangeRequestCount ?? 0;
     return message;
   },
 };
@@ -354,22 +984,55 @@ export const Shortcut_OpenGraphMetadata: MessageFns<Shortcut_OpenGraphMetadata>
   },
 };
 
-function createBaseListShortcutsRequest(): ListShortcutsRequest {
-  return {};
+function createBaseShortcut_Variant(): Shortcut_Variant {
+  return { name: "", link: "", weight: 0 };
 }
 
-export const ListShortcutsRequest: MessageFns<ListShortcutsRequest> = {
-  encode(_: ListShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const Shortcut_Variant: MessageFns<Shortcut_Variant> = {
+  encode(message: Shortcut_Variant, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    if (message.weight !== 0) {
+      writer.uint32(24).int32(message.weight);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutsRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_Variant {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListShortcutsRequest();
+    const message = createBaseShortcut_Variant();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.weight = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -379,22 +1042,293 @@ export const ListShortcutsRequest: MessageFns<ListShortcutsRequest> = {
     return message;
   },
 
-  create(base?: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
-    return ListShortcutsRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<Shortcut_Variant>): Shortcut_Variant {
+    return Shortcut_Variant.fromPartial(base ?? {});
   },
-  fromPartial(_: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
-    const message = createBaseListShortcutsRequest();
+  fromPartial(object: DeepPartial<Shortcut_Variant>): Shortcut_Variant {
+    const message = createBaseShortcut_Variant();
+    message.name = object.name ?? "";
+    message.link = object.link ?? "";
+    message.weight = object.weight ?? 0;
     return message;
   },
 };
 
-function createBaseListShortcutsResponse(): ListShortcutsResponse {
-  return { shortcuts: [] };
+function createBaseShortcut_RoutingRule(): Shortcut_RoutingRule {
+  return { conditions: [], link: "" };
 }
 
-export const ListShortcutsResponse: MessageFns<ListShortcutsResponse> = {
-  encode(message: ListShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.shortcuts) {
+export const Shortcut_RoutingRule: MessageFns<Shortcut_RoutingRule> = {
+  encode(message: Shortcut_RoutingRule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.conditions) {
+      Shortcut_RoutingCondition.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_RoutingRule {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcut_RoutingRule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.conditions.push(Shortcut_RoutingCondition.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Shortcut_RoutingRule>): Shortcut_RoutingRule {
+    return Shortcut_RoutingRule.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Shortcut_RoutingRule>): Shortcut_RoutingRule {
+    const message = createBaseShortcut_RoutingRule();
+    message.conditions = object.conditions?.map((e) => Shortcut_RoutingCondition.fromPartial(e)) || [];
+    message.link = object.link ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcut_RoutingCondition(): Shortcut_RoutingCondition {
+  return { field: Shortcut_RoutingCondition_Field.FIELD_UNSPECIFIED, header: "", values: [] };
+}
+
+export const Shortcut_RoutingCondition: MessageFns<Shortcut_RoutingCondition> = {
+  encode(message: Shortcut_RoutingCondition, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== Shortcut_RoutingCondition_Field.FIELD_UNSPECIFIED) {
+      writer.uint32(8).int32(shortcut_RoutingCondition_FieldToNumber(message.field));
+    }
+    if (message.header !== "") {
+      writer.uint32(18).string(message.header);
+    }
+    for (const v of message.values) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_RoutingCondition {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcut_RoutingCondition();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.field = shortcut_RoutingCondition_FieldFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.header = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.values.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Shortcut_RoutingCondition>): Shortcut_RoutingCondition {
+    return Shortcut_RoutingCondition.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Shortcut_RoutingCondition>): Shortcut_RoutingCondition {
+    const message = createBaseShortcut_RoutingCondition();
+    message.field = object.field ?? Shortcut_RoutingCondition_Field.FIELD_UNSPECIFIED;
+    message.header = object.header ?? "";
+    message.values = object.values?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseShortcut_TargetHealth(): Shortcut_TargetHealth {
+  return { link: "", healthy: false, statusCode: 0, error: "", checkedTime: undefined };
+}
+
+export const Shortcut_TargetHealth: MessageFns<Shortcut_TargetHealth> = {
+  encode(message: Shortcut_TargetHealth, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.link !== "") {
+      writer.uint32(10).string(message.link);
+    }
+    if (message.healthy !== false) {
+      writer.uint32(16).bool(message.healthy);
+    }
+    if (message.statusCode !== 0) {
+      writer.uint32(24).int32(message.statusCode);
+    }
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    if (message.checkedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.checkedTime), writer.uint32(42).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Shortcut_TargetHealth {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcut_TargetHealth();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.healthy = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.statusCode = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.checkedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<Shortcut_TargetHealth>): Shortcut_TargetHealth {
+    return Shortcut_TargetHealth.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Shortcut_TargetHealth>): Shortcut_TargetHealth {
+    const message = createBaseShortcut_TargetHealth();
+    message.link = object.link ?? "";
+    message.healthy = object.healthy ?? false;
+    message.statusCode = object.statusCode ?? 0;
+    message.error = object.error ?? "";
+    message.checkedTime = object.checkedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListShortcutsRequest(): ListShortcutsRequest {
+  return { excludeExpired: false };
+}
+
+export const ListShortcutsRequest: MessageFns<ListShortcutsRequest> = {
+  encode(message: ListShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.excludeExpired !== false) {
+      writer.uint32(8).bool(message.excludeExpired);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.excludeExpired = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
+    return ListShortcutsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutsRequest>): ListShortcutsRequest {
+    const message = createBaseListShortcutsRequest();
+    message.excludeExpired = object.excludeExpired ?? false;
+    return message;
+  },
+};
+
+function createBaseListShortcutsResponse(): ListShortcutsResponse {
+  return { shortcuts: [] };
+}
+
+export const ListShortcutsResponse: MessageFns<ListShortcutsResponse> = {
+  encode(message: ListShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
       Shortcut.encode(v!, writer.uint32(10).fork()).join();
     }
     return writer;
@@ -575,7 +1509,7 @@ export const CreateShortcutRequest: MessageFns<CreateShortcutRequest> = {
 };
 
 function createBaseUpdateShortcutRequest(): UpdateShortcutRequest {
-  return { shortcut: undefined, updateMask: undefined };
+  return { shortcut: undefined, updateMask: undefined, keepOldNameAsAlias: false };
 }
 
 export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
@@ -586,6 +1520,9 @@ export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
     if (message.updateMask !== undefined) {
       FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).join();
     }
+    if (message.keepOldNameAsAlias !== false) {
+      writer.uint32(24).bool(message.keepOldNameAsAlias);
+    }
     return writer;
   },
 
@@ -612,6 +1549,14 @@ export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
           message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.keepOldNameAsAlias = reader.bool();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -630,6 +1575,7 @@ export const UpdateShortcutRequest: MessageFns<UpdateShortcutRequest> = {
       ? Shortcut.fromPartial(object.shortcut)
       : undefined;
     message.updateMask = object.updateMask ?? undefined;
+    message.keepOldNameAsAlias = object.keepOldNameAsAlias ?? false;
     return message;
   },
 };
@@ -680,33 +1626,22 @@ export const DeleteShortcutRequest: MessageFns<DeleteShortcutRequest> = {
   },
 };
 
-function createBaseGetShortcutAnalyticsRequest(): GetShortcutAnalyticsRequest {
-  return { id: 0 };
+function createBaseListTrashedShortcutsRequest(): ListTrashedShortcutsRequest {
+  return {};
 }
 
-export const GetShortcutAnalyticsRequest: MessageFns<GetShortcutAnalyticsRequest> = {
-  encode(message: GetShortcutAnalyticsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.id !== 0) {
-      writer.uint32(8).int32(message.id);
-    }
+export const ListTrashedShortcutsRequest: MessageFns<ListTrashedShortcutsRequest> = {
+  encode(_: ListTrashedShortcutsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): ListTrashedShortcutsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutAnalyticsRequest();
+    const message = createBaseListTrashedShortcutsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 8) {
-            break;
-          }
-
-          message.id = reader.int32();
-          continue;
-        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -716,38 +1651,31 @@ export const GetShortcutAnalyticsRequest: MessageFns<GetShortcutAnalyticsRequest
     return message;
   },
 
-  create(base?: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
-    return GetShortcutAnalyticsRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListTrashedShortcutsRequest>): ListTrashedShortcutsRequest {
+    return ListTrashedShortcutsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
-    const message = createBaseGetShortcutAnalyticsRequest();
-    message.id = object.id ?? 0;
+  fromPartial(_: DeepPartial<ListTrashedShortcutsRequest>): ListTrashedShortcutsRequest {
+    const message = createBaseListTrashedShortcutsRequest();
     return message;
   },
 };
 
-function createBaseGetShortcutAnalyticsResponse(): GetShortcutAnalyticsResponse {
-  return { references: [], devices: [], browsers: [] };
+function createBaseListTrashedShortcutsResponse(): ListTrashedShortcutsResponse {
+  return { shortcuts: [] };
 }
 
-export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsResponse> = {
-  encode(message: GetShortcutAnalyticsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    for (const v of message.references) {
-      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(10).fork()).join();
-    }
-    for (const v of message.devices) {
-      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(18).fork()).join();
-    }
-    for (const v of message.browsers) {
-      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(26).fork()).join();
+export const ListTrashedShortcutsResponse: MessageFns<ListTrashedShortcutsResponse> = {
+  encode(message: ListTrashedShortcutsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(10).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): ListTrashedShortcutsResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetShortcutAnalyticsResponse();
+    const message = createBaseListTrashedShortcutsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -756,16 +1684,212 @@ export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsRespon
             break;
           }
 
-          message.references.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
           continue;
         }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.devices.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
-          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListTrashedShortcutsResponse>): ListTrashedShortcutsResponse {
+    return ListTrashedShortcutsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListTrashedShortcutsResponse>): ListTrashedShortcutsResponse {
+    const message = createBaseListTrashedShortcutsResponse();
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRestoreShortcutRequest(): RestoreShortcutRequest {
+  return { id: 0 };
+}
+
+export const RestoreShortcutRequest: MessageFns<RestoreShortcutRequest> = {
+  encode(message: RestoreShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RestoreShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRestoreShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RestoreShortcutRequest>): RestoreShortcutRequest {
+    return RestoreShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RestoreShortcutRequest>): RestoreShortcutRequest {
+    const message = createBaseRestoreShortcutRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBasePurgeShortcutRequest(): PurgeShortcutRequest {
+  return { id: 0 };
+}
+
+export const PurgeShortcutRequest: MessageFns<PurgeShortcutRequest> = {
+  encode(message: PurgeShortcutRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PurgeShortcutRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePurgeShortcutRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<PurgeShortcutRequest>): PurgeShortcutRequest {
+    return PurgeShortcutRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PurgeShortcutRequest>): PurgeShortcutRequest {
+    const message = createBasePurgeShortcutRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsRequest(): GetShortcutAnalyticsRequest {
+  return { id: 0 };
+}
+
+export const GetShortcutAnalyticsRequest: MessageFns<GetShortcutAnalyticsRequest> = {
+  encode(message: GetShortcutAnalyticsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
+    return GetShortcutAnalyticsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetShortcutAnalyticsRequest>): GetShortcutAnalyticsRequest {
+    const message = createBaseGetShortcutAnalyticsRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetShortcutAnalyticsResponse(): GetShortcutAnalyticsResponse {
+  return { references: [], devices: [], browsers: [], variants: [], bots: [] };
+}
+
+export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsResponse> = {
+  encode(message: GetShortcutAnalyticsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.references) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.devices) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(18).fork()).join();
+    }
+    for (const v of message.browsers) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(26).fork()).join();
+    }
+    for (const v of message.variants) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(34).fork()).join();
+    }
+    for (const v of message.bots) {
+      GetShortcutAnalyticsResponse_AnalyticsItem.encode(v!, writer.uint32(42).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetShortcutAnalyticsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetShortcutAnalyticsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.references.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.devices.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
         }
         case 3: {
           if (tag !== 26) {
@@ -775,6 +1899,22 @@ export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsRespon
           message.browsers.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.variants.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.bots.push(GetShortcutAnalyticsResponse_AnalyticsItem.decode(reader, reader.uint32()));
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -792,6 +1932,8 @@ export const GetShortcutAnalyticsResponse: MessageFns<GetShortcutAnalyticsRespon
     message.references = object.references?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
     message.devices = object.devices?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
     message.browsers = object.browsers?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.variants = object.variants?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
+    message.bots = object.bots?.map((e) => GetShortcutAnalyticsResponse_AnalyticsItem.fromPartial(e)) || [];
     return message;
   },
 };
@@ -856,41 +1998,2455 @@ export const GetShortcutAnalyticsResponse_AnalyticsItem: MessageFns<GetShortcutA
   },
 };
 
-export type ShortcutServiceDefinition = typeof ShortcutServiceDefinition;
-export const ShortcutServiceDefinition = {
-  name: "ShortcutService",
-  fullName: "monotreme.api.v1.ShortcutService",
-  methods: {
-    /** ListShortcuts returns a list of shortcuts. */
-    listShortcuts: {
-      name: "ListShortcuts",
-      requestType: ListShortcutsRequest,
-      requestStream: false,
-      responseType: ListShortcutsResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          578365826: [
-            new Uint8Array([19, 18, 17, 47, 97, 112, 105, 47, 118, 49, 47, 115, 104, 111, 114, 116, 99, 117, 116, 115]),
-          ],
-        },
-      },
-    },
-    /** GetShortcut returns a shortcut by id. */
-    getShortcut: {
-      name: "GetShortcut",
-      requestType: GetShortcutRequest,
-      requestStream: false,
-      responseType: Shortcut,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
-          578365826: [
-            new Uint8Array([
-              24,
-              18,
-              22,
+function createBaseShortcutLinkChange(): ShortcutLinkChange {
+  return {
+    id: 0,
+    shortcutId: 0,
+    creatorId: 0,
+    createdTime: undefined,
+    effectiveTime: undefined,
+    link: "",
+    appliedTime: undefined,
+  };
+}
+
+export const ShortcutLinkChange: MessageFns<ShortcutLinkChange> = {
+  encode(message: ShortcutLinkChange, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    if (message.effectiveTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.effectiveTime), writer.uint32(42).fork()).join();
+    }
+    if (message.link !== "") {
+      writer.uint32(50).string(message.link);
+    }
+    if (message.appliedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.appliedTime), writer.uint32(58).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutLinkChange {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutLinkChange();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.effectiveTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.appliedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutLinkChange>): ShortcutLinkChange {
+    return ShortcutLinkChange.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutLinkChange>): ShortcutLinkChange {
+    const message = createBaseShortcutLinkChange();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.effectiveTime = object.effectiveTime ?? undefined;
+    message.link = object.link ?? "";
+    message.appliedTime = object.appliedTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListShortcutLinkChangesRequest(): ListShortcutLinkChangesRequest {
+  return { shortcutId: 0, pendingOnly: false };
+}
+
+export const ListShortcutLinkChangesRequest: MessageFns<ListShortcutLinkChangesRequest> = {
+  encode(message: ListShortcutLinkChangesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.pendingOnly !== false) {
+      writer.uint32(16).bool(message.pendingOnly);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutLinkChangesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutLinkChangesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pendingOnly = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutLinkChangesRequest>): ListShortcutLinkChangesRequest {
+    return ListShortcutLinkChangesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutLinkChangesRequest>): ListShortcutLinkChangesRequest {
+    const message = createBaseListShortcutLinkChangesRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.pendingOnly = object.pendingOnly ?? false;
+    return message;
+  },
+};
+
+function createBaseListShortcutLinkChangesResponse(): ListShortcutLinkChangesResponse {
+  return { linkChanges: [] };
+}
+
+export const ListShortcutLinkChangesResponse: MessageFns<ListShortcutLinkChangesResponse> = {
+  encode(message: ListShortcutLinkChangesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.linkChanges) {
+      ShortcutLinkChange.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutLinkChangesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutLinkChangesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.linkChanges.push(ShortcutLinkChange.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutLinkChangesResponse>): ListShortcutLinkChangesResponse {
+    return ListShortcutLinkChangesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutLinkChangesResponse>): ListShortcutLinkChangesResponse {
+    const message = createBaseListShortcutLinkChangesResponse();
+    message.linkChanges = object.linkChanges?.map((e) => ShortcutLinkChange.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseCreateShortcutLinkChangeRequest(): CreateShortcutLinkChangeRequest {
+  return { linkChange: undefined };
+}
+
+export const CreateShortcutLinkChangeRequest: MessageFns<CreateShortcutLinkChangeRequest> = {
+  encode(message: CreateShortcutLinkChangeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.linkChange !== undefined) {
+      ShortcutLinkChange.encode(message.linkChange, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateShortcutLinkChangeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateShortcutLinkChangeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.linkChange = ShortcutLinkChange.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CreateShortcutLinkChangeRequest>): CreateShortcutLinkChangeRequest {
+    return CreateShortcutLinkChangeRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateShortcutLinkChangeRequest>): CreateShortcutLinkChangeRequest {
+    const message = createBaseCreateShortcutLinkChangeRequest();
+    message.linkChange = (object.linkChange !== undefined && object.linkChange !== null)
+      ? ShortcutLinkChange.fromPartial(object.linkChange)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseCancelShortcutLinkChangeRequest(): CancelShortcutLinkChangeRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const CancelShortcutLinkChangeRequest: MessageFns<CancelShortcutLinkChangeRequest> = {
+  encode(message: CancelShortcutLinkChangeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CancelShortcutLinkChangeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCancelShortcutLinkChangeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<CancelShortcutLinkChangeRequest>): CancelShortcutLinkChangeRequest {
+    return CancelShortcutLinkChangeRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CancelShortcutLinkChangeRequest>): CancelShortcutLinkChangeRequest {
+    const message = createBaseCancelShortcutLinkChangeRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseDryRunShortcutRoutingRequest(): DryRunShortcutRoutingRequest {
+  return { id: 0, headers: {} };
+}
+
+export const DryRunShortcutRoutingRequest: MessageFns<DryRunShortcutRoutingRequest> = {
+  encode(message: DryRunShortcutRoutingRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    Object.entries(message.headers).forEach(([key, value]) => {
+      DryRunShortcutRoutingRequest_HeadersEntry.encode({ key: key as any, value }, writer.uint32(18).fork()).join();
+    });
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DryRunShortcutRoutingRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDryRunShortcutRoutingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          const entry2 = DryRunShortcutRoutingRequest_HeadersEntry.decode(reader, reader.uint32());
+          if (entry2.value !== undefined) {
+            message.headers[entry2.key] = entry2.value;
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DryRunShortcutRoutingRequest>): DryRunShortcutRoutingRequest {
+    return DryRunShortcutRoutingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DryRunShortcutRoutingRequest>): DryRunShortcutRoutingRequest {
+    const message = createBaseDryRunShortcutRoutingRequest();
+    message.id = object.id ?? 0;
+    message.headers = Object.entries(object.headers ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = globalThis.String(value);
+      }
+      return acc;
+    }, {});
+    return message;
+  },
+};
+
+function createBaseDryRunShortcutRoutingRequest_HeadersEntry(): DryRunShortcutRoutingRequest_HeadersEntry {
+  return { key: "", value: "" };
+}
+
+export const DryRunShortcutRoutingRequest_HeadersEntry: MessageFns<DryRunShortcutRoutingRequest_HeadersEntry> = {
+  encode(message: DryRunShortcutRoutingRequest_HeadersEntry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DryRunShortcutRoutingRequest_HeadersEntry {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDryRunShortcutRoutingRequest_HeadersEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DryRunShortcutRoutingRequest_HeadersEntry>): DryRunShortcutRoutingRequest_HeadersEntry {
+    return DryRunShortcutRoutingRequest_HeadersEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DryRunShortcutRoutingRequest_HeadersEntry>): DryRunShortcutRoutingRequest_HeadersEntry {
+    const message = createBaseDryRunShortcutRoutingRequest_HeadersEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseDryRunShortcutRoutingResponse(): DryRunShortcutRoutingResponse {
+  return { link: "", matchedRule: 0, device: "", os: "", browser: "", language: "" };
+}
+
+export const DryRunShortcutRoutingResponse: MessageFns<DryRunShortcutRoutingResponse> = {
+  encode(message: DryRunShortcutRoutingResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.link !== "") {
+      writer.uint32(10).string(message.link);
+    }
+    if (message.matchedRule !== 0) {
+      writer.uint32(16).int32(message.matchedRule);
+    }
+    if (message.device !== "") {
+      writer.uint32(26).string(message.device);
+    }
+    if (message.os !== "") {
+      writer.uint32(34).string(message.os);
+    }
+    if (message.browser !== "") {
+      writer.uint32(42).string(message.browser);
+    }
+    if (message.language !== "") {
+      writer.uint32(50).string(message.language);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DryRunShortcutRoutingResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDryRunShortcutRoutingResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.matchedRule = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.device = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.os = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.browser = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.language = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<DryRunShortcutRoutingResponse>): DryRunShortcutRoutingResponse {
+    return DryRunShortcutRoutingResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DryRunShortcutRoutingResponse>): DryRunShortcutRoutingResponse {
+    const message = createBaseDryRunShortcutRoutingResponse();
+    message.link = object.link ?? "";
+    message.matchedRule = object.matchedRule ?? 0;
+    message.device = object.device ?? "";
+    message.os = object.os ?? "";
+    message.browser = object.browser ?? "";
+    message.language = object.language ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcutRevision(): ShortcutRevision {
+  return { id: 0, shortcutId: 0, creatorId: 0, createdTime: undefined, changes: [], restoredRevisionId: 0 };
+}
+
+export const ShortcutRevision: MessageFns<ShortcutRevision> = {
+  encode(message: ShortcutRevision, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    for (const v of message.changes) {
+      ShortcutRevision_FieldChange.encode(v!, writer.uint32(42).fork()).join();
+    }
+    if (message.restoredRevisionId !== 0) {
+      writer.uint32(48).int32(message.restoredRevisionId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRevision {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRevision();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.changes.push(ShortcutRevision_FieldChange.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.restoredRevisionId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRevision>): ShortcutRevision {
+    return ShortcutRevision.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRevision>): ShortcutRevision {
+    const message = createBaseShortcutRevision();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.changes = object.changes?.map((e) => ShortcutRevision_FieldChange.fromPartial(e)) || [];
+    message.restoredRevisionId = object.restoredRevisionId ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutRevision_FieldChange(): ShortcutRevision_FieldChange {
+  return { field: "", previousValue: "", value: "" };
+}
+
+export const ShortcutRevision_FieldChange: MessageFns<ShortcutRevision_FieldChange> = {
+  encode(message: ShortcutRevision_FieldChange, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== "") {
+      writer.uint32(10).string(message.field);
+    }
+    if (message.previousValue !== "") {
+      writer.uint32(18).string(message.previousValue);
+    }
+    if (message.value !== "") {
+      writer.uint32(26).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRevision_FieldChange {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRevision_FieldChange();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.field = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previousValue = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRevision_FieldChange>): ShortcutRevision_FieldChange {
+    return ShortcutRevision_FieldChange.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRevision_FieldChange>): ShortcutRevision_FieldChange {
+    const message = createBaseShortcutRevision_FieldChange();
+    message.field = object.field ?? "";
+    message.previousValue = object.previousValue ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseListShortcutRevisionsRequest(): ListShortcutRevisionsRequest {
+  return { shortcutId: 0 };
+}
+
+export const ListShortcutRevisionsRequest: MessageFns<ListShortcutRevisionsRequest> = {
+  encode(message: ListShortcutRevisionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutRevisionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutRevisionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutRevisionsRequest>): ListShortcutRevisionsRequest {
+    return ListShortcutRevisionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutRevisionsRequest>): ListShortcutRevisionsRequest {
+    const message = createBaseListShortcutRevisionsRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    return message;
+  },
+};
+
+function createBaseListShortcutRevisionsResponse(): ListShortcutRevisionsResponse {
+  return { revisions: [] };
+}
+
+export const ListShortcutRevisionsResponse: MessageFns<ListShortcutRevisionsResponse> = {
+  encode(message: ListShortcutRevisionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.revisions) {
+      ShortcutRevision.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutRevisionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutRevisionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.revisions.push(ShortcutRevision.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutRevisionsResponse>): ListShortcutRevisionsResponse {
+    return ListShortcutRevisionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutRevisionsResponse>): ListShortcutRevisionsResponse {
+    const message = createBaseListShortcutRevisionsResponse();
+    message.revisions = object.revisions?.map((e) => ShortcutRevision.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRestoreShortcutRevisionRequest(): RestoreShortcutRevisionRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const RestoreShortcutRevisionRequest: MessageFns<RestoreShortcutRevisionRequest> = {
+  encode(message: RestoreShortcutRevisionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RestoreShortcutRevisionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRestoreShortcutRevisionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RestoreShortcutRevisionRequest>): RestoreShortcutRevisionRequest {
+    return RestoreShortcutRevisionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RestoreShortcutRevisionRequest>): RestoreShortcutRevisionRequest {
+    const message = createBaseRestoreShortcutRevisionRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseRestoreShortcutRevisionResponse(): RestoreShortcutRevisionResponse {
+  return { shortcut: undefined, revision: undefined };
+}
+
+export const RestoreShortcutRevisionResponse: MessageFns<RestoreShortcutRevisionResponse> = {
+  encode(message: RestoreShortcutRevisionResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(10).fork()).join();
+    }
+    if (message.revision !== undefined) {
+      ShortcutRevision.encode(message.revision, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RestoreShortcutRevisionResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRestoreShortcutRevisionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.revision = ShortcutRevision.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RestoreShortcutRevisionResponse>): RestoreShortcutRevisionResponse {
+    return RestoreShortcutRevisionResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RestoreShortcutRevisionResponse>): RestoreShortcutRevisionResponse {
+    const message = createBaseRestoreShortcutRevisionResponse();
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.revision = (object.revision !== undefined && object.revision !== null)
+      ? ShortcutRevision.fromPartial(object.revision)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListShortcutPermissionsRequest(): ListShortcutPermissionsRequest {
+  return { id: 0 };
+}
+
+export const ListShortcutPermissionsRequest: MessageFns<ListShortcutPermissionsRequest> = {
+  encode(message: ListShortcutPermissionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutPermissionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutPermissionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutPermissionsRequest>): ListShortcutPermissionsRequest {
+    return ListShortcutPermissionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutPermissionsRequest>): ListShortcutPermissionsRequest {
+    const message = createBaseListShortcutPermissionsRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseListShortcutPermissionsResponse(): ListShortcutPermissionsResponse {
+  return { permissions: [] };
+}
+
+export const ListShortcutPermissionsResponse: MessageFns<ListShortcutPermissionsResponse> = {
+  encode(message: ListShortcutPermissionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.permissions) {
+      Permission.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutPermissionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutPermissionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.permissions.push(Permission.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutPermissionsResponse>): ListShortcutPermissionsResponse {
+    return ListShortcutPermissionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutPermissionsResponse>): ListShortcutPermissionsResponse {
+    const message = createBaseListShortcutPermissionsResponse();
+    message.permissions = object.permissions?.map((e) => Permission.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGrantShortcutPermissionRequest(): GrantShortcutPermissionRequest {
+  return { id: 0, permission: undefined };
+}
+
+export const GrantShortcutPermissionRequest: MessageFns<GrantShortcutPermissionRequest> = {
+  encode(message: GrantShortcutPermissionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.permission !== undefined) {
+      Permission.encode(message.permission, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GrantShortcutPermissionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGrantShortcutPermissionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.permission = Permission.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GrantShortcutPermissionRequest>): GrantShortcutPermissionRequest {
+    return GrantShortcutPermissionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GrantShortcutPermissionRequest>): GrantShortcutPermissionRequest {
+    const message = createBaseGrantShortcutPermissionRequest();
+    message.id = object.id ?? 0;
+    message.permission = (object.permission !== undefined && object.permission !== null)
+      ? Permission.fromPartial(object.permission)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseRevokeShortcutPermissionRequest(): RevokeShortcutPermissionRequest {
+  return { id: 0, userId: 0, groupId: 0 };
+}
+
+export const RevokeShortcutPermissionRequest: MessageFns<RevokeShortcutPermissionRequest> = {
+  encode(message: RevokeShortcutPermissionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    if (message.groupId !== 0) {
+      writer.uint32(24).int32(message.groupId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RevokeShortcutPermissionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRevokeShortcutPermissionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.groupId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RevokeShortcutPermissionRequest>): RevokeShortcutPermissionRequest {
+    return RevokeShortcutPermissionRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RevokeShortcutPermissionRequest>): RevokeShortcutPermissionRequest {
+    const message = createBaseRevokeShortcutPermissionRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    message.groupId = object.groupId ?? 0;
+    return message;
+  },
+};
+
+function createBaseTransferShortcutOwnershipRequest(): TransferShortcutOwnershipRequest {
+  return { id: 0, userId: 0 };
+}
+
+export const TransferShortcutOwnershipRequest: MessageFns<TransferShortcutOwnershipRequest> = {
+  encode(message: TransferShortcutOwnershipRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.userId !== 0) {
+      writer.uint32(16).int32(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TransferShortcutOwnershipRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTransferShortcutOwnershipRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.userId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<TransferShortcutOwnershipRequest>): TransferShortcutOwnershipRequest {
+    return TransferShortcutOwnershipRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TransferShortcutOwnershipRequest>): TransferShortcutOwnershipRequest {
+    const message = createBaseTransferShortcutOwnershipRequest();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutChangeRequest(): ShortcutChangeRequest {
+  return {
+    id: 0,
+    shortcutId: 0,
+    creatorId: 0,
+    createdTime: undefined,
+    updatedTime: undefined,
+    status: ShortcutChangeRequest_Status.STATUS_UNSPECIFIED,
+    reviewerId: 0,
+    changes: [],
+  };
+}
+
+export const ShortcutChangeRequest: MessageFns<ShortcutChangeRequest> = {
+  encode(message: ShortcutChangeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(34).fork()).join();
+    }
+    if (message.updatedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedTime), writer.uint32(42).fork()).join();
+    }
+    if (message.status !== ShortcutChangeRequest_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(48).int32(shortcutChangeRequest_StatusToNumber(message.status));
+    }
+    if (message.reviewerId !== 0) {
+      writer.uint32(56).int32(message.reviewerId);
+    }
+    for (const v of message.changes) {
+      ShortcutRevision_FieldChange.encode(v!, writer.uint32(66).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutChangeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutChangeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.updatedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.status = shortcutChangeRequest_StatusFromJSON(reader.int32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.reviewerId = reader.int32();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.changes.push(ShortcutRevision_FieldChange.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutChangeRequest>): ShortcutChangeRequest {
+    return ShortcutChangeRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutChangeRequest>): ShortcutChangeRequest {
+    const message = createBaseShortcutChangeRequest();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTime = object.createdTime ?? undefined;
+    message.updatedTime = object.updatedTime ?? undefined;
+    message.status = object.status ?? ShortcutChangeRequest_Status.STATUS_UNSPECIFIED;
+    message.reviewerId = object.reviewerId ?? 0;
+    message.changes = object.changes?.map((e) => ShortcutRevision_FieldChange.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseListShortcutChangeRequestsRequest(): ListShortcutChangeRequestsRequest {
+  return { shortcutId: 0 };
+}
+
+export const ListShortcutChangeRequestsRequest: MessageFns<ListShortcutChangeRequestsRequest> = {
+  encode(message: ListShortcutChangeRequestsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutChangeRequestsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutChangeRequestsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutChangeRequestsRequest>): ListShortcutChangeRequestsRequest {
+    return ListShortcutChangeRequestsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutChangeRequestsRequest>): ListShortcutChangeRequestsRequest {
+    const message = createBaseListShortcutChangeRequestsRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    return message;
+  },
+};
+
+function createBaseListShortcutChangeRequestsResponse(): ListShortcutChangeRequestsResponse {
+  return { changeRequests: [] };
+}
+
+export const ListShortcutChangeRequestsResponse: MessageFns<ListShortcutChangeRequestsResponse> = {
+  encode(message: ListShortcutChangeRequestsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.changeRequests) {
+      ShortcutChangeRequest.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListShortcutChangeRequestsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListShortcutChangeRequestsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.changeRequests.push(ShortcutChangeRequest.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ListShortcutChangeRequestsResponse>): ListShortcutChangeRequestsResponse {
+    return ListShortcutChangeRequestsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListShortcutChangeRequestsResponse>): ListShortcutChangeRequestsResponse {
+    const message = createBaseListShortcutChangeRequestsResponse();
+    message.changeRequests = object.changeRequests?.map((e) => ShortcutChangeRequest.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseApproveShortcutChangeRequestRequest(): ApproveShortcutChangeRequestRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const ApproveShortcutChangeRequestRequest: MessageFns<ApproveShortcutChangeRequestRequest> = {
+  encode(message: ApproveShortcutChangeRequestRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ApproveShortcutChangeRequestRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApproveShortcutChangeRequestRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ApproveShortcutChangeRequestRequest>): ApproveShortcutChangeRequestRequest {
+    return ApproveShortcutChangeRequestRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApproveShortcutChangeRequestRequest>): ApproveShortcutChangeRequestRequest {
+    const message = createBaseApproveShortcutChangeRequestRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseRejectShortcutChangeRequestRequest(): RejectShortcutChangeRequestRequest {
+  return { shortcutId: 0, id: 0 };
+}
+
+export const RejectShortcutChangeRequestRequest: MessageFns<RejectShortcutChangeRequestRequest> = {
+  encode(message: RejectShortcutChangeRequestRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.id !== 0) {
+      writer.uint32(16).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RejectShortcutChangeRequestRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRejectShortcutChangeRequestRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<RejectShortcutChangeRequestRequest>): RejectShortcutChangeRequestRequest {
+    return RejectShortcutChangeRequestRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RejectShortcutChangeRequestRequest>): RejectShortcutChangeRequestRequest {
+    const message = createBaseRejectShortcutChangeRequestRequest();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+export type ShortcutServiceDefinition = typeof ShortcutServiceDefinition;
+export const ShortcutServiceDefinition = {
+  name: "ShortcutService",
+  fullName: "monotreme.api.v1.ShortcutService",
+  methods: {
+    /** ListShortcuts returns a list of shortcuts. */
+    listShortcuts: {
+      name: "ListShortcuts",
+      requestType: ListShortcutsRequest,
+      requestStream: false,
+      responseType: ListShortcutsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([19, 18, 17, 47, 97, 112, 105, 47, 118, 49, 47, 115, 104, 111, 114, 116, 99, 117, 116, 115]),
+          ],
+        },
+      },
+    },
+    /** GetShortcut returns a shortcut by id. */
+    getShortcut: {
+      name: "GetShortcut",
+      requestType: GetShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              24,
+              18,
+              22,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetShortcutByName returns a shortcut by name. */
+    getShortcutByName: {
+      name: "GetShortcutByName",
+      requestType: GetShortcutByNameRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {},
+    },
+    /** CreateShortcut creates a shortcut. */
+    createShortcut: {
+      name: "CreateShortcut",
+      requestType: CreateShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              29,
+              58,
+              8,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              34,
+              17,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** UpdateShortcut updates a shortcut. */
+    updateShortcut: {
+      name: "UpdateShortcut",
+      requestType: UpdateShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              20,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          578365826: [
+            new Uint8Array([
+              43,
+              58,
+              8,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              26,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              46,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DeleteShortcut deletes a shortcut by name. */
+    deleteShortcut: {
+      name: "DeleteShortcut",
+      requestType: DeleteShortcutRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              24,
+              42,
+              22,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetShortcutAnalytics returns the analytics for a shortcut. */
+    getShortcutAnalytics: {
+      name: "GetShortcutAnalytics",
+      requestType: GetShortcutAnalyticsRequest,
+      requestStream: false,
+      responseType: GetShortcutAnalyticsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              34,
+              18,
+              32,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              97,
+              110,
+              97,
+              108,
+              121,
+              116,
+              105,
+              99,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListShortcutLinkChanges returns the scheduled link changes of a shortcut. */
+    listShortcutLinkChanges: {
+      name: "ListShortcutLinkChanges",
+      requestType: ListShortcutLinkChangesRequest,
+      requestStream: false,
+      responseType: ListShortcutLinkChangesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              46,
+              18,
+              44,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              108,
+              105,
+              110,
+              107,
+              95,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateShortcutLinkChange schedules a link change for a shortcut. */
+    createShortcutLinkChange: {
+      name: "CreateShortcutLinkChange",
+      requestType: CreateShortcutLinkChangeRequest,
+      requestStream: false,
+      responseType: ShortcutLinkChange,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 108, 105, 110, 107, 95, 99, 104, 97, 110, 103, 101])],
+          578365826: [
+            new Uint8Array([
+              71,
+              58,
+              11,
+              108,
+              105,
+              110,
+              107,
+              95,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              34,
+              56,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              108,
+              105,
+              110,
+              107,
+              95,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              46,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              108,
+              105,
+              110,
+              107,
+              95,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CancelShortcutLinkChange cancels a pending link change. */
+    cancelShortcutLinkChange: {
+      name: "CancelShortcutLinkChange",
+      requestType: CancelShortcutLinkChangeRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([14, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100, 44, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              51,
+              42,
+              49,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              108,
+              105,
+              110,
+              107,
+              95,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DryRunShortcutRouting reports which link a request with the given headers would be sent to. */
+    dryRunShortcutRouting: {
+      name: "DryRunShortcutRouting",
+      requestType: DryRunShortcutRoutingRequest,
+      requestStream: false,
+      responseType: DryRunShortcutRoutingResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              42,
+              58,
+              1,
+              42,
+              34,
+              37,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              114,
+              111,
+              117,
+              116,
+              105,
+              110,
+              103,
+              58,
+              100,
+              114,
+              121,
+              82,
+              117,
+              110,
+            ]),
+          ],
+        },
+      },
+    },
+    listTrashedShortcuts: {
+      name: "ListTrashedShortcuts",
+      requestType: ListTrashedShortcutsRequest,
+      requestStream: false,
+      responseType: ListTrashedShortcutsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              25,
+              18,
+              23,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              97,
+              115,
+              104,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    restoreShortcut: {
+      name: "RestoreShortcut",
+      requestType: RestoreShortcutRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              38,
+              34,
+              36,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              97,
+              115,
+              104,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              114,
+              101,
+              115,
+              116,
+              111,
+              114,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    purgeShortcut: {
+      name: "PurgeShortcut",
+      requestType: PurgeShortcutRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              30,
+              42,
+              28,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              116,
+              114,
+              97,
+              115,
+              104,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    listShortcutRevisions: {
+      name: "ListShortcutRevisions",
+      requestType: ListShortcutRevisionsRequest,
+      requestStream: false,
+      responseType: ListShortcutRevisionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              43,
+              18,
+              41,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              114,
+              101,
+              118,
+              105,
+              115,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    restoreShortcutRevision: {
+      name: "RestoreShortcutRevision",
+      requestType: RestoreShortcutRevisionRequest,
+      requestStream: false,
+      responseType: RestoreShortcutRevisionResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([14, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100, 44, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              56,
+              34,
+              54,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              115,
+              47,
+              123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              114,
+              101,
+              118,
+              105,
+              115,
+              105,
+              111,
+              110,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              114,
+              101,
+              115,
+              116,
+              111,
+              114,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListShortcutPermissions returns the roles granted on a shortcut. */
+    listShortcutPermissions: {
+      name: "ListShortcutPermissions",
+      requestType: ListShortcutPermissionsRequest,
+      requestStream: false,
+      responseType: ListShortcutPermissionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              36,
+              18,
+              34,
               47,
               97,
               112,
@@ -913,44 +4469,53 @@ export const ShortcutServiceDefinition = {
               105,
               100,
               125,
+              47,
+              112,
+              101,
+              114,
+              109,
+              105,
+              115,
+              115,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
         },
       },
     },
-    /** GetShortcutByName returns a shortcut by name. */
-    getShortcutByName: {
-      name: "GetShortcutByName",
-      requestType: GetShortcutByNameRequest,
-      requestStream: false,
-      responseType: Shortcut,
-      responseStream: false,
-      options: {},
-    },
-    /** CreateShortcut creates a shortcut. */
-    createShortcut: {
-      name: "CreateShortcut",
-      requestType: CreateShortcutRequest,
+    /**
+     * GrantShortcutPermission grants a role on a shortcut to a user or group, replacing the role they had.
+     * Only owners can grant roles.
+     */
+    grantShortcutPermission: {
+      name: "GrantShortcutPermission",
+      requestType: GrantShortcutPermissionRequest,
       requestStream: false,
-      responseType: Shortcut,
+      responseType: Permission,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([13, 105, 100, 44, 112, 101, 114, 109, 105, 115, 115, 105, 111, 110])],
           578365826: [
             new Uint8Array([
-              29,
+              48,
               58,
-              8,
+              10,
+              112,
+              101,
+              114,
+              109,
+              105,
               115,
-              104,
+              115,
+              105,
               111,
-              114,
-              116,
-              99,
-              117,
-              116,
+              110,
+              34,
               34,
-              17,
               47,
               97,
               112,
@@ -968,23 +4533,50 @@ export const ShortcutServiceDefinition = {
               117,
               116,
               115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              112,
+              101,
+              114,
+              109,
+              105,
+              115,
+              115,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
         },
       },
     },
-    /** UpdateShortcut updates a shortcut. */
-    updateShortcut: {
-      name: "UpdateShortcut",
-      requestType: UpdateShortcutRequest,
+    /** RevokeShortcutPermission revokes the role of a user or group on a shortcut. Only owners can revoke roles. */
+    revokeShortcutPermission: {
+      name: "RevokeShortcutPermission",
+      requestType: RevokeShortcutPermissionRequest,
       requestStream: false,
-      responseType: Shortcut,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [
+          578365826: [
             new Uint8Array([
-              20,
+              36,
+              42,
+              34,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
               115,
               104,
               111,
@@ -993,25 +4585,55 @@ export const ShortcutServiceDefinition = {
               99,
               117,
               116,
-              44,
-              117,
-              112,
+              115,
+              47,
+              123,
+              105,
               100,
-              97,
-              116,
+              125,
+              47,
+              112,
               101,
-              95,
+              114,
               109,
-              97,
+              105,
+              115,
+              115,
+              105,
+              111,
+              110,
               115,
-              107,
             ]),
           ],
+        },
+      },
+    },
+    /** TransferShortcutOwnership makes another user the creator of a shortcut. Only owners can transfer it. */
+    transferShortcutOwnership: {
+      name: "TransferShortcutOwnership",
+      requestType: TransferShortcutOwnershipRequest,
+      requestStream: false,
+      responseType: Shortcut,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([10, 105, 100, 44, 117, 115, 101, 114, 95, 105, 100])],
           578365826: [
             new Uint8Array([
-              43,
+              36,
               58,
-              8,
+              1,
+              42,
+              34,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
               115,
               104,
               111,
@@ -1020,8 +4642,41 @@ export const ShortcutServiceDefinition = {
               99,
               117,
               116,
-              26,
-              31,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              58,
+              116,
+              114,
+              97,
+              110,
+              115,
+              102,
+              101,
+              114,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListShortcutChangeRequests returns the change requests of a shortcut, newest first. */
+    listShortcutChangeRequests: {
+      name: "ListShortcutChangeRequests",
+      requestType: ListShortcutChangeRequestsRequest,
+      requestStream: false,
+      responseType: ListShortcutChangeRequestsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([11, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              49,
+              18,
+              47,
               47,
               97,
               112,
@@ -1049,30 +4704,49 @@ export const ShortcutServiceDefinition = {
               99,
               117,
               116,
-              46,
+              95,
               105,
               100,
               125,
+              47,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              95,
+              114,
+              101,
+              113,
+              117,
+              101,
+              115,
+              116,
+              115,
             ]),
           ],
         },
       },
     },
-    /** DeleteShortcut deletes a shortcut by name. */
-    deleteShortcut: {
-      name: "DeleteShortcut",
-      requestType: DeleteShortcutRequest,
+    /**
+     * ApproveShortcutChangeRequest applies a pending change request. Only approvers other than
+     * the requester can approve it.
+     */
+    approveShortcutChangeRequest: {
+      name: "ApproveShortcutChangeRequest",
+      requestType: ApproveShortcutChangeRequestRequest,
       requestStream: false,
-      responseType: Empty,
+      responseType: ShortcutChangeRequest,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
+          8410: [new Uint8Array([14, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100, 44, 105, 100])],
           578365826: [
             new Uint8Array([
-              24,
-              42,
-              22,
+              62,
+              34,
+              60,
               47,
               97,
               112,
@@ -1092,29 +4766,70 @@ export const ShortcutServiceDefinition = {
               115,
               47,
               123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
+              105,
+              100,
+              125,
+              47,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              95,
+              114,
+              101,
+              113,
+              117,
+              101,
+              115,
+              116,
+              115,
+              47,
+              123,
               105,
               100,
               125,
+              58,
+              97,
+              112,
+              112,
+              114,
+              111,
+              118,
+              101,
             ]),
           ],
         },
       },
     },
-    /** GetShortcutAnalytics returns the analytics for a shortcut. */
-    getShortcutAnalytics: {
-      name: "GetShortcutAnalytics",
-      requestType: GetShortcutAnalyticsRequest,
+    /**
+     * RejectShortcutChangeRequest rejects a pending change request. Only approvers other than
+     * the requester can reject it.
+     */
+    rejectShortcutChangeRequest: {
+      name: "RejectShortcutChangeRequest",
+      requestType: RejectShortcutChangeRequestRequest,
       requestStream: false,
-      responseType: GetShortcutAnalyticsResponse,
+      responseType: ShortcutChangeRequest,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([2, 105, 100])],
+          8410: [new Uint8Array([14, 115, 104, 111, 114, 116, 99, 117, 116, 95, 105, 100, 44, 105, 100])],
           578365826: [
             new Uint8Array([
+              61,
               34,
-              18,
-              32,
+              59,
               47,
               97,
               112,
@@ -1134,19 +4849,46 @@ export const ShortcutServiceDefinition = {
               115,
               47,
               123,
+              115,
+              104,
+              111,
+              114,
+              116,
+              99,
+              117,
+              116,
+              95,
               105,
               100,
               125,
               47,
+              99,
+              104,
               97,
               110,
-              97,
-              108,
-              121,
+              103,
+              101,
+              95,
+              114,
+              101,
+              113,
+              117,
+              101,
+              115,
               116,
+              115,
+              47,
+              123,
               105,
+              100,
+              125,
+              58,
+              114,
+              101,
+              106,
+              101,
               99,
-              115,
+              116,
             ]),
           ],
         },
@@ -9,7 +9,10 @@ import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { Empty } from "../../google/protobuf/empty";
 import { FieldMask } from "../../google/protobuf/field_mask";
 import { Timestamp } from "../../google/protobuf/timestamp";
+import { Collection } from "./collection_service";
 import { State, stateFromJSON, stateToNumber } from "./common";
+import { Group } from "./group_service";
+import { Shortcut } from "./shortcut_service";
 
 export const protobufPackage = "monotreme.api.v1";
 
@@ -92,6 +95,85 @@ export interface UpdateUserRequest {
 
 export interface DeleteUserRequest {
   id: number;
+  /** What happens to the shortcuts and collections of the user. */
+  contentDisposition: DeleteUserRequest_ContentDisposition;
+  /** The user who becomes the creator of the content when it is transferred. */
+  transferUserId: number;
+  /**
+   * The group the content is transferred to. The creator of the group becomes the creator
+   * of the content and the members of the group become its owners.
+   */
+  transferGroupId: number;
+}
+
+export enum DeleteUserRequest_ContentDisposition {
+  CONTENT_DISPOSITION_UNSPECIFIED = "CONTENT_DISPOSITION_UNSPECIFIED",
+  /** TRANSFER - Transfers the shortcuts and collections of the user to another user or group. */
+  TRANSFER = "TRANSFER",
+  /**
+   * ARCHIVE - Deactivates the user instead of deleting it. Their shortcuts and collections are kept
+   * and their shortcuts keep resolving.
+   */
+  ARCHIVE = "ARCHIVE",
+  /**
+   * DELETE - Deletes the shortcuts and collections of the user with it, for good and without
+   * going through the trash. Users who still own groups can't be deleted this way.
+   */
+  DELETE = "DELETE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function deleteUserRequest_ContentDispositionFromJSON(object: any): DeleteUserRequest_ContentDisposition {
+  switch (object) {
+    case 0:
+    case "CONTENT_DISPOSITION_UNSPECIFIED":
+      return DeleteUserRequest_ContentDisposition.CONTENT_DISPOSITION_UNSPECIFIED;
+    case 1:
+    case "TRANSFER":
+      return DeleteUserRequest_ContentDisposition.TRANSFER;
+    case 2:
+    case "ARCHIVE":
+      return DeleteUserRequest_ContentDisposition.ARCHIVE;
+    case 3:
+    case "DELETE":
+      return DeleteUserRequest_ContentDisposition.DELETE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DeleteUserRequest_ContentDisposition.UNRECOGNIZED;
+  }
+}
+
+export function deleteUserRequest_ContentDispositionToNumber(object: DeleteUserRequest_ContentDisposition): number {
+  switch (object) {
+    case DeleteUserRequest_ContentDisposition.CONTENT_DISPOSITION_UNSPECIFIED:
+      return 0;
+    case DeleteUserRequest_ContentDisposition.TRANSFER:
+      return 1;
+    case DeleteUserRequest_ContentDisposition.ARCHIVE:
+      return 2;
+    case DeleteUserRequest_ContentDisposition.DELETE:
+      return 3;
+    case DeleteUserRequest_ContentDisposition.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface PreviewDeleteUserRequest {
+  id: number;
+}
+
+export interface PreviewDeleteUserResponse {
+  /** The shortcuts created by the user, including the ones in the trash. */
+  shortcuts: Shortcut[];
+  /** The collections created by the user, including the ones in the trash. */
+  collections: Collection[];
+  /**
+   * The groups created by the user. They are transferred along with the content, otherwise
+   * they are kept and can still be managed by admins.
+   */
+  groups: Group[];
 }
 
 export interface ListUserAccessTokensRequest {
@@ -577,7 +659,12 @@ export const UpdateUserRequest: MessageFns<UpdateUserRequest> = {
 };
 
 function createBaseDeleteUserRequest(): DeleteUserRequest {
-  return { id: 0 };
+  return {
+    id: 0,
+    contentDisposition: DeleteUserRequest_ContentDisposition.CONTENT_DISPOSITION_UNSPECIFIED,
+    transferUserId: 0,
+    transferGroupId: 0,
+  };
 }
 
 export const DeleteUserRequest: MessageFns<DeleteUserRequest> = {
@@ -585,6 +672,15 @@ export const DeleteUserRequest: MessageFns<DeleteUserRequest> = {
     if (message.id !== 0) {
       writer.uint32(8).int32(message.id);
     }
+    if (message.contentDisposition !== DeleteUserRequest_ContentDisposition.CONTENT_DISPOSITION_UNSPECIFIED) {
+      writer.uint32(16).int32(deleteUserRequest_ContentDispositionToNumber(message.contentDisposition));
+    }
+    if (message.transferUserId !== 0) {
+      writer.uint32(24).int32(message.transferUserId);
+    }
+    if (message.transferGroupId !== 0) {
+      writer.uint32(32).int32(message.transferGroupId);
+    }
     return writer;
   },
 
@@ -603,6 +699,30 @@ export const DeleteUserRequest: MessageFns<DeleteUserRequest> = {
           message.id = reader.int32();
           continue;
         }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.contentDisposition = deleteUserRequest_ContentDispositionFromJSON(reader.int32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.transferUserId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.transferGroupId = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -618,6 +738,125 @@ export const DeleteUserRequest: MessageFns<DeleteUserRequest> = {
   fromPartial(object: DeepPartial<DeleteUserRequest>): DeleteUserRequest {
     const message = createBaseDeleteUserRequest();
     message.id = object.id ?? 0;
+    message.contentDisposition = object.contentDisposition ?? DeleteUserRequest_ContentDisposition.CONTENT_DISPOSITION_UNSPECIFIED;
+    message.transferUserId = object.transferUserId ?? 0;
+    message.transferGroupId = object.transferGroupId ?? 0;
+    return message;
+  },
+};
+
+function createBasePreviewDeleteUserRequest(): PreviewDeleteUserRequest {
+  return { id: 0 };
+}
+
+export const PreviewDeleteUserRequest: MessageFns<PreviewDeleteUserRequest> = {
+  encode(message: PreviewDeleteUserRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PreviewDeleteUserRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePreviewDeleteUserRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<PreviewDeleteUserRequest>): PreviewDeleteUserRequest {
+    return PreviewDeleteUserRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PreviewDeleteUserRequest>): PreviewDeleteUserRequest {
+    const message = createBasePreviewDeleteUserRequest();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBasePreviewDeleteUserResponse(): PreviewDeleteUserResponse {
+  return { shortcuts: [], collections: [], groups: [] };
+}
+
+export const PreviewDeleteUserResponse: MessageFns<PreviewDeleteUserResponse> = {
+  encode(message: PreviewDeleteUserResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.shortcuts) {
+      Shortcut.encode(v!, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.collections) {
+      Collection.encode(v!, writer.uint32(18).fork()).join();
+    }
+    for (const v of message.groups) {
+      Group.encode(v!, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PreviewDeleteUserResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePreviewDeleteUserResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.shortcuts.push(Shortcut.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.collections.push(Collection.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.groups.push(Group.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<PreviewDeleteUserResponse>): PreviewDeleteUserResponse {
+    return PreviewDeleteUserResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PreviewDeleteUserResponse>): PreviewDeleteUserResponse {
+    const message = createBasePreviewDeleteUserResponse();
+    message.shortcuts = object.shortcuts?.map((e) => Shortcut.fromPartial(e)) || [];
+    message.collections = object.collections?.map((e) => Collection.fromPartial(e)) || [];
+    message.groups = object.groups?.map((e) => Group.fromPartial(e)) || [];
     return message;
   },
 };
@@ -1066,7 +1305,10 @@ export const UserServiceDefinition = {
         },
       },
     },
-    /** DeleteUser deletes a user by id. */
+    /**
+     * DeleteUser deletes a user by id. A content disposition is required when the user
+     * has created shortcuts or collections.
+     */
     deleteUser: {
       name: "DeleteUser",
       requestType: DeleteUserRequest,
@@ -1104,6 +1346,61 @@ export const UserServiceDefinition = {
         },
       },
     },
+    /** PreviewDeleteUser returns the content affected by deleting a user. */
+    previewDeleteUser: {
+      name: "PreviewDeleteUser",
+      requestType: PreviewDeleteUserRequest,
+      requestStream: false,
+      responseType: PreviewDeleteUserResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([2, 105, 100])],
+          578365826: [
+            new Uint8Array([
+              37,
+              18,
+              35,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              123,
+              105,
+              100,
+              125,
+              47,
+              100,
+              101,
+              108,
+              101,
+              116,
+              105,
+              111,
+              110,
+              95,
+              112,
+              114,
+              101,
+              118,
+              105,
+              101,
+              119,
+            ]),
+          ],
+        },
+      },
+    },
     /** ListUserAccessTokens returns a list of access tokens for a user. */
     listUserAccessTokens: {
       name: "ListUserAccessTokens",
@@ -7,7 +7,14 @@
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
 import { FieldMask } from "../../google/protobuf/field_mask";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import {
+  RedirectMode,
+  redirectModeFromJSON,
+  redirectModeToNumber,
+  Visibility,
+  visibilityFromJSON,
+  visibilityToNumber,
+} from "./common";
 import { Subscription } from "./subscription_service";
 
 export const protobufPackage = "monotreme.api.v1";
@@ -49,6 +56,39 @@ export interface WorkspaceSetting {
   disallowPasswordAuth: boolean;
   /** The prefix used for shortcut URLs (e.g. "s" for "/s/shortcut-name"). */
   shortcutPrefix: string;
+  /** The default redirect mode of shortcuts. */
+  defaultRedirectMode: RedirectMode;
+  /** The message shown when a shortcut is visited before it becomes valid. */
+  shortcutPendingMessage: string;
+  /** The message shown when an expired shortcut is visited. */
+  shortcutExpiredMessage: string;
+  /** The number of days after expiry before a shortcut is archived. 0 uses the default of 30 days. */
+  expiredShortcutArchiveDays: number;
+  /** Whether a visit to an unknown shortcut name redirects to the only close match. */
+  autoRedirectNotFound: boolean;
+  /** How shortcut names are compared. */
+  shortcutNamePolicy?:
+    | ShortcutNamePolicy
+    | undefined;
+  /** The hosts on which bare paths such as /standup resolve as shortcut names. */
+  redirectHosts: string[];
+  /** Former shortcut prefixes, which redirect permanently to the shortcut_prefix URL. */
+  legacyShortcutPrefixes: string[];
+  /** The prefix of collection URLs. */
+  collectionPrefix: string;
+  /** Former collection prefixes, which redirect permanently to the collection_prefix URL. */
+  legacyCollectionPrefixes: string[];
+  /** The number of days deleted shortcuts and collections stay in the trash. 0 uses the default of 30 days. */
+  trashRetentionDays: number;
+}
+
+export interface ShortcutNamePolicy {
+  /** Whether names differing only in case are the same. */
+  caseInsensitive: boolean;
+  /** Whether names are compared after NFKC Unicode normalization. */
+  unicodeNormalization: boolean;
+  /** Whether "-", "_" and "." are the same. */
+  equivalentSeparators: boolean;
 }
 
 export interface IdentityProvider {
@@ -142,6 +182,50 @@ export interface WorkspaceStats {
   historicalData: StatsMeasurement[];
 }
 
+export interface GetWorkspaceRuntimeStatsRequest {
+}
+
+export interface WorkspaceRuntimeStats {
+  /** The counters of the cache that resolves shortcut paths on redirects. */
+  resolverCache?:
+    | ResolverCacheStats
+    | undefined;
+  /** The counters of the buffer that click events are written through. */
+  activityIngestion?: ActivityIngestionStats | undefined;
+}
+
+export interface ResolverCacheStats {
+  /** The maximum number of cached paths, zero when the cache is disabled. */
+  capacity: number;
+  /** The number of cached paths. */
+  entries: number;
+  /** The lookups served from the cache. */
+  hits: number;
+  /** The hits for paths that name no shortcut, included in hits. */
+  negativeHits: number;
+  /** The lookups that went to the database. */
+  misses: number;
+  /** The paths dropped to stay within the capacity. */
+  evictions: number;
+}
+
+export interface ActivityIngestionStats {
+  /** What happens to events when the buffer is full, "drop" or "block". */
+  policy: string;
+  /** The number of events the buffer holds. */
+  capacity: number;
+  /** The number of events waiting to be written. */
+  buffered: number;
+  /** The events accepted into the buffer. */
+  enqueued: number;
+  /** The events written to the database. */
+  written: number;
+  /** The events dropped because the buffer was full or the server was stopping. */
+  dropped: number;
+  /** The events of batches that could not be written. */
+  failed: number;
+}
+
 export interface StatsMeasurement {
   /** Unix timestamp when the measurement was taken */
   measuredTs: number;
@@ -273,6 +357,17 @@ function createBaseWorkspaceSetting(): WorkspaceSetting {
     disallowUserRegistration: false,
     disallowPasswordAuth: false,
     shortcutPrefix: "",
+    defaultRedirectMode: RedirectMode.REDIRECT_MODE_UNSPECIFIED,
+    shortcutPendingMessage: "",
+    shortcutExpiredMessage: "",
+    expiredShortcutArchiveDays: 0,
+    autoRedirectNotFound: false,
+    shortcutNamePolicy: undefined,
+    redirectHosts: [],
+    legacyShortcutPrefixes: [],
+    collectionPrefix: "",
+    legacyCollectionPrefixes: [],
+    trashRetentionDays: 0,
   };
 }
 
@@ -302,6 +397,39 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
     if (message.shortcutPrefix !== "") {
       writer.uint32(66).string(message.shortcutPrefix);
     }
+    if (message.defaultRedirectMode !== RedirectMode.REDIRECT_MODE_UNSPECIFIED) {
+      writer.uint32(72).int32(redirectModeToNumber(message.defaultRedirectMode));
+    }
+    if (message.shortcutPendingMessage !== "") {
+      writer.uint32(82).string(message.shortcutPendingMessage);
+    }
+    if (message.shortcutExpiredMessage !== "") {
+      writer.uint32(90).string(message.shortcutExpiredMessage);
+    }
+    if (message.expiredShortcutArchiveDays !== 0) {
+      writer.uint32(96).int32(message.expiredShortcutArchiveDays);
+    }
+    if (message.autoRedirectNotFound !== false) {
+      writer.uint32(104).bool(message.autoRedirectNotFound);
+    }
+    if (message.shortcutNamePolicy !== undefined) {
+      ShortcutNamePolicy.encode(message.shortcutNamePolicy, writer.uint32(114).fork()).join();
+    }
+    for (const v of message.redirectHosts) {
+      writer.uint32(122).string(v!);
+    }
+    for (const v of message.legacyShortcutPrefixes) {
+      writer.uint32(130).string(v!);
+    }
+    if (message.collectionPrefix !== "") {
+      writer.uint32(138).string(message.collectionPrefix);
+    }
+    for (const v of message.legacyCollectionPrefixes) {
+      writer.uint32(146).string(v!);
+    }
+    if (message.trashRetentionDays !== 0) {
+      writer.uint32(152).int32(message.trashRetentionDays);
+    }
     return writer;
   },
 
@@ -376,6 +504,94 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
           message.shortcutPrefix = reader.string();
           continue;
         }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.defaultRedirectMode = redirectModeFromJSON(reader.int32());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.shortcutPendingMessage = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.shortcutExpiredMessage = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 96) {
+            break;
+          }
+
+          message.expiredShortcutArchiveDays = reader.int32();
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
+
+          message.autoRedirectNotFound = reader.bool();
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.shortcutNamePolicy = ShortcutNamePolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 15: {
+          if (tag !== 122) {
+            break;
+          }
+
+          message.redirectHosts.push(reader.string());
+          continue;
+        }
+        case 16: {
+          if (tag !== 130) {
+            break;
+          }
+
+          message.legacyShortcutPrefixes.push(reader.string());
+          continue;
+        }
+        case 17: {
+          if (tag !== 138) {
+            break;
+          }
+
+          message.collectionPrefix = reader.string();
+          continue;
+        }
+        case 18: {
+          if (tag !== 146) {
+            break;
+          }
+
+          message.legacyCollectionPrefixes.push(reader.string());
+          continue;
+        }
+        case 19: {
+          if (tag !== 152) {
+            break;
+          }
+
+          message.trashRetentionDays = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -398,6 +614,89 @@ export const WorkspaceSetting: MessageFns<WorkspaceSetting> = {
     message.disallowUserRegistration = object.disallowUserRegistration ?? false;
     message.disallowPasswordAuth = object.disallowPasswordAuth ?? false;
     message.shortcutPrefix = object.shortcutPrefix ?? "";
+    message.defaultRedirectMode = object.defaultRedirectMode ?? RedirectMode.REDIRECT_MODE_UNSPECIFIED;
+    message.shortcutPendingMessage = object.shortcutPendingMessage ?? "";
+    message.shortcutExpiredMessage = object.shortcutExpiredMessage ?? "";
+    message.expiredShortcutArchiveDays = object.expiredShortcutArchiveDays ?? 0;
+    message.autoRedirectNotFound = object.autoRedirectNotFound ?? false;
+    message.shortcutNamePolicy = (object.shortcutNamePolicy !== undefined && object.shortcutNamePolicy !== null)
+      ? ShortcutNamePolicy.fromPartial(object.shortcutNamePolicy)
+      : undefined;
+    message.redirectHosts = object.redirectHosts?.map((e) => e) || [];
+    message.legacyShortcutPrefixes = object.legacyShortcutPrefixes?.map((e) => e) || [];
+    message.collectionPrefix = object.collectionPrefix ?? "";
+    message.legacyCollectionPrefixes = object.legacyCollectionPrefixes?.map((e) => e) || [];
+    message.trashRetentionDays = object.trashRetentionDays ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutNamePolicy(): ShortcutNamePolicy {
+  return { caseInsensitive: false, unicodeNormalization: false, equivalentSeparators: false };
+}
+
+export const ShortcutNamePolicy: MessageFns<ShortcutNamePolicy> = {
+  encode(message: ShortcutNamePolicy, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.caseInsensitive !== false) {
+      writer.uint32(8).bool(message.caseInsensitive);
+    }
+    if (message.unicodeNormalization !== false) {
+      writer.uint32(16).bool(message.unicodeNormalization);
+    }
+    if (message.equivalentSeparators !== false) {
+      writer.uint32(24).bool(message.equivalentSeparators);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutNamePolicy {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutNamePolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.caseInsensitive = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.unicodeNormalization = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.equivalentSeparators = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutNamePolicy>): ShortcutNamePolicy {
+    return ShortcutNamePolicy.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutNamePolicy>): ShortcutNamePolicy {
+    const message = createBaseShortcutNamePolicy();
+    message.caseInsensitive = object.caseInsensitive ?? false;
+    message.unicodeNormalization = object.unicodeNormalization ?? false;
+    message.equivalentSeparators = object.equivalentSeparators ?? false;
     return message;
   },
 };
@@ -976,6 +1275,326 @@ export const WorkspaceStats: MessageFns<WorkspaceStats> = {
   },
 };
 
+function createBaseGetWorkspaceRuntimeStatsRequest(): GetWorkspaceRuntimeStatsRequest {
+  return {};
+}
+
+export const GetWorkspaceRuntimeStatsRequest: MessageFns<GetWorkspaceRuntimeStatsRequest> = {
+  encode(_: GetWorkspaceRuntimeStatsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetWorkspaceRuntimeStatsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetWorkspaceRuntimeStatsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<GetWorkspaceRuntimeStatsRequest>): GetWorkspaceRuntimeStatsRequest {
+    return GetWorkspaceRuntimeStatsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<GetWorkspaceRuntimeStatsRequest>): GetWorkspaceRuntimeStatsRequest {
+    const message = createBaseGetWorkspaceRuntimeStatsRequest();
+    return message;
+  },
+};
+
+function createBaseWorkspaceRuntimeStats(): WorkspaceRuntimeStats {
+  return { resolverCache: undefined, activityIngestion: undefined };
+}
+
+export const WorkspaceRuntimeStats: MessageFns<WorkspaceRuntimeStats> = {
+  encode(message: WorkspaceRuntimeStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.resolverCache !== undefined) {
+      ResolverCacheStats.encode(message.resolverCache, writer.uint32(10).fork()).join();
+    }
+    if (message.activityIngestion !== undefined) {
+      ActivityIngestionStats.encode(message.activityIngestion, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WorkspaceRuntimeStats {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceRuntimeStats();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.resolverCache = ResolverCacheStats.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.activityIngestion = ActivityIngestionStats.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<WorkspaceRuntimeStats>): WorkspaceRuntimeStats {
+    return WorkspaceRuntimeStats.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceRuntimeStats>): WorkspaceRuntimeStats {
+    const message = createBaseWorkspaceRuntimeStats();
+    message.resolverCache = (object.resolverCache !== undefined && object.resolverCache !== null)
+      ? ResolverCacheStats.fromPartial(object.resolverCache)
+      : undefined;
+    message.activityIngestion = (object.activityIngestion !== undefined && object.activityIngestion !== null)
+      ? ActivityIngestionStats.fromPartial(object.activityIngestion)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseResolverCacheStats(): ResolverCacheStats {
+  return { capacity: 0, entries: 0, hits: 0, negativeHits: 0, misses: 0, evictions: 0 };
+}
+
+export const ResolverCacheStats: MessageFns<ResolverCacheStats> = {
+  encode(message: ResolverCacheStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.capacity !== 0) {
+      writer.uint32(8).int32(message.capacity);
+    }
+    if (message.entries !== 0) {
+      writer.uint32(16).int32(message.entries);
+    }
+    if (message.hits !== 0) {
+      writer.uint32(24).int64(message.hits);
+    }
+    if (message.negativeHits !== 0) {
+      writer.uint32(32).int64(message.negativeHits);
+    }
+    if (message.misses !== 0) {
+      writer.uint32(40).int64(message.misses);
+    }
+    if (message.evictions !== 0) {
+      writer.uint32(48).int64(message.evictions);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResolverCacheStats {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResolverCacheStats();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.capacity = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.entries = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.hits = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.negativeHits = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.misses = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.evictions = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ResolverCacheStats>): ResolverCacheStats {
+    return ResolverCacheStats.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ResolverCacheStats>): ResolverCacheStats {
+    const message = createBaseResolverCacheStats();
+    message.capacity = object.capacity ?? 0;
+    message.entries = object.entries ?? 0;
+    message.hits = object.hits ?? 0;
+    message.negativeHits = object.negativeHits ?? 0;
+    message.misses = object.misses ?? 0;
+    message.evictions = object.evictions ?? 0;
+    return message;
+  },
+};
+
+function createBaseActivityIngestionStats(): ActivityIngestionStats {
+  return { policy: "", capacity: 0, buffered: 0, enqueued: 0, written: 0, dropped: 0, failed: 0 };
+}
+
+export const ActivityIngestionStats: MessageFns<ActivityIngestionStats> = {
+  encode(message: ActivityIngestionStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.policy !== "") {
+      writer.uint32(10).string(message.policy);
+    }
+    if (message.capacity !== 0) {
+      writer.uint32(16).int32(message.capacity);
+    }
+    if (message.buffered !== 0) {
+      writer.uint32(24).int32(message.buffered);
+    }
+    if (message.enqueued !== 0) {
+      writer.uint32(32).int64(message.enqueued);
+    }
+    if (message.written !== 0) {
+      writer.uint32(40).int64(message.written);
+    }
+    if (message.dropped !== 0) {
+      writer.uint32(48).int64(message.dropped);
+    }
+    if (message.failed !== 0) {
+      writer.uint32(56).int64(message.failed);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityIngestionStats {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityIngestionStats();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.policy = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.capacity = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.buffered = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.enqueued = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.written = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.dropped = longToNumber(reader.int64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.failed = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityIngestionStats>): ActivityIngestionStats {
+    return ActivityIngestionStats.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityIngestionStats>): ActivityIngestionStats {
+    const message = createBaseActivityIngestionStats();
+    message.policy = object.policy ?? "";
+    message.capacity = object.capacity ?? 0;
+    message.buffered = object.buffered ?? 0;
+    message.enqueued = object.enqueued ?? 0;
+    message.written = object.written ?? 0;
+    message.dropped = object.dropped ?? 0;
+    message.failed = object.failed ?? 0;
+    return message;
+  },
+};
+
 function createBaseStatsMeasurement(): StatsMeasurement {
   return { measuredTs: 0, shortcutsCount: 0, usersCount: 0, collectionsCount: 0, hitsCount: 0 };
 }
@@ -1278,6 +1897,55 @@ export const WorkspaceServiceDefinition = {
         },
       },
     },
+    getWorkspaceRuntimeStats: {
+      name: "GetWorkspaceRuntimeStats",
+      requestType: GetWorkspaceRuntimeStatsRequest,
+      requestStream: false,
+      responseType: WorkspaceRuntimeStats,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              33,
+              18,
+              31,
+              47,
+              97,
+              112,
+              105,
+              47,
+              118,
+              49,
+              47,
+              119,
+              111,
+              114,
+              107,
+              115,
+              112,
+              97,
+              99,
+              101,
+              47,
+              114,
+              117,
+              110,
+              116,
+              105,
+              109,
+              101,
+              45,
+              115,
+              116,
+              97,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -19,6 +19,10 @@ export interface ActivityShorcutViewPayload {
   referer: string;
   userAgent: string;
   params: { [key: string]: ActivityShorcutViewPayload_ValueList };
+  /** The name of the variant served, if the shortcut has variants. */
+  variant: string;
+  /** The bot signature that matched the user agent, for bot views. */
+  bot: string;
 }
 
 export interface ActivityShorcutViewPayload_ParamsEntry {
@@ -30,6 +34,32 @@ export interface ActivityShorcutViewPayload_ValueList {
   values: string[];
 }
 
+export interface ActivityShortcutLinkChangePayload {
+  shortcutId: number;
+  changeId: number;
+  previousLink: string;
+  link: string;
+}
+
+export interface ActivityShortcutFailoverPayload {
+  shortcutId: number;
+  previousLink: string;
+  link: string;
+  /** Whether the link now served is a backup rather than the primary link. */
+  backup: boolean;
+}
+
+/**
+ * ActivityShortcutChangeRequestPayload is the payload of the activities of a change request
+ * of a protected shortcut being created, approved or rejected.
+ */
+export interface ActivityShortcutChangeRequestPayload {
+  shortcutId: number;
+  changeRequestId: number;
+  /** The fields changed by the request. */
+  fields: string[];
+}
+
 function createBaseActivityShorcutCreatePayload(): ActivityShorcutCreatePayload {
   return { shortcutId: 0 };
 }
@@ -77,7 +107,7 @@ export const ActivityShorcutCreatePayload: MessageFns<ActivityShorcutCreatePaylo
 };
 
 function createBaseActivityShorcutViewPayload(): ActivityShorcutViewPayload {
-  return { shortcutId: 0, ip: "", referer: "", userAgent: "", params: {} };
+  return { shortcutId: 0, ip: "", referer: "", userAgent: "", params: {}, variant: "", bot: "" };
 }
 
 export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload> = {
@@ -97,6 +127,12 @@ export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload>
     Object.entries(message.params).forEach(([key, value]) => {
       ActivityShorcutViewPayload_ParamsEntry.encode({ key: key as any, value }, writer.uint32(42).fork()).join();
     });
+    if (message.variant !== "") {
+      writer.uint32(50).string(message.variant);
+    }
+    if (message.bot !== "") {
+      writer.uint32(58).string(message.bot);
+    }
     return writer;
   },
 
@@ -150,6 +186,22 @@ export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload>
           }
           continue;
         }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.variant = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.bot = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -176,6 +228,8 @@ export const ActivityShorcutViewPayload: MessageFns<ActivityShorcutViewPayload>
       }
       return acc;
     }, {});
+    message.variant = object.variant ?? "";
+    message.bot = object.bot ?? "";
     return message;
   },
 };
@@ -286,6 +340,240 @@ export const ActivityShorcutViewPayload_ValueList: MessageFns<ActivityShorcutVie
   },
 };
 
+function createBaseActivityShortcutLinkChangePayload(): ActivityShortcutLinkChangePayload {
+  return { shortcutId: 0, changeId: 0, previousLink: "", link: "" };
+}
+
+export const ActivityShortcutLinkChangePayload: MessageFns<ActivityShortcutLinkChangePayload> = {
+  encode(message: ActivityShortcutLinkChangePayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.changeId !== 0) {
+      writer.uint32(16).int32(message.changeId);
+    }
+    if (message.previousLink !== "") {
+      writer.uint32(26).string(message.previousLink);
+    }
+    if (message.link !== "") {
+      writer.uint32(34).string(message.link);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityShortcutLinkChangePayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityShortcutLinkChangePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.changeId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.previousLink = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityShortcutLinkChangePayload>): ActivityShortcutLinkChangePayload {
+    return ActivityShortcutLinkChangePayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityShortcutLinkChangePayload>): ActivityShortcutLinkChangePayload {
+    const message = createBaseActivityShortcutLinkChangePayload();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.changeId = object.changeId ?? 0;
+    message.previousLink = object.previousLink ?? "";
+    message.link = object.link ?? "";
+    return message;
+  },
+};
+
+function createBaseActivityShortcutFailoverPayload(): ActivityShortcutFailoverPayload {
+  return { shortcutId: 0, previousLink: "", link: "", backup: false };
+}
+
+export const ActivityShortcutFailoverPayload: MessageFns<ActivityShortcutFailoverPayload> = {
+  encode(message: ActivityShortcutFailoverPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.previousLink !== "") {
+      writer.uint32(18).string(message.previousLink);
+    }
+    if (message.link !== "") {
+      writer.uint32(26).string(message.link);
+    }
+    if (message.backup !== false) {
+      writer.uint32(32).bool(message.backup);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityShortcutFailoverPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityShortcutFailoverPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previousLink = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.backup = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityShortcutFailoverPayload>): ActivityShortcutFailoverPayload {
+    return ActivityShortcutFailoverPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityShortcutFailoverPayload>): ActivityShortcutFailoverPayload {
+    const message = createBaseActivityShortcutFailoverPayload();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.previousLink = object.previousLink ?? "";
+    message.link = object.link ?? "";
+    message.backup = object.backup ?? false;
+    return message;
+  },
+};
+
+function createBaseActivityShortcutChangeRequestPayload(): ActivityShortcutChangeRequestPayload {
+  return { shortcutId: 0, changeRequestId: 0, fields: [] };
+}
+
+export const ActivityShortcutChangeRequestPayload: MessageFns<ActivityShortcutChangeRequestPayload> = {
+  encode(message: ActivityShortcutChangeRequestPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.shortcutId !== 0) {
+      writer.uint32(8).int32(message.shortcutId);
+    }
+    if (message.changeRequestId !== 0) {
+      writer.uint32(16).int32(message.changeRequestId);
+    }
+    for (const v of message.fields) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ActivityShortcutChangeRequestPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseActivityShortcutChangeRequestPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.changeRequestId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.fields.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ActivityShortcutChangeRequestPayload>): ActivityShortcutChangeRequestPayload {
+    return ActivityShortcutChangeRequestPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ActivityShortcutChangeRequestPayload>): ActivityShortcutChangeRequestPayload {
+    const message = createBaseActivityShortcutChangeRequestPayload();
+    message.shortcutId = object.shortcutId ?? 0;
+    message.changeRequestId = object.changeRequestId ?? 0;
+    message.fields = object.fields?.map((e) => e) || [];
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
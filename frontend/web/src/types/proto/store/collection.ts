@@ -21,6 +21,10 @@ export interface Collection {
   shortcutIds: number[];
   visibility: Visibility;
   customIcon: string;
+  /** The time the collection was moved to the trash, or 0 if it is not in the trash. */
+  deletedTs: number;
+  /** The groups a GROUP collection is visible to. */
+  groupIds: number[];
 }
 
 function createBaseCollection(): Collection {
@@ -35,6 +39,8 @@ function createBaseCollection(): Collection {
     shortcutIds: [],
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
     customIcon: "",
+    deletedTs: 0,
+    groupIds: [],
   };
 }
 
@@ -72,6 +78,14 @@ export const Collection: MessageFns<Collection> = {
     if (message.customIcon !== "") {
       writer.uint32(90).string(message.customIcon);
     }
+    if (message.deletedTs !== 0) {
+      writer.uint32(96).int64(message.deletedTs);
+    }
+    writer.uint32(106).fork();
+    for (const v of message.groupIds) {
+      writer.int32(v);
+    }
+    writer.join();
     return writer;
   },
 
@@ -172,6 +186,32 @@ export const Collection: MessageFns<Collection> = {
           message.customIcon = reader.string();
           continue;
         }
+        case 12: {
+          if (tag !== 96) {
+            break;
+          }
+
+          message.deletedTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 13: {
+          if (tag === 104) {
+            message.groupIds.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 106) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.groupIds.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -196,6 +236,8 @@ export const Collection: MessageFns<Collection> = {
     message.shortcutIds = object.shortcutIds?.map((e) => e) || [];
     message.visibility = object.visibility ?? Visibility.VISIBILITY_UNSPECIFIED;
     message.customIcon = object.customIcon ?? "";
+    message.deletedTs = object.deletedTs ?? 0;
+    message.groupIds = object.groupIds?.map((e) => e) || [];
     return message;
   },
 };
@@ -51,6 +51,10 @@ export enum Visibility {
   VISIBILITY_UNSPECIFIED = "VISIBILITY_UNSPECIFIED",
   WORKSPACE = "WORKSPACE",
   PUBLIC = "PUBLIC",
+  /** PRIVATE - Only visible to the creator. */
+  PRIVATE = "PRIVATE",
+  /** GROUP - Only visible to the creator and the members of the groups it is shared with. */
+  GROUP = "GROUP",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -65,6 +69,12 @@ export function visibilityFromJSON(object: any): Visibility {
     case 2:
     case "PUBLIC":
       return Visibility.PUBLIC;
+    case 3:
+    case "PRIVATE":
+      return Visibility.PRIVATE;
+    case 4:
+    case "GROUP":
+      return Visibility.GROUP;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -80,8 +90,81 @@ export function visibilityToNumber(object: Visibility): number {
       return 1;
     case Visibility.PUBLIC:
       return 2;
+    case Visibility.PRIVATE:
+      return 3;
+    case Visibility.GROUP:
+      return 4;
     case Visibility.UNRECOGNIZED:
     default:
       return -1;
   }
 }
+
+export enum RedirectMode {
+  REDIRECT_MODE_UNSPECIFIED = "REDIRECT_MODE_UNSPECIFIED",
+  /** FOUND - HTTP 302. */
+  FOUND = "FOUND",
+  /** MOVED_PERMANENTLY - HTTP 301. */
+  MOVED_PERMANENTLY = "MOVED_PERMANENTLY",
+  /** TEMPORARY_REDIRECT - HTTP 307. */
+  TEMPORARY_REDIRECT = "TEMPORARY_REDIRECT",
+  /** PERMANENT_REDIRECT - HTTP 308. */
+  PERMANENT_REDIRECT = "PERMANENT_REDIRECT",
+  /** META_REFRESH - An HTML page that refreshes to the link without sending a referrer. */
+  META_REFRESH = "META_REFRESH",
+  /** INTERSTITIAL - A preview page that shows the destination before continuing. */
+  INTERSTITIAL = "INTERSTITIAL",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function redirectModeFromJSON(object: any): RedirectMode {
+  switch (object) {
+    case 0:
+    case "REDIRECT_MODE_UNSPECIFIED":
+      return RedirectMode.REDIRECT_MODE_UNSPECIFIED;
+    case 1:
+    case "FOUND":
+      return RedirectMode.FOUND;
+    case 2:
+    case "MOVED_PERMANENTLY":
+      return RedirectMode.MOVED_PERMANENTLY;
+    case 3:
+    case "TEMPORARY_REDIRECT":
+      return RedirectMode.TEMPORARY_REDIRECT;
+    case 4:
+    case "PERMANENT_REDIRECT":
+      return RedirectMode.PERMANENT_REDIRECT;
+    case 5:
+    case "META_REFRESH":
+      return RedirectMode.META_REFRESH;
+    case 6:
+    case "INTERSTITIAL":
+      return RedirectMode.INTERSTITIAL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return RedirectMode.UNRECOGNIZED;
+  }
+}
+
+export function redirectModeToNumber(object: RedirectMode): number {
+  switch (object) {
+    case RedirectMode.REDIRECT_MODE_UNSPECIFIED:
+      return 0;
+    case RedirectMode.FOUND:
+      return 1;
+    case RedirectMode.MOVED_PERMANENTLY:
+      return 2;
+    case RedirectMode.TEMPORARY_REDIRECT:
+      return 3;
+    case RedirectMode.PERMANENT_REDIRECT:
+      return 4;
+    case RedirectMode.META_REFRESH:
+      return 5;
+    case RedirectMode.INTERSTITIAL:
+      return 6;
+    case RedirectMode.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
@@ -6,7 +6,17 @@
 
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import {
+  RedirectMode,
+  redirectModeFromJSON,
+  redirectModeToNumber,
+  RowStatus,
+  rowStatusFromJSON,
+  rowStatusToNumber,
+  Visibility,
+  visibilityFromJSON,
+  visibilityToNumber,
+} from "./common";
 
 export const protobufPackage = "monotreme.store";
 
@@ -24,6 +34,148 @@ export interface Shortcut {
   visibility: Visibility;
   ogMetadata?: OpenGraphMetadata | undefined;
   customIcon: string;
+  template: boolean;
+  forwardPath: boolean;
+  redirectMode: RedirectMode;
+  /** The time from which the shortcut resolves, or 0 for no start. */
+  validFrom: number;
+  /** The time at which the shortcut expires, or 0 for no expiry. */
+  validUntil: number;
+  rowStatus: RowStatus;
+  variants?: ShortcutVariants | undefined;
+  routingRules?: ShortcutRoutingRules | undefined;
+  failover?:
+    | ShortcutFailover
+    | undefined;
+  /**
+   * The name under the workspace name policy, unique among shortcuts.
+   * Empty if it collides with the name of an older shortcut.
+   */
+  normalizedName: string;
+  /** The time the shortcut was moved to the trash, or 0 if it is not in the trash. */
+  deletedTs: number;
+  /** The groups a GROUP shortcut is visible to. */
+  groupIds: number[];
+  /** Whether edits to the shortcut must be approved by another person. */
+  protected: boolean;
+}
+
+export interface ShortcutVariants {
+  variants: ShortcutVariant[];
+  /** Whether a visitor keeps being served the same variant, keyed on a cookie. */
+  sticky: boolean;
+}
+
+export interface ShortcutVariant {
+  name: string;
+  link: string;
+  /** The relative share of traffic served by this variant. */
+  weight: number;
+}
+
+/**
+ * ShortcutRoutingRules sends visitors to different links depending on their request.
+ * The first rule whose conditions all match wins; the shortcut link is the fallback.
+ */
+export interface ShortcutRoutingRules {
+  rules: ShortcutRoutingRule[];
+}
+
+export interface ShortcutRoutingRule {
+  conditions: ShortcutRoutingCondition[];
+  link: string;
+}
+
+export interface ShortcutRoutingCondition {
+  field: ShortcutRoutingCondition_Field;
+  /** The header name, for HEADER conditions. */
+  header: string;
+  /**
+   * The condition matches when the request has any of the values, compared case-insensitively.
+   * A HEADER condition without values matches when the header is present.
+   */
+  values: string[];
+}
+
+export enum ShortcutRoutingCondition_Field {
+  FIELD_UNSPECIFIED = "FIELD_UNSPECIFIED",
+  /** DEVICE - One of mobile, tablet, desktop or bot. */
+  DEVICE = "DEVICE",
+  /** OS - One of ios, android, windows, macos, linux or chromeos. */
+  OS = "OS",
+  /** BROWSER - The browser name, such as chrome, firefox, safari or edge. */
+  BROWSER = "BROWSER",
+  /** LANGUAGE - A language tag matched against the most preferred Accept-Language, such as de or de-AT. */
+  LANGUAGE = "LANGUAGE",
+  /** HEADER - The request header named by header. */
+  HEADER = "HEADER",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function shortcutRoutingCondition_FieldFromJSON(object: any): ShortcutRoutingCondition_Field {
+  switch (object) {
+    case 0:
+    case "FIELD_UNSPECIFIED":
+      return ShortcutRoutingCondition_Field.FIELD_UNSPECIFIED;
+    case 1:
+    case "DEVICE":
+      return ShortcutRoutingCondition_Field.DEVICE;
+    case 2:
+    case "OS":
+      return ShortcutRoutingCondition_Field.OS;
+    case 3:
+    case "BROWSER":
+      return ShortcutRoutingCondition_Field.BROWSER;
+    case 4:
+    case "LANGUAGE":
+      return ShortcutRoutingCondition_Field.LANGUAGE;
+    case 5:
+    case "HEADER":
+      return ShortcutRoutingCondition_Field.HEADER;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ShortcutRoutingCondition_Field.UNRECOGNIZED;
+  }
+}
+
+export function shortcutRoutingCondition_FieldToNumber(object: ShortcutRoutingCondition_Field): number {
+  switch (object) {
+    case ShortcutRoutingCondition_Field.FIELD_UNSPECIFIED:
+      return 0;
+    case ShortcutRoutingCondition_Field.DEVICE:
+      return 1;
+    case ShortcutRoutingCondition_Field.OS:
+      return 2;
+    case ShortcutRoutingCondition_Field.BROWSER:
+      return 3;
+    case ShortcutRoutingCondition_Field.LANGUAGE:
+      return 4;
+    case ShortcutRoutingCondition_Field.HEADER:
+      return 5;
+    case ShortcutRoutingCondition_Field.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** ShortcutFailover lists the links to fall back to, in order, when the link is unhealthy. */
+export interface ShortcutFailover {
+  backupLinks: string[];
+}
+
+/** Another name that resolves to a shortcut, such as the name it had before a rename. */
+export interface ShortcutAlias {
+  id: number;
+  shortcutId: number;
+  creatorId: number;
+  createdTs: number;
+  name: string;
+  /**
+   * The name under the workspace name policy, unique among shortcuts and aliases.
+   * Empty if it collides with the name of a shortcut or an older alias.
+   */
+  normalizedName: string;
 }
 
 export interface OpenGraphMetadata {
@@ -32,6 +184,41 @@ export interface OpenGraphMetadata {
   image: string;
 }
 
+/** ShortcutRevisionPayload is the content of a shortcut revision. */
+export interface ShortcutRevisionPayload {
+  /**
+   * The fields changed by the revision, named as in the UpdateShortcut update mask.
+   * It is empty for the baseline revision written before the first recorded change.
+   */
+  fields: string[];
+  /** The shortcut before the change, unset for the baseline revision. */
+  previous?: Shortcut | undefined;
+  /** The shortcut after the change. */
+  shortcut?: Shortcut | undefined;
+  /** The revision that was restored by this one, if it is a rollback. */
+  restoredRevisionId: number;
+}
+
+/** ShortcutChangeRequestPayload is the content of a change request of a protected shortcut. */
+export interface ShortcutChangeRequestPayload {
+  /** The fields changed by the request, named as in the UpdateShortcut update mask. */
+  fields: string[];
+  /** The shortcut when the change was requested. */
+  previous?:
+    | Shortcut
+    | undefined;
+  /** The shortcut with the change applied. */
+  shortcut?:
+    | Shortcut
+    | undefined;
+  /** The aliases when the change was requested. */
+  previousAliases: string[];
+  /** The aliases with the change applied, if fields contains aliases. */
+  aliases: string[];
+  /** The revision restored by the change, if it is a rollback. */
+  restoredRevisionId: number;
+}
+
 function createBaseShortcut(): Shortcut {
   return {
     id: 0,
@@ -47,6 +234,19 @@ function createBaseShortcut(): Shortcut {
     visibility: Visibility.VISIBILITY_UNSPECIFIED,
     ogMetadata: undefined,
     customIcon: "",
+    template: false,
+    forwardPath: false,
+    redirectMode: RedirectMode.REDIRECT_MODE_UNSPECIFIED,
+    validFrom: 0,
+    validUntil: 0,
+    rowStatus: RowStatus.ROW_STATUS_UNSPECIFIED,
+    variants: undefined,
+    routingRules: undefined,
+    failover: undefined,
+    normalizedName: "",
+    deletedTs: 0,
+    groupIds: [],
+    protected: false,
   };
 }
 
@@ -91,6 +291,47 @@ export const Shortcut: MessageFns<Shortcut> = {
     if (message.customIcon !== "") {
       writer.uint32(106).string(message.customIcon);
     }
+    if (message.template !== false) {
+      writer.uint32(112).bool(message.template);
+    }
+    if (message.forwardPath !== false) {
+      writer.uint32(120).bool(message.forwardPath);
+    }
+    if (message.redirectMode !== RedirectMode.REDIRECT_MODE_UNSPECIFIED) {
+      writer.uint32(128).int32(redirectModeToNumber(message.redirectMode));
+    }
+    if (message.validFrom !== 0) {
+      writer.uint32(136).int64(message.validFrom);
+    }
+    if (message.validUntil !== 0) {
+      writer.uint32(144).int64(message.validUntil);
+    }
+    if (message.rowStatus !== RowStatus.ROW_STATUS_UNSPECIFIED) {
+      writer.uint32(152).int32(rowStatusToNumber(message.rowStatus));
+    }
+    if (message.variants !== undefined) {
+      ShortcutVariants.encode(message.variants, writer.uint32(162).fork()).join();
+    }
+    if (message.routingRules !== undefined) {
+      ShortcutRoutingRules.encode(message.routingRules, writer.uint32(170).fork()).join();
+    }
+    if (message.failover !== undefined) {
+      ShortcutFailover.encode(message.failover, writer.uint32(178).fork()).join();
+    }
+    if (message.normalizedName !== "") {
+      writer.uint32(186).string(message.normalizedName);
+    }
+    if (message.deletedTs !== 0) {
+      writer.uint32(192).int64(message.deletedTs);
+    }
+    writer.uint32(202).fork();
+    for (const v of message.groupIds) {
+      writer.int32(v);
+    }
+    writer.join();
+    if (message.protected !== false) {
+      writer.uint32(208).bool(message.protected);
+    }
     return writer;
   },
 
@@ -205,6 +446,120 @@ export const Shortcut: MessageFns<Shortcut> = {
           message.customIcon = reader.string();
           continue;
         }
+        case 14: {
+          if (tag !== 112) {
+            break;
+          }
+
+          message.template = reader.bool();
+          continue;
+        }
+        case 15: {
+          if (tag !== 120) {
+            break;
+          }
+
+          message.forwardPath = reader.bool();
+          continue;
+        }
+        case 16: {
+          if (tag !== 128) {
+            break;
+          }
+
+          message.redirectMode = redirectModeFromJSON(reader.int32());
+          continue;
+        }
+        case 17: {
+          if (tag !== 136) {
+            break;
+          }
+
+          message.validFrom = longToNumber(reader.int64());
+          continue;
+        }
+        case 18: {
+          if (tag !== 144) {
+            break;
+          }
+
+          message.validUntil = longToNumber(reader.int64());
+          continue;
+        }
+        case 19: {
+          if (tag !== 152) {
+            break;
+          }
+
+          message.rowStatus = rowStatusFromJSON(reader.int32());
+          continue;
+        }
+        case 20: {
+          if (tag !== 162) {
+            break;
+          }
+
+          message.variants = ShortcutVariants.decode(reader, reader.uint32());
+          continue;
+        }
+        case 21: {
+          if (tag !== 170) {
+            break;
+          }
+
+          message.routingRules = ShortcutRoutingRules.decode(reader, reader.uint32());
+          continue;
+        }
+        case 22: {
+          if (tag !== 178) {
+            break;
+          }
+
+          message.failover = ShortcutFailover.decode(reader, reader.uint32());
+          continue;
+        }
+        case 23: {
+          if (tag !== 186) {
+            break;
+          }
+
+          message.normalizedName = reader.string();
+          continue;
+        }
+        case 24: {
+          if (tag !== 192) {
+            break;
+          }
+
+          message.deletedTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 25: {
+          if (tag === 200) {
+            message.groupIds.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 202) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.groupIds.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 26: {
+          if (tag !== 208) {
+            break;
+          }
+
+          message.protected = reader.bool();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -234,32 +589,109 @@ export const Shortcut: MessageFns<Shortcut> = {
       ? OpenGraphMetadata.fromPartial(object.ogMetadata)
       : undefined;
     message.customIcon = object.customIcon ?? "";
+    message.template = object.template ?? false;
+    message.forwardPath = object.forwardPath ?? false;
+    message.redirectMode = object.redirectMode ?? RedirectMode.REDIRECT_MODE_UNSPECIFIED;
+    message.validFrom = object.validFrom ?? 0;
+    message.validUntil = object.validUntil ?? 0;
+    message.rowStatus = object.rowStatus ?? RowStatus.ROW_STATUS_UNSPECIFIED;
+    message.variants = (object.variants !== undefined && object.variants !== null)
+      ? ShortcutVariants.fromPartial(object.variants)
+      : undefined;
+    message.routingRules = (object.routingRules !== undefined && object.routingRules !== null)
+      ? ShortcutRoutingRules.fromPartial(object.routingRules)
+      : undefined;
+    message.failover = (object.failover !== undefined && object.failover !== null)
+      ? ShortcutFailover.fromPartial(object.failover)
+      : undefined;
+    message.normalizedName = object.normalizedName ?? "";
+    message.deletedTs = object.deletedTs ?? 0;
+    message.groupIds = object.groupIds?.map((e) => e) || [];
+    message.protected = object.protected ?? false;
     return message;
   },
 };
 
-function createBaseOpenGraphMetadata(): OpenGraphMetadata {
-  return { title: "", description: "", image: "" };
+function createBaseShortcutVariants(): ShortcutVariants {
+  return { variants: [], sticky: false };
 }
 
-export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
-  encode(message: OpenGraphMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.title !== "") {
-      writer.uint32(10).string(message.title);
+export const ShortcutVariants: MessageFns<ShortcutVariants> = {
+  encode(message: ShortcutVariants, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.variants) {
+      ShortcutVariant.encode(v!, writer.uint32(10).fork()).join();
     }
-    if (message.description !== "") {
-      writer.uint32(18).string(message.description);
+    if (message.sticky !== false) {
+      writer.uint32(16).bool(message.sticky);
     }
-    if (message.image !== "") {
-      writer.uint32(26).string(message.image);
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutVariants {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutVariants();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.variants.push(ShortcutVariant.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.sticky = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutVariants>): ShortcutVariants {
+    return ShortcutVariants.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutVariants>): ShortcutVariants {
+    const message = createBaseShortcutVariants();
+    message.variants = object.variants?.map((e) => ShortcutVariant.fromPartial(e)) || [];
+    message.sticky = object.sticky ?? false;
+    return message;
+  },
+};
+
+function createBaseShortcutVariant(): ShortcutVariant {
+  return { name: "", link: "", weight: 0 };
+}
+
+export const ShortcutVariant: MessageFns<ShortcutVariant> = {
+  encode(message: ShortcutVariant, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    if (message.weight !== 0) {
+      writer.uint32(24).int32(message.weight);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): OpenGraphMetadata {
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutVariant {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseOpenGraphMetadata();
+    const message = createBaseShortcutVariant();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -268,7 +700,7 @@ export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
             break;
           }
 
-          message.title = reader.string();
+          message.name = reader.string();
           continue;
         }
         case 2: {
@@ -276,15 +708,15 @@ export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
             break;
           }
 
-          message.description = reader.string();
+          message.link = reader.string();
           continue;
         }
         case 3: {
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.image = reader.string();
+          message.weight = reader.int32();
           continue;
         }
       }
@@ -296,14 +728,613 @@ export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
     return message;
   },
 
-  create(base?: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
-    return OpenGraphMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<ShortcutVariant>): ShortcutVariant {
+    return ShortcutVariant.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
-    const message = createBaseOpenGraphMetadata();
-    message.title = object.title ?? "";
-    message.description = object.description ?? "";
-    message.image = object.image ?? "";
+  fromPartial(object: DeepPartial<ShortcutVariant>): ShortcutVariant {
+    const message = createBaseShortcutVariant();
+    message.name = object.name ?? "";
+    message.link = object.link ?? "";
+    message.weight = object.weight ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutRoutingRules(): ShortcutRoutingRules {
+  return { rules: [] };
+}
+
+export const ShortcutRoutingRules: MessageFns<ShortcutRoutingRules> = {
+  encode(message: ShortcutRoutingRules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.rules) {
+      ShortcutRoutingRule.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRoutingRules {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRoutingRules();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rules.push(ShortcutRoutingRule.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRoutingRules>): ShortcutRoutingRules {
+    return ShortcutRoutingRules.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRoutingRules>): ShortcutRoutingRules {
+    const message = createBaseShortcutRoutingRules();
+    message.rules = object.rules?.map((e) => ShortcutRoutingRule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseShortcutRoutingRule(): ShortcutRoutingRule {
+  return { conditions: [], link: "" };
+}
+
+export const ShortcutRoutingRule: MessageFns<ShortcutRoutingRule> = {
+  encode(message: ShortcutRoutingRule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.conditions) {
+      ShortcutRoutingCondition.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.link !== "") {
+      writer.uint32(18).string(message.link);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRoutingRule {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRoutingRule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.conditions.push(ShortcutRoutingCondition.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRoutingRule>): ShortcutRoutingRule {
+    return ShortcutRoutingRule.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRoutingRule>): ShortcutRoutingRule {
+    const message = createBaseShortcutRoutingRule();
+    message.conditions = object.conditions?.map((e) => ShortcutRoutingCondition.fromPartial(e)) || [];
+    message.link = object.link ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcutRoutingCondition(): ShortcutRoutingCondition {
+  return { field: ShortcutRoutingCondition_Field.FIELD_UNSPECIFIED, header: "", values: [] };
+}
+
+export const ShortcutRoutingCondition: MessageFns<ShortcutRoutingCondition> = {
+  encode(message: ShortcutRoutingCondition, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== ShortcutRoutingCondition_Field.FIELD_UNSPECIFIED) {
+      writer.uint32(8).int32(shortcutRoutingCondition_FieldToNumber(message.field));
+    }
+    if (message.header !== "") {
+      writer.uint32(18).string(message.header);
+    }
+    for (const v of message.values) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRoutingCondition {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRoutingCondition();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.field = shortcutRoutingCondition_FieldFromJSON(reader.int32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.header = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.values.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRoutingCondition>): ShortcutRoutingCondition {
+    return ShortcutRoutingCondition.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRoutingCondition>): ShortcutRoutingCondition {
+    const message = createBaseShortcutRoutingCondition();
+    message.field = object.field ?? ShortcutRoutingCondition_Field.FIELD_UNSPECIFIED;
+    message.header = object.header ?? "";
+    message.values = object.values?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseShortcutFailover(): ShortcutFailover {
+  return { backupLinks: [] };
+}
+
+export const ShortcutFailover: MessageFns<ShortcutFailover> = {
+  encode(message: ShortcutFailover, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.backupLinks) {
+      writer.uint32(10).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutFailover {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutFailover();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.backupLinks.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutFailover>): ShortcutFailover {
+    return ShortcutFailover.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutFailover>): ShortcutFailover {
+    const message = createBaseShortcutFailover();
+    message.backupLinks = object.backupLinks?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseShortcutAlias(): ShortcutAlias {
+  return { id: 0, shortcutId: 0, creatorId: 0, createdTs: 0, name: "", normalizedName: "" };
+}
+
+export const ShortcutAlias: MessageFns<ShortcutAlias> = {
+  encode(message: ShortcutAlias, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).int32(message.id);
+    }
+    if (message.shortcutId !== 0) {
+      writer.uint32(16).int32(message.shortcutId);
+    }
+    if (message.creatorId !== 0) {
+      writer.uint32(24).int32(message.creatorId);
+    }
+    if (message.createdTs !== 0) {
+      writer.uint32(32).int64(message.createdTs);
+    }
+    if (message.name !== "") {
+      writer.uint32(42).string(message.name);
+    }
+    if (message.normalizedName !== "") {
+      writer.uint32(50).string(message.normalizedName);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutAlias {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutAlias();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.shortcutId = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.creatorId = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.createdTs = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.normalizedName = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutAlias>): ShortcutAlias {
+    return ShortcutAlias.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutAlias>): ShortcutAlias {
+    const message = createBaseShortcutAlias();
+    message.id = object.id ?? 0;
+    message.shortcutId = object.shortcutId ?? 0;
+    message.creatorId = object.creatorId ?? 0;
+    message.createdTs = object.createdTs ?? 0;
+    message.name = object.name ?? "";
+    message.normalizedName = object.normalizedName ?? "";
+    return message;
+  },
+};
+
+function createBaseOpenGraphMetadata(): OpenGraphMetadata {
+  return { title: "", description: "", image: "" };
+}
+
+export const OpenGraphMetadata: MessageFns<OpenGraphMetadata> = {
+  encode(message: OpenGraphMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    if (message.image !== "") {
+      writer.uint32(26).string(message.image);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): OpenGraphMetadata {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOpenGraphMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.image = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
+    return OpenGraphMetadata.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<OpenGraphMetadata>): OpenGraphMetadata {
+    const message = createBaseOpenGraphMetadata();
+    message.title = object.title ?? "";
+    message.description = object.description ?? "";
+    message.image = object.image ?? "";
+    return message;
+  },
+};
+
+function createBaseShortcutRevisionPayload(): ShortcutRevisionPayload {
+  return { fields: [], previous: undefined, shortcut: undefined, restoredRevisionId: 0 };
+}
+
+export const ShortcutRevisionPayload: MessageFns<ShortcutRevisionPayload> = {
+  encode(message: ShortcutRevisionPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.fields) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.previous !== undefined) {
+      Shortcut.encode(message.previous, writer.uint32(18).fork()).join();
+    }
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(26).fork()).join();
+    }
+    if (message.restoredRevisionId !== 0) {
+      writer.uint32(32).int32(message.restoredRevisionId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutRevisionPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutRevisionPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fields.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previous = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.restoredRevisionId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutRevisionPayload>): ShortcutRevisionPayload {
+    return ShortcutRevisionPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutRevisionPayload>): ShortcutRevisionPayload {
+    const message = createBaseShortcutRevisionPayload();
+    message.fields = object.fields?.map((e) => e) || [];
+    message.previous = (object.previous !== undefined && object.previous !== null)
+      ? Shortcut.fromPartial(object.previous)
+      : undefined;
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.restoredRevisionId = object.restoredRevisionId ?? 0;
+    return message;
+  },
+};
+
+function createBaseShortcutChangeRequestPayload(): ShortcutChangeRequestPayload {
+  return {
+    fields: [],
+    previous: undefined,
+    shortcut: undefined,
+    previousAliases: [],
+    aliases: [],
+    restoredRevisionId: 0,
+  };
+}
+
+export const ShortcutChangeRequestPayload: MessageFns<ShortcutChangeRequestPayload> = {
+  encode(message: ShortcutChangeRequestPayload, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.fields) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.previous !== undefined) {
+      Shortcut.encode(message.previous, writer.uint32(18).fork()).join();
+    }
+    if (message.shortcut !== undefined) {
+      Shortcut.encode(message.shortcut, writer.uint32(26).fork()).join();
+    }
+    for (const v of message.previousAliases) {
+      writer.uint32(34).string(v!);
+    }
+    for (const v of message.aliases) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.restoredRevisionId !== 0) {
+      writer.uint32(48).int32(message.restoredRevisionId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutChangeRequestPayload {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutChangeRequestPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fields.push(reader.string());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previous = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.shortcut = Shortcut.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.previousAliases.push(reader.string());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.aliases.push(reader.string());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.restoredRevisionId = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutChangeRequestPayload>): ShortcutChangeRequestPayload {
+    return ShortcutChangeRequestPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutChangeRequestPayload>): ShortcutChangeRequestPayload {
+    const message = createBaseShortcutChangeRequestPayload();
+    message.fields = object.fields?.map((e) => e) || [];
+    message.previous = (object.previous !== undefined && object.previous !== null)
+      ? Shortcut.fromPartial(object.previous)
+      : undefined;
+    message.shortcut = (object.shortcut !== undefined && object.shortcut !== null)
+      ? Shortcut.fromPartial(object.shortcut)
+      : undefined;
+    message.previousAliases = object.previousAliases?.map((e) => e) || [];
+    message.aliases = object.aliases?.map((e) => e) || [];
+    message.restoredRevisionId = object.restoredRevisionId ?? 0;
     return message;
   },
 };
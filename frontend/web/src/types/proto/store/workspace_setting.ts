@@ -6,7 +6,14 @@
 
 /* eslint-disable */
 import { BinaryReader, BinaryWriter } from "@bufbuild/protobuf/wire";
-import { Visibility, visibilityFromJSON, visibilityToNumber } from "./common";
+import {
+  RedirectMode,
+  redirectModeFromJSON,
+  redirectModeToNumber,
+  Visibility,
+  visibilityFromJSON,
+  visibilityToNumber,
+} from "./common";
 import { IdentityProvider } from "./idp";
 
 export const protobufPackage = "monotreme.store";
@@ -122,12 +129,48 @@ export interface WorkspaceSetting_SecuritySetting {
 export interface WorkspaceSetting_ShortcutRelatedSetting {
   defaultVisibility: Visibility;
   shortcutPrefix: string;
+  defaultRedirectMode: RedirectMode;
+  /** The message shown when a shortcut is visited before it becomes valid. */
+  pendingMessage: string;
+  /** The message shown when an expired shortcut is visited. */
+  expiredMessage: string;
+  /** The number of days after expiry before a shortcut is archived. 0 uses the default of 30 days. */
+  expiredArchiveDays: number;
+  /** Whether a visit to an unknown shortcut name redirects to the only close match. */
+  autoRedirectNotFound: boolean;
+  /** How shortcut names are compared. Unset compares names case-insensitively after NFKC normalization. */
+  namePolicy?:
+    | ShortcutNamePolicy
+    | undefined;
+  /** The hosts, like "go" or "go.example.com", on which bare paths such as /standup resolve as shortcut names. */
+  redirectHosts: string[];
+  /** Former shortcut prefixes, which redirect permanently to the shortcut_prefix URL. */
+  legacyShortcutPrefixes: string[];
+  /** The prefix of collection URLs. Empty uses "c". */
+  collectionPrefix: string;
+  /** Former collection prefixes, which redirect permanently to the collection_prefix URL. */
+  legacyCollectionPrefixes: string[];
+  /**
+   * The number of days deleted shortcuts and collections stay in the trash before they are purged.
+   * 0 uses the default of 30 days.
+   */
+  trashRetentionDays: number;
 }
 
 export interface WorkspaceSetting_IdentityProviderSetting {
   identityProviders: IdentityProvider[];
 }
 
+/** ShortcutNamePolicy decides which shortcut names are considered the same. */
+export interface ShortcutNamePolicy {
+  /** Whether names differing only in case are the same. */
+  caseInsensitive: boolean;
+  /** Whether names are compared after NFKC Unicode normalization. */
+  unicodeNormalization: boolean;
+  /** Whether "-", "_" and "." are the same. */
+  equivalentSeparators: boolean;
+}
+
 function createBaseWorkspaceSetting(): WorkspaceSetting {
   return {
     key: WorkspaceSettingKey.WORKSPACE_SETTING_KEY_UNSPECIFIED,
@@ -402,7 +445,21 @@ export const WorkspaceSetting_SecuritySetting: MessageFns<WorkspaceSetting_Secur
 };
 
 function createBaseWorkspaceSetting_ShortcutRelatedSetting(): WorkspaceSetting_ShortcutRelatedSetting {
-  return { defaultVisibility: Visibility.VISIBILITY_UNSPECIFIED, shortcutPrefix: "" };
+  return {
+    defaultVisibility: Visibility.VISIBILITY_UNSPECIFIED,
+    shortcutPrefix: "",
+    defaultRedirectMode: RedirectMode.REDIRECT_MODE_UNSPECIFIED,
+    pendingMessage: "",
+    expiredMessage: "",
+    expiredArchiveDays: 0,
+    autoRedirectNotFound: false,
+    namePolicy: undefined,
+    redirectHosts: [],
+    legacyShortcutPrefixes: [],
+    collectionPrefix: "",
+    legacyCollectionPrefixes: [],
+    trashRetentionDays: 0,
+  };
 }
 
 export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSetting_ShortcutRelatedSetting> = {
@@ -413,6 +470,39 @@ export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSettin
     if (message.shortcutPrefix !== "") {
       writer.uint32(18).string(message.shortcutPrefix);
     }
+    if (message.defaultRedirectMode !== RedirectMode.REDIRECT_MODE_UNSPECIFIED) {
+      writer.uint32(24).int32(redirectModeToNumber(message.defaultRedirectMode));
+    }
+    if (message.pendingMessage !== "") {
+      writer.uint32(34).string(message.pendingMessage);
+    }
+    if (message.expiredMessage !== "") {
+      writer.uint32(42).string(message.expiredMessage);
+    }
+    if (message.expiredArchiveDays !== 0) {
+      writer.uint32(48).int32(message.expiredArchiveDays);
+    }
+    if (message.autoRedirectNotFound !== false) {
+      writer.uint32(56).bool(message.autoRedirectNotFound);
+    }
+    if (message.namePolicy !== undefined) {
+      ShortcutNamePolicy.encode(message.namePolicy, writer.uint32(66).fork()).join();
+    }
+    for (const v of message.redirectHosts) {
+      writer.uint32(74).string(v!);
+    }
+    for (const v of message.legacyShortcutPrefixes) {
+      writer.uint32(82).string(v!);
+    }
+    if (message.collectionPrefix !== "") {
+      writer.uint32(90).string(message.collectionPrefix);
+    }
+    for (const v of message.legacyCollectionPrefixes) {
+      writer.uint32(98).string(v!);
+    }
+    if (message.trashRetentionDays !== 0) {
+      writer.uint32(104).int32(message.trashRetentionDays);
+    }
     return writer;
   },
 
@@ -439,6 +529,94 @@ export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSettin
           message.shortcutPrefix = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.defaultRedirectMode = redirectModeFromJSON(reader.int32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.pendingMessage = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.expiredMessage = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.expiredArchiveDays = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.autoRedirectNotFound = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.namePolicy = ShortcutNamePolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.redirectHosts.push(reader.string());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.legacyShortcutPrefixes.push(reader.string());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.collectionPrefix = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.legacyCollectionPrefixes.push(reader.string());
+          continue;
+        }
+        case 13: {
+          if (tag !== 104) {
+            break;
+          }
+
+          message.trashRetentionDays = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -455,6 +633,19 @@ export const WorkspaceSetting_ShortcutRelatedSetting: MessageFns<WorkspaceSettin
     const message = createBaseWorkspaceSetting_ShortcutRelatedSetting();
     message.defaultVisibility = object.defaultVisibility ?? Visibility.VISIBILITY_UNSPECIFIED;
     message.shortcutPrefix = object.shortcutPrefix ?? "";
+    message.defaultRedirectMode = object.defaultRedirectMode ?? RedirectMode.REDIRECT_MODE_UNSPECIFIED;
+    message.pendingMessage = object.pendingMessage ?? "";
+    message.expiredMessage = object.expiredMessage ?? "";
+    message.expiredArchiveDays = object.expiredArchiveDays ?? 0;
+    message.autoRedirectNotFound = object.autoRedirectNotFound ?? false;
+    message.namePolicy = (object.namePolicy !== undefined && object.namePolicy !== null)
+      ? ShortcutNamePolicy.fromPartial(object.namePolicy)
+      : undefined;
+    message.redirectHosts = object.redirectHosts?.map((e) => e) || [];
+    message.legacyShortcutPrefixes = object.legacyShortcutPrefixes?.map((e) => e) || [];
+    message.collectionPrefix = object.collectionPrefix ?? "";
+    message.legacyCollectionPrefixes = object.legacyCollectionPrefixes?.map((e) => e) || [];
+    message.trashRetentionDays = object.trashRetentionDays ?? 0;
     return message;
   },
 };
@@ -505,6 +696,76 @@ export const WorkspaceSetting_IdentityProviderSetting: MessageFns<WorkspaceSetti
   },
 };
 
+function createBaseShortcutNamePolicy(): ShortcutNamePolicy {
+  return { caseInsensitive: false, unicodeNormalization: false, equivalentSeparators: false };
+}
+
+export const ShortcutNamePolicy: MessageFns<ShortcutNamePolicy> = {
+  encode(message: ShortcutNamePolicy, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.caseInsensitive !== false) {
+      writer.uint32(8).bool(message.caseInsensitive);
+    }
+    if (message.unicodeNormalization !== false) {
+      writer.uint32(16).bool(message.unicodeNormalization);
+    }
+    if (message.equivalentSeparators !== false) {
+      writer.uint32(24).bool(message.equivalentSeparators);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ShortcutNamePolicy {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShortcutNamePolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.caseInsensitive = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.unicodeNormalization = reader.bool();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.equivalentSeparators = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  create(base?: DeepPartial<ShortcutNamePolicy>): ShortcutNamePolicy {
+    return ShortcutNamePolicy.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ShortcutNamePolicy>): ShortcutNamePolicy {
+    const message = createBaseShortcutNamePolicy();
+    message.caseInsensitive = object.caseInsensitive ?? false;
+    message.unicodeNormalization = object.unicodeNormalization ?? false;
+    message.equivalentSeparators = object.equivalentSeparators ?? false;
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -1,4 +1,5 @@
 import useWorkspaceStore from "@/stores/workspace";
+import { RedirectMode } from "@/types/proto/api/v1/common";
 
 export const getShortcutUrl = (shortcutName: string): string => {
   const workspaceStore = useWorkspaceStore.getState();
@@ -6,8 +7,31 @@ export const getShortcutUrl = (shortcutName: string): string => {
   return `/${prefix}/${shortcutName}`;
 };
 
+// getBaseUrl returns the instance URL of the workspace, or the current origin if it has not set one.
+const getBaseUrl = (): string => {
+  const workspaceStore = useWorkspaceStore.getState();
+  return workspaceStore.setting.instanceUrl.replace(/\/+$/, "") || `${window.location.protocol}//${window.location.host}`;
+};
+
 export const getShortcutAbsoluteUrl = (shortcutName: string): string => {
+  return `${getBaseUrl()}${getShortcutUrl(shortcutName)}`;
+};
+
+export const getCollectionUrl = (collectionName: string): string => {
   const workspaceStore = useWorkspaceStore.getState();
-  const prefix = workspaceStore.getShortcutPrefix();
-  return `${window.location.protocol}//${window.location.host}/${prefix}/${shortcutName}`;
-};
\ No newline at end of file
+  const prefix = workspaceStore.getCollectionPrefix();
+  return `/${prefix}/${collectionName}`;
+};
+
+export const getCollectionAbsoluteUrl = (collectionName: string): string => {
+  return `${getBaseUrl()}${getCollectionUrl(collectionName)}`;
+};
+
+export const redirectModeOptions: { value: RedirectMode; label: string }[] = [
+  { value: RedirectMode.FOUND, label: "Temporary (302)" },
+  { value: RedirectMode.MOVED_PERMANENTLY, label: "Permanent (301)" },
+  { value: RedirectMode.TEMPORARY_REDIRECT, label: "Temporary, keep method (307)" },
+  { value: RedirectMode.PERMANENT_REDIRECT, label: "Permanent, keep method (308)" },
+  { value: RedirectMode.META_REFRESH, label: "Meta refresh, no referrer" },
+  { value: RedirectMode.INTERSTITIAL, label: "Preview page" },
+];
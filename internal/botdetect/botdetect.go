@@ -0,0 +1,103 @@
+// Package botdetect classifies requests from known bots by their user agent.
+//
+// Signatures are read from a plain text list with one "<kind> <substring>" pair
+// per line, so the list can be updated without code changes. A default list is
+// embedded, and deployments can put their own signatures in front of it.
+package botdetect
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Kind is what a bot does with the links it fetches.
+type Kind string
+
+const (
+	// KindUnfurl is a chat or social app that fetches a link to show a preview of it.
+	KindUnfurl Kind = "unfurl"
+	// KindCrawler is a search engine or another automated client.
+	KindCrawler Kind = "crawler"
+)
+
+//go:embed signatures.txt
+var defaultSignatures string
+
+// Signature matches the user agents that contain Pattern, ignoring case.
+type Signature struct {
+	Kind    Kind
+	Pattern string
+}
+
+// Detector matches user agents against a list of signatures.
+type Detector struct {
+	signatures []*Signature
+	// patterns are the lowercased patterns of signatures.
+	patterns []string
+}
+
+// NewDetector returns a Detector for signatures, tried in order.
+func NewDetector(signatures []*Signature) *Detector {
+	detector := &Detector{
+		signatures: signatures,
+	}
+	for _, signature := range signatures {
+		detector.patterns = append(detector.patterns, strings.ToLower(signature.Pattern))
+	}
+	return detector
+}
+
+// DefaultSignatures returns the embedded signature list.
+func DefaultSignatures() []*Signature {
+	signatures, err := ParseSignatures(strings.NewReader(defaultSignatures))
+	if err != nil {
+		panic(err)
+	}
+	return signatures
+}
+
+// ParseSignatures reads a signature list. Blank lines and lines starting with # are skipped.
+func ParseSignatures(r io.Reader) ([]*Signature, error) {
+	signatures := []*Signature{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		kind, pattern, ok := strings.Cut(text, " ")
+		pattern = strings.TrimSpace(pattern)
+		if !ok || pattern == "" {
+			return nil, errors.Errorf("line %d: expected a kind and a pattern", line)
+		}
+		if Kind(kind) != KindUnfurl && Kind(kind) != KindCrawler {
+			return nil, errors.Errorf("line %d: unknown kind %q", line, kind)
+		}
+		signatures = append(signatures, &Signature{
+			Kind:    Kind(kind),
+			Pattern: pattern,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return signatures, nil
+}
+
+// Detect returns the first signature that matches userAgent, or nil for other clients.
+func (d *Detector) Detect(userAgent string) *Signature {
+	if userAgent == "" {
+		return nil
+	}
+	userAgent = strings.ToLower(userAgent)
+	for i, pattern := range d.patterns {
+		if strings.Contains(userAgent, pattern) {
+			return d.signatures[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package botdetect
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	detector := NewDetector(DefaultSignatures())
+	tests := []struct {
+		userAgent string
+		kind      Kind
+	}{
+		{"Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)", KindUnfurl},
+		{"Mozilla/5.0 (Windows NT 6.1; WOW64) SkypeUriPreview Preview/0.5 skype-url-preview@microsoft.com", KindUnfurl},
+		{"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)", KindUnfurl},
+		{"Mozilla/5.0 (compatible; Discordbot/2.0; +https://discordapp.com)", KindUnfurl},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", KindCrawler},
+		{"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)", KindCrawler},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36", ""},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Teams/1.6.00.4472 Chrome/98.0 Electron/17.3.1 Safari/537.36", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		signature := detector.Detect(test.userAgent)
+		if test.kind == "" {
+			require.Nil(t, signature, test.userAgent)
+			continue
+		}
+		require.NotNil(t, signature, test.userAgent)
+		require.Equal(t, test.kind, signature.Kind, test.userAgent)
+	}
+}
+
+func TestParseSignatures(t *testing.T) {
+	signatures, err := ParseSignatures(strings.NewReader("# Internal tools.\n\nunfurl Acme Preview\ncrawler acme-indexer\n"))
+	require.NoError(t, err)
+	require.Equal(t, []*Signature{
+		{Kind: KindUnfurl, Pattern: "Acme Preview"},
+		{Kind: KindCrawler, Pattern: "acme-indexer"},
+	}, signatures)
+
+	_, err = ParseSignatures(strings.NewReader("robot acme\n"))
+	require.Error(t, err)
+	_, err = ParseSignatures(strings.NewReader("unfurl\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,251 @@
+// Package linktemplate implements go-link style templates such as
+// "https://jira.example.com/browse/{1}" or "https://github.com/{org=acme}/{repo}".
+//
+// Placeholders are written as {key} or {key=default}. Numeric keys refer to the
+// extra path segments after the shortcut name (1-based). Named keys are filled
+// from query parameters of the same name, or else from the path segments that
+// no numeric placeholder claims, in template order.
+package linktemplate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// Placeholder is a single {key} or {key=default} in a template.
+type Placeholder struct {
+	Key        string
+	Default    string
+	HasDefault bool
+	// Position is the 1-based path segment for numeric keys, or 0 for named keys.
+	Position int
+	// InQuery reports whether the placeholder appears after the "?" of the template.
+	InQuery bool
+
+	start, end int
+}
+
+// Required reports whether the placeholder must be given a value.
+func (p *Placeholder) Required() bool {
+	return !p.HasDefault
+}
+
+// Template is a parsed link template.
+type Template struct {
+	raw          string
+	Placeholders []*Placeholder
+}
+
+// MissingArgumentsError is returned by Expand when required placeholders have no value.
+type MissingArgumentsError struct {
+	Missing []*Placeholder
+}
+
+func (e *MissingArgumentsError) Error() string {
+	keys := []string{}
+	for _, placeholder := range e.Missing {
+		keys = append(keys, placeholder.Key)
+	}
+	return fmt.Sprintf("missing arguments: %s", strings.Join(keys, ", "))
+}
+
+// TooManyArgumentsError is returned by Expand when more path segments are given than the template can use.
+type TooManyArgumentsError struct {
+	Extra []string
+}
+
+func (e *TooManyArgumentsError) Error() string {
+	return fmt.Sprintf("unexpected arguments: %s", strings.Join(e.Extra, "/"))
+}
+
+// Parse parses a link template.
+func Parse(raw string) (*Template, error) {
+	template := &Template{raw: raw}
+	queryStart := strings.Index(raw, "?")
+	for i := 0; i < len(raw); i++ {
+		switch raw[i] {
+		case '}':
+			return nil, errors.Errorf("unexpected '}' at offset %d", i)
+		case '{':
+			end := strings.IndexByte(raw[i:], '}')
+			if end < 0 {
+				return nil, errors.Errorf("unclosed '{' at offset %d", i)
+			}
+			end += i
+			body := raw[i+1 : end]
+			if strings.ContainsRune(body, '{') {
+				return nil, errors.Errorf("nested '{' at offset %d", i)
+			}
+			placeholder := &Placeholder{
+				start:   i,
+				end:     end + 1,
+				InQuery: queryStart >= 0 && i > queryStart,
+			}
+			key, defaultValue, hasDefault := strings.Cut(body, "=")
+			placeholder.Key, placeholder.Default, placeholder.HasDefault = strings.TrimSpace(key), defaultValue, hasDefault
+			if position, err := strconv.Atoi(placeholder.Key); err == nil {
+				if position < 1 {
+					return nil, errors.Errorf("positional placeholder {%s} must start from 1", placeholder.Key)
+				}
+				placeholder.Position = position
+			} else if !keyPattern.MatchString(placeholder.Key) {
+				return nil, errors.Errorf("invalid placeholder name %q", placeholder.Key)
+			}
+			for _, existing := range template.Placeholders {
+				if existing.Key == placeholder.Key && (existing.HasDefault != placeholder.HasDefault || existing.Default != placeholder.Default) {
+					return nil, errors.Errorf("placeholder {%s} is declared with different defaults", placeholder.Key)
+				}
+			}
+			template.Placeholders = append(template.Placeholders, placeholder)
+			i = end
+		}
+	}
+	return template, nil
+}
+
+// Validate checks that raw is a usable template: it must parse, contain at least
+// one placeholder, and expand to an absolute URL when every placeholder is filled.
+func Validate(raw string) error {
+	template, err := Parse(raw)
+	if err != nil {
+		return err
+	}
+	if len(template.Placeholders) == 0 {
+		return errors.New("template has no placeholders")
+	}
+	sample := map[string]string{}
+	for _, placeholder := range template.Placeholders {
+		sample[placeholder.Key] = "x"
+	}
+	link := template.render(sample)
+	u, err := url.Parse(link)
+	if err != nil {
+		return errors.Wrap(err, "template does not expand to a valid URL")
+	}
+	if u.Scheme == "" {
+		return errors.New("template must expand to an absolute URL")
+	}
+	return nil
+}
+
+// Keys returns the distinct placeholder keys in template order.
+func (t *Template) Keys() []*Placeholder {
+	seen := map[string]bool{}
+	keys := []*Placeholder{}
+	for _, placeholder := range t.Placeholders {
+		if seen[placeholder.Key] {
+			continue
+		}
+		seen[placeholder.Key] = true
+		keys = append(keys, placeholder)
+	}
+	return keys
+}
+
+// Expand fills the template with path segments and query parameters. It returns the
+// expanded link and the query parameters that were not consumed by a placeholder.
+func (t *Template) Expand(segments []string, query url.Values) (string, url.Values, error) {
+	values := map[string]string{}
+	remainingQuery := url.Values{}
+	for key, list := range query {
+		remainingQuery[key] = list
+	}
+
+	// Numeric placeholders claim their path segment directly.
+	claimed := map[int]bool{}
+	for _, placeholder := range t.Keys() {
+		if placeholder.Position == 0 {
+			continue
+		}
+		claimed[placeholder.Position] = true
+		if placeholder.Position <= len(segments) {
+			values[placeholder.Key] = segments[placeholder.Position-1]
+		} else if v := query.Get(placeholder.Key); v != "" {
+			values[placeholder.Key] = v
+			remainingQuery.Del(placeholder.Key)
+		}
+	}
+	unclaimed := []string{}
+	for i, segment := range segments {
+		if !claimed[i+1] {
+			unclaimed = append(unclaimed, segment)
+		}
+	}
+
+	// Named placeholders prefer query parameters, then take unclaimed segments.
+	named := []*Placeholder{}
+	for _, placeholder := range t.Keys() {
+		if placeholder.Position != 0 {
+			continue
+		}
+		if v := query.Get(placeholder.Key); v != "" {
+			values[placeholder.Key] = v
+			remainingQuery.Del(placeholder.Key)
+			continue
+		}
+		named = append(named, placeholder)
+	}
+	required := 0
+	for _, placeholder := range named {
+		if placeholder.Required() {
+			required++
+		}
+	}
+	// Optional placeholders only take a segment once every required one is served.
+	spare := len(unclaimed) - required
+	for _, placeholder := range named {
+		if len(unclaimed) == 0 {
+			break
+		}
+		if !placeholder.Required() {
+			if spare <= 0 {
+				continue
+			}
+			spare--
+		}
+		values[placeholder.Key], unclaimed = unclaimed[0], unclaimed[1:]
+	}
+	if len(unclaimed) > 0 {
+		return "", nil, &TooManyArgumentsError{Extra: unclaimed}
+	}
+
+	missing := []*Placeholder{}
+	for _, placeholder := range t.Keys() {
+		if _, ok := values[placeholder.Key]; ok {
+			continue
+		}
+		if placeholder.HasDefault {
+			values[placeholder.Key] = placeholder.Default
+			continue
+		}
+		missing = append(missing, placeholder)
+	}
+	if len(missing) > 0 {
+		return "", nil, &MissingArgumentsError{Missing: missing}
+	}
+	return t.render(values), remainingQuery, nil
+}
+
+func (t *Template) render(values map[string]string) string {
+	var sb strings.Builder
+	last := 0
+	for _, placeholder := range t.Placeholders {
+		sb.WriteString(t.raw[last:placeholder.start])
+		value := values[placeholder.Key]
+		if placeholder.InQuery {
+			sb.WriteString(url.QueryEscape(value))
+		} else {
+			sb.WriteString(url.PathEscape(value))
+		}
+		last = placeholder.end
+	}
+	sb.WriteString(t.raw[last:])
+	return sb.String()
+}
@@ -0,0 +1,101 @@
+package linktemplate
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  string
+		segments  []string
+		query     url.Values
+		want      string
+		wantQuery url.Values
+	}{
+		{
+			name:      "positional",
+			template:  "https://jira.example.com/browse/{1}",
+			segments:  []string{"OPS-123"},
+			want:      "https://jira.example.com/browse/OPS-123",
+			wantQuery: url.Values{},
+		},
+		{
+			name:      "named with default",
+			template:  "https://github.com/{org=acme}/{repo}",
+			segments:  []string{"monotreme"},
+			want:      "https://github.com/acme/monotreme",
+			wantQuery: url.Values{},
+		},
+		{
+			name:      "named overrides default when enough segments",
+			template:  "https://github.com/{org=acme}/{repo}",
+			segments:  []string{"bshort", "monotreme"},
+			want:      "https://github.com/bshort/monotreme",
+			wantQuery: url.Values{},
+		},
+		{
+			name:      "named from query",
+			template:  "https://github.com/{org=acme}/{repo}",
+			query:     url.Values{"repo": {"monotreme"}, "tab": {"issues"}},
+			want:      "https://github.com/acme/monotreme",
+			wantQuery: url.Values{"tab": {"issues"}},
+		},
+		{
+			name:      "escaping",
+			template:  "https://search.example.com/{1}?q={q}",
+			segments:  []string{"a b"},
+			query:     url.Values{"q": {"x&y"}},
+			want:      "https://search.example.com/a%20b?q=x%26y",
+			wantQuery: url.Values{},
+		},
+		{
+			name:      "repeated placeholder",
+			template:  "https://example.com/{1}/{1}",
+			segments:  []string{"a"},
+			want:      "https://example.com/a/a",
+			wantQuery: url.Values{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := Parse(tt.template)
+			require.NoError(t, err)
+			got, remaining, err := template.Expand(tt.segments, tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantQuery, remaining)
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	template, err := Parse("https://github.com/{org=acme}/{repo}")
+	require.NoError(t, err)
+
+	_, _, err = template.Expand(nil, nil)
+	missingErr := &MissingArgumentsError{}
+	require.ErrorAs(t, err, &missingErr)
+	require.Len(t, missingErr.Missing, 1)
+	require.Equal(t, "repo", missingErr.Missing[0].Key)
+
+	_, _, err = template.Expand([]string{"a", "b", "c"}, nil)
+	tooManyErr := &TooManyArgumentsError{}
+	require.ErrorAs(t, err, &tooManyErr)
+	require.Equal(t, []string{"c"}, tooManyErr.Extra)
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate("https://jira.example.com/browse/{1}"))
+	require.NoError(t, Validate("https://github.com/{org=acme}/{repo}"))
+	require.Error(t, Validate("https://example.com/"))
+	require.Error(t, Validate("https://example.com/{1"))
+	require.Error(t, Validate("https://example.com/1}"))
+	require.Error(t, Validate("https://example.com/{0}"))
+	require.Error(t, Validate("https://example.com/{bad key}"))
+	require.Error(t, Validate("{1}"))
+	require.Error(t, Validate("https://example.com/{a=1}/{a=2}"))
+}
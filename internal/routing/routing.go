@@ -0,0 +1,245 @@
+// Package routing evaluates the conditional routing rules of a shortcut against
+// an incoming request.
+//
+// Requests are reduced to a few normalized properties (device, OS, browser and
+// preferred language) so that rules can be written without knowing how a user
+// agent string spells them. Rules are tried in order and the first one whose
+// conditions all match wins.
+package routing
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mssola/useragent"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+const (
+	DeviceMobile  = "mobile"
+	DeviceTablet  = "tablet"
+	DeviceDesktop = "desktop"
+	DeviceBot     = "bot"
+
+	OSIOS      = "ios"
+	OSAndroid  = "android"
+	OSWindows  = "windows"
+	OSMacOS    = "macos"
+	OSLinux    = "linux"
+	OSChromeOS = "chromeos"
+)
+
+// Devices lists the values a DEVICE condition can take.
+var Devices = []string{DeviceMobile, DeviceTablet, DeviceDesktop, DeviceBot}
+
+// OperatingSystems lists the values an OS condition can take.
+var OperatingSystems = []string{OSIOS, OSAndroid, OSWindows, OSMacOS, OSLinux, OSChromeOS}
+
+// Request holds the properties of a request that rules are matched against.
+type Request struct {
+	Device   string
+	OS       string
+	Browser  string
+	Language string
+	Header   http.Header
+}
+
+// NewRequest derives the routing properties of the given headers.
+func NewRequest(header http.Header) *Request {
+	ua := useragent.New(header.Get("User-Agent"))
+	browser, _ := ua.Browser()
+	return &Request{
+		Device:   device(ua),
+		OS:       operatingSystem(ua),
+		Browser:  strings.ToLower(browser),
+		Language: preferredLanguage(header.Get("Accept-Language")),
+		Header:   header,
+	}
+}
+
+// Match returns the index of the first rule that matches the request, or -1 if none does.
+func Match(rules []*storepb.ShortcutRoutingRule, request *Request) int {
+	for i, rule := range rules {
+		if matchRule(rule, request) {
+			return i
+		}
+	}
+	return -1
+}
+
+func matchRule(rule *storepb.ShortcutRoutingRule, request *Request) bool {
+	if len(rule.Conditions) == 0 {
+		return false
+	}
+	for _, condition := range rule.Conditions {
+		if !matchCondition(condition, request) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchCondition(condition *storepb.ShortcutRoutingCondition, request *Request) bool {
+	switch condition.Field {
+	case storepb.ShortcutRoutingCondition_DEVICE:
+		return containsFold(condition.Values, request.Device)
+	case storepb.ShortcutRoutingCondition_OS:
+		return containsFold(condition.Values, request.OS)
+	case storepb.ShortcutRoutingCondition_BROWSER:
+		return containsFold(condition.Values, request.Browser)
+	case storepb.ShortcutRoutingCondition_LANGUAGE:
+		for _, value := range condition.Values {
+			if matchLanguage(value, request.Language) {
+				return true
+			}
+		}
+		return false
+	case storepb.ShortcutRoutingCondition_HEADER:
+		values, ok := request.Header[http.CanonicalHeaderKey(condition.Header)]
+		if !ok {
+			return false
+		}
+		if len(condition.Values) == 0 {
+			return true
+		}
+		for _, value := range values {
+			if containsFold(condition.Values, strings.TrimSpace(value)) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Validate checks that every rule has a link and well-formed conditions.
+func Validate(rules []*storepb.ShortcutRoutingRule) error {
+	for i, rule := range rules {
+		if rule.Link == "" {
+			return errors.Errorf("routing rule %d: link is required", i+1)
+		}
+		if len(rule.Conditions) == 0 {
+			return errors.Errorf("routing rule %d: at least one condition is required", i+1)
+		}
+		for _, condition := range rule.Conditions {
+			if err := validateCondition(condition); err != nil {
+				return errors.Wrapf(err, "routing rule %d", i+1)
+			}
+		}
+	}
+	return nil
+}
+
+func validateCondition(condition *storepb.ShortcutRoutingCondition) error {
+	switch condition.Field {
+	case storepb.ShortcutRoutingCondition_DEVICE:
+		return validateValues(condition.Values, Devices)
+	case storepb.ShortcutRoutingCondition_OS:
+		return validateValues(condition.Values, OperatingSystems)
+	case storepb.ShortcutRoutingCondition_BROWSER, storepb.ShortcutRoutingCondition_LANGUAGE:
+		return validateValues(condition.Values, nil)
+	case storepb.ShortcutRoutingCondition_HEADER:
+		if strings.TrimSpace(condition.Header) == "" {
+			return errors.New("header name is required")
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown condition field %q", condition.Field)
+	}
+}
+
+func validateValues(values, allowed []string) error {
+	if len(values) == 0 {
+		return errors.New("at least one value is required")
+	}
+	for _, value := range values {
+		if strings.TrimSpace(value) == "" {
+			return errors.New("values must not be empty")
+		}
+		if allowed != nil && !containsFold(allowed, value) {
+			return errors.Errorf("unknown value %q, expected one of %s", value, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+func device(ua *useragent.UserAgent) string {
+	switch {
+	case ua.Bot():
+		return DeviceBot
+	case ua.Platform() == "iPad":
+		return DeviceTablet
+	case ua.OSInfo().Name == "Android" && !strings.Contains(ua.UA(), "Mobile"):
+		return DeviceTablet
+	case ua.Mobile():
+		return DeviceMobile
+	default:
+		return DeviceDesktop
+	}
+}
+
+func operatingSystem(ua *useragent.UserAgent) string {
+	name := ua.OSInfo().Name
+	switch {
+	case ua.Platform() == "iPhone" || ua.Platform() == "iPad" || ua.Platform() == "iPod" || name == "iPhone OS":
+		return OSIOS
+	case name == "Android":
+		return OSAndroid
+	case strings.HasPrefix(name, "Windows"):
+		return OSWindows
+	case name == "Mac OS X":
+		return OSMacOS
+	case name == "CrOS" || strings.HasPrefix(name, "Chrome OS"):
+		return OSChromeOS
+	case name == "Linux" || strings.HasPrefix(name, "Linux"):
+		return OSLinux
+	default:
+		return strings.ToLower(name)
+	}
+}
+
+// preferredLanguage returns the lower-cased language tag with the highest quality in an
+// Accept-Language header, or an empty string if there is none.
+func preferredLanguage(acceptLanguage string) string {
+	language, quality := "", 0.0
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > quality {
+			language, quality = strings.ToLower(tag), q
+		}
+	}
+	return language
+}
+
+// matchLanguage reports whether the language tag matches the value, either exactly or
+// because the value is a prefix of it, so that "de" matches "de-at" but "de-at" does not match "de".
+func matchLanguage(value, language string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if language == "" || value == "" {
+		return false
+	}
+	return language == value || strings.HasPrefix(language, value+"-")
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(strings.TrimSpace(v), value)
+	})
+}
@@ -0,0 +1,133 @@
+package routing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+const (
+	iPhoneUserAgent  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+	iPadUserAgent    = "Mozilla/5.0 (iPad; CPU OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1"
+	androidUserAgent = "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Mobile Safari/537.36"
+	windowsUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
+	macUserAgent     = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Safari/605.1.15"
+	botUserAgent     = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+)
+
+func TestNewRequest(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		device    string
+		os        string
+	}{
+		{userAgent: iPhoneUserAgent, device: DeviceMobile, os: OSIOS},
+		{userAgent: iPadUserAgent, device: DeviceTablet, os: OSIOS},
+		{userAgent: androidUserAgent, device: DeviceMobile, os: OSAndroid},
+		{userAgent: windowsUserAgent, device: DeviceDesktop, os: OSWindows},
+		{userAgent: macUserAgent, device: DeviceDesktop, os: OSMacOS},
+		{userAgent: botUserAgent, device: DeviceBot},
+	}
+	for _, test := range tests {
+		request := NewRequest(http.Header{"User-Agent": []string{test.userAgent}})
+		require.Equal(t, test.device, request.Device, test.userAgent)
+		if test.os != "" {
+			require.Equal(t, test.os, request.OS, test.userAgent)
+		}
+	}
+}
+
+func TestPreferredLanguage(t *testing.T) {
+	require.Equal(t, "", preferredLanguage(""))
+	require.Equal(t, "de-at", preferredLanguage("de-AT,de;q=0.9,en;q=0.8"))
+	require.Equal(t, "fr", preferredLanguage("en;q=0.5, fr"))
+	require.Equal(t, "en", preferredLanguage("*;q=0.1, en;q=0.5"))
+}
+
+func TestMatch(t *testing.T) {
+	rules := []*storepb.ShortcutRoutingRule{
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{
+				{Field: storepb.ShortcutRoutingCondition_OS, Values: []string{"ios"}},
+				{Field: storepb.ShortcutRoutingCondition_LANGUAGE, Values: []string{"de"}},
+			},
+			Link: "https://apps.apple.com/de/app",
+		},
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{
+				{Field: storepb.ShortcutRoutingCondition_DEVICE, Values: []string{"mobile", "tablet"}},
+			},
+			Link: "https://m.example.com",
+		},
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{
+				{Field: storepb.ShortcutRoutingCondition_HEADER, Header: "x-beta", Values: []string{"on"}},
+			},
+			Link: "https://beta.example.com",
+		},
+	}
+	tests := []struct {
+		name   string
+		header http.Header
+		want   int
+	}{
+		{
+			name:   "all conditions match",
+			header: http.Header{"User-Agent": []string{iPhoneUserAgent}, "Accept-Language": []string{"de-DE,en;q=0.5"}},
+			want:   0,
+		},
+		{
+			name:   "falls through to a later rule",
+			header: http.Header{"User-Agent": []string{iPhoneUserAgent}, "Accept-Language": []string{"en-US"}},
+			want:   1,
+		},
+		{
+			name:   "header value",
+			header: http.Header{"User-Agent": []string{windowsUserAgent}, "X-Beta": []string{"ON"}},
+			want:   2,
+		},
+		{
+			name:   "no match",
+			header: http.Header{"User-Agent": []string{windowsUserAgent}, "X-Beta": []string{"off"}},
+			want:   -1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, Match(rules, NewRequest(test.header)))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(nil))
+	require.Error(t, Validate([]*storepb.ShortcutRoutingRule{
+		{Link: "https://example.com"},
+	}))
+	require.Error(t, Validate([]*storepb.ShortcutRoutingRule{
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{{Field: storepb.ShortcutRoutingCondition_OS, Values: []string{"ios"}}},
+		},
+	}))
+	require.Error(t, Validate([]*storepb.ShortcutRoutingRule{
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{{Field: storepb.ShortcutRoutingCondition_DEVICE, Values: []string{"watch"}}},
+			Link:       "https://example.com",
+		},
+	}))
+	require.Error(t, Validate([]*storepb.ShortcutRoutingRule{
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{{Field: storepb.ShortcutRoutingCondition_HEADER}},
+			Link:       "https://example.com",
+		},
+	}))
+	require.NoError(t, Validate([]*storepb.ShortcutRoutingRule{
+		{
+			Conditions: []*storepb.ShortcutRoutingCondition{{Field: storepb.ShortcutRoutingCondition_HEADER, Header: "X-Beta"}},
+			Link:       "https://example.com",
+		},
+	}))
+}
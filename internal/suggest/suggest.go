@@ -0,0 +1,140 @@
+// Package suggest ranks existing shortcuts by how likely they are to be the one
+// a visitor meant when they typed an unknown name.
+//
+// Candidates are compared by edit distance (counting a swap of two adjacent
+// characters as a single edit), by whether one name is a prefix of the other and
+// by how many of their tags appear as words of the unknown name.
+package suggest
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+type Suggestion struct {
+	Shortcut *storepb.Shortcut
+	// Distance is the edit distance between the unknown name and the shortcut name.
+	Distance int
+	// Close reports whether the names differ by no more than a typo or two.
+	Close bool
+
+	cost int
+}
+
+// Rank returns the shortcuts related to name, best first. Shortcuts that are neither
+// close, a prefix match nor share a tag with the words of name are left out.
+func Rank(name string, shortcuts []*storepb.Shortcut) []*Suggestion {
+	name = strings.ToLower(name)
+	words := splitWords(name)
+	maxDistance := maxDistance(name)
+
+	suggestions := []*Suggestion{}
+	for _, shortcut := range shortcuts {
+		candidate := strings.ToLower(shortcut.Name)
+		if candidate == name {
+			continue
+		}
+		distance := Distance(name, candidate)
+		prefix := strings.HasPrefix(candidate, name) || strings.HasPrefix(name, candidate)
+		sharedTags := 0
+		for _, tag := range shortcut.Tags {
+			if tag != "" && slices.Contains(words, strings.ToLower(tag)) {
+				sharedTags++
+			}
+		}
+		close := distance <= maxDistance
+		if !close && !prefix && sharedTags == 0 {
+			continue
+		}
+
+		cost := distance * 2
+		if prefix {
+			cost -= 3
+		}
+		cost -= sharedTags * 2
+		suggestions = append(suggestions, &Suggestion{
+			Shortcut: shortcut,
+			Distance: distance,
+			Close:    close,
+			cost:     cost,
+		})
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b *Suggestion) int {
+		if a.cost != b.cost {
+			return a.cost - b.cost
+		}
+		if a.Distance != b.Distance {
+			return a.Distance - b.Distance
+		}
+		return strings.Compare(a.Shortcut.Name, b.Shortcut.Name)
+	})
+	return suggestions
+}
+
+// OnlyClose returns the close suggestion if there is exactly one, or nil.
+func OnlyClose(suggestions []*Suggestion) *Suggestion {
+	var only *Suggestion
+	for _, suggestion := range suggestions {
+		if !suggestion.Close {
+			continue
+		}
+		if only != nil {
+			return nil
+		}
+		only = suggestion
+	}
+	return only
+}
+
+// Distance returns the optimal string alignment distance between a and b: the number
+// of insertions, deletions, substitutions and adjacent transpositions of runes needed
+// to turn one into the other.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// Three rolling rows are enough, as a transposition looks two rows back.
+	previous2 := make([]int, len(rb)+1)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				current[j] = min(current[j], previous2[j-2]+1)
+			}
+		}
+		previous2, previous, current = previous, current, previous2
+	}
+	return previous[len(rb)]
+}
+
+// maxDistance is the largest edit distance at which a name still counts as close.
+// Short names allow fewer edits so that "go" does not match every two-letter name.
+func maxDistance(name string) int {
+	length := len([]rune(name))
+	switch {
+	case length <= 3:
+		return 1
+	case length <= 8:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func splitWords(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
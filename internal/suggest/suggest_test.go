@@ -0,0 +1,53 @@
+package suggest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+func TestDistance(t *testing.T) {
+	require.Equal(t, 0, Distance("jira", "jira"))
+	require.Equal(t, 1, Distance("jria", "jira"))
+	require.Equal(t, 1, Distance("jir", "jira"))
+	require.Equal(t, 1, Distance("gihtub", "github"))
+	require.Equal(t, 2, Distance("gitlab", "github"))
+	require.Equal(t, 4, Distance("", "docs"))
+	require.Equal(t, 1, Distance("café", "cafe"))
+}
+
+func TestRank(t *testing.T) {
+	shortcuts := []*storepb.Shortcut{
+		{Name: "jira"},
+		{Name: "jira-board"},
+		{Name: "wiki", Tags: []string{"docs"}},
+		{Name: "github"},
+		{Name: "mail"},
+	}
+
+	suggestions := Rank("jir", shortcuts)
+	require.Equal(t, 2, len(suggestions))
+	require.Equal(t, "jira", suggestions[0].Shortcut.Name)
+	require.True(t, suggestions[0].Close)
+	require.Equal(t, "jira-board", suggestions[1].Shortcut.Name)
+	require.False(t, suggestions[1].Close)
+	require.Equal(t, "jira", OnlyClose(suggestions).Shortcut.Name)
+
+	suggestions = Rank("team-docs", shortcuts)
+	require.Equal(t, 1, len(suggestions))
+	require.Equal(t, "wiki", suggestions[0].Shortcut.Name)
+	require.Nil(t, OnlyClose(suggestions))
+
+	suggestions = Rank("JIRA-BOARDS", shortcuts)
+	require.Equal(t, "jira-board", suggestions[0].Shortcut.Name)
+
+	require.Empty(t, Rank("unrelated", shortcuts))
+}
+
+func TestOnlyClose(t *testing.T) {
+	suggestions := Rank("mai", []*storepb.Shortcut{{Name: "mail"}, {Name: "main"}})
+	require.Equal(t, 2, len(suggestions))
+	require.Nil(t, OnlyClose(suggestions))
+}
@@ -34,17 +34,31 @@ const (
 	ActivityType_SHORTCUT_VIEWED           ActivityType = 3
 	ActivityType_COLLECTION_CREATED        ActivityType = 4
 	ActivityType_COLLECTION_VIEWED         ActivityType = 5
+	ActivityType_SHORTCUT_LINK_CHANGED     ActivityType = 6
+	ActivityType_SHORTCUT_FAILED_OVER      ActivityType = 7
+	// A shortcut fetched by a known bot, which is not counted as a view.
+	ActivityType_SHORTCUT_BOT_VIEWED ActivityType = 8
+	// An edit of a protected shortcut submitted for approval.
+	ActivityType_SHORTCUT_CHANGE_REQUESTED ActivityType = 9
+	ActivityType_SHORTCUT_CHANGE_APPROVED  ActivityType = 10
+	ActivityType_SHORTCUT_CHANGE_REJECTED  ActivityType = 11
 )
 
 // Enum value maps for ActivityType.
 var (
 	ActivityType_name = map[int32]string{
-		0: "ACTIVITY_TYPE_UNSPECIFIED",
-		1: "USER_CREATED",
-		2: "SHORTCUT_CREATED",
-		3: "SHORTCUT_VIEWED",
-		4: "COLLECTION_CREATED",
-		5: "COLLECTION_VIEWED",
+		0:  "ACTIVITY_TYPE_UNSPECIFIED",
+		1:  "USER_CREATED",
+		2:  "SHORTCUT_CREATED",
+		3:  "SHORTCUT_VIEWED",
+		4:  "COLLECTION_CREATED",
+		5:  "COLLECTION_VIEWED",
+		6:  "SHORTCUT_LINK_CHANGED",
+		7:  "SHORTCUT_FAILED_OVER",
+		8:  "SHORTCUT_BOT_VIEWED",
+		9:  "SHORTCUT_CHANGE_REQUESTED",
+		10: "SHORTCUT_CHANGE_APPROVED",
+		11: "SHORTCUT_CHANGE_REJECTED",
 	}
 	ActivityType_value = map[string]int32{
 		"ACTIVITY_TYPE_UNSPECIFIED": 0,
@@ -53,6 +67,12 @@ var (
 		"SHORTCUT_VIEWED":           3,
 		"COLLECTION_CREATED":        4,
 		"COLLECTION_VIEWED":         5,
+		"SHORTCUT_LINK_CHANGED":     6,
+		"SHORTCUT_FAILED_OVER":      7,
+		"SHORTCUT_BOT_VIEWED":       8,
+		"SHORTCUT_CHANGE_REQUESTED": 9,
+		"SHORTCUT_CHANGE_APPROVED":  10,
+		"SHORTCUT_CHANGE_REJECTED":  11,
 	}
 )
 
@@ -1008,6 +1028,9 @@ type ActivityItem struct {
 	//	*ActivityItem_ShortcutViewed
 	//	*ActivityItem_CollectionCreated
 	//	*ActivityItem_CollectionViewed
+	//	*ActivityItem_ShortcutLinkChanged
+	//	*ActivityItem_ShortcutFailedOver
+	//	*ActivityItem_ShortcutChangeRequest
 	Data          isActivityItem_Data `protobuf_oneof:"data"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1130,6 +1153,33 @@ func (x *ActivityItem) GetCollectionViewed() *CollectionViewedData {
 	return nil
 }
 
+func (x *ActivityItem) GetShortcutLinkChanged() *ShortcutLinkChangedData {
+	if x != nil {
+		if x, ok := x.Data.(*ActivityItem_ShortcutLinkChanged); ok {
+			return x.ShortcutLinkChanged
+		}
+	}
+	return nil
+}
+
+func (x *ActivityItem) GetShortcutFailedOver() *ShortcutFailedOverData {
+	if x != nil {
+		if x, ok := x.Data.(*ActivityItem_ShortcutFailedOver); ok {
+			return x.ShortcutFailedOver
+		}
+	}
+	return nil
+}
+
+func (x *ActivityItem) GetShortcutChangeRequest() *ShortcutChangeRequestData {
+	if x != nil {
+		if x, ok := x.Data.(*ActivityItem_ShortcutChangeRequest); ok {
+			return x.ShortcutChangeRequest
+		}
+	}
+	return nil
+}
+
 type isActivityItem_Data interface {
 	isActivityItem_Data()
 }
@@ -1154,6 +1204,18 @@ type ActivityItem_CollectionViewed struct {
 	CollectionViewed *CollectionViewedData `protobuf:"bytes,14,opt,name=collection_viewed,json=collectionViewed,proto3,oneof"`
 }
 
+type ActivityItem_ShortcutLinkChanged struct {
+	ShortcutLinkChanged *ShortcutLinkChangedData `protobuf:"bytes,15,opt,name=shortcut_link_changed,json=shortcutLinkChanged,proto3,oneof"`
+}
+
+type ActivityItem_ShortcutFailedOver struct {
+	ShortcutFailedOver *ShortcutFailedOverData `protobuf:"bytes,16,opt,name=shortcut_failed_over,json=shortcutFailedOver,proto3,oneof"`
+}
+
+type ActivityItem_ShortcutChangeRequest struct {
+	ShortcutChangeRequest *ShortcutChangeRequestData `protobuf:"bytes,17,opt,name=shortcut_change_request,json=shortcutChangeRequest,proto3,oneof"`
+}
+
 func (*ActivityItem_UserCreated) isActivityItem_Data() {}
 
 func (*ActivityItem_ShortcutCreated) isActivityItem_Data() {}
@@ -1164,6 +1226,12 @@ func (*ActivityItem_CollectionCreated) isActivityItem_Data() {}
 
 func (*ActivityItem_CollectionViewed) isActivityItem_Data() {}
 
+func (*ActivityItem_ShortcutLinkChanged) isActivityItem_Data() {}
+
+func (*ActivityItem_ShortcutFailedOver) isActivityItem_Data() {}
+
+func (*ActivityItem_ShortcutChangeRequest) isActivityItem_Data() {}
+
 type UserCreatedData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1301,12 +1369,14 @@ func (x *ShortcutCreatedData) GetLink() string {
 }
 
 type ShortcutViewedData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Referer       string                 `protobuf:"bytes,5,opt,name=referer,proto3" json:"referer,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title      string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	UserAgent  string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Referer    string                 `protobuf:"bytes,5,opt,name=referer,proto3" json:"referer,omitempty"`
+	// The bot signature that matched the user agent, for bot views.
+	Bot           string `protobuf:"bytes,6,opt,name=bot,proto3" json:"bot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1376,6 +1446,253 @@ func (x *ShortcutViewedData) GetReferer() string {
 	return ""
 }
 
+func (x *ShortcutViewedData) GetBot() string {
+	if x != nil {
+		return x.Bot
+	}
+	return ""
+}
+
+type ShortcutLinkChangedData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	PreviousLink  string                 `protobuf:"bytes,4,opt,name=previous_link,json=previousLink,proto3" json:"previous_link,omitempty"`
+	Link          string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutLinkChangedData) Reset() {
+	*x = ShortcutLinkChangedData{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutLinkChangedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutLinkChangedData) ProtoMessage() {}
+
+func (x *ShortcutLinkChangedData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutLinkChangedData.ProtoReflect.Descriptor instead.
+func (*ShortcutLinkChangedData) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ShortcutLinkChangedData) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutLinkChangedData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutLinkChangedData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutLinkChangedData) GetPreviousLink() string {
+	if x != nil {
+		return x.PreviousLink
+	}
+	return ""
+}
+
+func (x *ShortcutLinkChangedData) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type ShortcutFailedOverData struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId   int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title        string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	PreviousLink string                 `protobuf:"bytes,4,opt,name=previous_link,json=previousLink,proto3" json:"previous_link,omitempty"`
+	Link         string                 `protobuf:"bytes,5,opt,name=link,proto3" json:"link,omitempty"`
+	// Whether the link now served is a backup rather than the primary link.
+	Backup        bool `protobuf:"varint,6,opt,name=backup,proto3" json:"backup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutFailedOverData) Reset() {
+	*x = ShortcutFailedOverData{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutFailedOverData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutFailedOverData) ProtoMessage() {}
+
+func (x *ShortcutFailedOverData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutFailedOverData.ProtoReflect.Descriptor instead.
+func (*ShortcutFailedOverData) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShortcutFailedOverData) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutFailedOverData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutFailedOverData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutFailedOverData) GetPreviousLink() string {
+	if x != nil {
+		return x.PreviousLink
+	}
+	return ""
+}
+
+func (x *ShortcutFailedOverData) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ShortcutFailedOverData) GetBackup() bool {
+	if x != nil {
+		return x.Backup
+	}
+	return false
+}
+
+// ShortcutChangeRequestData is the data of a change request of a protected shortcut being
+// requested, approved or rejected.
+type ShortcutChangeRequestData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId      int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ChangeRequestId int32                  `protobuf:"varint,4,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	// The fields changed by the request, named as in the UpdateShortcut update mask.
+	Fields        []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutChangeRequestData) Reset() {
+	*x = ShortcutChangeRequestData{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutChangeRequestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutChangeRequestData) ProtoMessage() {}
+
+func (x *ShortcutChangeRequestData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutChangeRequestData.ProtoReflect.Descriptor instead.
+func (*ShortcutChangeRequestData) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShortcutChangeRequestData) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutChangeRequestData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutChangeRequestData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutChangeRequestData) GetChangeRequestId() int32 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
+func (x *ShortcutChangeRequestData) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type CollectionCreatedData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CollectionId  int32                  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
@@ -1388,7 +1705,7 @@ type CollectionCreatedData struct {
 
 func (x *CollectionCreatedData) Reset() {
 	*x = CollectionCreatedData{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[15]
+	mi := &file_api_v1_activity_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +1717,7 @@ func (x *CollectionCreatedData) String() string {
 func (*CollectionCreatedData) ProtoMessage() {}
 
 func (x *CollectionCreatedData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[15]
+	mi := &file_api_v1_activity_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +1730,7 @@ func (x *CollectionCreatedData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectionCreatedData.ProtoReflect.Descriptor instead.
 func (*CollectionCreatedData) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CollectionCreatedData) GetCollectionId() int32 {
@@ -1455,7 +1772,7 @@ type CollectionViewedData struct {
 
 func (x *CollectionViewedData) Reset() {
 	*x = CollectionViewedData{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[16]
+	mi := &file_api_v1_activity_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1467,7 +1784,7 @@ func (x *CollectionViewedData) String() string {
 func (*CollectionViewedData) ProtoMessage() {}
 
 func (x *CollectionViewedData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[16]
+	mi := &file_api_v1_activity_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,7 +1797,7 @@ func (x *CollectionViewedData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CollectionViewedData.ProtoReflect.Descriptor instead.
 func (*CollectionViewedData) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CollectionViewedData) GetCollectionId() int32 {
@@ -1516,7 +1833,7 @@ type UserSummary struct {
 
 func (x *UserSummary) Reset() {
 	*x = UserSummary{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[17]
+	mi := &file_api_v1_activity_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1528,7 +1845,7 @@ func (x *UserSummary) String() string {
 func (*UserSummary) ProtoMessage() {}
 
 func (x *UserSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[17]
+	mi := &file_api_v1_activity_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1541,7 +1858,7 @@ func (x *UserSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
 func (*UserSummary) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UserSummary) GetUserShortcutsCount() int32 {
@@ -1670,7 +1987,7 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\fcreator_name\x18\x06 \x01(\tR\vcreatorName\x12\x1d\n" +
 	"\n" +
 	"view_count\x18\a \x01(\x05R\tviewCount\x12=\n" +
-	"\flast_clicked\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastClicked\"\xed\x04\n" +
+	"\flast_clicked\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastClicked\"\x93\a\n" +
 	"\fActivityItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1e.monotreme.api.v1.ActivityTypeR\x04type\x12\x17\n" +
@@ -1682,7 +1999,10 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x10shortcut_created\x18\v \x01(\v2%.monotreme.api.v1.ShortcutCreatedDataH\x00R\x0fshortcutCreated\x12O\n" +
 	"\x0fshortcut_viewed\x18\f \x01(\v2$.monotreme.api.v1.ShortcutViewedDataH\x00R\x0eshortcutViewed\x12X\n" +
 	"\x12collection_created\x18\r \x01(\v2'.monotreme.api.v1.CollectionCreatedDataH\x00R\x11collectionCreated\x12U\n" +
-	"\x11collection_viewed\x18\x0e \x01(\v2&.monotreme.api.v1.CollectionViewedDataH\x00R\x10collectionViewedB\x06\n" +
+	"\x11collection_viewed\x18\x0e \x01(\v2&.monotreme.api.v1.CollectionViewedDataH\x00R\x10collectionViewed\x12_\n" +
+	"\x15shortcut_link_changed\x18\x0f \x01(\v2).monotreme.api.v1.ShortcutLinkChangedDataH\x00R\x13shortcutLinkChanged\x12\\\n" +
+	"\x14shortcut_failed_over\x18\x10 \x01(\v2(.monotreme.api.v1.ShortcutFailedOverDataH\x00R\x12shortcutFailedOver\x12e\n" +
+	"\x17shortcut_change_request\x18\x11 \x01(\v2+.monotreme.api.v1.ShortcutChangeRequestDataH\x00R\x15shortcutChangeRequestB\x06\n" +
 	"\x04data\"\x88\x01\n" +
 	"\x0fUserCreatedData\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x14\n" +
@@ -1694,7 +2014,7 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"shortcutId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
-	"\x04link\x18\x04 \x01(\tR\x04link\"\x98\x01\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\"\xaa\x01\n" +
 	"\x12ShortcutViewedData\x12\x1f\n" +
 	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
 	"shortcutId\x12\x12\n" +
@@ -1702,7 +2022,30 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1d\n" +
 	"\n" +
 	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x18\n" +
-	"\areferer\x18\x05 \x01(\tR\areferer\"\x88\x01\n" +
+	"\areferer\x18\x05 \x01(\tR\areferer\x12\x10\n" +
+	"\x03bot\x18\x06 \x01(\tR\x03bot\"\x9d\x01\n" +
+	"\x17ShortcutLinkChangedData\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12#\n" +
+	"\rprevious_link\x18\x04 \x01(\tR\fpreviousLink\x12\x12\n" +
+	"\x04link\x18\x05 \x01(\tR\x04link\"\xb4\x01\n" +
+	"\x16ShortcutFailedOverData\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12#\n" +
+	"\rprevious_link\x18\x04 \x01(\tR\fpreviousLink\x12\x12\n" +
+	"\x04link\x18\x05 \x01(\tR\x04link\x12\x16\n" +
+	"\x06backup\x18\x06 \x01(\bR\x06backup\"\xaa\x01\n" +
+	"\x19ShortcutChangeRequestData\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12*\n" +
+	"\x11change_request_id\x18\x04 \x01(\x05R\x0fchangeRequestId\x12\x16\n" +
+	"\x06fields\x18\x05 \x03(\tR\x06fields\"\x88\x01\n" +
 	"\x15CollectionCreatedData\x12#\n" +
 	"\rcollection_id\x18\x01 \x01(\x05R\fcollectionId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1716,14 +2059,21 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x14user_shortcuts_count\x18\x01 \x01(\x05R\x12userShortcutsCount\x124\n" +
 	"\x16user_collections_count\x18\x02 \x01(\x05R\x14userCollectionsCount\x12*\n" +
 	"\x11user_total_clicks\x18\x03 \x01(\x05R\x0fuserTotalClicks\x12\x1b\n" +
-	"\tuser_tags\x18\x04 \x03(\tR\buserTags*\x99\x01\n" +
+	"\tuser_tags\x18\x04 \x03(\tR\buserTags*\xc2\x02\n" +
 	"\fActivityType\x12\x1d\n" +
 	"\x19ACTIVITY_TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fUSER_CREATED\x10\x01\x12\x14\n" +
 	"\x10SHORTCUT_CREATED\x10\x02\x12\x13\n" +
 	"\x0fSHORTCUT_VIEWED\x10\x03\x12\x16\n" +
 	"\x12COLLECTION_CREATED\x10\x04\x12\x15\n" +
-	"\x11COLLECTION_VIEWED\x10\x052\xba\x03\n" +
+	"\x11COLLECTION_VIEWED\x10\x05\x12\x19\n" +
+	"\x15SHORTCUT_LINK_CHANGED\x10\x06\x12\x18\n" +
+	"\x14SHORTCUT_FAILED_OVER\x10\a\x12\x17\n" +
+	"\x13SHORTCUT_BOT_VIEWED\x10\b\x12\x1d\n" +
+	"\x19SHORTCUT_CHANGE_REQUESTED\x10\t\x12\x1c\n" +
+	"\x18SHORTCUT_CHANGE_APPROVED\x10\n" +
+	"\x12\x1c\n" +
+	"\x18SHORTCUT_CHANGE_REJECTED\x10\v2\xba\x03\n" +
 	"\x0fActivityService\x12\x8f\x01\n" +
 	"\x11GetRecentActivity\x12*.monotreme.api.v1.GetRecentActivityRequest\x1a+.monotreme.api.v1.GetRecentActivityResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/activities/recent\x12\x93\x01\n" +
 	"\x12GetActivitySummary\x12+.monotreme.api.v1.GetActivitySummaryRequest\x1a,.monotreme.api.v1.GetActivitySummaryResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/activities/summary\x12\x7f\n" +
@@ -1743,7 +2093,7 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_activity_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_api_v1_activity_service_proto_goTypes = []any{
 	(ActivityType)(0),                  // 0: monotreme.api.v1.ActivityType
 	(*GetRecentActivityRequest)(nil),   // 1: monotreme.api.v1.GetRecentActivityRequest
@@ -1761,11 +2111,14 @@ var file_api_v1_activity_service_proto_goTypes = []any{
 	(*UserCreatedData)(nil),            // 13: monotreme.api.v1.UserCreatedData
 	(*ShortcutCreatedData)(nil),        // 14: monotreme.api.v1.ShortcutCreatedData
 	(*ShortcutViewedData)(nil),         // 15: monotreme.api.v1.ShortcutViewedData
-	(*CollectionCreatedData)(nil),      // 16: monotreme.api.v1.CollectionCreatedData
-	(*CollectionViewedData)(nil),       // 17: monotreme.api.v1.CollectionViewedData
-	(*UserSummary)(nil),                // 18: monotreme.api.v1.UserSummary
-	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
-	(Role)(0),                          // 20: monotreme.api.v1.Role
+	(*ShortcutLinkChangedData)(nil),    // 16: monotreme.api.v1.ShortcutLinkChangedData
+	(*ShortcutFailedOverData)(nil),     // 17: monotreme.api.v1.ShortcutFailedOverData
+	(*ShortcutChangeRequestData)(nil),  // 18: monotreme.api.v1.ShortcutChangeRequestData
+	(*CollectionCreatedData)(nil),      // 19: monotreme.api.v1.CollectionCreatedData
+	(*CollectionViewedData)(nil),       // 20: monotreme.api.v1.CollectionViewedData
+	(*UserSummary)(nil),                // 21: monotreme.api.v1.UserSummary
+	(*timestamppb.Timestamp)(nil),      // 22: google.protobuf.Timestamp
+	(Role)(0),                          // 23: monotreme.api.v1.Role
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
 	7,  // 0: monotreme.api.v1.GetRecentActivityResponse.recent_users:type_name -> monotreme.api.v1.RecentUser
@@ -1773,36 +2126,39 @@ var file_api_v1_activity_service_proto_depIdxs = []int32{
 	9,  // 2: monotreme.api.v1.GetRecentActivityResponse.recent_collections:type_name -> monotreme.api.v1.RecentCollection
 	10, // 3: monotreme.api.v1.GetRecentActivityResponse.recent_clicks:type_name -> monotreme.api.v1.RecentClick
 	11, // 4: monotreme.api.v1.GetRecentActivityResponse.most_clicked_shortcuts:type_name -> monotreme.api.v1.MostClickedShortcut
-	18, // 5: monotreme.api.v1.GetActivitySummaryResponse.user_summary:type_name -> monotreme.api.v1.UserSummary
+	21, // 5: monotreme.api.v1.GetActivitySummaryResponse.user_summary:type_name -> monotreme.api.v1.UserSummary
 	0,  // 6: monotreme.api.v1.ListActivitiesRequest.activity_type:type_name -> monotreme.api.v1.ActivityType
-	19, // 7: monotreme.api.v1.ListActivitiesRequest.created_after:type_name -> google.protobuf.Timestamp
-	19, // 8: monotreme.api.v1.ListActivitiesRequest.created_before:type_name -> google.protobuf.Timestamp
+	22, // 7: monotreme.api.v1.ListActivitiesRequest.created_after:type_name -> google.protobuf.Timestamp
+	22, // 8: monotreme.api.v1.ListActivitiesRequest.created_before:type_name -> google.protobuf.Timestamp
 	12, // 9: monotreme.api.v1.ListActivitiesResponse.activities:type_name -> monotreme.api.v1.ActivityItem
-	19, // 10: monotreme.api.v1.RecentUser.created_time:type_name -> google.protobuf.Timestamp
-	20, // 11: monotreme.api.v1.RecentUser.role:type_name -> monotreme.api.v1.Role
-	19, // 12: monotreme.api.v1.RecentShortcut.created_time:type_name -> google.protobuf.Timestamp
-	19, // 13: monotreme.api.v1.RecentCollection.created_time:type_name -> google.protobuf.Timestamp
-	19, // 14: monotreme.api.v1.RecentClick.clicked_time:type_name -> google.protobuf.Timestamp
-	19, // 15: monotreme.api.v1.MostClickedShortcut.last_clicked:type_name -> google.protobuf.Timestamp
+	22, // 10: monotreme.api.v1.RecentUser.created_time:type_name -> google.protobuf.Timestamp
+	23, // 11: monotreme.api.v1.RecentUser.role:type_name -> monotreme.api.v1.Role
+	22, // 12: monotreme.api.v1.RecentShortcut.created_time:type_name -> google.protobuf.Timestamp
+	22, // 13: monotreme.api.v1.RecentCollection.created_time:type_name -> google.protobuf.Timestamp
+	22, // 14: monotreme.api.v1.RecentClick.clicked_time:type_name -> google.protobuf.Timestamp
+	22, // 15: monotreme.api.v1.MostClickedShortcut.last_clicked:type_name -> google.protobuf.Timestamp
 	0,  // 16: monotreme.api.v1.ActivityItem.type:type_name -> monotreme.api.v1.ActivityType
-	19, // 17: monotreme.api.v1.ActivityItem.created_time:type_name -> google.protobuf.Timestamp
+	22, // 17: monotreme.api.v1.ActivityItem.created_time:type_name -> google.protobuf.Timestamp
 	13, // 18: monotreme.api.v1.ActivityItem.user_created:type_name -> monotreme.api.v1.UserCreatedData
 	14, // 19: monotreme.api.v1.ActivityItem.shortcut_created:type_name -> monotreme.api.v1.ShortcutCreatedData
 	15, // 20: monotreme.api.v1.ActivityItem.shortcut_viewed:type_name -> monotreme.api.v1.ShortcutViewedData
-	16, // 21: monotreme.api.v1.ActivityItem.collection_created:type_name -> monotreme.api.v1.CollectionCreatedData
-	17, // 22: monotreme.api.v1.ActivityItem.collection_viewed:type_name -> monotreme.api.v1.CollectionViewedData
-	20, // 23: monotreme.api.v1.UserCreatedData.role:type_name -> monotreme.api.v1.Role
-	1,  // 24: monotreme.api.v1.ActivityService.GetRecentActivity:input_type -> monotreme.api.v1.GetRecentActivityRequest
-	3,  // 25: monotreme.api.v1.ActivityService.GetActivitySummary:input_type -> monotreme.api.v1.GetActivitySummaryRequest
-	5,  // 26: monotreme.api.v1.ActivityService.ListActivities:input_type -> monotreme.api.v1.ListActivitiesRequest
-	2,  // 27: monotreme.api.v1.ActivityService.GetRecentActivity:output_type -> monotreme.api.v1.GetRecentActivityResponse
-	4,  // 28: monotreme.api.v1.ActivityService.GetActivitySummary:output_type -> monotreme.api.v1.GetActivitySummaryResponse
-	6,  // 29: monotreme.api.v1.ActivityService.ListActivities:output_type -> monotreme.api.v1.ListActivitiesResponse
-	27, // [27:30] is the sub-list for method output_type
-	24, // [24:27] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	19, // 21: monotreme.api.v1.ActivityItem.collection_created:type_name -> monotreme.api.v1.CollectionCreatedData
+	20, // 22: monotreme.api.v1.ActivityItem.collection_viewed:type_name -> monotreme.api.v1.CollectionViewedData
+	16, // 23: monotreme.api.v1.ActivityItem.shortcut_link_changed:type_name -> monotreme.api.v1.ShortcutLinkChangedData
+	17, // 24: monotreme.api.v1.ActivityItem.shortcut_failed_over:type_name -> monotreme.api.v1.ShortcutFailedOverData
+	18, // 25: monotreme.api.v1.ActivityItem.shortcut_change_request:type_name -> monotreme.api.v1.ShortcutChangeRequestData
+	23, // 26: monotreme.api.v1.UserCreatedData.role:type_name -> monotreme.api.v1.Role
+	1,  // 27: monotreme.api.v1.ActivityService.GetRecentActivity:input_type -> monotreme.api.v1.GetRecentActivityRequest
+	3,  // 28: monotreme.api.v1.ActivityService.GetActivitySummary:input_type -> monotreme.api.v1.GetActivitySummaryRequest
+	5,  // 29: monotreme.api.v1.ActivityService.ListActivities:input_type -> monotreme.api.v1.ListActivitiesRequest
+	2,  // 30: monotreme.api.v1.ActivityService.GetRecentActivity:output_type -> monotreme.api.v1.GetRecentActivityResponse
+	4,  // 31: monotreme.api.v1.ActivityService.GetActivitySummary:output_type -> monotreme.api.v1.GetActivitySummaryResponse
+	6,  // 32: monotreme.api.v1.ActivityService.ListActivities:output_type -> monotreme.api.v1.ListActivitiesResponse
+	30, // [30:33] is the sub-list for method output_type
+	27, // [27:30] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -1820,6 +2176,9 @@ func file_api_v1_activity_service_proto_init() {
 		(*ActivityItem_ShortcutViewed)(nil),
 		(*ActivityItem_CollectionCreated)(nil),
 		(*ActivityItem_CollectionViewed)(nil),
+		(*ActivityItem_ShortcutLinkChanged)(nil),
+		(*ActivityItem_ShortcutFailedOver)(nil),
+		(*ActivityItem_ShortcutChangeRequest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1827,7 +2186,7 @@ func file_api_v1_activity_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   18,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,16 +26,22 @@ const (
 )
 
 type Collection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	UpdatedTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	ShortcutIds   []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=monotreme.api.v1.Visibility" json:"visibility,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Title       string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ShortcutIds []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=monotreme.api.v1.Visibility" json:"visibility,omitempty"`
+	// The time the collection was moved to the trash, unset if it is not in the trash.
+	DeletedTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_time,json=deletedTime,proto3" json:"deleted_time,omitempty"`
+	// The time the collection will be purged from the trash.
+	PurgeTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"`
+	// The groups a GROUP collection is visible to.
+	GroupIds      []int32 `protobuf:"varint,13,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +139,27 @@ func (x *Collection) GetVisibility() Visibility {
 	return Visibility_VISIBILITY_UNSPECIFIED
 }
 
+func (x *Collection) GetDeletedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedTime
+	}
+	return nil
+}
+
+func (x *Collection) GetPurgeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return nil
+}
+
+func (x *Collection) GetGroupIds() []int32 {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
 type ListCollectionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -441,6 +468,174 @@ func (x *DeleteCollectionRequest) GetId() int32 {
 	return 0
 }
 
+type ListTrashedCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedCollectionsRequest) Reset() {
+	*x = ListTrashedCollectionsRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedCollectionsRequest) ProtoMessage() {}
+
+func (x *ListTrashedCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ListTrashedCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedCollectionsResponse) Reset() {
+	*x = ListTrashedCollectionsResponse{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedCollectionsResponse) ProtoMessage() {}
+
+func (x *ListTrashedCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTrashedCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type RestoreCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCollectionRequest) Reset() {
+	*x = RestoreCollectionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCollectionRequest) ProtoMessage() {}
+
+func (x *RestoreCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeCollectionRequest) Reset() {
+	*x = PurgeCollectionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCollectionRequest) ProtoMessage() {}
+
+func (x *PurgeCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCollectionRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PurgeCollectionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type ImportBookmarksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HtmlContent   string                 `protobuf:"bytes,1,opt,name=html_content,json=htmlContent,proto3" json:"html_content,omitempty"`
@@ -450,7 +645,7 @@ type ImportBookmarksRequest struct {
 
 func (x *ImportBookmarksRequest) Reset() {
 	*x = ImportBookmarksRequest{}
-	mi := &file_api_v1_collection_service_proto_msgTypes[8]
+	mi := &file_api_v1_collection_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +657,7 @@ func (x *ImportBookmarksRequest) String() string {
 func (*ImportBookmarksRequest) ProtoMessage() {}
 
 func (x *ImportBookmarksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_collection_service_proto_msgTypes[8]
+	mi := &file_api_v1_collection_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +670,7 @@ func (x *ImportBookmarksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportBookmarksRequest.ProtoReflect.Descriptor instead.
 func (*ImportBookmarksRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ImportBookmarksRequest) GetHtmlContent() string {
@@ -500,7 +695,7 @@ type ImportBookmarksResponse struct {
 
 func (x *ImportBookmarksResponse) Reset() {
 	*x = ImportBookmarksResponse{}
-	mi := &file_api_v1_collection_service_proto_msgTypes[9]
+	mi := &file_api_v1_collection_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -512,7 +707,7 @@ func (x *ImportBookmarksResponse) String() string {
 func (*ImportBookmarksResponse) ProtoMessage() {}
 
 func (x *ImportBookmarksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_collection_service_proto_msgTypes[9]
+	mi := &file_api_v1_collection_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +720,7 @@ func (x *ImportBookmarksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportBookmarksResponse.ProtoReflect.Descriptor instead.
 func (*ImportBookmarksResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ImportBookmarksResponse) GetCollections() []*Collection {
@@ -577,11 +772,266 @@ func (x *ImportBookmarksResponse) GetCollectionsUpdated() int32 {
 	return 0
 }
 
+type ListCollectionPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionPermissionsRequest) Reset() {
+	*x = ListCollectionPermissionsRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionPermissionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCollectionPermissionsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCollectionPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionPermissionsResponse) Reset() {
+	*x = ListCollectionPermissionsResponse{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionPermissionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCollectionPermissionsResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type GrantCollectionPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission    *Permission            `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantCollectionPermissionRequest) Reset() {
+	*x = GrantCollectionPermissionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantCollectionPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCollectionPermissionRequest) ProtoMessage() {}
+
+func (x *GrantCollectionPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCollectionPermissionRequest.ProtoReflect.Descriptor instead.
+func (*GrantCollectionPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GrantCollectionPermissionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GrantCollectionPermissionRequest) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+type RevokeCollectionPermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user whose role is revoked. Exactly one of user_id and group_id is set.
+	UserId int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The group whose role is revoked.
+	GroupId       int32 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCollectionPermissionRequest) Reset() {
+	*x = RevokeCollectionPermissionRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCollectionPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCollectionPermissionRequest) ProtoMessage() {}
+
+func (x *RevokeCollectionPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCollectionPermissionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCollectionPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevokeCollectionPermissionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeCollectionPermissionRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeCollectionPermissionRequest) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type TransferCollectionOwnershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user who becomes the creator.
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferCollectionOwnershipRequest) Reset() {
+	*x = TransferCollectionOwnershipRequest{}
+	mi := &file_api_v1_collection_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferCollectionOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCollectionOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferCollectionOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_collection_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCollectionOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferCollectionOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_collection_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransferCollectionOwnershipRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferCollectionOwnershipRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 var File_api_v1_collection_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_collection_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fapi/v1/collection_service.proto\x12\x10monotreme.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x02\n" +
+	"\x1fapi/v1/collection_service.proto\x12\x10monotreme.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfd\x03\n" +
 	"\n" +
 	"Collection\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
@@ -596,7 +1046,11 @@ const file_api_v1_collection_service_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\n" +
 	" \x01(\x0e2\x1c.monotreme.api.v1.VisibilityR\n" +
-	"visibility\"\x18\n" +
+	"visibility\x12=\n" +
+	"\fdeleted_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vdeletedTime\x129\n" +
+	"\n" +
+	"purge_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tpurgeTime\x12\x1b\n" +
+	"\tgroup_ids\x18\r \x03(\x05R\bgroupIds\"\x18\n" +
 	"\x16ListCollectionsRequest\"Y\n" +
 	"\x17ListCollectionsResponse\x12>\n" +
 	"\vcollections\x18\x01 \x03(\v2\x1c.monotreme.api.v1.CollectionR\vcollections\"&\n" +
@@ -615,6 +1069,13 @@ const file_api_v1_collection_service_proto_rawDesc = "" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
 	"updateMask\")\n" +
 	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1f\n" +
+	"\x1dListTrashedCollectionsRequest\"`\n" +
+	"\x1eListTrashedCollectionsResponse\x12>\n" +
+	"\vcollections\x18\x01 \x03(\v2\x1c.monotreme.api.v1.CollectionR\vcollections\"*\n" +
+	"\x18RestoreCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"(\n" +
+	"\x16PurgeCollectionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\";\n" +
 	"\x16ImportBookmarksRequest\x12!\n" +
 	"\fhtml_content\x18\x01 \x01(\tR\vhtmlContent\"\xeb\x02\n" +
@@ -625,7 +1086,23 @@ const file_api_v1_collection_service_proto_rawDesc = "" +
 	"\x11shortcuts_created\x18\x04 \x01(\x05R\x10shortcutsCreated\x12+\n" +
 	"\x11shortcuts_updated\x18\x05 \x01(\x05R\x10shortcutsUpdated\x12/\n" +
 	"\x13collections_created\x18\x06 \x01(\x05R\x12collectionsCreated\x12/\n" +
-	"\x13collections_updated\x18\a \x01(\x05R\x12collectionsUpdated2\xc1\a\n" +
+	"\x13collections_updated\x18\a \x01(\x05R\x12collectionsUpdated\"2\n" +
+	" ListCollectionPermissionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"c\n" +
+	"!ListCollectionPermissionsResponse\x12>\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x1c.monotreme.api.v1.PermissionR\vpermissions\"p\n" +
+	" GrantCollectionPermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12<\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\v2\x1c.monotreme.api.v1.PermissionR\n" +
+	"permission\"g\n" +
+	"!RevokeCollectionPermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x05R\agroupId\"M\n" +
+	"\"TransferCollectionOwnershipRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId2\xb7\x10\n" +
 	"\x11CollectionService\x12\x83\x01\n" +
 	"\x0fListCollections\x12(.monotreme.api.v1.ListCollectionsRequest\x1a).monotreme.api.v1.ListCollectionsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/collections\x12|\n" +
 	"\rGetCollection\x12&.monotreme.api.v1.GetCollectionRequest\x1a\x1c.monotreme.api.v1.Collection\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/collections/{id}\x12c\n" +
@@ -634,8 +1111,17 @@ const file_api_v1_collection_service_proto_rawDesc = "" +
 	"collection\"\x13/api/v1/collections\x12\xad\x01\n" +
 	"\x10UpdateCollection\x12).monotreme.api.v1.UpdateCollectionRequest\x1a\x1c.monotreme.api.v1.Collection\"P\xdaA\x16collection,update_mask\x82\xd3\xe4\x93\x021:\n" +
 	"collection\x1a#/api/v1/collections/{collection.id}\x12|\n" +
-	"\x10DeleteCollection\x12).monotreme.api.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/collections/{id}\x12\x8d\x01\n" +
-	"\x0fImportBookmarks\x12(.monotreme.api.v1.ImportBookmarksRequest\x1a).monotreme.api.v1.ImportBookmarksResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/collections/importB\xc4\x01\n" +
+	"\x10DeleteCollection\x12).monotreme.api.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x02id\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/collections/{id}\x12\x9e\x01\n" +
+	"\x16ListTrashedCollections\x12/.monotreme.api.v1.ListTrashedCollectionsRequest\x1a0.monotreme.api.v1.ListTrashedCollectionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/trash/collections\x12\x92\x01\n" +
+	"\x11RestoreCollection\x12*.monotreme.api.v1.RestoreCollectionRequest\x1a\x1c.monotreme.api.v1.Collection\"3\xdaA\x02id\x82\xd3\xe4\x93\x02(\"&/api/v1/trash/collections/{id}:restore\x12\x80\x01\n" +
+	"\x0fPurgeCollection\x12(.monotreme.api.v1.PurgeCollectionRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x02id\x82\xd3\xe4\x93\x02 *\x1e/api/v1/trash/collections/{id}\x12\x8d\x01\n" +
+	"\x0fImportBookmarks\x12(.monotreme.api.v1.ImportBookmarksRequest\x1a).monotreme.api.v1.ImportBookmarksResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/collections/import\x12\xb7\x01\n" +
+	"\x19ListCollectionPermissions\x122.monotreme.api.v1.ListCollectionPermissionsRequest\x1a3.monotreme.api.v1.ListCollectionPermissionsResponse\"1\xdaA\x02id\x82\xd3\xe4\x93\x02&\x12$/api/v1/collections/{id}/permissions\x12\xb7\x01\n" +
+	"\x19GrantCollectionPermission\x122.monotreme.api.v1.GrantCollectionPermissionRequest\x1a\x1c.monotreme.api.v1.Permission\"H\xdaA\rid,permission\x82\xd3\xe4\x93\x022:\n" +
+	"permission\"$/api/v1/collections/{id}/permissions\x12\x97\x01\n" +
+	"\x1aRevokeCollectionPermission\x123.monotreme.api.v1.RevokeCollectionPermissionRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&*$/api/v1/collections/{id}/permissions\x12\xac\x01\n" +
+	"\x1bTransferCollectionOwnership\x124.monotreme.api.v1.TransferCollectionOwnershipRequest\x1a\x1c.monotreme.api.v1.Collection\"9\xdaA\n" +
+	"id,user_id\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/collections/{id}:transferB\xc4\x01\n" +
 	"\x14com.monotreme.api.v1B\x16CollectionServiceProtoP\x01Z2github.com/bshort/monotreme/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\x10Monotreme.Api.V1\xca\x02\x10Monotreme\\Api\\V1\xe2\x02\x1cMonotreme\\Api\\V1\\GPBMetadata\xea\x02\x12Monotreme::Api::V1b\x06proto3"
 
 var (
@@ -650,51 +1136,80 @@ func file_api_v1_collection_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_collection_service_proto_rawDescData
 }
 
-var file_api_v1_collection_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_collection_service_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_api_v1_collection_service_proto_goTypes = []any{
-	(*Collection)(nil),                 // 0: monotreme.api.v1.Collection
-	(*ListCollectionsRequest)(nil),     // 1: monotreme.api.v1.ListCollectionsRequest
-	(*ListCollectionsResponse)(nil),    // 2: monotreme.api.v1.ListCollectionsResponse
-	(*GetCollectionRequest)(nil),       // 3: monotreme.api.v1.GetCollectionRequest
-	(*GetCollectionByNameRequest)(nil), // 4: monotreme.api.v1.GetCollectionByNameRequest
-	(*CreateCollectionRequest)(nil),    // 5: monotreme.api.v1.CreateCollectionRequest
-	(*UpdateCollectionRequest)(nil),    // 6: monotreme.api.v1.UpdateCollectionRequest
-	(*DeleteCollectionRequest)(nil),    // 7: monotreme.api.v1.DeleteCollectionRequest
-	(*ImportBookmarksRequest)(nil),     // 8: monotreme.api.v1.ImportBookmarksRequest
-	(*ImportBookmarksResponse)(nil),    // 9: monotreme.api.v1.ImportBookmarksResponse
-	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
-	(Visibility)(0),                    // 11: monotreme.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),      // 12: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),              // 13: google.protobuf.Empty
+	(*Collection)(nil),                         // 0: monotreme.api.v1.Collection
+	(*ListCollectionsRequest)(nil),             // 1: monotreme.api.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),            // 2: monotreme.api.v1.ListCollectionsResponse
+	(*GetCollectionRequest)(nil),               // 3: monotreme.api.v1.GetCollectionRequest
+	(*GetCollectionByNameRequest)(nil),         // 4: monotreme.api.v1.GetCollectionByNameRequest
+	(*CreateCollectionRequest)(nil),            // 5: monotreme.api.v1.CreateCollectionRequest
+	(*UpdateCollectionRequest)(nil),            // 6: monotreme.api.v1.UpdateCollectionRequest
+	(*DeleteCollectionRequest)(nil),            // 7: monotreme.api.v1.DeleteCollectionRequest
+	(*ListTrashedCollectionsRequest)(nil),      // 8: monotreme.api.v1.ListTrashedCollectionsRequest
+	(*ListTrashedCollectionsResponse)(nil),     // 9: monotreme.api.v1.ListTrashedCollectionsResponse
+	(*RestoreCollectionRequest)(nil),           // 10: monotreme.api.v1.RestoreCollectionRequest
+	(*PurgeCollectionRequest)(nil),             // 11: monotreme.api.v1.PurgeCollectionRequest
+	(*ImportBookmarksRequest)(nil),             // 12: monotreme.api.v1.ImportBookmarksRequest
+	(*ImportBookmarksResponse)(nil),            // 13: monotreme.api.v1.ImportBookmarksResponse
+	(*ListCollectionPermissionsRequest)(nil),   // 14: monotreme.api.v1.ListCollectionPermissionsRequest
+	(*ListCollectionPermissionsResponse)(nil),  // 15: monotreme.api.v1.ListCollectionPermissionsResponse
+	(*GrantCollectionPermissionRequest)(nil),   // 16: monotreme.api.v1.GrantCollectionPermissionRequest
+	(*RevokeCollectionPermissionRequest)(nil),  // 17: monotreme.api.v1.RevokeCollectionPermissionRequest
+	(*TransferCollectionOwnershipRequest)(nil), // 18: monotreme.api.v1.TransferCollectionOwnershipRequest
+	(*timestamppb.Timestamp)(nil),              // 19: google.protobuf.Timestamp
+	(Visibility)(0),                            // 20: monotreme.api.v1.Visibility
+	(*fieldmaskpb.FieldMask)(nil),              // 21: google.protobuf.FieldMask
+	(*Permission)(nil),                         // 22: monotreme.api.v1.Permission
+	(*emptypb.Empty)(nil),                      // 23: google.protobuf.Empty
 }
 var file_api_v1_collection_service_proto_depIdxs = []int32{
-	10, // 0: monotreme.api.v1.Collection.created_time:type_name -> google.protobuf.Timestamp
-	10, // 1: monotreme.api.v1.Collection.updated_time:type_name -> google.protobuf.Timestamp
-	11, // 2: monotreme.api.v1.Collection.visibility:type_name -> monotreme.api.v1.Visibility
-	0,  // 3: monotreme.api.v1.ListCollectionsResponse.collections:type_name -> monotreme.api.v1.Collection
-	0,  // 4: monotreme.api.v1.CreateCollectionRequest.collection:type_name -> monotreme.api.v1.Collection
-	0,  // 5: monotreme.api.v1.UpdateCollectionRequest.collection:type_name -> monotreme.api.v1.Collection
-	12, // 6: monotreme.api.v1.UpdateCollectionRequest.update_mask:type_name -> google.protobuf.FieldMask
-	0,  // 7: monotreme.api.v1.ImportBookmarksResponse.collections:type_name -> monotreme.api.v1.Collection
-	1,  // 8: monotreme.api.v1.CollectionService.ListCollections:input_type -> monotreme.api.v1.ListCollectionsRequest
-	3,  // 9: monotreme.api.v1.CollectionService.GetCollection:input_type -> monotreme.api.v1.GetCollectionRequest
-	4,  // 10: monotreme.api.v1.CollectionService.GetCollectionByName:input_type -> monotreme.api.v1.GetCollectionByNameRequest
-	5,  // 11: monotreme.api.v1.CollectionService.CreateCollection:input_type -> monotreme.api.v1.CreateCollectionRequest
-	6,  // 12: monotreme.api.v1.CollectionService.UpdateCollection:input_type -> monotreme.api.v1.UpdateCollectionRequest
-	7,  // 13: monotreme.api.v1.CollectionService.DeleteCollection:input_type -> monotreme.api.v1.DeleteCollectionRequest
-	8,  // 14: monotreme.api.v1.CollectionService.ImportBookmarks:input_type -> monotreme.api.v1.ImportBookmarksRequest
-	2,  // 15: monotreme.api.v1.CollectionService.ListCollections:output_type -> monotreme.api.v1.ListCollectionsResponse
-	0,  // 16: monotreme.api.v1.CollectionService.GetCollection:output_type -> monotreme.api.v1.Collection
-	0,  // 17: monotreme.api.v1.CollectionService.GetCollectionByName:output_type -> monotreme.api.v1.Collection
-	0,  // 18: monotreme.api.v1.CollectionService.CreateCollection:output_type -> monotreme.api.v1.Collection
-	0,  // 19: monotreme.api.v1.CollectionService.UpdateCollection:output_type -> monotreme.api.v1.Collection
-	13, // 20: monotreme.api.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
-	9,  // 21: monotreme.api.v1.CollectionService.ImportBookmarks:output_type -> monotreme.api.v1.ImportBookmarksResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	19, // 0: monotreme.api.v1.Collection.created_time:type_name -> google.protobuf.Timestamp
+	19, // 1: monotreme.api.v1.Collection.updated_time:type_name -> google.protobuf.Timestamp
+	20, // 2: monotreme.api.v1.Collection.visibility:type_name -> monotreme.api.v1.Visibility
+	19, // 3: monotreme.api.v1.Collection.deleted_time:type_name -> google.protobuf.Timestamp
+	19, // 4: monotreme.api.v1.Collection.purge_time:type_name -> google.protobuf.Timestamp
+	0,  // 5: monotreme.api.v1.ListCollectionsResponse.collections:type_name -> monotreme.api.v1.Collection
+	0,  // 6: monotreme.api.v1.CreateCollectionRequest.collection:type_name -> monotreme.api.v1.Collection
+	0,  // 7: monotreme.api.v1.UpdateCollectionRequest.collection:type_name -> monotreme.api.v1.Collection
+	21, // 8: monotreme.api.v1.UpdateCollectionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 9: monotreme.api.v1.ListTrashedCollectionsResponse.collections:type_name -> monotreme.api.v1.Collection
+	0,  // 10: monotreme.api.v1.ImportBookmarksResponse.collections:type_name -> monotreme.api.v1.Collection
+	22, // 11: monotreme.api.v1.ListCollectionPermissionsResponse.permissions:type_name -> monotreme.api.v1.Permission
+	22, // 12: monotreme.api.v1.GrantCollectionPermissionRequest.permission:type_name -> monotreme.api.v1.Permission
+	1,  // 13: monotreme.api.v1.CollectionService.ListCollections:input_type -> monotreme.api.v1.ListCollectionsRequest
+	3,  // 14: monotreme.api.v1.CollectionService.GetCollection:input_type -> monotreme.api.v1.GetCollectionRequest
+	4,  // 15: monotreme.api.v1.CollectionService.GetCollectionByName:input_type -> monotreme.api.v1.GetCollectionByNameRequest
+	5,  // 16: monotreme.api.v1.CollectionService.CreateCollection:input_type -> monotreme.api.v1.CreateCollectionRequest
+	6,  // 17: monotreme.api.v1.CollectionService.UpdateCollection:input_type -> monotreme.api.v1.UpdateCollectionRequest
+	7,  // 18: monotreme.api.v1.CollectionService.DeleteCollection:input_type -> monotreme.api.v1.DeleteCollectionRequest
+	8,  // 19: monotreme.api.v1.CollectionService.ListTrashedCollections:input_type -> monotreme.api.v1.ListTrashedCollectionsRequest
+	10, // 20: monotreme.api.v1.CollectionService.RestoreCollection:input_type -> monotreme.api.v1.RestoreCollectionRequest
+	11, // 21: monotreme.api.v1.CollectionService.PurgeCollection:input_type -> monotreme.api.v1.PurgeCollectionRequest
+	12, // 22: monotreme.api.v1.CollectionService.ImportBookmarks:input_type -> monotreme.api.v1.ImportBookmarksRequest
+	14, // 23: monotreme.api.v1.CollectionService.ListCollectionPermissions:input_type -> monotreme.api.v1.ListCollectionPermissionsRequest
+	16, // 24: monotreme.api.v1.CollectionService.GrantCollectionPermission:input_type -> monotreme.api.v1.GrantCollectionPermissionRequest
+	17, // 25: monotreme.api.v1.CollectionService.RevokeCollectionPermission:input_type -> monotreme.api.v1.RevokeCollectionPermissionRequest
+	18, // 26: monotreme.api.v1.CollectionService.TransferCollectionOwnership:input_type -> monotreme.api.v1.TransferCollectionOwnershipRequest
+	2,  // 27: monotreme.api.v1.CollectionService.ListCollections:output_type -> monotreme.api.v1.ListCollectionsResponse
+	0,  // 28: monotreme.api.v1.CollectionService.GetCollection:output_type -> monotreme.api.v1.Collection
+	0,  // 29: monotreme.api.v1.CollectionService.GetCollectionByName:output_type -> monotreme.api.v1.Collection
+	0,  // 30: monotreme.api.v1.CollectionService.CreateCollection:output_type -> monotreme.api.v1.Collection
+	0,  // 31: monotreme.api.v1.CollectionService.UpdateCollection:output_type -> monotreme.api.v1.Collection
+	23, // 32: monotreme.api.v1.CollectionService.DeleteCollection:output_type -> google.protobuf.Empty
+	9,  // 33: monotreme.api.v1.CollectionService.ListTrashedCollections:output_type -> monotreme.api.v1.ListTrashedCollectionsResponse
+	0,  // 34: monotreme.api.v1.CollectionService.RestoreCollection:output_type -> monotreme.api.v1.Collection
+	23, // 35: monotreme.api.v1.CollectionService.PurgeCollection:output_type -> google.protobuf.Empty
+	13, // 36: monotreme.api.v1.CollectionService.ImportBookmarks:output_type -> monotreme.api.v1.ImportBookmarksResponse
+	15, // 37: monotreme.api.v1.CollectionService.ListCollectionPermissions:output_type -> monotreme.api.v1.ListCollectionPermissionsResponse
+	22, // 38: monotreme.api.v1.CollectionService.GrantCollectionPermission:output_type -> monotreme.api.v1.Permission
+	23, // 39: monotreme.api.v1.CollectionService.RevokeCollectionPermission:output_type -> google.protobuf.Empty
+	0,  // 40: monotreme.api.v1.CollectionService.TransferCollectionOwnership:output_type -> monotreme.api.v1.Collection
+	27, // [27:41] is the sub-list for method output_type
+	13, // [13:27] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_collection_service_proto_init() }
@@ -709,7 +1224,7 @@ func file_api_v1_collection_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_collection_service_proto_rawDesc), len(file_api_v1_collection_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,13 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CollectionService_ListCollections_FullMethodName     = "/monotreme.api.v1.CollectionService/ListCollections"
-	CollectionService_GetCollection_FullMethodName       = "/monotreme.api.v1.CollectionService/GetCollection"
-	CollectionService_GetCollectionByName_FullMethodName = "/monotreme.api.v1.CollectionService/GetCollectionByName"
-	CollectionService_CreateCollection_FullMethodName    = "/monotreme.api.v1.CollectionService/CreateCollection"
-	CollectionService_UpdateCollection_FullMethodName    = "/monotreme.api.v1.CollectionService/UpdateCollection"
-	CollectionService_DeleteCollection_FullMethodName    = "/monotreme.api.v1.CollectionService/DeleteCollection"
-	CollectionService_ImportBookmarks_FullMethodName     = "/monotreme.api.v1.CollectionService/ImportBookmarks"
+	CollectionService_ListCollections_FullMethodName             = "/monotreme.api.v1.CollectionService/ListCollections"
+	CollectionService_GetCollection_FullMethodName               = "/monotreme.api.v1.CollectionService/GetCollection"
+	CollectionService_GetCollectionByName_FullMethodName         = "/monotreme.api.v1.CollectionService/GetCollectionByName"
+	CollectionService_CreateCollection_FullMethodName            = "/monotreme.api.v1.CollectionService/CreateCollection"
+	CollectionService_UpdateCollection_FullMethodName            = "/monotreme.api.v1.CollectionService/UpdateCollection"
+	CollectionService_DeleteCollection_FullMethodName            = "/monotreme.api.v1.CollectionService/DeleteCollection"
+	CollectionService_ListTrashedCollections_FullMethodName      = "/monotreme.api.v1.CollectionService/ListTrashedCollections"
+	CollectionService_RestoreCollection_FullMethodName           = "/monotreme.api.v1.CollectionService/RestoreCollection"
+	CollectionService_PurgeCollection_FullMethodName             = "/monotreme.api.v1.CollectionService/PurgeCollection"
+	CollectionService_ImportBookmarks_FullMethodName             = "/monotreme.api.v1.CollectionService/ImportBookmarks"
+	CollectionService_ListCollectionPermissions_FullMethodName   = "/monotreme.api.v1.CollectionService/ListCollectionPermissions"
+	CollectionService_GrantCollectionPermission_FullMethodName   = "/monotreme.api.v1.CollectionService/GrantCollectionPermission"
+	CollectionService_RevokeCollectionPermission_FullMethodName  = "/monotreme.api.v1.CollectionService/RevokeCollectionPermission"
+	CollectionService_TransferCollectionOwnership_FullMethodName = "/monotreme.api.v1.CollectionService/TransferCollectionOwnership"
 )
 
 // CollectionServiceClient is the client API for CollectionService service.
@@ -43,10 +50,25 @@ type CollectionServiceClient interface {
 	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
 	// UpdateCollection updates a collection.
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
-	// DeleteCollection deletes a collection by id.
+	// DeleteCollection moves a collection to the trash. Its name stays reserved until it is purged.
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTrashedCollections returns the collections in the trash of the current user, or of every user for admins.
+	ListTrashedCollections(ctx context.Context, in *ListTrashedCollectionsRequest, opts ...grpc.CallOption) (*ListTrashedCollectionsResponse, error)
+	// RestoreCollection moves a collection out of the trash and re-links its shortcuts.
+	RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	// PurgeCollection permanently deletes a collection in the trash.
+	PurgeCollection(ctx context.Context, in *PurgeCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ImportBookmarks imports bookmarks from an HTML file and creates collections and shortcuts.
 	ImportBookmarks(ctx context.Context, in *ImportBookmarksRequest, opts ...grpc.CallOption) (*ImportBookmarksResponse, error)
+	// ListCollectionPermissions returns the roles granted on a collection.
+	ListCollectionPermissions(ctx context.Context, in *ListCollectionPermissionsRequest, opts ...grpc.CallOption) (*ListCollectionPermissionsResponse, error)
+	// GrantCollectionPermission grants a role on a collection to a user or group, replacing the role they had.
+	// Only owners can grant roles.
+	GrantCollectionPermission(ctx context.Context, in *GrantCollectionPermissionRequest, opts ...grpc.CallOption) (*Permission, error)
+	// RevokeCollectionPermission revokes the role of a user or group on a collection. Only owners can revoke roles.
+	RevokeCollectionPermission(ctx context.Context, in *RevokeCollectionPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TransferCollectionOwnership makes another user the creator of a collection. Only owners can transfer it.
+	TransferCollectionOwnership(ctx context.Context, in *TransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*Collection, error)
 }
 
 type collectionServiceClient struct {
@@ -117,6 +139,36 @@ func (c *collectionServiceClient) DeleteCollection(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *collectionServiceClient) ListTrashedCollections(ctx context.Context, in *ListTrashedCollectionsRequest, opts ...grpc.CallOption) (*ListTrashedCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedCollectionsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListTrashedCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_RestoreCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) PurgeCollection(ctx context.Context, in *PurgeCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_PurgeCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *collectionServiceClient) ImportBookmarks(ctx context.Context, in *ImportBookmarksRequest, opts ...grpc.CallOption) (*ImportBookmarksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ImportBookmarksResponse)
@@ -127,6 +179,46 @@ func (c *collectionServiceClient) ImportBookmarks(ctx context.Context, in *Impor
 	return out, nil
 }
 
+func (c *collectionServiceClient) ListCollectionPermissions(ctx context.Context, in *ListCollectionPermissionsRequest, opts ...grpc.CallOption) (*ListCollectionPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionPermissionsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_ListCollectionPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GrantCollectionPermission(ctx context.Context, in *GrantCollectionPermissionRequest, opts ...grpc.CallOption) (*Permission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Permission)
+	err := c.cc.Invoke(ctx, CollectionService_GrantCollectionPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RevokeCollectionPermission(ctx context.Context, in *RevokeCollectionPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_RevokeCollectionPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) TransferCollectionOwnership(ctx context.Context, in *TransferCollectionOwnershipRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, CollectionService_TransferCollectionOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CollectionServiceServer is the server API for CollectionService service.
 // All implementations must embed UnimplementedCollectionServiceServer
 // for forward compatibility.
@@ -141,10 +233,25 @@ type CollectionServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
 	// UpdateCollection updates a collection.
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
-	// DeleteCollection deletes a collection by id.
+	// DeleteCollection moves a collection to the trash. Its name stays reserved until it is purged.
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	// ListTrashedCollections returns the collections in the trash of the current user, or of every user for admins.
+	ListTrashedCollections(context.Context, *ListTrashedCollectionsRequest) (*ListTrashedCollectionsResponse, error)
+	// RestoreCollection moves a collection out of the trash and re-links its shortcuts.
+	RestoreCollection(context.Context, *RestoreCollectionRequest) (*Collection, error)
+	// PurgeCollection permanently deletes a collection in the trash.
+	PurgeCollection(context.Context, *PurgeCollectionRequest) (*emptypb.Empty, error)
 	// ImportBookmarks imports bookmarks from an HTML file and creates collections and shortcuts.
 	ImportBookmarks(context.Context, *ImportBookmarksRequest) (*ImportBookmarksResponse, error)
+	// ListCollectionPermissions returns the roles granted on a collection.
+	ListCollectionPermissions(context.Context, *ListCollectionPermissionsRequest) (*ListCollectionPermissionsResponse, error)
+	// GrantCollectionPermission grants a role on a collection to a user or group, replacing the role they had.
+	// Only owners can grant roles.
+	GrantCollectionPermission(context.Context, *GrantCollectionPermissionRequest) (*Permission, error)
+	// RevokeCollectionPermission revokes the role of a user or group on a collection. Only owners can revoke roles.
+	RevokeCollectionPermission(context.Context, *RevokeCollectionPermissionRequest) (*emptypb.Empty, error)
+	// TransferCollectionOwnership makes another user the creator of a collection. Only owners can transfer it.
+	TransferCollectionOwnership(context.Context, *TransferCollectionOwnershipRequest) (*Collection, error)
 	mustEmbedUnimplementedCollectionServiceServer()
 }
 
@@ -173,9 +280,30 @@ func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *U
 func (UnimplementedCollectionServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
 }
+func (UnimplementedCollectionServiceServer) ListTrashedCollections(context.Context, *ListTrashedCollectionsRequest) (*ListTrashedCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) RestoreCollection(context.Context, *RestoreCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) PurgeCollection(context.Context, *PurgeCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCollection not implemented")
+}
 func (UnimplementedCollectionServiceServer) ImportBookmarks(context.Context, *ImportBookmarksRequest) (*ImportBookmarksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportBookmarks not implemented")
 }
+func (UnimplementedCollectionServiceServer) ListCollectionPermissions(context.Context, *ListCollectionPermissionsRequest) (*ListCollectionPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionPermissions not implemented")
+}
+func (UnimplementedCollectionServiceServer) GrantCollectionPermission(context.Context, *GrantCollectionPermissionRequest) (*Permission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCollectionPermission not implemented")
+}
+func (UnimplementedCollectionServiceServer) RevokeCollectionPermission(context.Context, *RevokeCollectionPermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCollectionPermission not implemented")
+}
+func (UnimplementedCollectionServiceServer) TransferCollectionOwnership(context.Context, *TransferCollectionOwnershipRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferCollectionOwnership not implemented")
+}
 func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
 func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
 
@@ -305,6 +433,60 @@ func _CollectionService_DeleteCollection_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_ListTrashedCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListTrashedCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListTrashedCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListTrashedCollections(ctx, req.(*ListTrashedCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RestoreCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RestoreCollection(ctx, req.(*RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_PurgeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).PurgeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_PurgeCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).PurgeCollection(ctx, req.(*PurgeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CollectionService_ImportBookmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportBookmarksRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +505,78 @@ func _CollectionService_ImportBookmarks_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_ListCollectionPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ListCollectionPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ListCollectionPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ListCollectionPermissions(ctx, req.(*ListCollectionPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GrantCollectionPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCollectionPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GrantCollectionPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GrantCollectionPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GrantCollectionPermission(ctx, req.(*GrantCollectionPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RevokeCollectionPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCollectionPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RevokeCollectionPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RevokeCollectionPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RevokeCollectionPermission(ctx, req.(*RevokeCollectionPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_TransferCollectionOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferCollectionOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).TransferCollectionOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_TransferCollectionOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).TransferCollectionOwnership(ctx, req.(*TransferCollectionOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -354,10 +608,38 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCollection",
 			Handler:    _CollectionService_DeleteCollection_Handler,
 		},
+		{
+			MethodName: "ListTrashedCollections",
+			Handler:    _CollectionService_ListTrashedCollections_Handler,
+		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _CollectionService_RestoreCollection_Handler,
+		},
+		{
+			MethodName: "PurgeCollection",
+			Handler:    _CollectionService_PurgeCollection_Handler,
+		},
 		{
 			MethodName: "ImportBookmarks",
 			Handler:    _CollectionService_ImportBookmarks_Handler,
 		},
+		{
+			MethodName: "ListCollectionPermissions",
+			Handler:    _CollectionService_ListCollectionPermissions_Handler,
+		},
+		{
+			MethodName: "GrantCollectionPermission",
+			Handler:    _CollectionService_GrantCollectionPermission_Handler,
+		},
+		{
+			MethodName: "RevokeCollectionPermission",
+			Handler:    _CollectionService_RevokeCollectionPermission_Handler,
+		},
+		{
+			MethodName: "TransferCollectionOwnership",
+			Handler:    _CollectionService_TransferCollectionOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/collection_service.proto",
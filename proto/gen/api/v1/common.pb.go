@@ -9,6 +9,7 @@ package apiv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -76,6 +77,10 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
 	Visibility_WORKSPACE              Visibility = 1
 	Visibility_PUBLIC                 Visibility = 2
+	// Only visible to the creator.
+	Visibility_PRIVATE Visibility = 3
+	// Only visible to the creator and the members of the groups it is shared with.
+	Visibility_GROUP Visibility = 4
 )
 
 // Enum value maps for Visibility.
@@ -84,11 +89,15 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "WORKSPACE",
 		2: "PUBLIC",
+		3: "PRIVATE",
+		4: "GROUP",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"WORKSPACE":              1,
 		"PUBLIC":                 2,
+		"PRIVATE":                3,
+		"GROUP":                  4,
 	}
 )
 
@@ -119,22 +128,240 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+type RedirectMode int32
+
+const (
+	RedirectMode_REDIRECT_MODE_UNSPECIFIED RedirectMode = 0
+	// HTTP 302.
+	RedirectMode_FOUND RedirectMode = 1
+	// HTTP 301.
+	RedirectMode_MOVED_PERMANENTLY RedirectMode = 2
+	// HTTP 307.
+	RedirectMode_TEMPORARY_REDIRECT RedirectMode = 3
+	// HTTP 308.
+	RedirectMode_PERMANENT_REDIRECT RedirectMode = 4
+	// An HTML page that refreshes to the link without sending a referrer.
+	RedirectMode_META_REFRESH RedirectMode = 5
+	// A preview page that shows the destination before continuing.
+	RedirectMode_INTERSTITIAL RedirectMode = 6
+)
+
+// Enum value maps for RedirectMode.
+var (
+	RedirectMode_name = map[int32]string{
+		0: "REDIRECT_MODE_UNSPECIFIED",
+		1: "FOUND",
+		2: "MOVED_PERMANENTLY",
+		3: "TEMPORARY_REDIRECT",
+		4: "PERMANENT_REDIRECT",
+		5: "META_REFRESH",
+		6: "INTERSTITIAL",
+	}
+	RedirectMode_value = map[string]int32{
+		"REDIRECT_MODE_UNSPECIFIED": 0,
+		"FOUND":                     1,
+		"MOVED_PERMANENTLY":         2,
+		"TEMPORARY_REDIRECT":        3,
+		"PERMANENT_REDIRECT":        4,
+		"META_REFRESH":              5,
+		"INTERSTITIAL":              6,
+	}
+)
+
+func (x RedirectMode) Enum() *RedirectMode {
+	p := new(RedirectMode)
+	*p = x
+	return p
+}
+
+func (x RedirectMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RedirectMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (RedirectMode) Type() protoreflect.EnumType {
+	return &file_api_v1_common_proto_enumTypes[2]
+}
+
+func (x RedirectMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RedirectMode.Descriptor instead.
+func (RedirectMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+// PermissionRole is the role a permission grants on a shortcut or collection.
+// Each role includes the ones before it.
+type PermissionRole int32
+
+const (
+	PermissionRole_PERMISSION_ROLE_UNSPECIFIED PermissionRole = 0
+	// Can see the resource whatever its visibility.
+	PermissionRole_VIEWER PermissionRole = 1
+	// Can also change the resource, except for who it is shared with.
+	PermissionRole_EDITOR PermissionRole = 2
+	// Can do everything the creator of the resource can.
+	PermissionRole_OWNER PermissionRole = 3
+)
+
+// Enum value maps for PermissionRole.
+var (
+	PermissionRole_name = map[int32]string{
+		0: "PERMISSION_ROLE_UNSPECIFIED",
+		1: "VIEWER",
+		2: "EDITOR",
+		3: "OWNER",
+	}
+	PermissionRole_value = map[string]int32{
+		"PERMISSION_ROLE_UNSPECIFIED": 0,
+		"VIEWER":                      1,
+		"EDITOR":                      2,
+		"OWNER":                       3,
+	}
+)
+
+func (x PermissionRole) Enum() *PermissionRole {
+	p := new(PermissionRole)
+	*p = x
+	return p
+}
+
+func (x PermissionRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (PermissionRole) Type() protoreflect.EnumType {
+	return &file_api_v1_common_proto_enumTypes[3]
+}
+
+func (x PermissionRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionRole.Descriptor instead.
+func (PermissionRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+// Permission is a role granted to a user, or to the members of a group, on a shortcut or collection.
+type Permission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user the role is granted to. Exactly one of user_id and group_id is set.
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The group whose members the role is granted to.
+	GroupId       int32                  `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Role          PermissionRole         `protobuf:"varint,3,opt,name=role,proto3,enum=monotreme.api.v1.PermissionRole" json:"role,omitempty"`
+	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_api_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Permission) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Permission) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *Permission) GetRole() PermissionRole {
+	if x != nil {
+		return x.Role
+	}
+	return PermissionRole_PERMISSION_ROLE_UNSPECIFIED
+}
+
+func (x *Permission) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
 var File_api_v1_common_proto protoreflect.FileDescriptor
 
 const file_api_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/v1/common.proto\x12\x10monotreme.api.v1*8\n" +
+	"\x13api/v1/common.proto\x12\x10monotreme.api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x01\n" +
+	"\n" +
+	"Permission\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x05R\agroupId\x124\n" +
+	"\x04role\x18\x03 \x01(\x0e2 .monotreme.api.v1.PermissionRoleR\x04role\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime*8\n" +
 	"\x05State\x12\x15\n" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06ACTIVE\x10\x01\x12\f\n" +
-	"\bINACTIVE\x10\x02*C\n" +
+	"\bINACTIVE\x10\x02*[\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x02B\xb9\x01\n" +
+	"\x06PUBLIC\x10\x02\x12\v\n" +
+	"\aPRIVATE\x10\x03\x12\t\n" +
+	"\x05GROUP\x10\x04*\xa3\x01\n" +
+	"\fRedirectMode\x12\x1d\n" +
+	"\x19REDIRECT_MODE_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05FOUND\x10\x01\x12\x15\n" +
+	"\x11MOVED_PERMANENTLY\x10\x02\x12\x16\n" +
+	"\x12TEMPORARY_REDIRECT\x10\x03\x12\x16\n" +
+	"\x12PERMANENT_REDIRECT\x10\x04\x12\x10\n" +
+	"\fMETA_REFRESH\x10\x05\x12\x10\n" +
+	"\fINTERSTITIAL\x10\x06*T\n" +
+	"\x0ePermissionRole\x12\x1f\n" +
+	"\x1bPERMISSION_ROLE_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x01\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x02\x12\t\n" +
+	"\x05OWNER\x10\x03B\xb9\x01\n" +
 	"\x14com.monotreme.api.v1B\vCommonProtoP\x01Z2github.com/bshort/monotreme/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\x10Monotreme.Api.V1\xca\x02\x10Monotreme\\Api\\V1\xe2\x02\x1cMonotreme\\Api\\V1\\GPBMetadata\xea\x02\x12Monotreme::Api::V1b\x06proto3"
 
 var (
@@ -149,17 +376,24 @@ func file_api_v1_common_proto_rawDescGZIP() []byte {
 	return file_api_v1_common_proto_rawDescData
 }
 
-var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_api_v1_common_proto_goTypes = []any{
-	(State)(0),      // 0: monotreme.api.v1.State
-	(Visibility)(0), // 1: monotreme.api.v1.Visibility
+	(State)(0),                    // 0: monotreme.api.v1.State
+	(Visibility)(0),               // 1: monotreme.api.v1.Visibility
+	(RedirectMode)(0),             // 2: monotreme.api.v1.RedirectMode
+	(PermissionRole)(0),           // 3: monotreme.api.v1.PermissionRole
+	(*Permission)(nil),            // 4: monotreme.api.v1.Permission
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_api_v1_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: monotreme.api.v1.Permission.role:type_name -> monotreme.api.v1.PermissionRole
+	5, // 1: monotreme.api.v1.Permission.created_time:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_common_proto_init() }
@@ -172,14 +406,15 @@ func file_api_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_common_proto_rawDesc), len(file_api_v1_common_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   0,
+			NumEnums:      4,
+			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_v1_common_proto_goTypes,
 		DependencyIndexes: file_api_v1_common_proto_depIdxs,
 		EnumInfos:         file_api_v1_common_proto_enumTypes,
+		MessageInfos:      file_api_v1_common_proto_msgTypes,
 	}.Build()
 	File_api_v1_common_proto = out.File
 	file_api_v1_common_proto_goTypes = nil
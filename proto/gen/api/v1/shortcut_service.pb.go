@@ -25,23 +25,175 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Shortcut_RoutingCondition_Field int32
+
+const (
+	Shortcut_RoutingCondition_FIELD_UNSPECIFIED Shortcut_RoutingCondition_Field = 0
+	// One of mobile, tablet, desktop or bot.
+	Shortcut_RoutingCondition_DEVICE Shortcut_RoutingCondition_Field = 1
+	// One of ios, android, windows, macos, linux or chromeos.
+	Shortcut_RoutingCondition_OS Shortcut_RoutingCondition_Field = 2
+	// The browser name, such as chrome, firefox, safari or edge.
+	Shortcut_RoutingCondition_BROWSER Shortcut_RoutingCondition_Field = 3
+	// A language tag matched against the most preferred Accept-Language, such as de or de-AT.
+	Shortcut_RoutingCondition_LANGUAGE Shortcut_RoutingCondition_Field = 4
+	// The request header named by header.
+	Shortcut_RoutingCondition_HEADER Shortcut_RoutingCondition_Field = 5
+)
+
+// Enum value maps for Shortcut_RoutingCondition_Field.
+var (
+	Shortcut_RoutingCondition_Field_name = map[int32]string{
+		0: "FIELD_UNSPECIFIED",
+		1: "DEVICE",
+		2: "OS",
+		3: "BROWSER",
+		4: "LANGUAGE",
+		5: "HEADER",
+	}
+	Shortcut_RoutingCondition_Field_value = map[string]int32{
+		"FIELD_UNSPECIFIED": 0,
+		"DEVICE":            1,
+		"OS":                2,
+		"BROWSER":           3,
+		"LANGUAGE":          4,
+		"HEADER":            5,
+	}
+)
+
+func (x Shortcut_RoutingCondition_Field) Enum() *Shortcut_RoutingCondition_Field {
+	p := new(Shortcut_RoutingCondition_Field)
+	*p = x
+	return p
+}
+
+func (x Shortcut_RoutingCondition_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Shortcut_RoutingCondition_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Shortcut_RoutingCondition_Field) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[0]
+}
+
+func (x Shortcut_RoutingCondition_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Shortcut_RoutingCondition_Field.Descriptor instead.
+func (Shortcut_RoutingCondition_Field) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 3, 0}
+}
+
+type ShortcutChangeRequest_Status int32
+
+const (
+	ShortcutChangeRequest_STATUS_UNSPECIFIED ShortcutChangeRequest_Status = 0
+	ShortcutChangeRequest_PENDING            ShortcutChangeRequest_Status = 1
+	ShortcutChangeRequest_APPROVED           ShortcutChangeRequest_Status = 2
+	ShortcutChangeRequest_REJECTED           ShortcutChangeRequest_Status = 3
+)
+
+// Enum value maps for ShortcutChangeRequest_Status.
+var (
+	ShortcutChangeRequest_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "APPROVED",
+		3: "REJECTED",
+	}
+	ShortcutChangeRequest_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"APPROVED":           2,
+		"REJECTED":           3,
+	}
+)
+
+func (x ShortcutChangeRequest_Status) Enum() *ShortcutChangeRequest_Status {
+	p := new(ShortcutChangeRequest_Status)
+	*p = x
+	return p
+}
+
+func (x ShortcutChangeRequest_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShortcutChangeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ShortcutChangeRequest_Status) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[1]
+}
+
+func (x ShortcutChangeRequest_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShortcutChangeRequest_Status.Descriptor instead.
+func (ShortcutChangeRequest_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{31, 0}
+}
+
 type Shortcut struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Id            int32                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid          string                      `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreatorId     int32                       `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTime   *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	UpdatedTime   *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
-	Name          string                      `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Link          string                      `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
-	Title         string                      `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Tags          []string                    `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Description   string                      `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    Visibility                  `protobuf:"varint,11,opt,name=visibility,proto3,enum=monotreme.api.v1.Visibility" json:"visibility,omitempty"`
-	ViewCount     int32                       `protobuf:"varint,12,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	OgMetadata    *Shortcut_OpenGraphMetadata `protobuf:"bytes,13,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState      `protogen:"open.v1"`
+	Id          int32                       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid        string                      `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreatorId   int32                       `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Name        string                      `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Link        string                      `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Title       string                      `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Tags        []string                    `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Description string                      `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility  Visibility                  `protobuf:"varint,11,opt,name=visibility,proto3,enum=monotreme.api.v1.Visibility" json:"visibility,omitempty"`
+	ViewCount   int32                       `protobuf:"varint,12,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	OgMetadata  *Shortcut_OpenGraphMetadata `protobuf:"bytes,13,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	// Whether the link is a template whose {placeholders} are filled from the visited path and query.
+	Template bool `protobuf:"varint,14,opt,name=template,proto3" json:"template,omitempty"`
+	// Whether path segments after the shortcut name are appended to the link.
+	ForwardPath bool `protobuf:"varint,15,opt,name=forward_path,json=forwardPath,proto3" json:"forward_path,omitempty"`
+	// How visitors are sent to the link. Unspecified uses the workspace default.
+	RedirectMode RedirectMode `protobuf:"varint,16,opt,name=redirect_mode,json=redirectMode,proto3,enum=monotreme.api.v1.RedirectMode" json:"redirect_mode,omitempty"`
+	// The time from which the shortcut resolves. Unset means no start.
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// The time at which the shortcut expires. Unset means no expiry.
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// Expired shortcuts become inactive when they are archived.
+	State State `protobuf:"varint,19,opt,name=state,proto3,enum=monotreme.api.v1.State" json:"state,omitempty"`
+	// Links to split the traffic across by weight. When set, they are served instead of the link.
+	Variants []*Shortcut_Variant `protobuf:"bytes,20,rep,name=variants,proto3" json:"variants,omitempty"`
+	// Whether a visitor keeps being served the same variant, keyed on a cookie.
+	StickyVariants bool `protobuf:"varint,21,opt,name=sticky_variants,json=stickyVariants,proto3" json:"sticky_variants,omitempty"`
+	// Rules that send matching requests elsewhere. The first matching rule wins; otherwise the link is used.
+	RoutingRules []*Shortcut_RoutingRule `protobuf:"bytes,22,rep,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	// Links served in order when the link is unhealthy.
+	BackupLinks []string `protobuf:"bytes,23,rep,name=backup_links,json=backupLinks,proto3" json:"backup_links,omitempty"`
+	// The last health check of the link and each backup link, if any has been checked.
+	TargetHealth []*Shortcut_TargetHealth `protobuf:"bytes,24,rep,name=target_health,json=targetHealth,proto3" json:"target_health,omitempty"`
+	// Other names that resolve to this shortcut. Visits through an alias count towards the shortcut.
+	Aliases []string `protobuf:"bytes,25,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// The time the shortcut was moved to the trash, unset if it is not in the trash.
+	DeletedTime *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=deleted_time,json=deletedTime,proto3" json:"deleted_time,omitempty"`
+	// The time the shortcut will be purged from the trash.
+	PurgeTime *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=purge_time,json=purgeTime,proto3" json:"purge_time,omitempty"`
+	// The groups a GROUP shortcut is visible to.
+	GroupIds []int32 `protobuf:"varint,28,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Whether edits to the shortcut must be approved by another person. Only owners can change it.
+	Protected bool `protobuf:"varint,29,opt,name=protected,proto3" json:"protected,omitempty"`
+	// Whether edits to the shortcut create a change request instead of being applied, because the
+	// shortcut is protected or its name is in a namespace that requires approval.
+	RequiresApproval bool `protobuf:"varint,30,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	// The number of change requests waiting for review.
+	PendingChangeRequestCount int32 `protobuf:"varint,31,opt,name=pending_change_request_count,json=pendingChangeRequestCount,proto3" json:"pending_change_request_count,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *Shortcut) Reset() {
@@ -165,10 +317,138 @@ func (x *Shortcut) GetOgMetadata() *Shortcut_OpenGraphMetadata {
 	return nil
 }
 
+func (x *Shortcut) GetTemplate() bool {
+	if x != nil {
+		return x.Template
+	}
+	return false
+}
+
+func (x *Shortcut) GetForwardPath() bool {
+	if x != nil {
+		return x.ForwardPath
+	}
+	return false
+}
+
+func (x *Shortcut) GetRedirectMode() RedirectMode {
+	if x != nil {
+		return x.RedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *Shortcut) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Shortcut) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *Shortcut) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *Shortcut) GetVariants() []*Shortcut_Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Shortcut) GetStickyVariants() bool {
+	if x != nil {
+		return x.StickyVariants
+	}
+	return false
+}
+
+func (x *Shortcut) GetRoutingRules() []*Shortcut_RoutingRule {
+	if x != nil {
+		return x.RoutingRules
+	}
+	return nil
+}
+
+func (x *Shortcut) GetBackupLinks() []string {
+	if x != nil {
+		return x.BackupLinks
+	}
+	return nil
+}
+
+func (x *Shortcut) GetTargetHealth() []*Shortcut_TargetHealth {
+	if x != nil {
+		return x.TargetHealth
+	}
+	return nil
+}
+
+func (x *Shortcut) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Shortcut) GetDeletedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedTime
+	}
+	return nil
+}
+
+func (x *Shortcut) GetPurgeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeTime
+	}
+	return nil
+}
+
+func (x *Shortcut) GetGroupIds() []int32 {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+func (x *Shortcut) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Shortcut) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Shortcut) GetPendingChangeRequestCount() int32 {
+	if x != nil {
+		return x.PendingChangeRequestCount
+	}
+	return 0
+}
+
 type ListShortcutsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to leave out shortcuts that have expired.
+	ExcludeExpired bool `protobuf:"varint,1,opt,name=exclude_expired,json=excludeExpired,proto3" json:"exclude_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListShortcutsRequest) Reset() {
@@ -201,6 +481,13 @@ func (*ListShortcutsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ListShortcutsRequest) GetExcludeExpired() bool {
+	if x != nil {
+		return x.ExcludeExpired
+	}
+	return false
+}
+
 type ListShortcutsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Shortcuts     []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
@@ -378,11 +665,13 @@ func (x *CreateShortcutRequest) GetShortcut() *Shortcut {
 }
 
 type UpdateShortcutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Shortcut      *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Shortcut   *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Whether the current name is kept as an alias when the name is changed.
+	KeepOldNameAsAlias bool `protobuf:"varint,3,opt,name=keep_old_name_as_alias,json=keepOldNameAsAlias,proto3" json:"keep_old_name_as_alias,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateShortcutRequest) Reset() {
@@ -429,6 +718,13 @@ func (x *UpdateShortcutRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	return nil
 }
 
+func (x *UpdateShortcutRequest) GetKeepOldNameAsAlias() bool {
+	if x != nil {
+		return x.KeepOldNameAsAlias
+	}
+	return false
+}
+
 type DeleteShortcutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -473,27 +769,26 @@ func (x *DeleteShortcutRequest) GetId() int32 {
 	return 0
 }
 
-type GetShortcutAnalyticsRequest struct {
+type ListTrashedShortcutsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsRequest) Reset() {
-	*x = GetShortcutAnalyticsRequest{}
+func (x *ListTrashedShortcutsRequest) Reset() {
+	*x = ListTrashedShortcutsRequest{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsRequest) String() string {
+func (x *ListTrashedShortcutsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsRequest) ProtoMessage() {}
+func (*ListTrashedShortcutsRequest) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTrashedShortcutsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,41 +800,32 @@ func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTrashedShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedShortcutsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetShortcutAnalyticsRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-type GetShortcutAnalyticsResponse struct {
-	state         protoimpl.MessageState                        `protogen:"open.v1"`
-	References    []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
-	Devices       []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
-	Browsers      []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,3,rep,name=browsers,proto3" json:"browsers,omitempty"`
+type ListTrashedShortcutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shortcuts     []*Shortcut            `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsResponse) Reset() {
-	*x = GetShortcutAnalyticsResponse{}
+func (x *ListTrashedShortcutsResponse) Reset() {
+	*x = ListTrashedShortcutsResponse{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsResponse) String() string {
+func (x *ListTrashedShortcutsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsResponse) ProtoMessage() {}
+func (*ListTrashedShortcutsResponse) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTrashedShortcutsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,55 +837,39 @@ func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsResponse.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTrashedShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedShortcutsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetShortcutAnalyticsResponse) GetReferences() []*GetShortcutAnalyticsResponse_AnalyticsItem {
-	if x != nil {
-		return x.References
-	}
-	return nil
-}
-
-func (x *GetShortcutAnalyticsResponse) GetDevices() []*GetShortcutAnalyticsResponse_AnalyticsItem {
-	if x != nil {
-		return x.Devices
-	}
-	return nil
-}
-
-func (x *GetShortcutAnalyticsResponse) GetBrowsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+func (x *ListTrashedShortcutsResponse) GetShortcuts() []*Shortcut {
 	if x != nil {
-		return x.Browsers
+		return x.Shortcuts
 	}
 	return nil
 }
 
-type Shortcut_OpenGraphMetadata struct {
+type RestoreShortcutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Shortcut_OpenGraphMetadata) Reset() {
-	*x = Shortcut_OpenGraphMetadata{}
+func (x *RestoreShortcutRequest) Reset() {
+	*x = RestoreShortcutRequest{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Shortcut_OpenGraphMetadata) String() string {
+func (x *RestoreShortcutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shortcut_OpenGraphMetadata) ProtoMessage() {}
+func (*RestoreShortcutRequest) ProtoMessage() {}
 
-func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
+func (x *RestoreShortcutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,54 +881,39 @@ func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shortcut_OpenGraphMetadata.ProtoReflect.Descriptor instead.
-func (*Shortcut_OpenGraphMetadata) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *Shortcut_OpenGraphMetadata) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Shortcut_OpenGraphMetadata) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use RestoreShortcutRequest.ProtoReflect.Descriptor instead.
+func (*RestoreShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Shortcut_OpenGraphMetadata) GetImage() string {
+func (x *RestoreShortcutRequest) GetId() int32 {
 	if x != nil {
-		return x.Image
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type GetShortcutAnalyticsResponse_AnalyticsItem struct {
+type PurgeShortcutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) Reset() {
-	*x = GetShortcutAnalyticsResponse_AnalyticsItem{}
+func (x *PurgeShortcutRequest) Reset() {
+	*x = PurgeShortcutRequest{}
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) String() string {
+func (x *PurgeShortcutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShortcutAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
+func (*PurgeShortcutRequest) ProtoMessage() {}
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
+func (x *PurgeShortcutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_shortcut_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -670,35 +925,1838 @@ func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShortcutAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
-func (*GetShortcutAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
-	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use PurgeShortcutRequest.ProtoReflect.Descriptor instead.
+func (*PurgeShortcutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetName() string {
+func (x *PurgeShortcutRequest) GetId() int32 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+type GetShortcutAnalyticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
+func (x *GetShortcutAnalyticsRequest) Reset() {
+	*x = GetShortcutAnalyticsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_api_v1_shortcut_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1dapi/v1/shortcut_service.proto\x12\x10monotreme.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x04\n" +
-	"\bShortcut\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04uuid\x18\x05 \x01(\tR\x04uuid\x12\x1d\n" +
-	"\n" +
-	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
+func (x *GetShortcutAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetShortcutAnalyticsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetShortcutAnalyticsResponse struct {
+	state      protoimpl.MessageState                        `protogen:"open.v1"`
+	References []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	Devices    []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,2,rep,name=devices,proto3" json:"devices,omitempty"`
+	Browsers   []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,3,rep,name=browsers,proto3" json:"browsers,omitempty"`
+	// Clicks per variant served, for shortcuts with variants.
+	Variants []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	// Fetches by known bots, which are left out of the other counts.
+	Bots          []*GetShortcutAnalyticsResponse_AnalyticsItem `protobuf:"bytes,5,rep,name=bots,proto3" json:"bots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsResponse) Reset() {
+	*x = GetShortcutAnalyticsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetShortcutAnalyticsResponse) GetReferences() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetDevices() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetBrowsers() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Browsers
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetVariants() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *GetShortcutAnalyticsResponse) GetBots() []*GetShortcutAnalyticsResponse_AnalyticsItem {
+	if x != nil {
+		return x.Bots
+	}
+	return nil
+}
+
+type ShortcutLinkChange struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId  int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// The time from which the link is in effect.
+	EffectiveTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+	Link          string                 `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	// The time the change was applied to the shortcut, unset while pending.
+	AppliedTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=applied_time,json=appliedTime,proto3" json:"applied_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutLinkChange) Reset() {
+	*x = ShortcutLinkChange{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutLinkChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutLinkChange) ProtoMessage() {}
+
+func (x *ShortcutLinkChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutLinkChange.ProtoReflect.Descriptor instead.
+func (*ShortcutLinkChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ShortcutLinkChange) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutLinkChange) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutLinkChange) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutLinkChange) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ShortcutLinkChange) GetEffectiveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTime
+	}
+	return nil
+}
+
+func (x *ShortcutLinkChange) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ShortcutLinkChange) GetAppliedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedTime
+	}
+	return nil
+}
+
+type ListShortcutLinkChangesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// Whether to only return changes that have not been applied yet.
+	PendingOnly   bool `protobuf:"varint,2,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutLinkChangesRequest) Reset() {
+	*x = ListShortcutLinkChangesRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutLinkChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutLinkChangesRequest) ProtoMessage() {}
+
+func (x *ListShortcutLinkChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutLinkChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutLinkChangesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListShortcutLinkChangesRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ListShortcutLinkChangesRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+type ListShortcutLinkChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkChanges   []*ShortcutLinkChange  `protobuf:"bytes,1,rep,name=link_changes,json=linkChanges,proto3" json:"link_changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutLinkChangesResponse) Reset() {
+	*x = ListShortcutLinkChangesResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutLinkChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutLinkChangesResponse) ProtoMessage() {}
+
+func (x *ListShortcutLinkChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutLinkChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutLinkChangesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListShortcutLinkChangesResponse) GetLinkChanges() []*ShortcutLinkChange {
+	if x != nil {
+		return x.LinkChanges
+	}
+	return nil
+}
+
+type CreateShortcutLinkChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkChange    *ShortcutLinkChange    `protobuf:"bytes,1,opt,name=link_change,json=linkChange,proto3" json:"link_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShortcutLinkChangeRequest) Reset() {
+	*x = CreateShortcutLinkChangeRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShortcutLinkChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShortcutLinkChangeRequest) ProtoMessage() {}
+
+func (x *CreateShortcutLinkChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShortcutLinkChangeRequest.ProtoReflect.Descriptor instead.
+func (*CreateShortcutLinkChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateShortcutLinkChangeRequest) GetLinkChange() *ShortcutLinkChange {
+	if x != nil {
+		return x.LinkChange
+	}
+	return nil
+}
+
+type CancelShortcutLinkChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelShortcutLinkChangeRequest) Reset() {
+	*x = CancelShortcutLinkChangeRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelShortcutLinkChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShortcutLinkChangeRequest) ProtoMessage() {}
+
+func (x *CancelShortcutLinkChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShortcutLinkChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelShortcutLinkChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelShortcutLinkChangeRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *CancelShortcutLinkChangeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DryRunShortcutRoutingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The request headers to route, such as User-Agent and Accept-Language.
+	Headers       map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunShortcutRoutingRequest) Reset() {
+	*x = DryRunShortcutRoutingRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunShortcutRoutingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunShortcutRoutingRequest) ProtoMessage() {}
+
+func (x *DryRunShortcutRoutingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunShortcutRoutingRequest.ProtoReflect.Descriptor instead.
+func (*DryRunShortcutRoutingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DryRunShortcutRoutingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DryRunShortcutRoutingRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type DryRunShortcutRoutingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The link the request would be sent to, before any template or path forwarding.
+	Link string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// The 0-based index of the matching rule, or -1 when the shortcut link is used.
+	MatchedRule int32 `protobuf:"varint,2,opt,name=matched_rule,json=matchedRule,proto3" json:"matched_rule,omitempty"`
+	// The request properties the rules were matched against.
+	Device        string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	Os            string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	Language      string `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunShortcutRoutingResponse) Reset() {
+	*x = DryRunShortcutRoutingResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunShortcutRoutingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunShortcutRoutingResponse) ProtoMessage() {}
+
+func (x *DryRunShortcutRoutingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunShortcutRoutingResponse.ProtoReflect.Descriptor instead.
+func (*DryRunShortcutRoutingResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DryRunShortcutRoutingResponse) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *DryRunShortcutRoutingResponse) GetMatchedRule() int32 {
+	if x != nil {
+		return x.MatchedRule
+	}
+	return 0
+}
+
+func (x *DryRunShortcutRoutingResponse) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DryRunShortcutRoutingResponse) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *DryRunShortcutRoutingResponse) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+func (x *DryRunShortcutRoutingResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// ShortcutRevision is a recorded change of a shortcut.
+type ShortcutRevision struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The user who made the change, or 0 for changes made by the system.
+	CreatorId   int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// The field-level diff of the change. It is empty for the baseline revision,
+	// which records the shortcut as it was before its first recorded change.
+	Changes []*ShortcutRevision_FieldChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	// The revision that was restored by this one, if it is a rollback.
+	RestoredRevisionId int32 `protobuf:"varint,6,opt,name=restored_revision_id,json=restoredRevisionId,proto3" json:"restored_revision_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ShortcutRevision) Reset() {
+	*x = ShortcutRevision{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRevision) ProtoMessage() {}
+
+func (x *ShortcutRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRevision.ProtoReflect.Descriptor instead.
+func (*ShortcutRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ShortcutRevision) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutRevision) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ShortcutRevision) GetChanges() []*ShortcutRevision_FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ShortcutRevision) GetRestoredRevisionId() int32 {
+	if x != nil {
+		return x.RestoredRevisionId
+	}
+	return 0
+}
+
+type ListShortcutRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutRevisionsRequest) Reset() {
+	*x = ListShortcutRevisionsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutRevisionsRequest) ProtoMessage() {}
+
+func (x *ListShortcutRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListShortcutRevisionsRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+type ListShortcutRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ShortcutRevision    `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutRevisionsResponse) Reset() {
+	*x = ListShortcutRevisionsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutRevisionsResponse) ProtoMessage() {}
+
+func (x *ListShortcutRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListShortcutRevisionsResponse) GetRevisions() []*ShortcutRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RestoreShortcutRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreShortcutRevisionRequest) Reset() {
+	*x = RestoreShortcutRevisionRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreShortcutRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreShortcutRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreShortcutRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreShortcutRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreShortcutRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreShortcutRevisionRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *RestoreShortcutRevisionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreShortcutRevisionResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Shortcut *Shortcut              `protobuf:"bytes,1,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The revision recording the rollback, unset if the shortcut already matched.
+	Revision      *ShortcutRevision `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreShortcutRevisionResponse) Reset() {
+	*x = RestoreShortcutRevisionResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreShortcutRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreShortcutRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreShortcutRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreShortcutRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreShortcutRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreShortcutRevisionResponse) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+func (x *RestoreShortcutRevisionResponse) GetRevision() *ShortcutRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type ListShortcutPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutPermissionsRequest) Reset() {
+	*x = ListShortcutPermissionsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutPermissionsRequest) ProtoMessage() {}
+
+func (x *ListShortcutPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListShortcutPermissionsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListShortcutPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutPermissionsResponse) Reset() {
+	*x = ListShortcutPermissionsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutPermissionsResponse) ProtoMessage() {}
+
+func (x *ListShortcutPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListShortcutPermissionsResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type GrantShortcutPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Permission    *Permission            `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantShortcutPermissionRequest) Reset() {
+	*x = GrantShortcutPermissionRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantShortcutPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantShortcutPermissionRequest) ProtoMessage() {}
+
+func (x *GrantShortcutPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantShortcutPermissionRequest.ProtoReflect.Descriptor instead.
+func (*GrantShortcutPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GrantShortcutPermissionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GrantShortcutPermissionRequest) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+type RevokeShortcutPermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user whose role is revoked. Exactly one of user_id and group_id is set.
+	UserId int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The group whose role is revoked.
+	GroupId       int32 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShortcutPermissionRequest) Reset() {
+	*x = RevokeShortcutPermissionRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShortcutPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShortcutPermissionRequest) ProtoMessage() {}
+
+func (x *RevokeShortcutPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShortcutPermissionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShortcutPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeShortcutPermissionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeShortcutPermissionRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeShortcutPermissionRequest) GetGroupId() int32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type TransferShortcutOwnershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The user who becomes the creator.
+	UserId        int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferShortcutOwnershipRequest) Reset() {
+	*x = TransferShortcutOwnershipRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferShortcutOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferShortcutOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferShortcutOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferShortcutOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferShortcutOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TransferShortcutOwnershipRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransferShortcutOwnershipRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ShortcutChangeRequest is an edit of a protected shortcut waiting for, or after, review.
+type ShortcutChangeRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	// The user who requested the change.
+	CreatorId   int32                        `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTime *timestamppb.Timestamp       `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	UpdatedTime *timestamppb.Timestamp       `protobuf:"bytes,5,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
+	Status      ShortcutChangeRequest_Status `protobuf:"varint,6,opt,name=status,proto3,enum=monotreme.api.v1.ShortcutChangeRequest_Status" json:"status,omitempty"`
+	// The user who approved or rejected the change, or 0 while pending.
+	ReviewerId int32 `protobuf:"varint,7,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// The field-level diff of the change, against the shortcut when the change was requested.
+	Changes       []*ShortcutRevision_FieldChange `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutChangeRequest) Reset() {
+	*x = ShortcutChangeRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutChangeRequest) ProtoMessage() {}
+
+func (x *ShortcutChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutChangeRequest.ProtoReflect.Descriptor instead.
+func (*ShortcutChangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ShortcutChangeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutChangeRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutChangeRequest) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutChangeRequest) GetCreatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequest) GetUpdatedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTime
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequest) GetStatus() ShortcutChangeRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ShortcutChangeRequest_STATUS_UNSPECIFIED
+}
+
+func (x *ShortcutChangeRequest) GetReviewerId() int32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ShortcutChangeRequest) GetChanges() []*ShortcutRevision_FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ListShortcutChangeRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShortcutChangeRequestsRequest) Reset() {
+	*x = ListShortcutChangeRequestsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutChangeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutChangeRequestsRequest) ProtoMessage() {}
+
+func (x *ListShortcutChangeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutChangeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListShortcutChangeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListShortcutChangeRequestsRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+type ListShortcutChangeRequestsResponse struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	ChangeRequests []*ShortcutChangeRequest `protobuf:"bytes,1,rep,name=change_requests,json=changeRequests,proto3" json:"change_requests,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListShortcutChangeRequestsResponse) Reset() {
+	*x = ListShortcutChangeRequestsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShortcutChangeRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShortcutChangeRequestsResponse) ProtoMessage() {}
+
+func (x *ListShortcutChangeRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShortcutChangeRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListShortcutChangeRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListShortcutChangeRequestsResponse) GetChangeRequests() []*ShortcutChangeRequest {
+	if x != nil {
+		return x.ChangeRequests
+	}
+	return nil
+}
+
+type ApproveShortcutChangeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveShortcutChangeRequestRequest) Reset() {
+	*x = ApproveShortcutChangeRequestRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveShortcutChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveShortcutChangeRequestRequest) ProtoMessage() {}
+
+func (x *ApproveShortcutChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveShortcutChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveShortcutChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ApproveShortcutChangeRequestRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ApproveShortcutChangeRequestRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectShortcutChangeRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectShortcutChangeRequestRequest) Reset() {
+	*x = RejectShortcutChangeRequestRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectShortcutChangeRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectShortcutChangeRequestRequest) ProtoMessage() {}
+
+func (x *RejectShortcutChangeRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectShortcutChangeRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectShortcutChangeRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RejectShortcutChangeRequestRequest) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *RejectShortcutChangeRequestRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Shortcut_OpenGraphMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_OpenGraphMetadata) Reset() {
+	*x = Shortcut_OpenGraphMetadata{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_OpenGraphMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_OpenGraphMetadata) ProtoMessage() {}
+
+func (x *Shortcut_OpenGraphMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_OpenGraphMetadata.ProtoReflect.Descriptor instead.
+func (*Shortcut_OpenGraphMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Shortcut_OpenGraphMetadata) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type Shortcut_Variant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Link  string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	// The relative share of traffic served by this variant.
+	Weight        int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_Variant) Reset() {
+	*x = Shortcut_Variant{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_Variant) ProtoMessage() {}
+
+func (x *Shortcut_Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_Variant.ProtoReflect.Descriptor instead.
+func (*Shortcut_Variant) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Shortcut_Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shortcut_Variant) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Shortcut_Variant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Shortcut_RoutingRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All conditions must match for the rule to apply.
+	Conditions    []*Shortcut_RoutingCondition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Link          string                       `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_RoutingRule) Reset() {
+	*x = Shortcut_RoutingRule{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_RoutingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_RoutingRule) ProtoMessage() {}
+
+func (x *Shortcut_RoutingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_RoutingRule.ProtoReflect.Descriptor instead.
+func (*Shortcut_RoutingRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Shortcut_RoutingRule) GetConditions() []*Shortcut_RoutingCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Shortcut_RoutingRule) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type Shortcut_RoutingCondition struct {
+	state protoimpl.MessageState          `protogen:"open.v1"`
+	Field Shortcut_RoutingCondition_Field `protobuf:"varint,1,opt,name=field,proto3,enum=monotreme.api.v1.Shortcut_RoutingCondition_Field" json:"field,omitempty"`
+	// The header name, for HEADER conditions.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// The condition matches when the request has any of the values, compared case-insensitively.
+	// A HEADER condition without values matches when the header is present.
+	Values        []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_RoutingCondition) Reset() {
+	*x = Shortcut_RoutingCondition{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_RoutingCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_RoutingCondition) ProtoMessage() {}
+
+func (x *Shortcut_RoutingCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_RoutingCondition.ProtoReflect.Descriptor instead.
+func (*Shortcut_RoutingCondition) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Shortcut_RoutingCondition) GetField() Shortcut_RoutingCondition_Field {
+	if x != nil {
+		return x.Field
+	}
+	return Shortcut_RoutingCondition_FIELD_UNSPECIFIED
+}
+
+func (x *Shortcut_RoutingCondition) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *Shortcut_RoutingCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Shortcut_TargetHealth struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Link    string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Healthy bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// The HTTP status of the last check, or 0 if the request failed.
+	StatusCode int32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Why the last check failed, if it did.
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	CheckedTime   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=checked_time,json=checkedTime,proto3" json:"checked_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shortcut_TargetHealth) Reset() {
+	*x = Shortcut_TargetHealth{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shortcut_TargetHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shortcut_TargetHealth) ProtoMessage() {}
+
+func (x *Shortcut_TargetHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shortcut_TargetHealth.ProtoReflect.Descriptor instead.
+func (*Shortcut_TargetHealth) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *Shortcut_TargetHealth) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Shortcut_TargetHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *Shortcut_TargetHealth) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Shortcut_TargetHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Shortcut_TargetHealth) GetCheckedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedTime
+	}
+	return nil
+}
+
+type GetShortcutAnalyticsResponse_AnalyticsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) Reset() {
+	*x = GetShortcutAnalyticsResponse_AnalyticsItem{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShortcutAnalyticsResponse_AnalyticsItem) ProtoMessage() {}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShortcutAnalyticsResponse_AnalyticsItem.ProtoReflect.Descriptor instead.
+func (*GetShortcutAnalyticsResponse_AnalyticsItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetShortcutAnalyticsResponse_AnalyticsItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ShortcutRevision_FieldChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The field name, as in the UpdateShortcut update mask.
+	Field         string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	PreviousValue string `protobuf:"bytes,2,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutRevision_FieldChange) Reset() {
+	*x = ShortcutRevision_FieldChange{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRevision_FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRevision_FieldChange) ProtoMessage() {}
+
+func (x *ShortcutRevision_FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRevision_FieldChange.ProtoReflect.Descriptor instead.
+func (*ShortcutRevision_FieldChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *ShortcutRevision_FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ShortcutRevision_FieldChange) GetPreviousValue() string {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return ""
+}
+
+func (x *ShortcutRevision_FieldChange) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_shortcut_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapi/v1/shortcut_service.proto\x12\x10monotreme.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x10\n" +
+	"\bShortcut\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04uuid\x18\x05 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\x05R\tcreatorId\x12=\n" +
 	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
 	"\fupdated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x12\n" +
@@ -713,12 +2771,63 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\n" +
 	"view_count\x18\f \x01(\x05R\tviewCount\x12M\n" +
 	"\vog_metadata\x18\r \x01(\v2,.monotreme.api.v1.Shortcut.OpenGraphMetadataR\n" +
-	"ogMetadata\x1aa\n" +
+	"ogMetadata\x12\x1a\n" +
+	"\btemplate\x18\x0e \x01(\bR\btemplate\x12!\n" +
+	"\fforward_path\x18\x0f \x01(\bR\vforwardPath\x12C\n" +
+	"\rredirect_mode\x18\x10 \x01(\x0e2\x1e.monotreme.api.v1.RedirectModeR\fredirectMode\x129\n" +
+	"\n" +
+	"valid_from\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12-\n" +
+	"\x05state\x18\x13 \x01(\x0e2\x17.monotreme.api.v1.StateR\x05state\x12>\n" +
+	"\bvariants\x18\x14 \x03(\v2\".monotreme.api.v1.Shortcut.VariantR\bvariants\x12'\n" +
+	"\x0fsticky_variants\x18\x15 \x01(\bR\x0estickyVariants\x12K\n" +
+	"\rrouting_rules\x18\x16 \x03(\v2&.monotreme.api.v1.Shortcut.RoutingRuleR\froutingRules\x12!\n" +
+	"\fbackup_links\x18\x17 \x03(\tR\vbackupLinks\x12L\n" +
+	"\rtarget_health\x18\x18 \x03(\v2'.monotreme.api.v1.Shortcut.TargetHealthR\ftargetHealth\x12\x18\n" +
+	"\aaliases\x18\x19 \x03(\tR\aaliases\x12=\n" +
+	"\fdeleted_time\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\vdeletedTime\x129\n" +
+	"\n" +
+	"purge_time\x18\x1b \x01(\v2\x1a.google.protobuf.TimestampR\tpurgeTime\x12\x1b\n" +
+	"\tgroup_ids\x18\x1c \x03(\x05R\bgroupIds\x12\x1c\n" +
+	"\tprotected\x18\x1d \x01(\bR\tprotected\x12+\n" +
+	"\x11requires_approval\x18\x1e \x01(\bR\x10requiresApproval\x12?\n" +
+	"\x1cpending_change_request_count\x18\x1f \x01(\x05R\x19pendingChangeRequestCount\x1aa\n" +
 	"\x11OpenGraphMetadata\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x03 \x01(\tR\x05image\"\x16\n" +
-	"\x14ListShortcutsRequest\"Q\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x1aI\n" +
+	"\aVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x1an\n" +
+	"\vRoutingRule\x12K\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2+.monotreme.api.v1.Shortcut.RoutingConditionR\n" +
+	"conditions\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x1a\xe6\x01\n" +
+	"\x10RoutingCondition\x12G\n" +
+	"\x05field\x18\x01 \x01(\x0e21.monotreme.api.v1.Shortcut.RoutingCondition.FieldR\x05field\x12\x16\n" +
+	"\x06header\x18\x02 \x01(\tR\x06header\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"Y\n" +
+	"\x05Field\x12\x15\n" +
+	"\x11FIELD_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06DEVICE\x10\x01\x12\x06\n" +
+	"\x02OS\x10\x02\x12\v\n" +
+	"\aBROWSER\x10\x03\x12\f\n" +
+	"\bLANGUAGE\x10\x04\x12\n" +
+	"\n" +
+	"\x06HEADER\x10\x05\x1a\xb2\x01\n" +
+	"\fTargetHealth\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12\x1f\n" +
+	"\vstatus_code\x18\x03 \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12=\n" +
+	"\fchecked_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcheckedTime\"?\n" +
+	"\x14ListShortcutsRequest\x12'\n" +
+	"\x0fexclude_expired\x18\x01 \x01(\bR\x0eexcludeExpired\"Q\n" +
 	"\x15ListShortcutsResponse\x128\n" +
 	"\tshortcuts\x18\x01 \x03(\v2\x1a.monotreme.api.v1.ShortcutR\tshortcuts\"$\n" +
 	"\x12GetShortcutRequest\x12\x0e\n" +
@@ -726,24 +2835,141 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\x18GetShortcutByNameRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"O\n" +
 	"\x15CreateShortcutRequest\x126\n" +
-	"\bshortcut\x18\x01 \x01(\v2\x1a.monotreme.api.v1.ShortcutR\bshortcut\"\x8c\x01\n" +
+	"\bshortcut\x18\x01 \x01(\v2\x1a.monotreme.api.v1.ShortcutR\bshortcut\"\xc0\x01\n" +
 	"\x15UpdateShortcutRequest\x126\n" +
 	"\bshortcut\x18\x01 \x01(\v2\x1a.monotreme.api.v1.ShortcutR\bshortcut\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"'\n" +
+	"updateMask\x122\n" +
+	"\x16keep_old_name_as_alias\x18\x03 \x01(\bR\x12keepOldNameAsAlias\"'\n" +
 	"\x15DeleteShortcutRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1d\n" +
+	"\x1bListTrashedShortcutsRequest\"X\n" +
+	"\x1cListTrashedShortcutsResponse\x128\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x1a.monotreme.api.v1.ShortcutR\tshortcuts\"(\n" +
+	"\x16RestoreShortcutRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"&\n" +
+	"\x14PurgeShortcutRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
 	"\x1bGetShortcutAnalyticsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\xe9\x02\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x95\x04\n" +
 	"\x1cGetShortcutAnalyticsResponse\x12\\\n" +
 	"\n" +
 	"references\x18\x01 \x03(\v2<.monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\n" +
 	"references\x12V\n" +
 	"\adevices\x18\x02 \x03(\v2<.monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\adevices\x12X\n" +
-	"\bbrowsers\x18\x03 \x03(\v2<.monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bbrowsers\x1a9\n" +
+	"\bbrowsers\x18\x03 \x03(\v2<.monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bbrowsers\x12X\n" +
+	"\bvariants\x18\x04 \x03(\v2<.monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\bvariants\x12P\n" +
+	"\x04bots\x18\x05 \x03(\v2<.monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItemR\x04bots\x1a9\n" +
 	"\rAnalyticsItem\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count2\xa0\a\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xb9\x02\n" +
+	"\x12ShortcutLinkChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12A\n" +
+	"\x0eeffective_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveTime\x12\x12\n" +
+	"\x04link\x18\x06 \x01(\tR\x04link\x12=\n" +
+	"\fapplied_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vappliedTime\"d\n" +
+	"\x1eListShortcutLinkChangesRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12!\n" +
+	"\fpending_only\x18\x02 \x01(\bR\vpendingOnly\"j\n" +
+	"\x1fListShortcutLinkChangesResponse\x12G\n" +
+	"\flink_changes\x18\x01 \x03(\v2$.monotreme.api.v1.ShortcutLinkChangeR\vlinkChanges\"h\n" +
+	"\x1fCreateShortcutLinkChangeRequest\x12E\n" +
+	"\vlink_change\x18\x01 \x01(\v2$.monotreme.api.v1.ShortcutLinkChangeR\n" +
+	"linkChange\"R\n" +
+	"\x1fCancelShortcutLinkChangeRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\xc1\x01\n" +
+	"\x1cDryRunShortcutRoutingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12U\n" +
+	"\aheaders\x18\x02 \x03(\v2;.monotreme.api.v1.DryRunShortcutRoutingRequest.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb4\x01\n" +
+	"\x1dDryRunShortcutRoutingResponse\x12\x12\n" +
+	"\x04link\x18\x01 \x01(\tR\x04link\x12!\n" +
+	"\fmatched_rule\x18\x02 \x01(\x05R\vmatchedRule\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\x12\x0e\n" +
+	"\x02os\x18\x04 \x01(\tR\x02os\x12\x18\n" +
+	"\abrowser\x18\x05 \x01(\tR\abrowser\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\"\xff\x02\n" +
+	"\x10ShortcutRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12H\n" +
+	"\achanges\x18\x05 \x03(\v2..monotreme.api.v1.ShortcutRevision.FieldChangeR\achanges\x120\n" +
+	"\x14restored_revision_id\x18\x06 \x01(\x05R\x12restoredRevisionId\x1a`\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12%\n" +
+	"\x0eprevious_value\x18\x02 \x01(\tR\rpreviousValue\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"?\n" +
+	"\x1cListShortcutRevisionsRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\"a\n" +
+	"\x1dListShortcutRevisionsResponse\x12@\n" +
+	"\trevisions\x18\x01 \x03(\v2\".monotreme.api.v1.ShortcutRevisionR\trevisions\"Q\n" +
+	"\x1eRestoreShortcutRevisionRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\x99\x01\n" +
+	"\x1fRestoreShortcutRevisionResponse\x126\n" +
+	"\bshortcut\x18\x01 \x01(\v2\x1a.monotreme.api.v1.ShortcutR\bshortcut\x12>\n" +
+	"\brevision\x18\x02 \x01(\v2\".monotreme.api.v1.ShortcutRevisionR\brevision\"0\n" +
+	"\x1eListShortcutPermissionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"a\n" +
+	"\x1fListShortcutPermissionsResponse\x12>\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x1c.monotreme.api.v1.PermissionR\vpermissions\"n\n" +
+	"\x1eGrantShortcutPermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12<\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\v2\x1c.monotreme.api.v1.PermissionR\n" +
+	"permission\"e\n" +
+	"\x1fRevokeShortcutPermissionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\x05R\agroupId\"K\n" +
+	" TransferShortcutOwnershipRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"\xe3\x03\n" +
+	"\x15ShortcutChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12=\n" +
+	"\fcreated_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
+	"\fupdated_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12F\n" +
+	"\x06status\x18\x06 \x01(\x0e2..monotreme.api.v1.ShortcutChangeRequest.StatusR\x06status\x12\x1f\n" +
+	"\vreviewer_id\x18\a \x01(\x05R\n" +
+	"reviewerId\x12H\n" +
+	"\achanges\x18\b \x03(\v2..monotreme.api.v1.ShortcutRevision.FieldChangeR\achanges\"I\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\f\n" +
+	"\bAPPROVED\x10\x02\x12\f\n" +
+	"\bREJECTED\x10\x03\"D\n" +
+	"!ListShortcutChangeRequestsRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\"v\n" +
+	"\"ListShortcutChangeRequestsResponse\x12P\n" +
+	"\x0fchange_requests\x18\x01 \x03(\v2'.monotreme.api.v1.ShortcutChangeRequestR\x0echangeRequests\"V\n" +
+	"#ApproveShortcutChangeRequestRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"U\n" +
+	"\"RejectShortcutChangeRequestRequest\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id2\xe8\x1d\n" +
 	"\x0fShortcutService\x12{\n" +
 	"\rListShortcuts\x12&.monotreme.api.v1.ListShortcutsRequest\x1a'.monotreme.api.v1.ListShortcutsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/shortcuts\x12t\n" +
 	"\vGetShortcut\x12$.monotreme.api.v1.GetShortcutRequest\x1a\x1a.monotreme.api.v1.Shortcut\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/shortcuts/{id}\x12]\n" +
@@ -751,7 +2977,25 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\x0eCreateShortcut\x12'.monotreme.api.v1.CreateShortcutRequest\x1a\x1a.monotreme.api.v1.Shortcut\"#\x82\xd3\xe4\x93\x02\x1d:\bshortcut\"\x11/api/v1/shortcuts\x12\x9f\x01\n" +
 	"\x0eUpdateShortcut\x12'.monotreme.api.v1.UpdateShortcutRequest\x1a\x1a.monotreme.api.v1.Shortcut\"H\xdaA\x14shortcut,update_mask\x82\xd3\xe4\x93\x02+:\bshortcut\x1a\x1f/api/v1/shortcuts/{shortcut.id}\x12v\n" +
 	"\x0eDeleteShortcut\x12'.monotreme.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"#\xdaA\x02id\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/shortcuts/{id}\x12\xa4\x01\n" +
-	"\x14GetShortcutAnalytics\x12-.monotreme.api.v1.GetShortcutAnalyticsRequest\x1a..monotreme.api.v1.GetShortcutAnalyticsResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts/{id}/analyticsB\xc2\x01\n" +
+	"\x14GetShortcutAnalytics\x12-.monotreme.api.v1.GetShortcutAnalyticsRequest\x1a..monotreme.api.v1.GetShortcutAnalyticsResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/shortcuts/{id}/analytics\x12\xc2\x01\n" +
+	"\x17ListShortcutLinkChanges\x120.monotreme.api.v1.ListShortcutLinkChangesRequest\x1a1.monotreme.api.v1.ListShortcutLinkChangesResponse\"B\xdaA\vshortcut_id\x82\xd3\xe4\x93\x02.\x12,/api/v1/shortcuts/{shortcut_id}/link_changes\x12\xd0\x01\n" +
+	"\x18CreateShortcutLinkChange\x121.monotreme.api.v1.CreateShortcutLinkChangeRequest\x1a$.monotreme.api.v1.ShortcutLinkChange\"[\xdaA\vlink_change\x82\xd3\xe4\x93\x02G:\vlink_change\"8/api/v1/shortcuts/{link_change.shortcut_id}/link_changes\x12\xb1\x01\n" +
+	"\x18CancelShortcutLinkChange\x121.monotreme.api.v1.CancelShortcutLinkChangeRequest\x1a\x16.google.protobuf.Empty\"J\xdaA\x0eshortcut_id,id\x82\xd3\xe4\x93\x023*1/api/v1/shortcuts/{shortcut_id}/link_changes/{id}\x12\xaa\x01\n" +
+	"\x15DryRunShortcutRouting\x12..monotreme.api.v1.DryRunShortcutRoutingRequest\x1a/.monotreme.api.v1.DryRunShortcutRoutingResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/shortcuts/{id}/routing:dryRun\x12\x96\x01\n" +
+	"\x14ListTrashedShortcuts\x12-.monotreme.api.v1.ListTrashedShortcutsRequest\x1a..monotreme.api.v1.ListTrashedShortcutsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/trash/shortcuts\x12\x8a\x01\n" +
+	"\x0fRestoreShortcut\x12(.monotreme.api.v1.RestoreShortcutRequest\x1a\x1a.monotreme.api.v1.Shortcut\"1\xdaA\x02id\x82\xd3\xe4\x93\x02&\"$/api/v1/trash/shortcuts/{id}:restore\x12z\n" +
+	"\rPurgeShortcut\x12&.monotreme.api.v1.PurgeShortcutRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x02id\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/trash/shortcuts/{id}\x12\xb9\x01\n" +
+	"\x15ListShortcutRevisions\x12..monotreme.api.v1.ListShortcutRevisionsRequest\x1a/.monotreme.api.v1.ListShortcutRevisionsResponse\"?\xdaA\vshortcut_id\x82\xd3\xe4\x93\x02+\x12)/api/v1/shortcuts/{shortcut_id}/revisions\x12\xcf\x01\n" +
+	"\x17RestoreShortcutRevision\x120.monotreme.api.v1.RestoreShortcutRevisionRequest\x1a1.monotreme.api.v1.RestoreShortcutRevisionResponse\"O\xdaA\x0eshortcut_id,id\x82\xd3\xe4\x93\x028\"6/api/v1/shortcuts/{shortcut_id}/revisions/{id}:restore\x12\xaf\x01\n" +
+	"\x17ListShortcutPermissions\x120.monotreme.api.v1.ListShortcutPermissionsRequest\x1a1.monotreme.api.v1.ListShortcutPermissionsResponse\"/\xdaA\x02id\x82\xd3\xe4\x93\x02$\x12\"/api/v1/shortcuts/{id}/permissions\x12\xb1\x01\n" +
+	"\x17GrantShortcutPermission\x120.monotreme.api.v1.GrantShortcutPermissionRequest\x1a\x1c.monotreme.api.v1.Permission\"F\xdaA\rid,permission\x82\xd3\xe4\x93\x020:\n" +
+	"permission\"\"/api/v1/shortcuts/{id}/permissions\x12\x91\x01\n" +
+	"\x18RevokeShortcutPermission\x121.monotreme.api.v1.RevokeShortcutPermissionRequest\x1a\x16.google.protobuf.Empty\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/shortcuts/{id}/permissions\x12\xa4\x01\n" +
+	"\x19TransferShortcutOwnership\x122.monotreme.api.v1.TransferShortcutOwnershipRequest\x1a\x1a.monotreme.api.v1.Shortcut\"7\xdaA\n" +
+	"id,user_id\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/shortcuts/{id}:transfer\x12\xce\x01\n" +
+	"\x1aListShortcutChangeRequests\x123.monotreme.api.v1.ListShortcutChangeRequestsRequest\x1a4.monotreme.api.v1.ListShortcutChangeRequestsResponse\"E\xdaA\vshortcut_id\x82\xd3\xe4\x93\x021\x12//api/v1/shortcuts/{shortcut_id}/change_requests\x12\xd5\x01\n" +
+	"\x1cApproveShortcutChangeRequest\x125.monotreme.api.v1.ApproveShortcutChangeRequestRequest\x1a'.monotreme.api.v1.ShortcutChangeRequest\"U\xdaA\x0eshortcut_id,id\x82\xd3\xe4\x93\x02>\"</api/v1/shortcuts/{shortcut_id}/change_requests/{id}:approve\x12\xd2\x01\n" +
+	"\x1bRejectShortcutChangeRequest\x124.monotreme.api.v1.RejectShortcutChangeRequestRequest\x1a'.monotreme.api.v1.ShortcutChangeRequest\"T\xdaA\x0eshortcut_id,id\x82\xd3\xe4\x93\x02=\";/api/v1/shortcuts/{shortcut_id}/change_requests/{id}:rejectB\xc2\x01\n" +
 	"\x14com.monotreme.api.v1B\x14ShortcutServiceProtoP\x01Z2github.com/bshort/monotreme/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\x10Monotreme.Api.V1\xca\x02\x10Monotreme\\Api\\V1\xe2\x02\x1cMonotreme\\Api\\V1\\GPBMetadata\xea\x02\x12Monotreme::Api::V1b\x06proto3"
 
 var (
@@ -766,56 +3010,159 @@ func file_api_v1_shortcut_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_shortcut_service_proto_rawDescData
 }
 
-var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_shortcut_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_api_v1_shortcut_service_proto_goTypes = []any{
-	(*Shortcut)(nil),                                   // 0: monotreme.api.v1.Shortcut
-	(*ListShortcutsRequest)(nil),                       // 1: monotreme.api.v1.ListShortcutsRequest
-	(*ListShortcutsResponse)(nil),                      // 2: monotreme.api.v1.ListShortcutsResponse
-	(*GetShortcutRequest)(nil),                         // 3: monotreme.api.v1.GetShortcutRequest
-	(*GetShortcutByNameRequest)(nil),                   // 4: monotreme.api.v1.GetShortcutByNameRequest
-	(*CreateShortcutRequest)(nil),                      // 5: monotreme.api.v1.CreateShortcutRequest
-	(*UpdateShortcutRequest)(nil),                      // 6: monotreme.api.v1.UpdateShortcutRequest
-	(*DeleteShortcutRequest)(nil),                      // 7: monotreme.api.v1.DeleteShortcutRequest
-	(*GetShortcutAnalyticsRequest)(nil),                // 8: monotreme.api.v1.GetShortcutAnalyticsRequest
-	(*GetShortcutAnalyticsResponse)(nil),               // 9: monotreme.api.v1.GetShortcutAnalyticsResponse
-	(*Shortcut_OpenGraphMetadata)(nil),                 // 10: monotreme.api.v1.Shortcut.OpenGraphMetadata
-	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil), // 11: monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	(*timestamppb.Timestamp)(nil),                      // 12: google.protobuf.Timestamp
-	(Visibility)(0),                                    // 13: monotreme.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),                      // 14: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                              // 15: google.protobuf.Empty
+	(Shortcut_RoutingCondition_Field)(0),               // 0: monotreme.api.v1.Shortcut.RoutingCondition.Field
+	(ShortcutChangeRequest_Status)(0),                  // 1: monotreme.api.v1.ShortcutChangeRequest.Status
+	(*Shortcut)(nil),                                   // 2: monotreme.api.v1.Shortcut
+	(*ListShortcutsRequest)(nil),                       // 3: monotreme.api.v1.ListShortcutsRequest
+	(*ListShortcutsResponse)(nil),                      // 4: monotreme.api.v1.ListShortcutsResponse
+	(*GetShortcutRequest)(nil),                         // 5: monotreme.api.v1.GetShortcutRequest
+	(*GetShortcutByNameRequest)(nil),                   // 6: monotreme.api.v1.GetShortcutByNameRequest
+	(*CreateShortcutRequest)(nil),                      // 7: monotreme.api.v1.CreateShortcutRequest
+	(*UpdateShortcutRequest)(nil),                      // 8: monotreme.api.v1.UpdateShortcutRequest
+	(*DeleteShortcutRequest)(nil),                      // 9: monotreme.api.v1.DeleteShortcutRequest
+	(*ListTrashedShortcutsRequest)(nil),                // 10: monotreme.api.v1.ListTrashedShortcutsRequest
+	(*ListTrashedShortcutsResponse)(nil),               // 11: monotreme.api.v1.ListTrashedShortcutsResponse
+	(*RestoreShortcutRequest)(nil),                     // 12: monotreme.api.v1.RestoreShortcutRequest
+	(*PurgeShortcutRequest)(nil),                       // 13: monotreme.api.v1.PurgeShortcutRequest
+	(*GetShortcutAnalyticsRequest)(nil),                // 14: monotreme.api.v1.GetShortcutAnalyticsRequest
+	(*GetShortcutAnalyticsResponse)(nil),               // 15: monotreme.api.v1.GetShortcutAnalyticsResponse
+	(*ShortcutLinkChange)(nil),                         // 16: monotreme.api.v1.ShortcutLinkChange
+	(*ListShortcutLinkChangesRequest)(nil),             // 17: monotreme.api.v1.ListShortcutLinkChangesRequest
+	(*ListShortcutLinkChangesResponse)(nil),            // 18: monotreme.api.v1.ListShortcutLinkChangesResponse
+	(*CreateShortcutLinkChangeRequest)(nil),            // 19: monotreme.api.v1.CreateShortcutLinkChangeRequest
+	(*CancelShortcutLinkChangeRequest)(nil),            // 20: monotreme.api.v1.CancelShortcutLinkChangeRequest
+	(*DryRunShortcutRoutingRequest)(nil),               // 21: monotreme.api.v1.DryRunShortcutRoutingRequest
+	(*DryRunShortcutRoutingResponse)(nil),              // 22: monotreme.api.v1.DryRunShortcutRoutingResponse
+	(*ShortcutRevision)(nil),                           // 23: monotreme.api.v1.ShortcutRevision
+	(*ListShortcutRevisionsRequest)(nil),               // 24: monotreme.api.v1.ListShortcutRevisionsRequest
+	(*ListShortcutRevisionsResponse)(nil),              // 25: monotreme.api.v1.ListShortcutRevisionsResponse
+	(*RestoreShortcutRevisionRequest)(nil),             // 26: monotreme.api.v1.RestoreShortcutRevisionRequest
+	(*RestoreShortcutRevisionResponse)(nil),            // 27: monotreme.api.v1.RestoreShortcutRevisionResponse
+	(*ListShortcutPermissionsRequest)(nil),             // 28: monotreme.api.v1.ListShortcutPermissionsRequest
+	(*ListShortcutPermissionsResponse)(nil),            // 29: monotreme.api.v1.ListShortcutPermissionsResponse
+	(*GrantShortcutPermissionRequest)(nil),             // 30: monotreme.api.v1.GrantShortcutPermissionRequest
+	(*RevokeShortcutPermissionRequest)(nil),            // 31: monotreme.api.v1.RevokeShortcutPermissionRequest
+	(*TransferShortcutOwnershipRequest)(nil),           // 32: monotreme.api.v1.TransferShortcutOwnershipRequest
+	(*ShortcutChangeRequest)(nil),                      // 33: monotreme.api.v1.ShortcutChangeRequest
+	(*ListShortcutChangeRequestsRequest)(nil),          // 34: monotreme.api.v1.ListShortcutChangeRequestsRequest
+	(*ListShortcutChangeRequestsResponse)(nil),         // 35: monotreme.api.v1.ListShortcutChangeRequestsResponse
+	(*ApproveShortcutChangeRequestRequest)(nil),        // 36: monotreme.api.v1.ApproveShortcutChangeRequestRequest
+	(*RejectShortcutChangeRequestRequest)(nil),         // 37: monotreme.api.v1.RejectShortcutChangeRequestRequest
+	(*Shortcut_OpenGraphMetadata)(nil),                 // 38: monotreme.api.v1.Shortcut.OpenGraphMetadata
+	(*Shortcut_Variant)(nil),                           // 39: monotreme.api.v1.Shortcut.Variant
+	(*Shortcut_RoutingRule)(nil),                       // 40: monotreme.api.v1.Shortcut.RoutingRule
+	(*Shortcut_RoutingCondition)(nil),                  // 41: monotreme.api.v1.Shortcut.RoutingCondition
+	(*Shortcut_TargetHealth)(nil),                      // 42: monotreme.api.v1.Shortcut.TargetHealth
+	(*GetShortcutAnalyticsResponse_AnalyticsItem)(nil), // 43: monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	nil,                                  // 44: monotreme.api.v1.DryRunShortcutRoutingRequest.HeadersEntry
+	(*ShortcutRevision_FieldChange)(nil), // 45: monotreme.api.v1.ShortcutRevision.FieldChange
+	(*timestamppb.Timestamp)(nil),        // 46: google.protobuf.Timestamp
+	(Visibility)(0),                      // 47: monotreme.api.v1.Visibility
+	(RedirectMode)(0),                    // 48: monotreme.api.v1.RedirectMode
+	(State)(0),                           // 49: monotreme.api.v1.State
+	(*fieldmaskpb.FieldMask)(nil),        // 50: google.protobuf.FieldMask
+	(*Permission)(nil),                   // 51: monotreme.api.v1.Permission
+	(*emptypb.Empty)(nil),                // 52: google.protobuf.Empty
 }
 var file_api_v1_shortcut_service_proto_depIdxs = []int32{
-	12, // 0: monotreme.api.v1.Shortcut.created_time:type_name -> google.protobuf.Timestamp
-	12, // 1: monotreme.api.v1.Shortcut.updated_time:type_name -> google.protobuf.Timestamp
-	13, // 2: monotreme.api.v1.Shortcut.visibility:type_name -> monotreme.api.v1.Visibility
-	10, // 3: monotreme.api.v1.Shortcut.og_metadata:type_name -> monotreme.api.v1.Shortcut.OpenGraphMetadata
-	0,  // 4: monotreme.api.v1.ListShortcutsResponse.shortcuts:type_name -> monotreme.api.v1.Shortcut
-	0,  // 5: monotreme.api.v1.CreateShortcutRequest.shortcut:type_name -> monotreme.api.v1.Shortcut
-	0,  // 6: monotreme.api.v1.UpdateShortcutRequest.shortcut:type_name -> monotreme.api.v1.Shortcut
-	14, // 7: monotreme.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 8: monotreme.api.v1.GetShortcutAnalyticsResponse.references:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	11, // 9: monotreme.api.v1.GetShortcutAnalyticsResponse.devices:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	11, // 10: monotreme.api.v1.GetShortcutAnalyticsResponse.browsers:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
-	1,  // 11: monotreme.api.v1.ShortcutService.ListShortcuts:input_type -> monotreme.api.v1.ListShortcutsRequest
-	3,  // 12: monotreme.api.v1.ShortcutService.GetShortcut:input_type -> monotreme.api.v1.GetShortcutRequest
-	4,  // 13: monotreme.api.v1.ShortcutService.GetShortcutByName:input_type -> monotreme.api.v1.GetShortcutByNameRequest
-	5,  // 14: monotreme.api.v1.ShortcutService.CreateShortcut:input_type -> monotreme.api.v1.CreateShortcutRequest
-	6,  // 15: monotreme.api.v1.ShortcutService.UpdateShortcut:input_type -> monotreme.api.v1.UpdateShortcutRequest
-	7,  // 16: monotreme.api.v1.ShortcutService.DeleteShortcut:input_type -> monotreme.api.v1.DeleteShortcutRequest
-	8,  // 17: monotreme.api.v1.ShortcutService.GetShortcutAnalytics:input_type -> monotreme.api.v1.GetShortcutAnalyticsRequest
-	2,  // 18: monotreme.api.v1.ShortcutService.ListShortcuts:output_type -> monotreme.api.v1.ListShortcutsResponse
-	0,  // 19: monotreme.api.v1.ShortcutService.GetShortcut:output_type -> monotreme.api.v1.Shortcut
-	0,  // 20: monotreme.api.v1.ShortcutService.GetShortcutByName:output_type -> monotreme.api.v1.Shortcut
-	0,  // 21: monotreme.api.v1.ShortcutService.CreateShortcut:output_type -> monotreme.api.v1.Shortcut
-	0,  // 22: monotreme.api.v1.ShortcutService.UpdateShortcut:output_type -> monotreme.api.v1.Shortcut
-	15, // 23: monotreme.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
-	9,  // 24: monotreme.api.v1.ShortcutService.GetShortcutAnalytics:output_type -> monotreme.api.v1.GetShortcutAnalyticsResponse
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	46, // 0: monotreme.api.v1.Shortcut.created_time:type_name -> google.protobuf.Timestamp
+	46, // 1: monotreme.api.v1.Shortcut.updated_time:type_name -> google.protobuf.Timestamp
+	47, // 2: monotreme.api.v1.Shortcut.visibility:type_name -> monotreme.api.v1.Visibility
+	38, // 3: monotreme.api.v1.Shortcut.og_metadata:type_name -> monotreme.api.v1.Shortcut.OpenGraphMetadata
+	48, // 4: monotreme.api.v1.Shortcut.redirect_mode:type_name -> monotreme.api.v1.RedirectMode
+	46, // 5: monotreme.api.v1.Shortcut.valid_from:type_name -> google.protobuf.Timestamp
+	46, // 6: monotreme.api.v1.Shortcut.valid_until:type_name -> google.protobuf.Timestamp
+	49, // 7: monotreme.api.v1.Shortcut.state:type_name -> monotreme.api.v1.State
+	39, // 8: monotreme.api.v1.Shortcut.variants:type_name -> monotreme.api.v1.Shortcut.Variant
+	40, // 9: monotreme.api.v1.Shortcut.routing_rules:type_name -> monotreme.api.v1.Shortcut.RoutingRule
+	42, // 10: monotreme.api.v1.Shortcut.target_health:type_name -> monotreme.api.v1.Shortcut.TargetHealth
+	46, // 11: monotreme.api.v1.Shortcut.deleted_time:type_name -> google.protobuf.Timestamp
+	46, // 12: monotreme.api.v1.Shortcut.purge_time:type_name -> google.protobuf.Timestamp
+	2,  // 13: monotreme.api.v1.ListShortcutsResponse.shortcuts:type_name -> monotreme.api.v1.Shortcut
+	2,  // 14: monotreme.api.v1.CreateShortcutRequest.shortcut:type_name -> monotreme.api.v1.Shortcut
+	2,  // 15: monotreme.api.v1.UpdateShortcutRequest.shortcut:type_name -> monotreme.api.v1.Shortcut
+	50, // 16: monotreme.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 17: monotreme.api.v1.ListTrashedShortcutsResponse.shortcuts:type_name -> monotreme.api.v1.Shortcut
+	43, // 18: monotreme.api.v1.GetShortcutAnalyticsResponse.references:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	43, // 19: monotreme.api.v1.GetShortcutAnalyticsResponse.devices:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	43, // 20: monotreme.api.v1.GetShortcutAnalyticsResponse.browsers:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	43, // 21: monotreme.api.v1.GetShortcutAnalyticsResponse.variants:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	43, // 22: monotreme.api.v1.GetShortcutAnalyticsResponse.bots:type_name -> monotreme.api.v1.GetShortcutAnalyticsResponse.AnalyticsItem
+	46, // 23: monotreme.api.v1.ShortcutLinkChange.created_time:type_name -> google.protobuf.Timestamp
+	46, // 24: monotreme.api.v1.ShortcutLinkChange.effective_time:type_name -> google.protobuf.Timestamp
+	46, // 25: monotreme.api.v1.ShortcutLinkChange.applied_time:type_name -> google.protobuf.Timestamp
+	16, // 26: monotreme.api.v1.ListShortcutLinkChangesResponse.link_changes:type_name -> monotreme.api.v1.ShortcutLinkChange
+	16, // 27: monotreme.api.v1.CreateShortcutLinkChangeRequest.link_change:type_name -> monotreme.api.v1.ShortcutLinkChange
+	44, // 28: monotreme.api.v1.DryRunShortcutRoutingRequest.headers:type_name -> monotreme.api.v1.DryRunShortcutRoutingRequest.HeadersEntry
+	46, // 29: monotreme.api.v1.ShortcutRevision.created_time:type_name -> google.protobuf.Timestamp
+	45, // 30: monotreme.api.v1.ShortcutRevision.changes:type_name -> monotreme.api.v1.ShortcutRevision.FieldChange
+	23, // 31: monotreme.api.v1.ListShortcutRevisionsResponse.revisions:type_name -> monotreme.api.v1.ShortcutRevision
+	2,  // 32: monotreme.api.v1.RestoreShortcutRevisionResponse.shortcut:type_name -> monotreme.api.v1.Shortcut
+	23, // 33: monotreme.api.v1.RestoreShortcutRevisionResponse.revision:type_name -> monotreme.api.v1.ShortcutRevision
+	51, // 34: monotreme.api.v1.ListShortcutPermissionsResponse.permissions:type_name -> monotreme.api.v1.Permission
+	51, // 35: monotreme.api.v1.GrantShortcutPermissionRequest.permission:type_name -> monotreme.api.v1.Permission
+	46, // 36: monotreme.api.v1.ShortcutChangeRequest.created_time:type_name -> google.protobuf.Timestamp
+	46, // 37: monotreme.api.v1.ShortcutChangeRequest.updated_time:type_name -> google.protobuf.Timestamp
+	1,  // 38: monotreme.api.v1.ShortcutChangeRequest.status:type_name -> monotreme.api.v1.ShortcutChangeRequest.Status
+	45, // 39: monotreme.api.v1.ShortcutChangeRequest.changes:type_name -> monotreme.api.v1.ShortcutRevision.FieldChange
+	33, // 40: monotreme.api.v1.ListShortcutChangeRequestsResponse.change_requests:type_name -> monotreme.api.v1.ShortcutChangeRequest
+	41, // 41: monotreme.api.v1.Shortcut.RoutingRule.conditions:type_name -> monotreme.api.v1.Shortcut.RoutingCondition
+	0,  // 42: monotreme.api.v1.Shortcut.RoutingCondition.field:type_name -> monotreme.api.v1.Shortcut.RoutingCondition.Field
+	46, // 43: monotreme.api.v1.Shortcut.TargetHealth.checked_time:type_name -> google.protobuf.Timestamp
+	3,  // 44: monotreme.api.v1.ShortcutService.ListShortcuts:input_type -> monotreme.api.v1.ListShortcutsRequest
+	5,  // 45: monotreme.api.v1.ShortcutService.GetShortcut:input_type -> monotreme.api.v1.GetShortcutRequest
+	6,  // 46: monotreme.api.v1.ShortcutService.GetShortcutByName:input_type -> monotreme.api.v1.GetShortcutByNameRequest
+	7,  // 47: monotreme.api.v1.ShortcutService.CreateShortcut:input_type -> monotreme.api.v1.CreateShortcutRequest
+	8,  // 48: monotreme.api.v1.ShortcutService.UpdateShortcut:input_type -> monotreme.api.v1.UpdateShortcutRequest
+	9,  // 49: monotreme.api.v1.ShortcutService.DeleteShortcut:input_type -> monotreme.api.v1.DeleteShortcutRequest
+	14, // 50: monotreme.api.v1.ShortcutService.GetShortcutAnalytics:input_type -> monotreme.api.v1.GetShortcutAnalyticsRequest
+	17, // 51: monotreme.api.v1.ShortcutService.ListShortcutLinkChanges:input_type -> monotreme.api.v1.ListShortcutLinkChangesRequest
+	19, // 52: monotreme.api.v1.ShortcutService.CreateShortcutLinkChange:input_type -> monotreme.api.v1.CreateShortcutLinkChangeRequest
+	20, // 53: monotreme.api.v1.ShortcutService.CancelShortcutLinkChange:input_type -> monotreme.api.v1.CancelShortcutLinkChangeRequest
+	21, // 54: monotreme.api.v1.ShortcutService.DryRunShortcutRouting:input_type -> monotreme.api.v1.DryRunShortcutRoutingRequest
+	10, // 55: monotreme.api.v1.ShortcutService.ListTrashedShortcuts:input_type -> monotreme.api.v1.ListTrashedShortcutsRequest
+	12, // 56: monotreme.api.v1.ShortcutService.RestoreShortcut:input_type -> monotreme.api.v1.RestoreShortcutRequest
+	13, // 57: monotreme.api.v1.ShortcutService.PurgeShortcut:input_type -> monotreme.api.v1.PurgeShortcutRequest
+	24, // 58: monotreme.api.v1.ShortcutService.ListShortcutRevisions:input_type -> monotreme.api.v1.ListShortcutRevisionsRequest
+	26, // 59: monotreme.api.v1.ShortcutService.RestoreShortcutRevision:input_type -> monotreme.api.v1.RestoreShortcutRevisionRequest
+	28, // 60: monotreme.api.v1.ShortcutService.ListShortcutPermissions:input_type -> monotreme.api.v1.ListShortcutPermissionsRequest
+	30, // 61: monotreme.api.v1.ShortcutService.GrantShortcutPermission:input_type -> monotreme.api.v1.GrantShortcutPermissionRequest
+	31, // 62: monotreme.api.v1.ShortcutService.RevokeShortcutPermission:input_type -> monotreme.api.v1.RevokeShortcutPermissionRequest
+	32, // 63: monotreme.api.v1.ShortcutService.TransferShortcutOwnership:input_type -> monotreme.api.v1.TransferShortcutOwnershipRequest
+	34, // 64: monotreme.api.v1.ShortcutService.ListShortcutChangeRequests:input_type -> monotreme.api.v1.ListShortcutChangeRequestsRequest
+	36, // 65: monotreme.api.v1.ShortcutService.ApproveShortcutChangeRequest:input_type -> monotreme.api.v1.ApproveShortcutChangeRequestRequest
+	37, // 66: monotreme.api.v1.ShortcutService.RejectShortcutChangeRequest:input_type -> monotreme.api.v1.RejectShortcutChangeRequestRequest
+	4,  // 67: monotreme.api.v1.ShortcutService.ListShortcuts:output_type -> monotreme.api.v1.ListShortcutsResponse
+	2,  // 68: monotreme.api.v1.ShortcutService.GetShortcut:output_type -> monotreme.api.v1.Shortcut
+	2,  // 69: monotreme.api.v1.ShortcutService.GetShortcutByName:output_type -> monotreme.api.v1.Shortcut
+	2,  // 70: monotreme.api.v1.ShortcutService.CreateShortcut:output_type -> monotreme.api.v1.Shortcut
+	2,  // 71: monotreme.api.v1.ShortcutService.UpdateShortcut:output_type -> monotreme.api.v1.Shortcut
+	52, // 72: monotreme.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
+	15, // 73: monotreme.api.v1.ShortcutService.GetShortcutAnalytics:output_type -> monotreme.api.v1.GetShortcutAnalyticsResponse
+	18, // 74: monotreme.api.v1.ShortcutService.ListShortcutLinkChanges:output_type -> monotreme.api.v1.ListShortcutLinkChangesResponse
+	16, // 75: monotreme.api.v1.ShortcutService.CreateShortcutLinkChange:output_type -> monotreme.api.v1.ShortcutLinkChange
+	52, // 76: monotreme.api.v1.ShortcutService.CancelShortcutLinkChange:output_type -> google.protobuf.Empty
+	22, // 77: monotreme.api.v1.ShortcutService.DryRunShortcutRouting:output_type -> monotreme.api.v1.DryRunShortcutRoutingResponse
+	11, // 78: monotreme.api.v1.ShortcutService.ListTrashedShortcuts:output_type -> monotreme.api.v1.ListTrashedShortcutsResponse
+	2,  // 79: monotreme.api.v1.ShortcutService.RestoreShortcut:output_type -> monotreme.api.v1.Shortcut
+	52, // 80: monotreme.api.v1.ShortcutService.PurgeShortcut:output_type -> google.protobuf.Empty
+	25, // 81: monotreme.api.v1.ShortcutService.ListShortcutRevisions:output_type -> monotreme.api.v1.ListShortcutRevisionsResponse
+	27, // 82: monotreme.api.v1.ShortcutService.RestoreShortcutRevision:output_type -> monotreme.api.v1.RestoreShortcutRevisionResponse
+	29, // 83: monotreme.api.v1.ShortcutService.ListShortcutPermissions:output_type -> monotreme.api.v1.ListShortcutPermissionsResponse
+	51, // 84: monotreme.api.v1.ShortcutService.GrantShortcutPermission:output_type -> monotreme.api.v1.Permission
+	52, // 85: monotreme.api.v1.ShortcutService.RevokeShortcutPermission:output_type -> google.protobuf.Empty
+	2,  // 86: monotreme.api.v1.ShortcutService.TransferShortcutOwnership:output_type -> monotreme.api.v1.Shortcut
+	35, // 87: monotreme.api.v1.ShortcutService.ListShortcutChangeRequests:output_type -> monotreme.api.v1.ListShortcutChangeRequestsResponse
+	33, // 88: monotreme.api.v1.ShortcutService.ApproveShortcutChangeRequest:output_type -> monotreme.api.v1.ShortcutChangeRequest
+	33, // 89: monotreme.api.v1.ShortcutService.RejectShortcutChangeRequest:output_type -> monotreme.api.v1.ShortcutChangeRequest
+	67, // [67:90] is the sub-list for method output_type
+	44, // [44:67] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_shortcut_service_proto_init() }
@@ -829,13 +3176,14 @@ func file_api_v1_shortcut_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_shortcut_service_proto_rawDesc), len(file_api_v1_shortcut_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_shortcut_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_shortcut_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_shortcut_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_shortcut_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_shortcut_service_proto = out.File
@@ -20,13 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName        = "/monotreme.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName          = "/monotreme.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_GetShortcutByName_FullMethodName    = "/monotreme.api.v1.ShortcutService/GetShortcutByName"
-	ShortcutService_CreateShortcut_FullMethodName       = "/monotreme.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName       = "/monotreme.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName       = "/monotreme.api.v1.ShortcutService/DeleteShortcut"
-	ShortcutService_GetShortcutAnalytics_FullMethodName = "/monotreme.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_ListShortcuts_FullMethodName                = "/monotreme.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_GetShortcut_FullMethodName                  = "/monotreme.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_GetShortcutByName_FullMethodName            = "/monotreme.api.v1.ShortcutService/GetShortcutByName"
+	ShortcutService_CreateShortcut_FullMethodName               = "/monotreme.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName               = "/monotreme.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_DeleteShortcut_FullMethodName               = "/monotreme.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_GetShortcutAnalytics_FullMethodName         = "/monotreme.api.v1.ShortcutService/GetShortcutAnalytics"
+	ShortcutService_ListShortcutLinkChanges_FullMethodName      = "/monotreme.api.v1.ShortcutService/ListShortcutLinkChanges"
+	ShortcutService_CreateShortcutLinkChange_FullMethodName     = "/monotreme.api.v1.ShortcutService/CreateShortcutLinkChange"
+	ShortcutService_CancelShortcutLinkChange_FullMethodName     = "/monotreme.api.v1.ShortcutService/CancelShortcutLinkChange"
+	ShortcutService_DryRunShortcutRouting_FullMethodName        = "/monotreme.api.v1.ShortcutService/DryRunShortcutRouting"
+	ShortcutService_ListTrashedShortcuts_FullMethodName         = "/monotreme.api.v1.ShortcutService/ListTrashedShortcuts"
+	ShortcutService_RestoreShortcut_FullMethodName              = "/monotreme.api.v1.ShortcutService/RestoreShortcut"
+	ShortcutService_PurgeShortcut_FullMethodName                = "/monotreme.api.v1.ShortcutService/PurgeShortcut"
+	ShortcutService_ListShortcutRevisions_FullMethodName        = "/monotreme.api.v1.ShortcutService/ListShortcutRevisions"
+	ShortcutService_RestoreShortcutRevision_FullMethodName      = "/monotreme.api.v1.ShortcutService/RestoreShortcutRevision"
+	ShortcutService_ListShortcutPermissions_FullMethodName      = "/monotreme.api.v1.ShortcutService/ListShortcutPermissions"
+	ShortcutService_GrantShortcutPermission_FullMethodName      = "/monotreme.api.v1.ShortcutService/GrantShortcutPermission"
+	ShortcutService_RevokeShortcutPermission_FullMethodName     = "/monotreme.api.v1.ShortcutService/RevokeShortcutPermission"
+	ShortcutService_TransferShortcutOwnership_FullMethodName    = "/monotreme.api.v1.ShortcutService/TransferShortcutOwnership"
+	ShortcutService_ListShortcutChangeRequests_FullMethodName   = "/monotreme.api.v1.ShortcutService/ListShortcutChangeRequests"
+	ShortcutService_ApproveShortcutChangeRequest_FullMethodName = "/monotreme.api.v1.ShortcutService/ApproveShortcutChangeRequest"
+	ShortcutService_RejectShortcutChangeRequest_FullMethodName  = "/monotreme.api.v1.ShortcutService/RejectShortcutChangeRequest"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -43,10 +59,46 @@ type ShortcutServiceClient interface {
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut moves a shortcut to the trash. Its name stays reserved until it is purged.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(ctx context.Context, in *GetShortcutAnalyticsRequest, opts ...grpc.CallOption) (*GetShortcutAnalyticsResponse, error)
+	// ListShortcutLinkChanges returns the scheduled link changes of a shortcut.
+	ListShortcutLinkChanges(ctx context.Context, in *ListShortcutLinkChangesRequest, opts ...grpc.CallOption) (*ListShortcutLinkChangesResponse, error)
+	// CreateShortcutLinkChange schedules a link change for a shortcut.
+	CreateShortcutLinkChange(ctx context.Context, in *CreateShortcutLinkChangeRequest, opts ...grpc.CallOption) (*ShortcutLinkChange, error)
+	// CancelShortcutLinkChange cancels a pending link change.
+	CancelShortcutLinkChange(ctx context.Context, in *CancelShortcutLinkChangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DryRunShortcutRouting reports which link a request with the given headers would be sent to.
+	DryRunShortcutRouting(ctx context.Context, in *DryRunShortcutRoutingRequest, opts ...grpc.CallOption) (*DryRunShortcutRoutingResponse, error)
+	// ListTrashedShortcuts returns the shortcuts in the trash of the current user, or of every user for admins.
+	ListTrashedShortcuts(ctx context.Context, in *ListTrashedShortcutsRequest, opts ...grpc.CallOption) (*ListTrashedShortcutsResponse, error)
+	// RestoreShortcut moves a shortcut out of the trash.
+	RestoreShortcut(ctx context.Context, in *RestoreShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// PurgeShortcut permanently deletes a shortcut in the trash.
+	PurgeShortcut(ctx context.Context, in *PurgeShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListShortcutRevisions returns the revision history of a shortcut, newest first.
+	ListShortcutRevisions(ctx context.Context, in *ListShortcutRevisionsRequest, opts ...grpc.CallOption) (*ListShortcutRevisionsResponse, error)
+	// RestoreShortcutRevision returns a shortcut to the state it had after a revision.
+	// The rollback is recorded as a new revision.
+	RestoreShortcutRevision(ctx context.Context, in *RestoreShortcutRevisionRequest, opts ...grpc.CallOption) (*RestoreShortcutRevisionResponse, error)
+	// ListShortcutPermissions returns the roles granted on a shortcut.
+	ListShortcutPermissions(ctx context.Context, in *ListShortcutPermissionsRequest, opts ...grpc.CallOption) (*ListShortcutPermissionsResponse, error)
+	// GrantShortcutPermission grants a role on a shortcut to a user or group, replacing the role they had.
+	// Only owners can grant roles.
+	GrantShortcutPermission(ctx context.Context, in *GrantShortcutPermissionRequest, opts ...grpc.CallOption) (*Permission, error)
+	// RevokeShortcutPermission revokes the role of a user or group on a shortcut. Only owners can revoke roles.
+	RevokeShortcutPermission(ctx context.Context, in *RevokeShortcutPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// TransferShortcutOwnership makes another user the creator of a shortcut. Only owners can transfer it.
+	TransferShortcutOwnership(ctx context.Context, in *TransferShortcutOwnershipRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// ListShortcutChangeRequests returns the change requests of a shortcut, newest first.
+	ListShortcutChangeRequests(ctx context.Context, in *ListShortcutChangeRequestsRequest, opts ...grpc.CallOption) (*ListShortcutChangeRequestsResponse, error)
+	// ApproveShortcutChangeRequest applies a pending change request. Only approvers other than
+	// the requester can approve it.
+	ApproveShortcutChangeRequest(ctx context.Context, in *ApproveShortcutChangeRequestRequest, opts ...grpc.CallOption) (*ShortcutChangeRequest, error)
+	// RejectShortcutChangeRequest rejects a pending change request. Only approvers other than
+	// the requester can reject it.
+	RejectShortcutChangeRequest(ctx context.Context, in *RejectShortcutChangeRequestRequest, opts ...grpc.CallOption) (*ShortcutChangeRequest, error)
 }
 
 type shortcutServiceClient struct {
@@ -127,6 +179,166 @@ func (c *shortcutServiceClient) GetShortcutAnalytics(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ListShortcutLinkChanges(ctx context.Context, in *ListShortcutLinkChangesRequest, opts ...grpc.CallOption) (*ListShortcutLinkChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutLinkChangesResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutLinkChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CreateShortcutLinkChange(ctx context.Context, in *CreateShortcutLinkChangeRequest, opts ...grpc.CallOption) (*ShortcutLinkChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortcutLinkChange)
+	err := c.cc.Invoke(ctx, ShortcutService_CreateShortcutLinkChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) CancelShortcutLinkChange(ctx context.Context, in *CancelShortcutLinkChangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_CancelShortcutLinkChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) DryRunShortcutRouting(ctx context.Context, in *DryRunShortcutRoutingRequest, opts ...grpc.CallOption) (*DryRunShortcutRoutingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DryRunShortcutRoutingResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_DryRunShortcutRouting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListTrashedShortcuts(ctx context.Context, in *ListTrashedShortcutsRequest, opts ...grpc.CallOption) (*ListTrashedShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListTrashedShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RestoreShortcut(ctx context.Context, in *RestoreShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_RestoreShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) PurgeShortcut(ctx context.Context, in *PurgeShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_PurgeShortcut_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutRevisions(ctx context.Context, in *ListShortcutRevisionsRequest, opts ...grpc.CallOption) (*ListShortcutRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutRevisionsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RestoreShortcutRevision(ctx context.Context, in *RestoreShortcutRevisionRequest, opts ...grpc.CallOption) (*RestoreShortcutRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreShortcutRevisionResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_RestoreShortcutRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutPermissions(ctx context.Context, in *ListShortcutPermissionsRequest, opts ...grpc.CallOption) (*ListShortcutPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutPermissionsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) GrantShortcutPermission(ctx context.Context, in *GrantShortcutPermissionRequest, opts ...grpc.CallOption) (*Permission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Permission)
+	err := c.cc.Invoke(ctx, ShortcutService_GrantShortcutPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RevokeShortcutPermission(ctx context.Context, in *RevokeShortcutPermissionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShortcutService_RevokeShortcutPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) TransferShortcutOwnership(ctx context.Context, in *TransferShortcutOwnershipRequest, opts ...grpc.CallOption) (*Shortcut, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shortcut)
+	err := c.cc.Invoke(ctx, ShortcutService_TransferShortcutOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ListShortcutChangeRequests(ctx context.Context, in *ListShortcutChangeRequestsRequest, opts ...grpc.CallOption) (*ListShortcutChangeRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShortcutChangeRequestsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListShortcutChangeRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) ApproveShortcutChangeRequest(ctx context.Context, in *ApproveShortcutChangeRequestRequest, opts ...grpc.CallOption) (*ShortcutChangeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortcutChangeRequest)
+	err := c.cc.Invoke(ctx, ShortcutService_ApproveShortcutChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortcutServiceClient) RejectShortcutChangeRequest(ctx context.Context, in *RejectShortcutChangeRequestRequest, opts ...grpc.CallOption) (*ShortcutChangeRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShortcutChangeRequest)
+	err := c.cc.Invoke(ctx, ShortcutService_RejectShortcutChangeRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShortcutServiceServer is the server API for ShortcutService service.
 // All implementations must embed UnimplementedShortcutServiceServer
 // for forward compatibility.
@@ -141,10 +353,46 @@ type ShortcutServiceServer interface {
 	CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut.
 	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
-	// DeleteShortcut deletes a shortcut by name.
+	// DeleteShortcut moves a shortcut to the trash. Its name stays reserved until it is purged.
 	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
 	// GetShortcutAnalytics returns the analytics for a shortcut.
 	GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error)
+	// ListShortcutLinkChanges returns the scheduled link changes of a shortcut.
+	ListShortcutLinkChanges(context.Context, *ListShortcutLinkChangesRequest) (*ListShortcutLinkChangesResponse, error)
+	// CreateShortcutLinkChange schedules a link change for a shortcut.
+	CreateShortcutLinkChange(context.Context, *CreateShortcutLinkChangeRequest) (*ShortcutLinkChange, error)
+	// CancelShortcutLinkChange cancels a pending link change.
+	CancelShortcutLinkChange(context.Context, *CancelShortcutLinkChangeRequest) (*emptypb.Empty, error)
+	// DryRunShortcutRouting reports which link a request with the given headers would be sent to.
+	DryRunShortcutRouting(context.Context, *DryRunShortcutRoutingRequest) (*DryRunShortcutRoutingResponse, error)
+	// ListTrashedShortcuts returns the shortcuts in the trash of the current user, or of every user for admins.
+	ListTrashedShortcuts(context.Context, *ListTrashedShortcutsRequest) (*ListTrashedShortcutsResponse, error)
+	// RestoreShortcut moves a shortcut out of the trash.
+	RestoreShortcut(context.Context, *RestoreShortcutRequest) (*Shortcut, error)
+	// PurgeShortcut permanently deletes a shortcut in the trash.
+	PurgeShortcut(context.Context, *PurgeShortcutRequest) (*emptypb.Empty, error)
+	// ListShortcutRevisions returns the revision history of a shortcut, newest first.
+	ListShortcutRevisions(context.Context, *ListShortcutRevisionsRequest) (*ListShortcutRevisionsResponse, error)
+	// RestoreShortcutRevision returns a shortcut to the state it had after a revision.
+	// The rollback is recorded as a new revision.
+	RestoreShortcutRevision(context.Context, *RestoreShortcutRevisionRequest) (*RestoreShortcutRevisionResponse, error)
+	// ListShortcutPermissions returns the roles granted on a shortcut.
+	ListShortcutPermissions(context.Context, *ListShortcutPermissionsRequest) (*ListShortcutPermissionsResponse, error)
+	// GrantShortcutPermission grants a role on a shortcut to a user or group, replacing the role they had.
+	// Only owners can grant roles.
+	GrantShortcutPermission(context.Context, *GrantShortcutPermissionRequest) (*Permission, error)
+	// RevokeShortcutPermission revokes the role of a user or group on a shortcut. Only owners can revoke roles.
+	RevokeShortcutPermission(context.Context, *RevokeShortcutPermissionRequest) (*emptypb.Empty, error)
+	// TransferShortcutOwnership makes another user the creator of a shortcut. Only owners can transfer it.
+	TransferShortcutOwnership(context.Context, *TransferShortcutOwnershipRequest) (*Shortcut, error)
+	// ListShortcutChangeRequests returns the change requests of a shortcut, newest first.
+	ListShortcutChangeRequests(context.Context, *ListShortcutChangeRequestsRequest) (*ListShortcutChangeRequestsResponse, error)
+	// ApproveShortcutChangeRequest applies a pending change request. Only approvers other than
+	// the requester can approve it.
+	ApproveShortcutChangeRequest(context.Context, *ApproveShortcutChangeRequestRequest) (*ShortcutChangeRequest, error)
+	// RejectShortcutChangeRequest rejects a pending change request. Only approvers other than
+	// the requester can reject it.
+	RejectShortcutChangeRequest(context.Context, *RejectShortcutChangeRequestRequest) (*ShortcutChangeRequest, error)
 	mustEmbedUnimplementedShortcutServiceServer()
 }
 
@@ -176,6 +424,54 @@ func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *Delet
 func (UnimplementedShortcutServiceServer) GetShortcutAnalytics(context.Context, *GetShortcutAnalyticsRequest) (*GetShortcutAnalyticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetShortcutAnalytics not implemented")
 }
+func (UnimplementedShortcutServiceServer) ListShortcutLinkChanges(context.Context, *ListShortcutLinkChangesRequest) (*ListShortcutLinkChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutLinkChanges not implemented")
+}
+func (UnimplementedShortcutServiceServer) CreateShortcutLinkChange(context.Context, *CreateShortcutLinkChangeRequest) (*ShortcutLinkChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShortcutLinkChange not implemented")
+}
+func (UnimplementedShortcutServiceServer) CancelShortcutLinkChange(context.Context, *CancelShortcutLinkChangeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShortcutLinkChange not implemented")
+}
+func (UnimplementedShortcutServiceServer) DryRunShortcutRouting(context.Context, *DryRunShortcutRoutingRequest) (*DryRunShortcutRoutingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunShortcutRouting not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListTrashedShortcuts(context.Context, *ListTrashedShortcutsRequest) (*ListTrashedShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedShortcuts not implemented")
+}
+func (UnimplementedShortcutServiceServer) RestoreShortcut(context.Context, *RestoreShortcutRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) PurgeShortcut(context.Context, *PurgeShortcutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeShortcut not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutRevisions(context.Context, *ListShortcutRevisionsRequest) (*ListShortcutRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutRevisions not implemented")
+}
+func (UnimplementedShortcutServiceServer) RestoreShortcutRevision(context.Context, *RestoreShortcutRevisionRequest) (*RestoreShortcutRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreShortcutRevision not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutPermissions(context.Context, *ListShortcutPermissionsRequest) (*ListShortcutPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutPermissions not implemented")
+}
+func (UnimplementedShortcutServiceServer) GrantShortcutPermission(context.Context, *GrantShortcutPermissionRequest) (*Permission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantShortcutPermission not implemented")
+}
+func (UnimplementedShortcutServiceServer) RevokeShortcutPermission(context.Context, *RevokeShortcutPermissionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShortcutPermission not implemented")
+}
+func (UnimplementedShortcutServiceServer) TransferShortcutOwnership(context.Context, *TransferShortcutOwnershipRequest) (*Shortcut, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferShortcutOwnership not implemented")
+}
+func (UnimplementedShortcutServiceServer) ListShortcutChangeRequests(context.Context, *ListShortcutChangeRequestsRequest) (*ListShortcutChangeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShortcutChangeRequests not implemented")
+}
+func (UnimplementedShortcutServiceServer) ApproveShortcutChangeRequest(context.Context, *ApproveShortcutChangeRequestRequest) (*ShortcutChangeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveShortcutChangeRequest not implemented")
+}
+func (UnimplementedShortcutServiceServer) RejectShortcutChangeRequest(context.Context, *RejectShortcutChangeRequestRequest) (*ShortcutChangeRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectShortcutChangeRequest not implemented")
+}
 func (UnimplementedShortcutServiceServer) mustEmbedUnimplementedShortcutServiceServer() {}
 func (UnimplementedShortcutServiceServer) testEmbeddedByValue()                         {}
 
@@ -323,6 +619,294 @@ func _ShortcutService_GetShortcutAnalytics_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ListShortcutLinkChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutLinkChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutLinkChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutLinkChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutLinkChanges(ctx, req.(*ListShortcutLinkChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CreateShortcutLinkChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShortcutLinkChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CreateShortcutLinkChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CreateShortcutLinkChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CreateShortcutLinkChange(ctx, req.(*CreateShortcutLinkChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_CancelShortcutLinkChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShortcutLinkChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).CancelShortcutLinkChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_CancelShortcutLinkChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).CancelShortcutLinkChange(ctx, req.(*CancelShortcutLinkChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_DryRunShortcutRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunShortcutRoutingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).DryRunShortcutRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_DryRunShortcutRouting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).DryRunShortcutRouting(ctx, req.(*DryRunShortcutRoutingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListTrashedShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListTrashedShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListTrashedShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListTrashedShortcuts(ctx, req.(*ListTrashedShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RestoreShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RestoreShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RestoreShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RestoreShortcut(ctx, req.(*RestoreShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_PurgeShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeShortcutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).PurgeShortcut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_PurgeShortcut_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).PurgeShortcut(ctx, req.(*PurgeShortcutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutRevisions(ctx, req.(*ListShortcutRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RestoreShortcutRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreShortcutRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RestoreShortcutRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RestoreShortcutRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RestoreShortcutRevision(ctx, req.(*RestoreShortcutRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutPermissions(ctx, req.(*ListShortcutPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_GrantShortcutPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantShortcutPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).GrantShortcutPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_GrantShortcutPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).GrantShortcutPermission(ctx, req.(*GrantShortcutPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RevokeShortcutPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShortcutPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RevokeShortcutPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RevokeShortcutPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RevokeShortcutPermission(ctx, req.(*RevokeShortcutPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_TransferShortcutOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferShortcutOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).TransferShortcutOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_TransferShortcutOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).TransferShortcutOwnership(ctx, req.(*TransferShortcutOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ListShortcutChangeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShortcutChangeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListShortcutChangeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListShortcutChangeRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListShortcutChangeRequests(ctx, req.(*ListShortcutChangeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_ApproveShortcutChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveShortcutChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ApproveShortcutChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ApproveShortcutChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ApproveShortcutChangeRequest(ctx, req.(*ApproveShortcutChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortcutService_RejectShortcutChangeRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectShortcutChangeRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).RejectShortcutChangeRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_RejectShortcutChangeRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).RejectShortcutChangeRequest(ctx, req.(*RejectShortcutChangeRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShortcutService_ServiceDesc is the grpc.ServiceDesc for ShortcutService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -358,6 +942,70 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetShortcutAnalytics",
 			Handler:    _ShortcutService_GetShortcutAnalytics_Handler,
 		},
+		{
+			MethodName: "ListShortcutLinkChanges",
+			Handler:    _ShortcutService_ListShortcutLinkChanges_Handler,
+		},
+		{
+			MethodName: "CreateShortcutLinkChange",
+			Handler:    _ShortcutService_CreateShortcutLinkChange_Handler,
+		},
+		{
+			MethodName: "CancelShortcutLinkChange",
+			Handler:    _ShortcutService_CancelShortcutLinkChange_Handler,
+		},
+		{
+			MethodName: "DryRunShortcutRouting",
+			Handler:    _ShortcutService_DryRunShortcutRouting_Handler,
+		},
+		{
+			MethodName: "ListTrashedShortcuts",
+			Handler:    _ShortcutService_ListTrashedShortcuts_Handler,
+		},
+		{
+			MethodName: "RestoreShortcut",
+			Handler:    _ShortcutService_RestoreShortcut_Handler,
+		},
+		{
+			MethodName: "PurgeShortcut",
+			Handler:    _ShortcutService_PurgeShortcut_Handler,
+		},
+		{
+			MethodName: "ListShortcutRevisions",
+			Handler:    _ShortcutService_ListShortcutRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreShortcutRevision",
+			Handler:    _ShortcutService_RestoreShortcutRevision_Handler,
+		},
+		{
+			MethodName: "ListShortcutPermissions",
+			Handler:    _ShortcutService_ListShortcutPermissions_Handler,
+		},
+		{
+			MethodName: "GrantShortcutPermission",
+			Handler:    _ShortcutService_GrantShortcutPermission_Handler,
+		},
+		{
+			MethodName: "RevokeShortcutPermission",
+			Handler:    _ShortcutService_RevokeShortcutPermission_Handler,
+		},
+		{
+			MethodName: "TransferShortcutOwnership",
+			Handler:    _ShortcutService_TransferShortcutOwnership_Handler,
+		},
+		{
+			MethodName: "ListShortcutChangeRequests",
+			Handler:    _ShortcutService_ListShortcutChangeRequests_Handler,
+		},
+		{
+			MethodName: "ApproveShortcutChangeRequest",
+			Handler:    _ShortcutService_ApproveShortcutChangeRequest_Handler,
+		},
+		{
+			MethodName: "RejectShortcutChangeRequest",
+			Handler:    _ShortcutService_RejectShortcutChangeRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/shortcut_service.proto",
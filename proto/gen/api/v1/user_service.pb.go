@@ -74,6 +74,63 @@ func (Role) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0}
 }
 
+type DeleteUserRequest_ContentDisposition int32
+
+const (
+	DeleteUserRequest_CONTENT_DISPOSITION_UNSPECIFIED DeleteUserRequest_ContentDisposition = 0
+	// Transfers the shortcuts and collections of the user to another user or group.
+	DeleteUserRequest_TRANSFER DeleteUserRequest_ContentDisposition = 1
+	// Deactivates the user instead of deleting it. Their shortcuts and collections are kept
+	// and their shortcuts keep resolving.
+	DeleteUserRequest_ARCHIVE DeleteUserRequest_ContentDisposition = 2
+	// Deletes the shortcuts and collections of the user with it, for good and without
+	// going through the trash. Users who still own groups can't be deleted this way.
+	DeleteUserRequest_DELETE DeleteUserRequest_ContentDisposition = 3
+)
+
+// Enum value maps for DeleteUserRequest_ContentDisposition.
+var (
+	DeleteUserRequest_ContentDisposition_name = map[int32]string{
+		0: "CONTENT_DISPOSITION_UNSPECIFIED",
+		1: "TRANSFER",
+		2: "ARCHIVE",
+		3: "DELETE",
+	}
+	DeleteUserRequest_ContentDisposition_value = map[string]int32{
+		"CONTENT_DISPOSITION_UNSPECIFIED": 0,
+		"TRANSFER":                        1,
+		"ARCHIVE":                         2,
+		"DELETE":                          3,
+	}
+)
+
+func (x DeleteUserRequest_ContentDisposition) Enum() *DeleteUserRequest_ContentDisposition {
+	p := new(DeleteUserRequest_ContentDisposition)
+	*p = x
+	return p
+}
+
+func (x DeleteUserRequest_ContentDisposition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteUserRequest_ContentDisposition) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[1].Descriptor()
+}
+
+func (DeleteUserRequest_ContentDisposition) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[1]
+}
+
+func (x DeleteUserRequest_ContentDisposition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteUserRequest_ContentDisposition.Descriptor instead.
+func (DeleteUserRequest_ContentDisposition) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
 type User struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -444,10 +501,17 @@ func (x *UpdateUserRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 }
 
 type DeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What happens to the shortcuts and collections of the user.
+	ContentDisposition DeleteUserRequest_ContentDisposition `protobuf:"varint,2,opt,name=content_disposition,json=contentDisposition,proto3,enum=monotreme.api.v1.DeleteUserRequest_ContentDisposition" json:"content_disposition,omitempty"`
+	// The user who becomes the creator of the content when it is transferred.
+	TransferUserId int32 `protobuf:"varint,3,opt,name=transfer_user_id,json=transferUserId,proto3" json:"transfer_user_id,omitempty"`
+	// The group the content is transferred to. The creator of the group becomes the creator
+	// of the content and the members of the group become its owners.
+	TransferGroupId int32 `protobuf:"varint,4,opt,name=transfer_group_id,json=transferGroupId,proto3" json:"transfer_group_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteUserRequest) Reset() {
@@ -487,6 +551,135 @@ func (x *DeleteUserRequest) GetId() int32 {
 	return 0
 }
 
+func (x *DeleteUserRequest) GetContentDisposition() DeleteUserRequest_ContentDisposition {
+	if x != nil {
+		return x.ContentDisposition
+	}
+	return DeleteUserRequest_CONTENT_DISPOSITION_UNSPECIFIED
+}
+
+func (x *DeleteUserRequest) GetTransferUserId() int32 {
+	if x != nil {
+		return x.TransferUserId
+	}
+	return 0
+}
+
+func (x *DeleteUserRequest) GetTransferGroupId() int32 {
+	if x != nil {
+		return x.TransferGroupId
+	}
+	return 0
+}
+
+type PreviewDeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDeleteUserRequest) Reset() {
+	*x = PreviewDeleteUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDeleteUserRequest) ProtoMessage() {}
+
+func (x *PreviewDeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*PreviewDeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewDeleteUserRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PreviewDeleteUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shortcuts created by the user, including the ones in the trash.
+	Shortcuts []*Shortcut `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	// The collections created by the user, including the ones in the trash.
+	Collections []*Collection `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	// The groups created by the user. They are transferred along with the content, otherwise
+	// they are kept and can still be managed by admins.
+	Groups        []*Group `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDeleteUserResponse) Reset() {
+	*x = PreviewDeleteUserResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDeleteUserResponse) ProtoMessage() {}
+
+func (x *PreviewDeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*PreviewDeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewDeleteUserResponse) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+func (x *PreviewDeleteUserResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *PreviewDeleteUserResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type ListUserAccessTokensRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// id is the user id.
@@ -497,7 +690,7 @@ type ListUserAccessTokensRequest struct {
 
 func (x *ListUserAccessTokensRequest) Reset() {
 	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -509,7 +702,7 @@ func (x *ListUserAccessTokensRequest) String() string {
 func (*ListUserAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,7 +715,7 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUserAccessTokensRequest) GetId() int32 {
@@ -541,7 +734,7 @@ type ListUserAccessTokensResponse struct {
 
 func (x *ListUserAccessTokensResponse) Reset() {
 	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -553,7 +746,7 @@ func (x *ListUserAccessTokensResponse) String() string {
 func (*ListUserAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,7 +759,7 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
@@ -591,7 +784,7 @@ type CreateUserAccessTokenRequest struct {
 
 func (x *CreateUserAccessTokenRequest) Reset() {
 	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +796,7 @@ func (x *CreateUserAccessTokenRequest) String() string {
 func (*CreateUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +809,7 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateUserAccessTokenRequest) GetId() int32 {
@@ -652,7 +845,7 @@ type DeleteUserAccessTokenRequest struct {
 
 func (x *DeleteUserAccessTokenRequest) Reset() {
 	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -664,7 +857,7 @@ func (x *DeleteUserAccessTokenRequest) String() string {
 func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +870,7 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteUserAccessTokenRequest) GetId() int32 {
@@ -706,7 +899,7 @@ type UserAccessToken struct {
 
 func (x *UserAccessToken) Reset() {
 	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +911,7 @@ func (x *UserAccessToken) String() string {
 func (*UserAccessToken) ProtoMessage() {}
 
 func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +924,7 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
 func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UserAccessToken) GetAccessToken() string {
@@ -766,7 +959,7 @@ var File_api_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/user_service.proto\x12\x10monotreme.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x04\n" +
+	"\x19api/v1/user_service.proto\x12\x10monotreme.api.v1\x1a\x1fapi/v1/collection_service.proto\x1a\x13api/v1/common.proto\x1a\x1aapi/v1/group_service.proto\x1a\x1dapi/v1/shortcut_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12-\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x17.monotreme.api.v1.StateR\x05state\x12=\n" +
@@ -794,9 +987,24 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x11UpdateUserRequest\x12*\n" +
 	"\x04user\x18\x01 \x01(\v2\x16.monotreme.api.v1.UserR\x04user\x12;\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
-	"updateMask\"#\n" +
+	"updateMask\"\xc4\x02\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12g\n" +
+	"\x13content_disposition\x18\x02 \x01(\x0e26.monotreme.api.v1.DeleteUserRequest.ContentDispositionR\x12contentDisposition\x12(\n" +
+	"\x10transfer_user_id\x18\x03 \x01(\x05R\x0etransferUserId\x12*\n" +
+	"\x11transfer_group_id\x18\x04 \x01(\x05R\x0ftransferGroupId\"`\n" +
+	"\x12ContentDisposition\x12#\n" +
+	"\x1fCONTENT_DISPOSITION_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bTRANSFER\x10\x01\x12\v\n" +
+	"\aARCHIVE\x10\x02\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x03\"*\n" +
+	"\x18PreviewDeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xc6\x01\n" +
+	"\x19PreviewDeleteUserResponse\x128\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x1a.monotreme.api.v1.ShortcutR\tshortcuts\x12>\n" +
+	"\vcollections\x18\x02 \x03(\v2\x1c.monotreme.api.v1.CollectionR\vcollections\x12/\n" +
+	"\x06groups\x18\x03 \x03(\v2\x17.monotreme.api.v1.GroupR\x06groups\"-\n" +
 	"\x1bListUserAccessTokensRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"f\n" +
 	"\x1cListUserAccessTokensResponse\x12F\n" +
@@ -819,7 +1027,7 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x04Role\x12\x14\n" +
 	"\x10ROLE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05ADMIN\x10\x01\x12\b\n" +
-	"\x04USER\x10\x022\xad\b\n" +
+	"\x04USER\x10\x022\xce\t\n" +
 	"\vUserService\x12k\n" +
 	"\tListUsers\x12\".monotreme.api.v1.ListUsersRequest\x1a#.monotreme.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12d\n" +
 	"\aGetUser\x12 .monotreme.api.v1.GetUserRequest\x1a\x16.monotreme.api.v1.User\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/users/{id}\x12f\n" +
@@ -828,7 +1036,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateUser\x12#.monotreme.api.v1.UpdateUserRequest\x1a\x16.monotreme.api.v1.User\"8\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02\x1f:\x04user2\x17/api/v1/users/{user.id}\x12j\n" +
 	"\n" +
-	"DeleteUser\x12#.monotreme.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12\xa4\x01\n" +
+	"DeleteUser\x12#.monotreme.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"\x1f\xdaA\x02id\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/users/{id}\x12\x9e\x01\n" +
+	"\x11PreviewDeleteUser\x12*.monotreme.api.v1.PreviewDeleteUserRequest\x1a+.monotreme.api.v1.PreviewDeleteUserResponse\"0\xdaA\x02id\x82\xd3\xe4\x93\x02%\x12#/api/v1/users/{id}/deletion_preview\x12\xa4\x01\n" +
 	"\x14ListUserAccessTokens\x12-.monotreme.api.v1.ListUserAccessTokensRequest\x1a..monotreme.api.v1.ListUserAccessTokensResponse\"-\xdaA\x02id\x82\xd3\xe4\x93\x02\"\x12 /api/v1/users/{id}/access_tokens\x12\x9c\x01\n" +
 	"\x15CreateUserAccessToken\x12..monotreme.api.v1.CreateUserAccessTokenRequest\x1a!.monotreme.api.v1.UserAccessToken\"0\xdaA\x02id\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/users/{id}/access_tokens\x12\xaa\x01\n" +
 	"\x15DeleteUserAccessToken\x12..monotreme.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"I\xdaA\x0fid,access_token\x82\xd3\xe4\x93\x021*//api/v1/users/{id}/access_tokens/{access_token}B\xbe\x01\n" +
@@ -846,61 +1055,73 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(Role)(0),                            // 0: monotreme.api.v1.Role
-	(*User)(nil),                         // 1: monotreme.api.v1.User
-	(*ListUsersRequest)(nil),             // 2: monotreme.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),            // 3: monotreme.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),               // 4: monotreme.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),            // 5: monotreme.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),            // 6: monotreme.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),            // 7: monotreme.api.v1.DeleteUserRequest
-	(*ListUserAccessTokensRequest)(nil),  // 8: monotreme.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil), // 9: monotreme.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil), // 10: monotreme.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil), // 11: monotreme.api.v1.DeleteUserAccessTokenRequest
-	(*UserAccessToken)(nil),              // 12: monotreme.api.v1.UserAccessToken
-	(State)(0),                           // 13: monotreme.api.v1.State
-	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 15: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 16: google.protobuf.Empty
+	(Role)(0), // 0: monotreme.api.v1.Role
+	(DeleteUserRequest_ContentDisposition)(0), // 1: monotreme.api.v1.DeleteUserRequest.ContentDisposition
+	(*User)(nil),                         // 2: monotreme.api.v1.User
+	(*ListUsersRequest)(nil),             // 3: monotreme.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),            // 4: monotreme.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),               // 5: monotreme.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),            // 6: monotreme.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),            // 7: monotreme.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),            // 8: monotreme.api.v1.DeleteUserRequest
+	(*PreviewDeleteUserRequest)(nil),     // 9: monotreme.api.v1.PreviewDeleteUserRequest
+	(*PreviewDeleteUserResponse)(nil),    // 10: monotreme.api.v1.PreviewDeleteUserResponse
+	(*ListUserAccessTokensRequest)(nil),  // 11: monotreme.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil), // 12: monotreme.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil), // 13: monotreme.api.v1.CreateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil), // 14: monotreme.api.v1.DeleteUserAccessTokenRequest
+	(*UserAccessToken)(nil),              // 15: monotreme.api.v1.UserAccessToken
+	(State)(0),                           // 16: monotreme.api.v1.State
+	(*timestamppb.Timestamp)(nil),        // 17: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),        // 18: google.protobuf.FieldMask
+	(*Shortcut)(nil),                     // 19: monotreme.api.v1.Shortcut
+	(*Collection)(nil),                   // 20: monotreme.api.v1.Collection
+	(*Group)(nil),                        // 21: monotreme.api.v1.Group
+	(*emptypb.Empty)(nil),                // 22: google.protobuf.Empty
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
-	13, // 0: monotreme.api.v1.User.state:type_name -> monotreme.api.v1.State
-	14, // 1: monotreme.api.v1.User.created_time:type_name -> google.protobuf.Timestamp
-	14, // 2: monotreme.api.v1.User.updated_time:type_name -> google.protobuf.Timestamp
+	16, // 0: monotreme.api.v1.User.state:type_name -> monotreme.api.v1.State
+	17, // 1: monotreme.api.v1.User.created_time:type_name -> google.protobuf.Timestamp
+	17, // 2: monotreme.api.v1.User.updated_time:type_name -> google.protobuf.Timestamp
 	0,  // 3: monotreme.api.v1.User.role:type_name -> monotreme.api.v1.Role
-	1,  // 4: monotreme.api.v1.ListUsersResponse.users:type_name -> monotreme.api.v1.User
-	1,  // 5: monotreme.api.v1.CreateUserRequest.user:type_name -> monotreme.api.v1.User
-	1,  // 6: monotreme.api.v1.UpdateUserRequest.user:type_name -> monotreme.api.v1.User
-	15, // 7: monotreme.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	12, // 8: monotreme.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> monotreme.api.v1.UserAccessToken
-	14, // 9: monotreme.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
-	14, // 10: monotreme.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	14, // 11: monotreme.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	2,  // 12: monotreme.api.v1.UserService.ListUsers:input_type -> monotreme.api.v1.ListUsersRequest
-	4,  // 13: monotreme.api.v1.UserService.GetUser:input_type -> monotreme.api.v1.GetUserRequest
-	5,  // 14: monotreme.api.v1.UserService.CreateUser:input_type -> monotreme.api.v1.CreateUserRequest
-	6,  // 15: monotreme.api.v1.UserService.UpdateUser:input_type -> monotreme.api.v1.UpdateUserRequest
-	7,  // 16: monotreme.api.v1.UserService.DeleteUser:input_type -> monotreme.api.v1.DeleteUserRequest
-	8,  // 17: monotreme.api.v1.UserService.ListUserAccessTokens:input_type -> monotreme.api.v1.ListUserAccessTokensRequest
-	10, // 18: monotreme.api.v1.UserService.CreateUserAccessToken:input_type -> monotreme.api.v1.CreateUserAccessTokenRequest
-	11, // 19: monotreme.api.v1.UserService.DeleteUserAccessToken:input_type -> monotreme.api.v1.DeleteUserAccessTokenRequest
-	3,  // 20: monotreme.api.v1.UserService.ListUsers:output_type -> monotreme.api.v1.ListUsersResponse
-	1,  // 21: monotreme.api.v1.UserService.GetUser:output_type -> monotreme.api.v1.User
-	1,  // 22: monotreme.api.v1.UserService.CreateUser:output_type -> monotreme.api.v1.User
-	1,  // 23: monotreme.api.v1.UserService.UpdateUser:output_type -> monotreme.api.v1.User
-	16, // 24: monotreme.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	9,  // 25: monotreme.api.v1.UserService.ListUserAccessTokens:output_type -> monotreme.api.v1.ListUserAccessTokensResponse
-	12, // 26: monotreme.api.v1.UserService.CreateUserAccessToken:output_type -> monotreme.api.v1.UserAccessToken
-	16, // 27: monotreme.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 4: monotreme.api.v1.ListUsersResponse.users:type_name -> monotreme.api.v1.User
+	2,  // 5: monotreme.api.v1.CreateUserRequest.user:type_name -> monotreme.api.v1.User
+	2,  // 6: monotreme.api.v1.UpdateUserRequest.user:type_name -> monotreme.api.v1.User
+	18, // 7: monotreme.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: monotreme.api.v1.DeleteUserRequest.content_disposition:type_name -> monotreme.api.v1.DeleteUserRequest.ContentDisposition
+	19, // 9: monotreme.api.v1.PreviewDeleteUserResponse.shortcuts:type_name -> monotreme.api.v1.Shortcut
+	20, // 10: monotreme.api.v1.PreviewDeleteUserResponse.collections:type_name -> monotreme.api.v1.Collection
+	21, // 11: monotreme.api.v1.PreviewDeleteUserResponse.groups:type_name -> monotreme.api.v1.Group
+	15, // 12: monotreme.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> monotreme.api.v1.UserAccessToken
+	17, // 13: monotreme.api.v1.CreateUserAccessTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	17, // 14: monotreme.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	17, // 15: monotreme.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 16: monotreme.api.v1.UserService.ListUsers:input_type -> monotreme.api.v1.ListUsersRequest
+	5,  // 17: monotreme.api.v1.UserService.GetUser:input_type -> monotreme.api.v1.GetUserRequest
+	6,  // 18: monotreme.api.v1.UserService.CreateUser:input_type -> monotreme.api.v1.CreateUserRequest
+	7,  // 19: monotreme.api.v1.UserService.UpdateUser:input_type -> monotreme.api.v1.UpdateUserRequest
+	8,  // 20: monotreme.api.v1.UserService.DeleteUser:input_type -> monotreme.api.v1.DeleteUserRequest
+	9,  // 21: monotreme.api.v1.UserService.PreviewDeleteUser:input_type -> monotreme.api.v1.PreviewDeleteUserRequest
+	11, // 22: monotreme.api.v1.UserService.ListUserAccessTokens:input_type -> monotreme.api.v1.ListUserAccessTokensRequest
+	13, // 23: monotreme.api.v1.UserService.CreateUserAccessToken:input_type -> monotreme.api.v1.CreateUserAccessTokenRequest
+	14, // 24: monotreme.api.v1.UserService.DeleteUserAccessToken:input_type -> monotreme.api.v1.DeleteUserAccessTokenRequest
+	4,  // 25: monotreme.api.v1.UserService.ListUsers:output_type -> monotreme.api.v1.ListUsersResponse
+	2,  // 26: monotreme.api.v1.UserService.GetUser:output_type -> monotreme.api.v1.User
+	2,  // 27: monotreme.api.v1.UserService.CreateUser:output_type -> monotreme.api.v1.User
+	2,  // 28: monotreme.api.v1.UserService.UpdateUser:output_type -> monotreme.api.v1.User
+	22, // 29: monotreme.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	10, // 30: monotreme.api.v1.UserService.PreviewDeleteUser:output_type -> monotreme.api.v1.PreviewDeleteUserResponse
+	12, // 31: monotreme.api.v1.UserService.ListUserAccessTokens:output_type -> monotreme.api.v1.ListUserAccessTokensResponse
+	15, // 32: monotreme.api.v1.UserService.CreateUserAccessToken:output_type -> monotreme.api.v1.UserAccessToken
+	22, // 33: monotreme.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -908,15 +1129,18 @@ func file_api_v1_user_service_proto_init() {
 	if File_api_v1_user_service_proto != nil {
 		return
 	}
+	file_api_v1_collection_service_proto_init()
 	file_api_v1_common_proto_init()
-	file_api_v1_user_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_api_v1_group_service_proto_init()
+	file_api_v1_shortcut_service_proto_init()
+	file_api_v1_user_service_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,7 @@ const (
 	UserService_CreateUser_FullMethodName            = "/monotreme.api.v1.UserService/CreateUser"
 	UserService_UpdateUser_FullMethodName            = "/monotreme.api.v1.UserService/UpdateUser"
 	UserService_DeleteUser_FullMethodName            = "/monotreme.api.v1.UserService/DeleteUser"
+	UserService_PreviewDeleteUser_FullMethodName     = "/monotreme.api.v1.UserService/PreviewDeleteUser"
 	UserService_ListUserAccessTokens_FullMethodName  = "/monotreme.api.v1.UserService/ListUserAccessTokens"
 	UserService_CreateUserAccessToken_FullMethodName = "/monotreme.api.v1.UserService/CreateUserAccessToken"
 	UserService_DeleteUserAccessToken_FullMethodName = "/monotreme.api.v1.UserService/DeleteUserAccessToken"
@@ -41,8 +42,11 @@ type UserServiceClient interface {
 	// CreateUser creates a new user.
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
-	// DeleteUser deletes a user by id.
+	// DeleteUser deletes a user by id. A content disposition is required when the user
+	// has created shortcuts or collections.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PreviewDeleteUser returns the content affected by deleting a user.
+	PreviewDeleteUser(ctx context.Context, in *PreviewDeleteUserRequest, opts ...grpc.CallOption) (*PreviewDeleteUserResponse, error)
 	// ListUserAccessTokens returns a list of access tokens for a user.
 	ListUserAccessTokens(ctx context.Context, in *ListUserAccessTokensRequest, opts ...grpc.CallOption) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
@@ -109,6 +113,16 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) PreviewDeleteUser(ctx context.Context, in *PreviewDeleteUserRequest, opts ...grpc.CallOption) (*PreviewDeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewDeleteUserResponse)
+	err := c.cc.Invoke(ctx, UserService_PreviewDeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ListUserAccessTokens(ctx context.Context, in *ListUserAccessTokensRequest, opts ...grpc.CallOption) (*ListUserAccessTokensResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserAccessTokensResponse)
@@ -150,8 +164,11 @@ type UserServiceServer interface {
 	// CreateUser creates a new user.
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
-	// DeleteUser deletes a user by id.
+	// DeleteUser deletes a user by id. A content disposition is required when the user
+	// has created shortcuts or collections.
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// PreviewDeleteUser returns the content affected by deleting a user.
+	PreviewDeleteUser(context.Context, *PreviewDeleteUserRequest) (*PreviewDeleteUserResponse, error)
 	// ListUserAccessTokens returns a list of access tokens for a user.
 	ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
@@ -183,6 +200,9 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedUserServiceServer) PreviewDeleteUser(context.Context, *PreviewDeleteUserRequest) (*PreviewDeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewDeleteUser not implemented")
+}
 func (UnimplementedUserServiceServer) ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUserAccessTokens not implemented")
 }
@@ -303,6 +323,24 @@ func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_PreviewDeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewDeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PreviewDeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_PreviewDeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PreviewDeleteUser(ctx, req.(*PreviewDeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ListUserAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserAccessTokensRequest)
 	if err := dec(in); err != nil {
@@ -384,6 +422,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "PreviewDeleteUser",
+			Handler:    _UserService_PreviewDeleteUser_Handler,
+		},
 		{
 			MethodName: "ListUserAccessTokens",
 			Handler:    _UserService_ListUserAccessTokens_Handler,
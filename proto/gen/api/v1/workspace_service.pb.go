@@ -66,7 +66,7 @@ func (x IdentityProvider_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IdentityProvider_Type.Descriptor instead.
 func (IdentityProvider_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 0}
 }
 
 type WorkspaceProfile struct {
@@ -178,8 +178,30 @@ type WorkspaceSetting struct {
 	DisallowPasswordAuth bool `protobuf:"varint,7,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
 	// The prefix used for shortcut URLs (e.g. "s" for "/s/shortcut-name").
 	ShortcutPrefix string `protobuf:"bytes,8,opt,name=shortcut_prefix,json=shortcutPrefix,proto3" json:"shortcut_prefix,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The default redirect mode of shortcuts.
+	DefaultRedirectMode RedirectMode `protobuf:"varint,9,opt,name=default_redirect_mode,json=defaultRedirectMode,proto3,enum=monotreme.api.v1.RedirectMode" json:"default_redirect_mode,omitempty"`
+	// The message shown when a shortcut is visited before it becomes valid.
+	ShortcutPendingMessage string `protobuf:"bytes,10,opt,name=shortcut_pending_message,json=shortcutPendingMessage,proto3" json:"shortcut_pending_message,omitempty"`
+	// The message shown when an expired shortcut is visited.
+	ShortcutExpiredMessage string `protobuf:"bytes,11,opt,name=shortcut_expired_message,json=shortcutExpiredMessage,proto3" json:"shortcut_expired_message,omitempty"`
+	// The number of days after expiry before a shortcut is archived. 0 uses the default of 30 days.
+	ExpiredShortcutArchiveDays int32 `protobuf:"varint,12,opt,name=expired_shortcut_archive_days,json=expiredShortcutArchiveDays,proto3" json:"expired_shortcut_archive_days,omitempty"`
+	// Whether a visit to an unknown shortcut name redirects to the only close match.
+	AutoRedirectNotFound bool `protobuf:"varint,13,opt,name=auto_redirect_not_found,json=autoRedirectNotFound,proto3" json:"auto_redirect_not_found,omitempty"`
+	// How shortcut names are compared.
+	ShortcutNamePolicy *ShortcutNamePolicy `protobuf:"bytes,14,opt,name=shortcut_name_policy,json=shortcutNamePolicy,proto3" json:"shortcut_name_policy,omitempty"`
+	// The hosts on which bare paths such as /standup resolve as shortcut names.
+	RedirectHosts []string `protobuf:"bytes,15,rep,name=redirect_hosts,json=redirectHosts,proto3" json:"redirect_hosts,omitempty"`
+	// Former shortcut prefixes, which redirect permanently to the shortcut_prefix URL.
+	LegacyShortcutPrefixes []string `protobuf:"bytes,16,rep,name=legacy_shortcut_prefixes,json=legacyShortcutPrefixes,proto3" json:"legacy_shortcut_prefixes,omitempty"`
+	// The prefix of collection URLs.
+	CollectionPrefix string `protobuf:"bytes,17,opt,name=collection_prefix,json=collectionPrefix,proto3" json:"collection_prefix,omitempty"`
+	// Former collection prefixes, which redirect permanently to the collection_prefix URL.
+	LegacyCollectionPrefixes []string `protobuf:"bytes,18,rep,name=legacy_collection_prefixes,json=legacyCollectionPrefixes,proto3" json:"legacy_collection_prefixes,omitempty"`
+	// The number of days deleted shortcuts and collections stay in the trash. 0 uses the default of 30 days.
+	TrashRetentionDays int32 `protobuf:"varint,19,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting) Reset() {
@@ -268,6 +290,146 @@ func (x *WorkspaceSetting) GetShortcutPrefix() string {
 	return ""
 }
 
+func (x *WorkspaceSetting) GetDefaultRedirectMode() RedirectMode {
+	if x != nil {
+		return x.DefaultRedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting) GetShortcutPendingMessage() string {
+	if x != nil {
+		return x.ShortcutPendingMessage
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetShortcutExpiredMessage() string {
+	if x != nil {
+		return x.ShortcutExpiredMessage
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetExpiredShortcutArchiveDays() int32 {
+	if x != nil {
+		return x.ExpiredShortcutArchiveDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting) GetAutoRedirectNotFound() bool {
+	if x != nil {
+		return x.AutoRedirectNotFound
+	}
+	return false
+}
+
+func (x *WorkspaceSetting) GetShortcutNamePolicy() *ShortcutNamePolicy {
+	if x != nil {
+		return x.ShortcutNamePolicy
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRedirectHosts() []string {
+	if x != nil {
+		return x.RedirectHosts
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetLegacyShortcutPrefixes() []string {
+	if x != nil {
+		return x.LegacyShortcutPrefixes
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCollectionPrefix() string {
+	if x != nil {
+		return x.CollectionPrefix
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetLegacyCollectionPrefixes() []string {
+	if x != nil {
+		return x.LegacyCollectionPrefixes
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
+type ShortcutNamePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether names differing only in case are the same.
+	CaseInsensitive bool `protobuf:"varint,1,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// Whether names are compared after NFKC Unicode normalization.
+	UnicodeNormalization bool `protobuf:"varint,2,opt,name=unicode_normalization,json=unicodeNormalization,proto3" json:"unicode_normalization,omitempty"`
+	// Whether "-", "_" and "." are the same.
+	EquivalentSeparators bool `protobuf:"varint,3,opt,name=equivalent_separators,json=equivalentSeparators,proto3" json:"equivalent_separators,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShortcutNamePolicy) Reset() {
+	*x = ShortcutNamePolicy{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutNamePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutNamePolicy) ProtoMessage() {}
+
+func (x *ShortcutNamePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutNamePolicy.ProtoReflect.Descriptor instead.
+func (*ShortcutNamePolicy) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShortcutNamePolicy) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *ShortcutNamePolicy) GetUnicodeNormalization() bool {
+	if x != nil {
+		return x.UnicodeNormalization
+	}
+	return false
+}
+
+func (x *ShortcutNamePolicy) GetEquivalentSeparators() bool {
+	if x != nil {
+		return x.EquivalentSeparators
+	}
+	return false
+}
+
 type IdentityProvider struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The unique identifier of the identity provider.
@@ -281,7 +443,7 @@ type IdentityProvider struct {
 
 func (x *IdentityProvider) Reset() {
 	*x = IdentityProvider{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +455,7 @@ func (x *IdentityProvider) String() string {
 func (*IdentityProvider) ProtoMessage() {}
 
 func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +468,7 @@ func (x *IdentityProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProvider.ProtoReflect.Descriptor instead.
 func (*IdentityProvider) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *IdentityProvider) GetId() string {
@@ -349,7 +511,7 @@ type IdentityProviderConfig struct {
 
 func (x *IdentityProviderConfig) Reset() {
 	*x = IdentityProviderConfig{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +523,7 @@ func (x *IdentityProviderConfig) String() string {
 func (*IdentityProviderConfig) ProtoMessage() {}
 
 func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +536,7 @@ func (x *IdentityProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityProviderConfig.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IdentityProviderConfig) GetConfig() isIdentityProviderConfig_Config {
@@ -411,7 +573,7 @@ type GetWorkspaceProfileRequest struct {
 
 func (x *GetWorkspaceProfileRequest) Reset() {
 	*x = GetWorkspaceProfileRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -423,7 +585,7 @@ func (x *GetWorkspaceProfileRequest) String() string {
 func (*GetWorkspaceProfileRequest) ProtoMessage() {}
 
 func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -436,7 +598,7 @@ func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
 }
 
 type GetWorkspaceSettingRequest struct {
@@ -447,7 +609,7 @@ type GetWorkspaceSettingRequest struct {
 
 func (x *GetWorkspaceSettingRequest) Reset() {
 	*x = GetWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +621,7 @@ func (x *GetWorkspaceSettingRequest) String() string {
 func (*GetWorkspaceSettingRequest) ProtoMessage() {}
 
 func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +634,7 @@ func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
 }
 
 type UpdateWorkspaceSettingRequest struct {
@@ -487,7 +649,7 @@ type UpdateWorkspaceSettingRequest struct {
 
 func (x *UpdateWorkspaceSettingRequest) Reset() {
 	*x = UpdateWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +661,7 @@ func (x *UpdateWorkspaceSettingRequest) String() string {
 func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
 
 func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +674,7 @@ func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
@@ -537,7 +699,7 @@ type GetWorkspaceStatsRequest struct {
 
 func (x *GetWorkspaceStatsRequest) Reset() {
 	*x = GetWorkspaceStatsRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -549,7 +711,7 @@ func (x *GetWorkspaceStatsRequest) String() string {
 func (*GetWorkspaceStatsRequest) ProtoMessage() {}
 
 func (x *GetWorkspaceStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +724,7 @@ func (x *GetWorkspaceStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkspaceStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkspaceStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
 }
 
 type WorkspaceStats struct {
@@ -583,7 +745,7 @@ type WorkspaceStats struct {
 
 func (x *WorkspaceStats) Reset() {
 	*x = WorkspaceStats{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +757,7 @@ func (x *WorkspaceStats) String() string {
 func (*WorkspaceStats) ProtoMessage() {}
 
 func (x *WorkspaceStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +770,7 @@ func (x *WorkspaceStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkspaceStats.ProtoReflect.Descriptor instead.
 func (*WorkspaceStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *WorkspaceStats) GetTotalShortcuts() int32 {
@@ -646,6 +808,285 @@ func (x *WorkspaceStats) GetHistoricalData() []*StatsMeasurement {
 	return nil
 }
 
+type GetWorkspaceRuntimeStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceRuntimeStatsRequest) Reset() {
+	*x = GetWorkspaceRuntimeStatsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceRuntimeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceRuntimeStatsRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceRuntimeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceRuntimeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRuntimeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10}
+}
+
+type WorkspaceRuntimeStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The counters of the cache that resolves shortcut paths on redirects.
+	ResolverCache *ResolverCacheStats `protobuf:"bytes,1,opt,name=resolver_cache,json=resolverCache,proto3" json:"resolver_cache,omitempty"`
+	// The counters of the buffer that click events are written through.
+	ActivityIngestion *ActivityIngestionStats `protobuf:"bytes,2,opt,name=activity_ingestion,json=activityIngestion,proto3" json:"activity_ingestion,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceRuntimeStats) Reset() {
+	*x = WorkspaceRuntimeStats{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceRuntimeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceRuntimeStats) ProtoMessage() {}
+
+func (x *WorkspaceRuntimeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceRuntimeStats.ProtoReflect.Descriptor instead.
+func (*WorkspaceRuntimeStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkspaceRuntimeStats) GetResolverCache() *ResolverCacheStats {
+	if x != nil {
+		return x.ResolverCache
+	}
+	return nil
+}
+
+func (x *WorkspaceRuntimeStats) GetActivityIngestion() *ActivityIngestionStats {
+	if x != nil {
+		return x.ActivityIngestion
+	}
+	return nil
+}
+
+type ResolverCacheStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of cached paths, zero when the cache is disabled.
+	Capacity int32 `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// The number of cached paths.
+	Entries int32 `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	// The lookups served from the cache.
+	Hits int64 `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`
+	// The hits for paths that name no shortcut, included in hits.
+	NegativeHits int64 `protobuf:"varint,4,opt,name=negative_hits,json=negativeHits,proto3" json:"negative_hits,omitempty"`
+	// The lookups that went to the database.
+	Misses int64 `protobuf:"varint,5,opt,name=misses,proto3" json:"misses,omitempty"`
+	// The paths dropped to stay within the capacity.
+	Evictions     int64 `protobuf:"varint,6,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolverCacheStats) Reset() {
+	*x = ResolverCacheStats{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolverCacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolverCacheStats) ProtoMessage() {}
+
+func (x *ResolverCacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolverCacheStats.ProtoReflect.Descriptor instead.
+func (*ResolverCacheStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResolverCacheStats) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ResolverCacheStats) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *ResolverCacheStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *ResolverCacheStats) GetNegativeHits() int64 {
+	if x != nil {
+		return x.NegativeHits
+	}
+	return 0
+}
+
+func (x *ResolverCacheStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *ResolverCacheStats) GetEvictions() int64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+type ActivityIngestionStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What happens to events when the buffer is full, "drop" or "block".
+	Policy string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The number of events the buffer holds.
+	Capacity int32 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// The number of events waiting to be written.
+	Buffered int32 `protobuf:"varint,3,opt,name=buffered,proto3" json:"buffered,omitempty"`
+	// The events accepted into the buffer.
+	Enqueued int64 `protobuf:"varint,4,opt,name=enqueued,proto3" json:"enqueued,omitempty"`
+	// The events written to the database.
+	Written int64 `protobuf:"varint,5,opt,name=written,proto3" json:"written,omitempty"`
+	// The events dropped because the buffer was full or the server was stopping.
+	Dropped int64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// The events of batches that could not be written.
+	Failed        int64 `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityIngestionStats) Reset() {
+	*x = ActivityIngestionStats{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityIngestionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityIngestionStats) ProtoMessage() {}
+
+func (x *ActivityIngestionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityIngestionStats.ProtoReflect.Descriptor instead.
+func (*ActivityIngestionStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ActivityIngestionStats) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *ActivityIngestionStats) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ActivityIngestionStats) GetBuffered() int32 {
+	if x != nil {
+		return x.Buffered
+	}
+	return 0
+}
+
+func (x *ActivityIngestionStats) GetEnqueued() int64 {
+	if x != nil {
+		return x.Enqueued
+	}
+	return 0
+}
+
+func (x *ActivityIngestionStats) GetWritten() int64 {
+	if x != nil {
+		return x.Written
+	}
+	return 0
+}
+
+func (x *ActivityIngestionStats) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *ActivityIngestionStats) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
 type StatsMeasurement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unix timestamp when the measurement was taken
@@ -664,7 +1105,7 @@ type StatsMeasurement struct {
 
 func (x *StatsMeasurement) Reset() {
 	*x = StatsMeasurement{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -676,7 +1117,7 @@ func (x *StatsMeasurement) String() string {
 func (*StatsMeasurement) ProtoMessage() {}
 
 func (x *StatsMeasurement) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +1130,7 @@ func (x *StatsMeasurement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsMeasurement.ProtoReflect.Descriptor instead.
 func (*StatsMeasurement) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StatsMeasurement) GetMeasuredTs() int64 {
@@ -737,7 +1178,7 @@ type IdentityProviderConfig_FieldMapping struct {
 
 func (x *IdentityProviderConfig_FieldMapping) Reset() {
 	*x = IdentityProviderConfig_FieldMapping{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +1190,7 @@ func (x *IdentityProviderConfig_FieldMapping) String() string {
 func (*IdentityProviderConfig_FieldMapping) ProtoMessage() {}
 
 func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +1203,7 @@ func (x *IdentityProviderConfig_FieldMapping) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use IdentityProviderConfig_FieldMapping.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig_FieldMapping) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 0}
 }
 
 func (x *IdentityProviderConfig_FieldMapping) GetIdentifier() string {
@@ -794,7 +1235,7 @@ type IdentityProviderConfig_OAuth2Config struct {
 
 func (x *IdentityProviderConfig_OAuth2Config) Reset() {
 	*x = IdentityProviderConfig_OAuth2Config{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +1247,7 @@ func (x *IdentityProviderConfig_OAuth2Config) String() string {
 func (*IdentityProviderConfig_OAuth2Config) ProtoMessage() {}
 
 func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +1260,7 @@ func (x *IdentityProviderConfig_OAuth2Config) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use IdentityProviderConfig_OAuth2Config.ProtoReflect.Descriptor instead.
 func (*IdentityProviderConfig_OAuth2Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3, 1}
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4, 1}
 }
 
 func (x *IdentityProviderConfig_OAuth2Config) GetClientId() string {
@@ -882,7 +1323,7 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x05owner\x18\x03 \x01(\tR\x05owner\x12B\n" +
 	"\fsubscription\x18\x04 \x01(\v2\x1e.monotreme.api.v1.SubscriptionR\fsubscription\x12!\n" +
 	"\fcustom_style\x18\x05 \x01(\tR\vcustomStyle\x12\x1a\n" +
-	"\bbranding\x18\x06 \x01(\fR\bbranding\"\xb1\x03\n" +
+	"\bbranding\x18\x06 \x01(\fR\bbranding\"\xc9\b\n" +
 	"\x10WorkspaceSetting\x12!\n" +
 	"\finstance_url\x18\x01 \x01(\tR\vinstanceUrl\x12\x1a\n" +
 	"\bbranding\x18\x02 \x01(\fR\bbranding\x12!\n" +
@@ -891,7 +1332,23 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x12identity_providers\x18\x05 \x03(\v2\".monotreme.api.v1.IdentityProviderR\x11identityProviders\x12<\n" +
 	"\x1adisallow_user_registration\x18\x06 \x01(\bR\x18disallowUserRegistration\x124\n" +
 	"\x16disallow_password_auth\x18\a \x01(\bR\x14disallowPasswordAuth\x12'\n" +
-	"\x0fshortcut_prefix\x18\b \x01(\tR\x0eshortcutPrefix\"\xe1\x01\n" +
+	"\x0fshortcut_prefix\x18\b \x01(\tR\x0eshortcutPrefix\x12R\n" +
+	"\x15default_redirect_mode\x18\t \x01(\x0e2\x1e.monotreme.api.v1.RedirectModeR\x13defaultRedirectMode\x128\n" +
+	"\x18shortcut_pending_message\x18\n" +
+	" \x01(\tR\x16shortcutPendingMessage\x128\n" +
+	"\x18shortcut_expired_message\x18\v \x01(\tR\x16shortcutExpiredMessage\x12A\n" +
+	"\x1dexpired_shortcut_archive_days\x18\f \x01(\x05R\x1aexpiredShortcutArchiveDays\x125\n" +
+	"\x17auto_redirect_not_found\x18\r \x01(\bR\x14autoRedirectNotFound\x12V\n" +
+	"\x14shortcut_name_policy\x18\x0e \x01(\v2$.monotreme.api.v1.ShortcutNamePolicyR\x12shortcutNamePolicy\x12%\n" +
+	"\x0eredirect_hosts\x18\x0f \x03(\tR\rredirectHosts\x128\n" +
+	"\x18legacy_shortcut_prefixes\x18\x10 \x03(\tR\x16legacyShortcutPrefixes\x12+\n" +
+	"\x11collection_prefix\x18\x11 \x01(\tR\x10collectionPrefix\x12<\n" +
+	"\x1alegacy_collection_prefixes\x18\x12 \x03(\tR\x18legacyCollectionPrefixes\x120\n" +
+	"\x14trash_retention_days\x18\x13 \x01(\x05R\x12trashRetentionDays\"\xa9\x01\n" +
+	"\x12ShortcutNamePolicy\x12)\n" +
+	"\x10case_insensitive\x18\x01 \x01(\bR\x0fcaseInsensitive\x123\n" +
+	"\x15unicode_normalization\x18\x02 \x01(\bR\x14unicodeNormalization\x123\n" +
+	"\x15equivalent_separators\x18\x03 \x01(\bR\x14equivalentSeparators\"\xe1\x01\n" +
 	"\x10IdentityProvider\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12;\n" +
@@ -931,7 +1388,26 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x11total_collections\x18\x03 \x01(\x05R\x10totalCollections\x12\x1d\n" +
 	"\n" +
 	"total_hits\x18\x04 \x01(\x05R\ttotalHits\x12K\n" +
-	"\x0fhistorical_data\x18\x05 \x03(\v2\".monotreme.api.v1.StatsMeasurementR\x0ehistoricalData\"\xc9\x01\n" +
+	"\x0fhistorical_data\x18\x05 \x03(\v2\".monotreme.api.v1.StatsMeasurementR\x0ehistoricalData\"!\n" +
+	"\x1fGetWorkspaceRuntimeStatsRequest\"\xbd\x01\n" +
+	"\x15WorkspaceRuntimeStats\x12K\n" +
+	"\x0eresolver_cache\x18\x01 \x01(\v2$.monotreme.api.v1.ResolverCacheStatsR\rresolverCache\x12W\n" +
+	"\x12activity_ingestion\x18\x02 \x01(\v2(.monotreme.api.v1.ActivityIngestionStatsR\x11activityIngestion\"\xb9\x01\n" +
+	"\x12ResolverCacheStats\x12\x1a\n" +
+	"\bcapacity\x18\x01 \x01(\x05R\bcapacity\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x05R\aentries\x12\x12\n" +
+	"\x04hits\x18\x03 \x01(\x03R\x04hits\x12#\n" +
+	"\rnegative_hits\x18\x04 \x01(\x03R\fnegativeHits\x12\x16\n" +
+	"\x06misses\x18\x05 \x01(\x03R\x06misses\x12\x1c\n" +
+	"\tevictions\x18\x06 \x01(\x03R\tevictions\"\xd0\x01\n" +
+	"\x16ActivityIngestionStats\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\bbuffered\x18\x03 \x01(\x05R\bbuffered\x12\x1a\n" +
+	"\benqueued\x18\x04 \x01(\x03R\benqueued\x12\x18\n" +
+	"\awritten\x18\x05 \x01(\x03R\awritten\x12\x18\n" +
+	"\adropped\x18\x06 \x01(\x03R\adropped\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x03R\x06failed\"\xc9\x01\n" +
 	"\x10StatsMeasurement\x12\x1f\n" +
 	"\vmeasured_ts\x18\x01 \x01(\x03R\n" +
 	"measuredTs\x12'\n" +
@@ -940,12 +1416,13 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"usersCount\x12+\n" +
 	"\x11collections_count\x18\x04 \x01(\x05R\x10collectionsCount\x12\x1d\n" +
 	"\n" +
-	"hits_count\x18\x05 \x01(\x05R\thitsCount2\xe3\x04\n" +
+	"hits_count\x18\x05 \x01(\x05R\thitsCount2\x85\x06\n" +
 	"\x10WorkspaceService\x12\x8a\x01\n" +
 	"\x13GetWorkspaceProfile\x12,.monotreme.api.v1.GetWorkspaceProfileRequest\x1a\".monotreme.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profile\x12\x8a\x01\n" +
 	"\x13GetWorkspaceSetting\x12,.monotreme.api.v1.GetWorkspaceSettingRequest\x1a\".monotreme.api.v1.WorkspaceSetting\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/setting\x12\xaf\x01\n" +
 	"\x16UpdateWorkspaceSetting\x12/.monotreme.api.v1.UpdateWorkspaceSettingRequest\x1a\".monotreme.api.v1.WorkspaceSetting\"@\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x02$:\asetting2\x19/api/v1/workspace/setting\x12\x82\x01\n" +
-	"\x11GetWorkspaceStats\x12*.monotreme.api.v1.GetWorkspaceStatsRequest\x1a .monotreme.api.v1.WorkspaceStats\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/workspace/statsB\xc3\x01\n" +
+	"\x11GetWorkspaceStats\x12*.monotreme.api.v1.GetWorkspaceStatsRequest\x1a .monotreme.api.v1.WorkspaceStats\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/workspace/stats\x12\x9f\x01\n" +
+	"\x18GetWorkspaceRuntimeStats\x121.monotreme.api.v1.GetWorkspaceRuntimeStatsRequest\x1a'.monotreme.api.v1.WorkspaceRuntimeStats\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/workspace/runtime-statsB\xc3\x01\n" +
 	"\x14com.monotreme.api.v1B\x15WorkspaceServiceProtoP\x01Z2github.com/bshort/monotreme/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\x10Monotreme.Api.V1\xca\x02\x10Monotreme\\Api\\V1\xe2\x02\x1cMonotreme\\Api\\V1\\GPBMetadata\xea\x02\x12Monotreme::Api::V1b\x06proto3"
 
 var (
@@ -961,49 +1438,61 @@ func file_api_v1_workspace_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_api_v1_workspace_service_proto_goTypes = []any{
 	(IdentityProvider_Type)(0),                  // 0: monotreme.api.v1.IdentityProvider.Type
 	(*WorkspaceProfile)(nil),                    // 1: monotreme.api.v1.WorkspaceProfile
 	(*WorkspaceSetting)(nil),                    // 2: monotreme.api.v1.WorkspaceSetting
-	(*IdentityProvider)(nil),                    // 3: monotreme.api.v1.IdentityProvider
-	(*IdentityProviderConfig)(nil),              // 4: monotreme.api.v1.IdentityProviderConfig
-	(*GetWorkspaceProfileRequest)(nil),          // 5: monotreme.api.v1.GetWorkspaceProfileRequest
-	(*GetWorkspaceSettingRequest)(nil),          // 6: monotreme.api.v1.GetWorkspaceSettingRequest
-	(*UpdateWorkspaceSettingRequest)(nil),       // 7: monotreme.api.v1.UpdateWorkspaceSettingRequest
-	(*GetWorkspaceStatsRequest)(nil),            // 8: monotreme.api.v1.GetWorkspaceStatsRequest
-	(*WorkspaceStats)(nil),                      // 9: monotreme.api.v1.WorkspaceStats
-	(*StatsMeasurement)(nil),                    // 10: monotreme.api.v1.StatsMeasurement
-	(*IdentityProviderConfig_FieldMapping)(nil), // 11: monotreme.api.v1.IdentityProviderConfig.FieldMapping
-	(*IdentityProviderConfig_OAuth2Config)(nil), // 12: monotreme.api.v1.IdentityProviderConfig.OAuth2Config
-	(*Subscription)(nil),                        // 13: monotreme.api.v1.Subscription
-	(Visibility)(0),                             // 14: monotreme.api.v1.Visibility
-	(*fieldmaskpb.FieldMask)(nil),               // 15: google.protobuf.FieldMask
+	(*ShortcutNamePolicy)(nil),                  // 3: monotreme.api.v1.ShortcutNamePolicy
+	(*IdentityProvider)(nil),                    // 4: monotreme.api.v1.IdentityProvider
+	(*IdentityProviderConfig)(nil),              // 5: monotreme.api.v1.IdentityProviderConfig
+	(*GetWorkspaceProfileRequest)(nil),          // 6: monotreme.api.v1.GetWorkspaceProfileRequest
+	(*GetWorkspaceSettingRequest)(nil),          // 7: monotreme.api.v1.GetWorkspaceSettingRequest
+	(*UpdateWorkspaceSettingRequest)(nil),       // 8: monotreme.api.v1.UpdateWorkspaceSettingRequest
+	(*GetWorkspaceStatsRequest)(nil),            // 9: monotreme.api.v1.GetWorkspaceStatsRequest
+	(*WorkspaceStats)(nil),                      // 10: monotreme.api.v1.WorkspaceStats
+	(*GetWorkspaceRuntimeStatsRequest)(nil),     // 11: monotreme.api.v1.GetWorkspaceRuntimeStatsRequest
+	(*WorkspaceRuntimeStats)(nil),               // 12: monotreme.api.v1.WorkspaceRuntimeStats
+	(*ResolverCacheStats)(nil),                  // 13: monotreme.api.v1.ResolverCacheStats
+	(*ActivityIngestionStats)(nil),              // 14: monotreme.api.v1.ActivityIngestionStats
+	(*StatsMeasurement)(nil),                    // 15: monotreme.api.v1.StatsMeasurement
+	(*IdentityProviderConfig_FieldMapping)(nil), // 16: monotreme.api.v1.IdentityProviderConfig.FieldMapping
+	(*IdentityProviderConfig_OAuth2Config)(nil), // 17: monotreme.api.v1.IdentityProviderConfig.OAuth2Config
+	(*Subscription)(nil),                        // 18: monotreme.api.v1.Subscription
+	(Visibility)(0),                             // 19: monotreme.api.v1.Visibility
+	(RedirectMode)(0),                           // 20: monotreme.api.v1.RedirectMode
+	(*fieldmaskpb.FieldMask)(nil),               // 21: google.protobuf.FieldMask
 }
 var file_api_v1_workspace_service_proto_depIdxs = []int32{
-	13, // 0: monotreme.api.v1.WorkspaceProfile.subscription:type_name -> monotreme.api.v1.Subscription
-	14, // 1: monotreme.api.v1.WorkspaceSetting.default_visibility:type_name -> monotreme.api.v1.Visibility
-	3,  // 2: monotreme.api.v1.WorkspaceSetting.identity_providers:type_name -> monotreme.api.v1.IdentityProvider
-	0,  // 3: monotreme.api.v1.IdentityProvider.type:type_name -> monotreme.api.v1.IdentityProvider.Type
-	4,  // 4: monotreme.api.v1.IdentityProvider.config:type_name -> monotreme.api.v1.IdentityProviderConfig
-	12, // 5: monotreme.api.v1.IdentityProviderConfig.oauth2:type_name -> monotreme.api.v1.IdentityProviderConfig.OAuth2Config
-	2,  // 6: monotreme.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> monotreme.api.v1.WorkspaceSetting
-	15, // 7: monotreme.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	10, // 8: monotreme.api.v1.WorkspaceStats.historical_data:type_name -> monotreme.api.v1.StatsMeasurement
-	11, // 9: monotreme.api.v1.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> monotreme.api.v1.IdentityProviderConfig.FieldMapping
-	5,  // 10: monotreme.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> monotreme.api.v1.GetWorkspaceProfileRequest
-	6,  // 11: monotreme.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> monotreme.api.v1.GetWorkspaceSettingRequest
-	7,  // 12: monotreme.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> monotreme.api.v1.UpdateWorkspaceSettingRequest
-	8,  // 13: monotreme.api.v1.WorkspaceService.GetWorkspaceStats:input_type -> monotreme.api.v1.GetWorkspaceStatsRequest
-	1,  // 14: monotreme.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> monotreme.api.v1.WorkspaceProfile
-	2,  // 15: monotreme.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> monotreme.api.v1.WorkspaceSetting
-	2,  // 16: monotreme.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> monotreme.api.v1.WorkspaceSetting
-	9,  // 17: monotreme.api.v1.WorkspaceService.GetWorkspaceStats:output_type -> monotreme.api.v1.WorkspaceStats
-	14, // [14:18] is the sub-list for method output_type
-	10, // [10:14] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	18, // 0: monotreme.api.v1.WorkspaceProfile.subscription:type_name -> monotreme.api.v1.Subscription
+	19, // 1: monotreme.api.v1.WorkspaceSetting.default_visibility:type_name -> monotreme.api.v1.Visibility
+	4,  // 2: monotreme.api.v1.WorkspaceSetting.identity_providers:type_name -> monotreme.api.v1.IdentityProvider
+	20, // 3: monotreme.api.v1.WorkspaceSetting.default_redirect_mode:type_name -> monotreme.api.v1.RedirectMode
+	3,  // 4: monotreme.api.v1.WorkspaceSetting.shortcut_name_policy:type_name -> monotreme.api.v1.ShortcutNamePolicy
+	0,  // 5: monotreme.api.v1.IdentityProvider.type:type_name -> monotreme.api.v1.IdentityProvider.Type
+	5,  // 6: monotreme.api.v1.IdentityProvider.config:type_name -> monotreme.api.v1.IdentityProviderConfig
+	17, // 7: monotreme.api.v1.IdentityProviderConfig.oauth2:type_name -> monotreme.api.v1.IdentityProviderConfig.OAuth2Config
+	2,  // 8: monotreme.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> monotreme.api.v1.WorkspaceSetting
+	21, // 9: monotreme.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	15, // 10: monotreme.api.v1.WorkspaceStats.historical_data:type_name -> monotreme.api.v1.StatsMeasurement
+	13, // 11: monotreme.api.v1.WorkspaceRuntimeStats.resolver_cache:type_name -> monotreme.api.v1.ResolverCacheStats
+	14, // 12: monotreme.api.v1.WorkspaceRuntimeStats.activity_ingestion:type_name -> monotreme.api.v1.ActivityIngestionStats
+	16, // 13: monotreme.api.v1.IdentityProviderConfig.OAuth2Config.field_mapping:type_name -> monotreme.api.v1.IdentityProviderConfig.FieldMapping
+	6,  // 14: monotreme.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> monotreme.api.v1.GetWorkspaceProfileRequest
+	7,  // 15: monotreme.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> monotreme.api.v1.GetWorkspaceSettingRequest
+	8,  // 16: monotreme.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> monotreme.api.v1.UpdateWorkspaceSettingRequest
+	9,  // 17: monotreme.api.v1.WorkspaceService.GetWorkspaceStats:input_type -> monotreme.api.v1.GetWorkspaceStatsRequest
+	11, // 18: monotreme.api.v1.WorkspaceService.GetWorkspaceRuntimeStats:input_type -> monotreme.api.v1.GetWorkspaceRuntimeStatsRequest
+	1,  // 19: monotreme.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> monotreme.api.v1.WorkspaceProfile
+	2,  // 20: monotreme.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> monotreme.api.v1.WorkspaceSetting
+	2,  // 21: monotreme.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> monotreme.api.v1.WorkspaceSetting
+	10, // 22: monotreme.api.v1.WorkspaceService.GetWorkspaceStats:output_type -> monotreme.api.v1.WorkspaceStats
+	12, // 23: monotreme.api.v1.WorkspaceService.GetWorkspaceRuntimeStats:output_type -> monotreme.api.v1.WorkspaceRuntimeStats
+	19, // [19:24] is the sub-list for method output_type
+	14, // [14:19] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_service_proto_init() }
@@ -1013,7 +1502,7 @@ func file_api_v1_workspace_service_proto_init() {
 	}
 	file_api_v1_common_proto_init()
 	file_api_v1_subscription_service_proto_init()
-	file_api_v1_workspace_service_proto_msgTypes[3].OneofWrappers = []any{
+	file_api_v1_workspace_service_proto_msgTypes[4].OneofWrappers = []any{
 		(*IdentityProviderConfig_Oauth2)(nil),
 	}
 	type x struct{}
@@ -1022,7 +1511,7 @@ func file_api_v1_workspace_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_service_proto_rawDesc), len(file_api_v1_workspace_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkspaceService_GetWorkspaceProfile_FullMethodName    = "/monotreme.api.v1.WorkspaceService/GetWorkspaceProfile"
-	WorkspaceService_GetWorkspaceSetting_FullMethodName    = "/monotreme.api.v1.WorkspaceService/GetWorkspaceSetting"
-	WorkspaceService_UpdateWorkspaceSetting_FullMethodName = "/monotreme.api.v1.WorkspaceService/UpdateWorkspaceSetting"
-	WorkspaceService_GetWorkspaceStats_FullMethodName      = "/monotreme.api.v1.WorkspaceService/GetWorkspaceStats"
+	WorkspaceService_GetWorkspaceProfile_FullMethodName      = "/monotreme.api.v1.WorkspaceService/GetWorkspaceProfile"
+	WorkspaceService_GetWorkspaceSetting_FullMethodName      = "/monotreme.api.v1.WorkspaceService/GetWorkspaceSetting"
+	WorkspaceService_UpdateWorkspaceSetting_FullMethodName   = "/monotreme.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetWorkspaceStats_FullMethodName        = "/monotreme.api.v1.WorkspaceService/GetWorkspaceStats"
+	WorkspaceService_GetWorkspaceRuntimeStats_FullMethodName = "/monotreme.api.v1.WorkspaceService/GetWorkspaceRuntimeStats"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -33,6 +34,8 @@ type WorkspaceServiceClient interface {
 	GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
 	UpdateWorkspaceSetting(ctx context.Context, in *UpdateWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
 	GetWorkspaceStats(ctx context.Context, in *GetWorkspaceStatsRequest, opts ...grpc.CallOption) (*WorkspaceStats, error)
+	// GetWorkspaceRuntimeStats returns the in-memory counters of the server. Only admins can call it.
+	GetWorkspaceRuntimeStats(ctx context.Context, in *GetWorkspaceRuntimeStatsRequest, opts ...grpc.CallOption) (*WorkspaceRuntimeStats, error)
 }
 
 type workspaceServiceClient struct {
@@ -83,6 +86,16 @@ func (c *workspaceServiceClient) GetWorkspaceStats(ctx context.Context, in *GetW
 	return out, nil
 }
 
+func (c *workspaceServiceClient) GetWorkspaceRuntimeStats(ctx context.Context, in *GetWorkspaceRuntimeStatsRequest, opts ...grpc.CallOption) (*WorkspaceRuntimeStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceRuntimeStats)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetWorkspaceRuntimeStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
@@ -91,6 +104,8 @@ type WorkspaceServiceServer interface {
 	GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error)
 	UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error)
 	GetWorkspaceStats(context.Context, *GetWorkspaceStatsRequest) (*WorkspaceStats, error)
+	// GetWorkspaceRuntimeStats returns the in-memory counters of the server. Only admins can call it.
+	GetWorkspaceRuntimeStats(context.Context, *GetWorkspaceRuntimeStatsRequest) (*WorkspaceRuntimeStats, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -113,6 +128,9 @@ func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSetting(context.Contex
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceStats(context.Context, *GetWorkspaceStatsRequest) (*WorkspaceStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceStats not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceRuntimeStats(context.Context, *GetWorkspaceRuntimeStatsRequest) (*WorkspaceRuntimeStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceRuntimeStats not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -206,6 +224,24 @@ func _WorkspaceService_GetWorkspaceStats_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_GetWorkspaceRuntimeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceRuntimeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceRuntimeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetWorkspaceRuntimeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceRuntimeStats(ctx, req.(*GetWorkspaceRuntimeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +265,10 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkspaceStats",
 			Handler:    _WorkspaceService_GetWorkspaceStats_Handler,
 		},
+		{
+			MethodName: "GetWorkspaceRuntimeStats",
+			Handler:    _WorkspaceService_GetWorkspaceRuntimeStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/workspace_service.proto",
@@ -66,12 +66,16 @@ func (x *ActivityShorcutCreatePayload) GetShortcutId() int32 {
 }
 
 type ActivityShorcutViewPayload struct {
-	state         protoimpl.MessageState                           `protogen:"open.v1"`
-	ShortcutId    int32                                            `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
-	Ip            string                                           `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
-	Referer       string                                           `protobuf:"bytes,3,opt,name=referer,proto3" json:"referer,omitempty"`
-	UserAgent     string                                           `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	Params        map[string]*ActivityShorcutViewPayload_ValueList `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state      protoimpl.MessageState                           `protogen:"open.v1"`
+	ShortcutId int32                                            `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	Ip         string                                           `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Referer    string                                           `protobuf:"bytes,3,opt,name=referer,proto3" json:"referer,omitempty"`
+	UserAgent  string                                           `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Params     map[string]*ActivityShorcutViewPayload_ValueList `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The name of the variant served, if the shortcut has variants.
+	Variant string `protobuf:"bytes,6,opt,name=variant,proto3" json:"variant,omitempty"`
+	// The bot signature that matched the user agent, for bot views.
+	Bot           string `protobuf:"bytes,7,opt,name=bot,proto3" json:"bot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,6 +145,220 @@ func (x *ActivityShorcutViewPayload) GetParams() map[string]*ActivityShorcutView
 	return nil
 }
 
+func (x *ActivityShorcutViewPayload) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ActivityShorcutViewPayload) GetBot() string {
+	if x != nil {
+		return x.Bot
+	}
+	return ""
+}
+
+type ActivityShortcutLinkChangePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId    int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	ChangeId      int32                  `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	PreviousLink  string                 `protobuf:"bytes,3,opt,name=previous_link,json=previousLink,proto3" json:"previous_link,omitempty"`
+	Link          string                 `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutLinkChangePayload) Reset() {
+	*x = ActivityShortcutLinkChangePayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutLinkChangePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutLinkChangePayload) ProtoMessage() {}
+
+func (x *ActivityShortcutLinkChangePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutLinkChangePayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutLinkChangePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityShortcutLinkChangePayload) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutLinkChangePayload) GetChangeId() int32 {
+	if x != nil {
+		return x.ChangeId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutLinkChangePayload) GetPreviousLink() string {
+	if x != nil {
+		return x.PreviousLink
+	}
+	return ""
+}
+
+func (x *ActivityShortcutLinkChangePayload) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type ActivityShortcutFailoverPayload struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId   int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	PreviousLink string                 `protobuf:"bytes,2,opt,name=previous_link,json=previousLink,proto3" json:"previous_link,omitempty"`
+	Link         string                 `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	// Whether the link now served is a backup rather than the primary link.
+	Backup        bool `protobuf:"varint,4,opt,name=backup,proto3" json:"backup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutFailoverPayload) Reset() {
+	*x = ActivityShortcutFailoverPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutFailoverPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutFailoverPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutFailoverPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutFailoverPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutFailoverPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityShortcutFailoverPayload) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutFailoverPayload) GetPreviousLink() string {
+	if x != nil {
+		return x.PreviousLink
+	}
+	return ""
+}
+
+func (x *ActivityShortcutFailoverPayload) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ActivityShortcutFailoverPayload) GetBackup() bool {
+	if x != nil {
+		return x.Backup
+	}
+	return false
+}
+
+// ActivityShortcutChangeRequestPayload is the payload of the activities of a change request
+// of a protected shortcut being created, approved or rejected.
+type ActivityShortcutChangeRequestPayload struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShortcutId      int32                  `protobuf:"varint,1,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	ChangeRequestId int32                  `protobuf:"varint,2,opt,name=change_request_id,json=changeRequestId,proto3" json:"change_request_id,omitempty"`
+	// The fields changed by the request.
+	Fields        []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityShortcutChangeRequestPayload) Reset() {
+	*x = ActivityShortcutChangeRequestPayload{}
+	mi := &file_store_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityShortcutChangeRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityShortcutChangeRequestPayload) ProtoMessage() {}
+
+func (x *ActivityShortcutChangeRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityShortcutChangeRequestPayload.ProtoReflect.Descriptor instead.
+func (*ActivityShortcutChangeRequestPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityShortcutChangeRequestPayload) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutChangeRequestPayload) GetChangeRequestId() int32 {
+	if x != nil {
+		return x.ChangeRequestId
+	}
+	return 0
+}
+
+func (x *ActivityShortcutChangeRequestPayload) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type ActivityShorcutViewPayload_ValueList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -150,7 +368,7 @@ type ActivityShorcutViewPayload_ValueList struct {
 
 func (x *ActivityShorcutViewPayload_ValueList) Reset() {
 	*x = ActivityShorcutViewPayload_ValueList{}
-	mi := &file_store_activity_proto_msgTypes[3]
+	mi := &file_store_activity_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +380,7 @@ func (x *ActivityShorcutViewPayload_ValueList) String() string {
 func (*ActivityShorcutViewPayload_ValueList) ProtoMessage() {}
 
 func (x *ActivityShorcutViewPayload_ValueList) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[3]
+	mi := &file_store_activity_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +410,7 @@ const file_store_activity_proto_rawDesc = "" +
 	"\x14store/activity.proto\x12\x0fmonotreme.store\"?\n" +
 	"\x1cActivityShorcutCreatePayload\x12\x1f\n" +
 	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
-	"shortcutId\"\xee\x02\n" +
+	"shortcutId\"\x9a\x03\n" +
 	"\x1aActivityShorcutViewPayload\x12\x1f\n" +
 	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
 	"shortcutId\x12\x0e\n" +
@@ -200,12 +418,31 @@ const file_store_activity_proto_rawDesc = "" +
 	"\areferer\x18\x03 \x01(\tR\areferer\x12\x1d\n" +
 	"\n" +
 	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12O\n" +
-	"\x06params\x18\x05 \x03(\v27.monotreme.store.ActivityShorcutViewPayload.ParamsEntryR\x06params\x1ap\n" +
+	"\x06params\x18\x05 \x03(\v27.monotreme.store.ActivityShorcutViewPayload.ParamsEntryR\x06params\x12\x18\n" +
+	"\avariant\x18\x06 \x01(\tR\avariant\x12\x10\n" +
+	"\x03bot\x18\a \x01(\tR\x03bot\x1ap\n" +
 	"\vParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12K\n" +
 	"\x05value\x18\x02 \x01(\v25.monotreme.store.ActivityShorcutViewPayload.ValueListR\x05value:\x028\x01\x1a#\n" +
 	"\tValueList\x12\x16\n" +
-	"\x06values\x18\x01 \x03(\tR\x06valuesB\xae\x01\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x9a\x01\n" +
+	"!ActivityShortcutLinkChangePayload\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12\x1b\n" +
+	"\tchange_id\x18\x02 \x01(\x05R\bchangeId\x12#\n" +
+	"\rprevious_link\x18\x03 \x01(\tR\fpreviousLink\x12\x12\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\"\x93\x01\n" +
+	"\x1fActivityShortcutFailoverPayload\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12#\n" +
+	"\rprevious_link\x18\x02 \x01(\tR\fpreviousLink\x12\x12\n" +
+	"\x04link\x18\x03 \x01(\tR\x04link\x12\x16\n" +
+	"\x06backup\x18\x04 \x01(\bR\x06backup\"\x8b\x01\n" +
+	"$ActivityShortcutChangeRequestPayload\x12\x1f\n" +
+	"\vshortcut_id\x18\x01 \x01(\x05R\n" +
+	"shortcutId\x12*\n" +
+	"\x11change_request_id\x18\x02 \x01(\x05R\x0fchangeRequestId\x12\x16\n" +
+	"\x06fields\x18\x03 \x03(\tR\x06fieldsB\xae\x01\n" +
 	"\x13com.monotreme.storeB\rActivityProtoP\x01Z+github.com/bshort/monotreme/proto/gen/store\xa2\x02\x03MSX\xaa\x02\x0fMonotreme.Store\xca\x02\x0fMonotreme\\Store\xe2\x02\x1bMonotreme\\Store\\GPBMetadata\xea\x02\x10Monotreme::Storeb\x06proto3"
 
 var (
@@ -220,16 +457,19 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityShorcutCreatePayload)(nil), // 0: monotreme.store.ActivityShorcutCreatePayload
-	(*ActivityShorcutViewPayload)(nil),   // 1: monotreme.store.ActivityShorcutViewPayload
-	nil,                                  // 2: monotreme.store.ActivityShorcutViewPayload.ParamsEntry
-	(*ActivityShorcutViewPayload_ValueList)(nil), // 3: monotreme.store.ActivityShorcutViewPayload.ValueList
+	(*ActivityShorcutCreatePayload)(nil),         // 0: monotreme.store.ActivityShorcutCreatePayload
+	(*ActivityShorcutViewPayload)(nil),           // 1: monotreme.store.ActivityShorcutViewPayload
+	(*ActivityShortcutLinkChangePayload)(nil),    // 2: monotreme.store.ActivityShortcutLinkChangePayload
+	(*ActivityShortcutFailoverPayload)(nil),      // 3: monotreme.store.ActivityShortcutFailoverPayload
+	(*ActivityShortcutChangeRequestPayload)(nil), // 4: monotreme.store.ActivityShortcutChangeRequestPayload
+	nil, // 5: monotreme.store.ActivityShorcutViewPayload.ParamsEntry
+	(*ActivityShorcutViewPayload_ValueList)(nil), // 6: monotreme.store.ActivityShorcutViewPayload.ValueList
 }
 var file_store_activity_proto_depIdxs = []int32{
-	2, // 0: monotreme.store.ActivityShorcutViewPayload.params:type_name -> monotreme.store.ActivityShorcutViewPayload.ParamsEntry
-	3, // 1: monotreme.store.ActivityShorcutViewPayload.ParamsEntry.value:type_name -> monotreme.store.ActivityShorcutViewPayload.ValueList
+	5, // 0: monotreme.store.ActivityShorcutViewPayload.params:type_name -> monotreme.store.ActivityShorcutViewPayload.ParamsEntry
+	6, // 1: monotreme.store.ActivityShorcutViewPayload.ParamsEntry.value:type_name -> monotreme.store.ActivityShorcutViewPayload.ValueList
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -248,7 +488,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
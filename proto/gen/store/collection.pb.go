@@ -22,17 +22,21 @@ const (
 )
 
 type Collection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	UpdatedTs     int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	ShortcutIds   []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=monotreme.store.Visibility" json:"visibility,omitempty"`
-	CustomIcon    string                 `protobuf:"bytes,11,opt,name=custom_icon,json=customIcon,proto3" json:"custom_icon,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId   int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs   int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs   int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	Name        string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Title       string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	ShortcutIds []int32                `protobuf:"varint,9,rep,packed,name=shortcut_ids,json=shortcutIds,proto3" json:"shortcut_ids,omitempty"`
+	Visibility  Visibility             `protobuf:"varint,10,opt,name=visibility,proto3,enum=monotreme.store.Visibility" json:"visibility,omitempty"`
+	CustomIcon  string                 `protobuf:"bytes,11,opt,name=custom_icon,json=customIcon,proto3" json:"custom_icon,omitempty"`
+	// The time the collection was moved to the trash, or 0 if it is not in the trash.
+	DeletedTs int64 `protobuf:"varint,12,opt,name=deleted_ts,json=deletedTs,proto3" json:"deleted_ts,omitempty"`
+	// The groups a GROUP collection is visible to.
+	GroupIds      []int32 `protobuf:"varint,13,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -137,11 +141,25 @@ func (x *Collection) GetCustomIcon() string {
 	return ""
 }
 
+func (x *Collection) GetDeletedTs() int64 {
+	if x != nil {
+		return x.DeletedTs
+	}
+	return 0
+}
+
+func (x *Collection) GetGroupIds() []int32 {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
 var File_store_collection_proto protoreflect.FileDescriptor
 
 const file_store_collection_proto_rawDesc = "" +
 	"\n" +
-	"\x16store/collection.proto\x12\x0fmonotreme.store\x1a\x12store/common.proto\"\xc6\x02\n" +
+	"\x16store/collection.proto\x12\x0fmonotreme.store\x1a\x12store/common.proto\"\x82\x03\n" +
 	"\n" +
 	"Collection\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
@@ -160,7 +178,10 @@ const file_store_collection_proto_rawDesc = "" +
 	" \x01(\x0e2\x1b.monotreme.store.VisibilityR\n" +
 	"visibility\x12\x1f\n" +
 	"\vcustom_icon\x18\v \x01(\tR\n" +
-	"customIconB\xb0\x01\n" +
+	"customIcon\x12\x1d\n" +
+	"\n" +
+	"deleted_ts\x18\f \x01(\x03R\tdeletedTs\x12\x1b\n" +
+	"\tgroup_ids\x18\r \x03(\x05R\bgroupIdsB\xb0\x01\n" +
 	"\x13com.monotreme.storeB\x0fCollectionProtoP\x01Z+github.com/bshort/monotreme/proto/gen/store\xa2\x02\x03MSX\xaa\x02\x0fMonotreme.Store\xca\x02\x0fMonotreme\\Store\xe2\x02\x1bMonotreme\\Store\\GPBMetadata\xea\x02\x10Monotreme::Storeb\x06proto3"
 
 var (
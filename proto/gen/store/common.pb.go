@@ -76,6 +76,10 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
 	Visibility_WORKSPACE              Visibility = 1
 	Visibility_PUBLIC                 Visibility = 2
+	// Only visible to the creator.
+	Visibility_PRIVATE Visibility = 3
+	// Only visible to the creator and the members of the groups it is shared with.
+	Visibility_GROUP Visibility = 4
 )
 
 // Enum value maps for Visibility.
@@ -84,11 +88,15 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "WORKSPACE",
 		2: "PUBLIC",
+		3: "PRIVATE",
+		4: "GROUP",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"WORKSPACE":              1,
 		"PUBLIC":                 2,
+		"PRIVATE":                3,
+		"GROUP":                  4,
 	}
 )
 
@@ -119,6 +127,73 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_store_common_proto_rawDescGZIP(), []int{1}
 }
 
+type RedirectMode int32
+
+const (
+	RedirectMode_REDIRECT_MODE_UNSPECIFIED RedirectMode = 0
+	// HTTP 302.
+	RedirectMode_FOUND RedirectMode = 1
+	// HTTP 301.
+	RedirectMode_MOVED_PERMANENTLY RedirectMode = 2
+	// HTTP 307.
+	RedirectMode_TEMPORARY_REDIRECT RedirectMode = 3
+	// HTTP 308.
+	RedirectMode_PERMANENT_REDIRECT RedirectMode = 4
+	// An HTML page that refreshes to the link without sending a referrer.
+	RedirectMode_META_REFRESH RedirectMode = 5
+	// A preview page that shows the destination before continuing.
+	RedirectMode_INTERSTITIAL RedirectMode = 6
+)
+
+// Enum value maps for RedirectMode.
+var (
+	RedirectMode_name = map[int32]string{
+		0: "REDIRECT_MODE_UNSPECIFIED",
+		1: "FOUND",
+		2: "MOVED_PERMANENTLY",
+		3: "TEMPORARY_REDIRECT",
+		4: "PERMANENT_REDIRECT",
+		5: "META_REFRESH",
+		6: "INTERSTITIAL",
+	}
+	RedirectMode_value = map[string]int32{
+		"REDIRECT_MODE_UNSPECIFIED": 0,
+		"FOUND":                     1,
+		"MOVED_PERMANENTLY":         2,
+		"TEMPORARY_REDIRECT":        3,
+		"PERMANENT_REDIRECT":        4,
+		"META_REFRESH":              5,
+		"INTERSTITIAL":              6,
+	}
+)
+
+func (x RedirectMode) Enum() *RedirectMode {
+	p := new(RedirectMode)
+	*p = x
+	return p
+}
+
+func (x RedirectMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RedirectMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_common_proto_enumTypes[2].Descriptor()
+}
+
+func (RedirectMode) Type() protoreflect.EnumType {
+	return &file_store_common_proto_enumTypes[2]
+}
+
+func (x RedirectMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RedirectMode.Descriptor instead.
+func (RedirectMode) EnumDescriptor() ([]byte, []int) {
+	return file_store_common_proto_rawDescGZIP(), []int{2}
+}
+
 var File_store_common_proto protoreflect.FileDescriptor
 
 const file_store_common_proto_rawDesc = "" +
@@ -128,13 +203,23 @@ const file_store_common_proto_rawDesc = "" +
 	"\x16ROW_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06NORMAL\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02*C\n" +
+	"\bARCHIVED\x10\x02*[\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tWORKSPACE\x10\x01\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x02B\xac\x01\n" +
+	"\x06PUBLIC\x10\x02\x12\v\n" +
+	"\aPRIVATE\x10\x03\x12\t\n" +
+	"\x05GROUP\x10\x04*\xa3\x01\n" +
+	"\fRedirectMode\x12\x1d\n" +
+	"\x19REDIRECT_MODE_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05FOUND\x10\x01\x12\x15\n" +
+	"\x11MOVED_PERMANENTLY\x10\x02\x12\x16\n" +
+	"\x12TEMPORARY_REDIRECT\x10\x03\x12\x16\n" +
+	"\x12PERMANENT_REDIRECT\x10\x04\x12\x10\n" +
+	"\fMETA_REFRESH\x10\x05\x12\x10\n" +
+	"\fINTERSTITIAL\x10\x06B\xac\x01\n" +
 	"\x13com.monotreme.storeB\vCommonProtoP\x01Z+github.com/bshort/monotreme/proto/gen/store\xa2\x02\x03MSX\xaa\x02\x0fMonotreme.Store\xca\x02\x0fMonotreme\\Store\xe2\x02\x1bMonotreme\\Store\\GPBMetadata\xea\x02\x10Monotreme::Storeb\x06proto3"
 
 var (
@@ -149,10 +234,11 @@ func file_store_common_proto_rawDescGZIP() []byte {
 	return file_store_common_proto_rawDescData
 }
 
-var file_store_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_store_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_store_common_proto_goTypes = []any{
-	(RowStatus)(0),  // 0: monotreme.store.RowStatus
-	(Visibility)(0), // 1: monotreme.store.Visibility
+	(RowStatus)(0),    // 0: monotreme.store.RowStatus
+	(Visibility)(0),   // 1: monotreme.store.Visibility
+	(RedirectMode)(0), // 2: monotreme.store.RedirectMode
 }
 var file_store_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -172,7 +258,7 @@ func file_store_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_common_proto_rawDesc), len(file_store_common_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
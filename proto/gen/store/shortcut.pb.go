@@ -21,21 +21,104 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ShortcutRoutingCondition_Field int32
+
+const (
+	ShortcutRoutingCondition_FIELD_UNSPECIFIED ShortcutRoutingCondition_Field = 0
+	// One of mobile, tablet, desktop or bot.
+	ShortcutRoutingCondition_DEVICE ShortcutRoutingCondition_Field = 1
+	// One of ios, android, windows, macos, linux or chromeos.
+	ShortcutRoutingCondition_OS ShortcutRoutingCondition_Field = 2
+	// The browser name, such as chrome, firefox, safari or edge.
+	ShortcutRoutingCondition_BROWSER ShortcutRoutingCondition_Field = 3
+	// A language tag matched against the most preferred Accept-Language, such as de or de-AT.
+	ShortcutRoutingCondition_LANGUAGE ShortcutRoutingCondition_Field = 4
+	// The request header named by header.
+	ShortcutRoutingCondition_HEADER ShortcutRoutingCondition_Field = 5
+)
+
+// Enum value maps for ShortcutRoutingCondition_Field.
+var (
+	ShortcutRoutingCondition_Field_name = map[int32]string{
+		0: "FIELD_UNSPECIFIED",
+		1: "DEVICE",
+		2: "OS",
+		3: "BROWSER",
+		4: "LANGUAGE",
+		5: "HEADER",
+	}
+	ShortcutRoutingCondition_Field_value = map[string]int32{
+		"FIELD_UNSPECIFIED": 0,
+		"DEVICE":            1,
+		"OS":                2,
+		"BROWSER":           3,
+		"LANGUAGE":          4,
+		"HEADER":            5,
+	}
+)
+
+func (x ShortcutRoutingCondition_Field) Enum() *ShortcutRoutingCondition_Field {
+	p := new(ShortcutRoutingCondition_Field)
+	*p = x
+	return p
+}
+
+func (x ShortcutRoutingCondition_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShortcutRoutingCondition_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_shortcut_proto_enumTypes[0].Descriptor()
+}
+
+func (ShortcutRoutingCondition_Field) Type() protoreflect.EnumType {
+	return &file_store_shortcut_proto_enumTypes[0]
+}
+
+func (x ShortcutRoutingCondition_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShortcutRoutingCondition_Field.Descriptor instead.
+func (ShortcutRoutingCondition_Field) EnumDescriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{5, 0}
+}
+
 type Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid          string                 `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CreatorId     int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	CreatedTs     int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	UpdatedTs     int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	Link          string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
-	Title         string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
-	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
-	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,11,opt,name=visibility,proto3,enum=monotreme.store.Visibility" json:"visibility,omitempty"`
-	OgMetadata    *OpenGraphMetadata     `protobuf:"bytes,12,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
-	CustomIcon    string                 `protobuf:"bytes,13,opt,name=custom_icon,json=customIcon,proto3" json:"custom_icon,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid         string                 `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CreatorId    int32                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs    int64                  `protobuf:"varint,3,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	UpdatedTs    int64                  `protobuf:"varint,4,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	Name         string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Link         string                 `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	Title        string                 `protobuf:"bytes,8,opt,name=title,proto3" json:"title,omitempty"`
+	Tags         []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	Description  string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Visibility   Visibility             `protobuf:"varint,11,opt,name=visibility,proto3,enum=monotreme.store.Visibility" json:"visibility,omitempty"`
+	OgMetadata   *OpenGraphMetadata     `protobuf:"bytes,12,opt,name=og_metadata,json=ogMetadata,proto3" json:"og_metadata,omitempty"`
+	CustomIcon   string                 `protobuf:"bytes,13,opt,name=custom_icon,json=customIcon,proto3" json:"custom_icon,omitempty"`
+	Template     bool                   `protobuf:"varint,14,opt,name=template,proto3" json:"template,omitempty"`
+	ForwardPath  bool                   `protobuf:"varint,15,opt,name=forward_path,json=forwardPath,proto3" json:"forward_path,omitempty"`
+	RedirectMode RedirectMode           `protobuf:"varint,16,opt,name=redirect_mode,json=redirectMode,proto3,enum=monotreme.store.RedirectMode" json:"redirect_mode,omitempty"`
+	// The time from which the shortcut resolves, or 0 for no start.
+	ValidFrom int64 `protobuf:"varint,17,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// The time at which the shortcut expires, or 0 for no expiry.
+	ValidUntil   int64                 `protobuf:"varint,18,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	RowStatus    RowStatus             `protobuf:"varint,19,opt,name=row_status,json=rowStatus,proto3,enum=monotreme.store.RowStatus" json:"row_status,omitempty"`
+	Variants     *ShortcutVariants     `protobuf:"bytes,20,opt,name=variants,proto3" json:"variants,omitempty"`
+	RoutingRules *ShortcutRoutingRules `protobuf:"bytes,21,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	Failover     *ShortcutFailover     `protobuf:"bytes,22,opt,name=failover,proto3" json:"failover,omitempty"`
+	// The name under the workspace name policy, unique among shortcuts.
+	// Empty if it collides with the name of an older shortcut.
+	NormalizedName string `protobuf:"bytes,23,opt,name=normalized_name,json=normalizedName,proto3" json:"normalized_name,omitempty"`
+	// The time the shortcut was moved to the trash, or 0 if it is not in the trash.
+	DeletedTs int64 `protobuf:"varint,24,opt,name=deleted_ts,json=deletedTs,proto3" json:"deleted_ts,omitempty"`
+	// The groups a GROUP shortcut is visible to.
+	GroupIds []int32 `protobuf:"varint,25,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	// Whether edits to the shortcut must be approved by another person.
+	Protected     bool `protobuf:"varint,26,opt,name=protected,proto3" json:"protected,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -161,6 +244,505 @@ func (x *Shortcut) GetCustomIcon() string {
 	return ""
 }
 
+func (x *Shortcut) GetTemplate() bool {
+	if x != nil {
+		return x.Template
+	}
+	return false
+}
+
+func (x *Shortcut) GetForwardPath() bool {
+	if x != nil {
+		return x.ForwardPath
+	}
+	return false
+}
+
+func (x *Shortcut) GetRedirectMode() RedirectMode {
+	if x != nil {
+		return x.RedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *Shortcut) GetValidFrom() int64 {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return 0
+}
+
+func (x *Shortcut) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
+}
+
+func (x *Shortcut) GetRowStatus() RowStatus {
+	if x != nil {
+		return x.RowStatus
+	}
+	return RowStatus_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *Shortcut) GetVariants() *ShortcutVariants {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Shortcut) GetRoutingRules() *ShortcutRoutingRules {
+	if x != nil {
+		return x.RoutingRules
+	}
+	return nil
+}
+
+func (x *Shortcut) GetFailover() *ShortcutFailover {
+	if x != nil {
+		return x.Failover
+	}
+	return nil
+}
+
+func (x *Shortcut) GetNormalizedName() string {
+	if x != nil {
+		return x.NormalizedName
+	}
+	return ""
+}
+
+func (x *Shortcut) GetDeletedTs() int64 {
+	if x != nil {
+		return x.DeletedTs
+	}
+	return 0
+}
+
+func (x *Shortcut) GetGroupIds() []int32 {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+func (x *Shortcut) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+// ShortcutVariants splits the traffic of a shortcut across several links.
+type ShortcutVariants struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Variants []*ShortcutVariant     `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	// Whether a visitor keeps being served the same variant, keyed on a cookie.
+	Sticky        bool `protobuf:"varint,2,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutVariants) Reset() {
+	*x = ShortcutVariants{}
+	mi := &file_store_shortcut_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutVariants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutVariants) ProtoMessage() {}
+
+func (x *ShortcutVariants) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutVariants.ProtoReflect.Descriptor instead.
+func (*ShortcutVariants) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShortcutVariants) GetVariants() []*ShortcutVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ShortcutVariants) GetSticky() bool {
+	if x != nil {
+		return x.Sticky
+	}
+	return false
+}
+
+type ShortcutVariant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Link  string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	// The relative share of traffic served by this variant.
+	Weight        int32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutVariant) Reset() {
+	*x = ShortcutVariant{}
+	mi := &file_store_shortcut_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutVariant) ProtoMessage() {}
+
+func (x *ShortcutVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutVariant.ProtoReflect.Descriptor instead.
+func (*ShortcutVariant) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShortcutVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutVariant) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *ShortcutVariant) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// ShortcutRoutingRules sends visitors to different links depending on their request.
+// The first rule whose conditions all match wins; the shortcut link is the fallback.
+type ShortcutRoutingRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ShortcutRoutingRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutRoutingRules) Reset() {
+	*x = ShortcutRoutingRules{}
+	mi := &file_store_shortcut_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRoutingRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRoutingRules) ProtoMessage() {}
+
+func (x *ShortcutRoutingRules) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRoutingRules.ProtoReflect.Descriptor instead.
+func (*ShortcutRoutingRules) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShortcutRoutingRules) GetRules() []*ShortcutRoutingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ShortcutRoutingRule struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Conditions    []*ShortcutRoutingCondition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	Link          string                      `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutRoutingRule) Reset() {
+	*x = ShortcutRoutingRule{}
+	mi := &file_store_shortcut_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRoutingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRoutingRule) ProtoMessage() {}
+
+func (x *ShortcutRoutingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRoutingRule.ProtoReflect.Descriptor instead.
+func (*ShortcutRoutingRule) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShortcutRoutingRule) GetConditions() []*ShortcutRoutingCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *ShortcutRoutingRule) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type ShortcutRoutingCondition struct {
+	state protoimpl.MessageState         `protogen:"open.v1"`
+	Field ShortcutRoutingCondition_Field `protobuf:"varint,1,opt,name=field,proto3,enum=monotreme.store.ShortcutRoutingCondition_Field" json:"field,omitempty"`
+	// The header name, for HEADER conditions.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// The condition matches when the request has any of the values, compared case-insensitively.
+	// A HEADER condition without values matches when the header is present.
+	Values        []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutRoutingCondition) Reset() {
+	*x = ShortcutRoutingCondition{}
+	mi := &file_store_shortcut_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRoutingCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRoutingCondition) ProtoMessage() {}
+
+func (x *ShortcutRoutingCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRoutingCondition.ProtoReflect.Descriptor instead.
+func (*ShortcutRoutingCondition) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShortcutRoutingCondition) GetField() ShortcutRoutingCondition_Field {
+	if x != nil {
+		return x.Field
+	}
+	return ShortcutRoutingCondition_FIELD_UNSPECIFIED
+}
+
+func (x *ShortcutRoutingCondition) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *ShortcutRoutingCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// ShortcutFailover lists the links to fall back to, in order, when the link is unhealthy.
+type ShortcutFailover struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BackupLinks   []string               `protobuf:"bytes,1,rep,name=backup_links,json=backupLinks,proto3" json:"backup_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutFailover) Reset() {
+	*x = ShortcutFailover{}
+	mi := &file_store_shortcut_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutFailover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutFailover) ProtoMessage() {}
+
+func (x *ShortcutFailover) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutFailover.ProtoReflect.Descriptor instead.
+func (*ShortcutFailover) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ShortcutFailover) GetBackupLinks() []string {
+	if x != nil {
+		return x.BackupLinks
+	}
+	return nil
+}
+
+// Another name that resolves to a shortcut, such as the name it had before a rename.
+type ShortcutAlias struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShortcutId int32                  `protobuf:"varint,2,opt,name=shortcut_id,json=shortcutId,proto3" json:"shortcut_id,omitempty"`
+	CreatorId  int32                  `protobuf:"varint,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	CreatedTs  int64                  `protobuf:"varint,4,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	Name       string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// The name under the workspace name policy, unique among shortcuts and aliases.
+	// Empty if it collides with the name of a shortcut or an older alias.
+	NormalizedName string `protobuf:"bytes,6,opt,name=normalized_name,json=normalizedName,proto3" json:"normalized_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShortcutAlias) Reset() {
+	*x = ShortcutAlias{}
+	mi := &file_store_shortcut_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutAlias) ProtoMessage() {}
+
+func (x *ShortcutAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutAlias.ProtoReflect.Descriptor instead.
+func (*ShortcutAlias) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ShortcutAlias) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShortcutAlias) GetShortcutId() int32 {
+	if x != nil {
+		return x.ShortcutId
+	}
+	return 0
+}
+
+func (x *ShortcutAlias) GetCreatorId() int32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ShortcutAlias) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *ShortcutAlias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShortcutAlias) GetNormalizedName() string {
+	if x != nil {
+		return x.NormalizedName
+	}
+	return ""
+}
+
 type OpenGraphMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -172,7 +754,7 @@ type OpenGraphMetadata struct {
 
 func (x *OpenGraphMetadata) Reset() {
 	*x = OpenGraphMetadata{}
-	mi := &file_store_shortcut_proto_msgTypes[1]
+	mi := &file_store_shortcut_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +766,7 @@ func (x *OpenGraphMetadata) String() string {
 func (*OpenGraphMetadata) ProtoMessage() {}
 
 func (x *OpenGraphMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_store_shortcut_proto_msgTypes[1]
+	mi := &file_store_shortcut_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +779,7 @@ func (x *OpenGraphMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpenGraphMetadata.ProtoReflect.Descriptor instead.
 func (*OpenGraphMetadata) Descriptor() ([]byte, []int) {
-	return file_store_shortcut_proto_rawDescGZIP(), []int{1}
+	return file_store_shortcut_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OpenGraphMetadata) GetTitle() string {
@@ -221,11 +803,176 @@ func (x *OpenGraphMetadata) GetImage() string {
 	return ""
 }
 
+// ShortcutRevisionPayload is the content of a shortcut revision.
+type ShortcutRevisionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The fields changed by the revision, named as in the UpdateShortcut update mask.
+	// It is empty for the baseline revision written before the first recorded change.
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The shortcut before the change, unset for the baseline revision.
+	Previous *Shortcut `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	// The shortcut after the change.
+	Shortcut *Shortcut `protobuf:"bytes,3,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The revision that was restored by this one, if it is a rollback.
+	RestoredRevisionId int32 `protobuf:"varint,4,opt,name=restored_revision_id,json=restoredRevisionId,proto3" json:"restored_revision_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ShortcutRevisionPayload) Reset() {
+	*x = ShortcutRevisionPayload{}
+	mi := &file_store_shortcut_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutRevisionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutRevisionPayload) ProtoMessage() {}
+
+func (x *ShortcutRevisionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutRevisionPayload.ProtoReflect.Descriptor instead.
+func (*ShortcutRevisionPayload) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ShortcutRevisionPayload) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ShortcutRevisionPayload) GetPrevious() *Shortcut {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *ShortcutRevisionPayload) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+func (x *ShortcutRevisionPayload) GetRestoredRevisionId() int32 {
+	if x != nil {
+		return x.RestoredRevisionId
+	}
+	return 0
+}
+
+// ShortcutChangeRequestPayload is the content of a change request of a protected shortcut.
+type ShortcutChangeRequestPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The fields changed by the request, named as in the UpdateShortcut update mask.
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The shortcut when the change was requested.
+	Previous *Shortcut `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
+	// The shortcut with the change applied.
+	Shortcut *Shortcut `protobuf:"bytes,3,opt,name=shortcut,proto3" json:"shortcut,omitempty"`
+	// The aliases when the change was requested.
+	PreviousAliases []string `protobuf:"bytes,4,rep,name=previous_aliases,json=previousAliases,proto3" json:"previous_aliases,omitempty"`
+	// The aliases with the change applied, if fields contains aliases.
+	Aliases []string `protobuf:"bytes,5,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// The revision restored by the change, if it is a rollback.
+	RestoredRevisionId int32 `protobuf:"varint,6,opt,name=restored_revision_id,json=restoredRevisionId,proto3" json:"restored_revision_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ShortcutChangeRequestPayload) Reset() {
+	*x = ShortcutChangeRequestPayload{}
+	mi := &file_store_shortcut_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutChangeRequestPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutChangeRequestPayload) ProtoMessage() {}
+
+func (x *ShortcutChangeRequestPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_shortcut_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutChangeRequestPayload.ProtoReflect.Descriptor instead.
+func (*ShortcutChangeRequestPayload) Descriptor() ([]byte, []int) {
+	return file_store_shortcut_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ShortcutChangeRequestPayload) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequestPayload) GetPrevious() *Shortcut {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequestPayload) GetShortcut() *Shortcut {
+	if x != nil {
+		return x.Shortcut
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequestPayload) GetPreviousAliases() []string {
+	if x != nil {
+		return x.PreviousAliases
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequestPayload) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *ShortcutChangeRequestPayload) GetRestoredRevisionId() int32 {
+	if x != nil {
+		return x.RestoredRevisionId
+	}
+	return 0
+}
+
 var File_store_shortcut_proto protoreflect.FileDescriptor
 
 const file_store_shortcut_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/shortcut.proto\x12\x0fmonotreme.store\x1a\x12store/common.proto\"\xa2\x03\n" +
+	"\x14store/shortcut.proto\x12\x0fmonotreme.store\x1a\x12store/common.proto\"\xed\a\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04uuid\x18\x05 \x01(\tR\x04uuid\x12\x1d\n" +
@@ -247,11 +994,79 @@ const file_store_shortcut_proto_rawDesc = "" +
 	"\vog_metadata\x18\f \x01(\v2\".monotreme.store.OpenGraphMetadataR\n" +
 	"ogMetadata\x12\x1f\n" +
 	"\vcustom_icon\x18\r \x01(\tR\n" +
-	"customIcon\"a\n" +
+	"customIcon\x12\x1a\n" +
+	"\btemplate\x18\x0e \x01(\bR\btemplate\x12!\n" +
+	"\fforward_path\x18\x0f \x01(\bR\vforwardPath\x12B\n" +
+	"\rredirect_mode\x18\x10 \x01(\x0e2\x1d.monotreme.store.RedirectModeR\fredirectMode\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\x11 \x01(\x03R\tvalidFrom\x12\x1f\n" +
+	"\vvalid_until\x18\x12 \x01(\x03R\n" +
+	"validUntil\x129\n" +
+	"\n" +
+	"row_status\x18\x13 \x01(\x0e2\x1a.monotreme.store.RowStatusR\trowStatus\x12=\n" +
+	"\bvariants\x18\x14 \x01(\v2!.monotreme.store.ShortcutVariantsR\bvariants\x12J\n" +
+	"\rrouting_rules\x18\x15 \x01(\v2%.monotreme.store.ShortcutRoutingRulesR\froutingRules\x12=\n" +
+	"\bfailover\x18\x16 \x01(\v2!.monotreme.store.ShortcutFailoverR\bfailover\x12'\n" +
+	"\x0fnormalized_name\x18\x17 \x01(\tR\x0enormalizedName\x12\x1d\n" +
+	"\n" +
+	"deleted_ts\x18\x18 \x01(\x03R\tdeletedTs\x12\x1b\n" +
+	"\tgroup_ids\x18\x19 \x03(\x05R\bgroupIds\x12\x1c\n" +
+	"\tprotected\x18\x1a \x01(\bR\tprotected\"h\n" +
+	"\x10ShortcutVariants\x12<\n" +
+	"\bvariants\x18\x01 \x03(\v2 .monotreme.store.ShortcutVariantR\bvariants\x12\x16\n" +
+	"\x06sticky\x18\x02 \x01(\bR\x06sticky\"Q\n" +
+	"\x0fShortcutVariant\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\"R\n" +
+	"\x14ShortcutRoutingRules\x12:\n" +
+	"\x05rules\x18\x01 \x03(\v2$.monotreme.store.ShortcutRoutingRuleR\x05rules\"t\n" +
+	"\x13ShortcutRoutingRule\x12I\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2).monotreme.store.ShortcutRoutingConditionR\n" +
+	"conditions\x12\x12\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\"\xec\x01\n" +
+	"\x18ShortcutRoutingCondition\x12E\n" +
+	"\x05field\x18\x01 \x01(\x0e2/.monotreme.store.ShortcutRoutingCondition.FieldR\x05field\x12\x16\n" +
+	"\x06header\x18\x02 \x01(\tR\x06header\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"Y\n" +
+	"\x05Field\x12\x15\n" +
+	"\x11FIELD_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06DEVICE\x10\x01\x12\x06\n" +
+	"\x02OS\x10\x02\x12\v\n" +
+	"\aBROWSER\x10\x03\x12\f\n" +
+	"\bLANGUAGE\x10\x04\x12\n" +
+	"\n" +
+	"\x06HEADER\x10\x05\"5\n" +
+	"\x10ShortcutFailover\x12!\n" +
+	"\fbackup_links\x18\x01 \x03(\tR\vbackupLinks\"\xbb\x01\n" +
+	"\rShortcutAlias\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vshortcut_id\x18\x02 \x01(\x05R\n" +
+	"shortcutId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\x05R\tcreatorId\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x04 \x01(\x03R\tcreatedTs\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12'\n" +
+	"\x0fnormalized_name\x18\x06 \x01(\tR\x0enormalizedName\"a\n" +
 	"\x11OpenGraphMetadata\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05image\x18\x03 \x01(\tR\x05imageB\xae\x01\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\"\xd1\x01\n" +
+	"\x17ShortcutRevisionPayload\x12\x16\n" +
+	"\x06fields\x18\x01 \x03(\tR\x06fields\x125\n" +
+	"\bprevious\x18\x02 \x01(\v2\x19.monotreme.store.ShortcutR\bprevious\x125\n" +
+	"\bshortcut\x18\x03 \x01(\v2\x19.monotreme.store.ShortcutR\bshortcut\x120\n" +
+	"\x14restored_revision_id\x18\x04 \x01(\x05R\x12restoredRevisionId\"\x9b\x02\n" +
+	"\x1cShortcutChangeRequestPayload\x12\x16\n" +
+	"\x06fields\x18\x01 \x03(\tR\x06fields\x125\n" +
+	"\bprevious\x18\x02 \x01(\v2\x19.monotreme.store.ShortcutR\bprevious\x125\n" +
+	"\bshortcut\x18\x03 \x01(\v2\x19.monotreme.store.ShortcutR\bshortcut\x12)\n" +
+	"\x10previous_aliases\x18\x04 \x03(\tR\x0fpreviousAliases\x12\x18\n" +
+	"\aaliases\x18\x05 \x03(\tR\aaliases\x120\n" +
+	"\x14restored_revision_id\x18\x06 \x01(\x05R\x12restoredRevisionIdB\xae\x01\n" +
 	"\x13com.monotreme.storeB\rShortcutProtoP\x01Z+github.com/bshort/monotreme/proto/gen/store\xa2\x02\x03MSX\xaa\x02\x0fMonotreme.Store\xca\x02\x0fMonotreme\\Store\xe2\x02\x1bMonotreme\\Store\\GPBMetadata\xea\x02\x10Monotreme::Storeb\x06proto3"
 
 var (
@@ -266,20 +1081,46 @@ func file_store_shortcut_proto_rawDescGZIP() []byte {
 	return file_store_shortcut_proto_rawDescData
 }
 
-var file_store_shortcut_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_shortcut_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_store_shortcut_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_store_shortcut_proto_goTypes = []any{
-	(*Shortcut)(nil),          // 0: monotreme.store.Shortcut
-	(*OpenGraphMetadata)(nil), // 1: monotreme.store.OpenGraphMetadata
-	(Visibility)(0),           // 2: monotreme.store.Visibility
+	(ShortcutRoutingCondition_Field)(0),  // 0: monotreme.store.ShortcutRoutingCondition.Field
+	(*Shortcut)(nil),                     // 1: monotreme.store.Shortcut
+	(*ShortcutVariants)(nil),             // 2: monotreme.store.ShortcutVariants
+	(*ShortcutVariant)(nil),              // 3: monotreme.store.ShortcutVariant
+	(*ShortcutRoutingRules)(nil),         // 4: monotreme.store.ShortcutRoutingRules
+	(*ShortcutRoutingRule)(nil),          // 5: monotreme.store.ShortcutRoutingRule
+	(*ShortcutRoutingCondition)(nil),     // 6: monotreme.store.ShortcutRoutingCondition
+	(*ShortcutFailover)(nil),             // 7: monotreme.store.ShortcutFailover
+	(*ShortcutAlias)(nil),                // 8: monotreme.store.ShortcutAlias
+	(*OpenGraphMetadata)(nil),            // 9: monotreme.store.OpenGraphMetadata
+	(*ShortcutRevisionPayload)(nil),      // 10: monotreme.store.ShortcutRevisionPayload
+	(*ShortcutChangeRequestPayload)(nil), // 11: monotreme.store.ShortcutChangeRequestPayload
+	(Visibility)(0),                      // 12: monotreme.store.Visibility
+	(RedirectMode)(0),                    // 13: monotreme.store.RedirectMode
+	(RowStatus)(0),                       // 14: monotreme.store.RowStatus
 }
 var file_store_shortcut_proto_depIdxs = []int32{
-	2, // 0: monotreme.store.Shortcut.visibility:type_name -> monotreme.store.Visibility
-	1, // 1: monotreme.store.Shortcut.og_metadata:type_name -> monotreme.store.OpenGraphMetadata
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	12, // 0: monotreme.store.Shortcut.visibility:type_name -> monotreme.store.Visibility
+	9,  // 1: monotreme.store.Shortcut.og_metadata:type_name -> monotreme.store.OpenGraphMetadata
+	13, // 2: monotreme.store.Shortcut.redirect_mode:type_name -> monotreme.store.RedirectMode
+	14, // 3: monotreme.store.Shortcut.row_status:type_name -> monotreme.store.RowStatus
+	2,  // 4: monotreme.store.Shortcut.variants:type_name -> monotreme.store.ShortcutVariants
+	4,  // 5: monotreme.store.Shortcut.routing_rules:type_name -> monotreme.store.ShortcutRoutingRules
+	7,  // 6: monotreme.store.Shortcut.failover:type_name -> monotreme.store.ShortcutFailover
+	3,  // 7: monotreme.store.ShortcutVariants.variants:type_name -> monotreme.store.ShortcutVariant
+	5,  // 8: monotreme.store.ShortcutRoutingRules.rules:type_name -> monotreme.store.ShortcutRoutingRule
+	6,  // 9: monotreme.store.ShortcutRoutingRule.conditions:type_name -> monotreme.store.ShortcutRoutingCondition
+	0,  // 10: monotreme.store.ShortcutRoutingCondition.field:type_name -> monotreme.store.ShortcutRoutingCondition.Field
+	1,  // 11: monotreme.store.ShortcutRevisionPayload.previous:type_name -> monotreme.store.Shortcut
+	1,  // 12: monotreme.store.ShortcutRevisionPayload.shortcut:type_name -> monotreme.store.Shortcut
+	1,  // 13: monotreme.store.ShortcutChangeRequestPayload.previous:type_name -> monotreme.store.Shortcut
+	1,  // 14: monotreme.store.ShortcutChangeRequestPayload.shortcut:type_name -> monotreme.store.Shortcut
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_store_shortcut_proto_init() }
@@ -293,13 +1134,14 @@ func file_store_shortcut_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_shortcut_proto_rawDesc), len(file_store_shortcut_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_store_shortcut_proto_goTypes,
 		DependencyIndexes: file_store_shortcut_proto_depIdxs,
+		EnumInfos:         file_store_shortcut_proto_enumTypes,
 		MessageInfos:      file_store_shortcut_proto_msgTypes,
 	}.Build()
 	File_store_shortcut_proto = out.File
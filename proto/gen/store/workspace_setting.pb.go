@@ -227,6 +227,70 @@ func (*WorkspaceSetting_ShortcutRelated) isWorkspaceSetting_Value() {}
 
 func (*WorkspaceSetting_IdentityProvider) isWorkspaceSetting_Value() {}
 
+// ShortcutNamePolicy decides which shortcut names are considered the same.
+type ShortcutNamePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether names differing only in case are the same.
+	CaseInsensitive bool `protobuf:"varint,1,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// Whether names are compared after NFKC Unicode normalization.
+	UnicodeNormalization bool `protobuf:"varint,2,opt,name=unicode_normalization,json=unicodeNormalization,proto3" json:"unicode_normalization,omitempty"`
+	// Whether "-", "_" and "." are the same.
+	EquivalentSeparators bool `protobuf:"varint,3,opt,name=equivalent_separators,json=equivalentSeparators,proto3" json:"equivalent_separators,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ShortcutNamePolicy) Reset() {
+	*x = ShortcutNamePolicy{}
+	mi := &file_store_workspace_setting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutNamePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutNamePolicy) ProtoMessage() {}
+
+func (x *ShortcutNamePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutNamePolicy.ProtoReflect.Descriptor instead.
+func (*ShortcutNamePolicy) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShortcutNamePolicy) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *ShortcutNamePolicy) GetUnicodeNormalization() bool {
+	if x != nil {
+		return x.UnicodeNormalization
+	}
+	return false
+}
+
+func (x *ShortcutNamePolicy) GetEquivalentSeparators() bool {
+	if x != nil {
+		return x.EquivalentSeparators
+	}
+	return false
+}
+
 type WorkspaceSetting_GeneralSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SecretSession string                 `protobuf:"bytes,1,opt,name=secret_session,json=secretSession,proto3" json:"secret_session,omitempty"`
@@ -240,7 +304,7 @@ type WorkspaceSetting_GeneralSetting struct {
 
 func (x *WorkspaceSetting_GeneralSetting) Reset() {
 	*x = WorkspaceSetting_GeneralSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[1]
+	mi := &file_store_workspace_setting_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -252,7 +316,7 @@ func (x *WorkspaceSetting_GeneralSetting) String() string {
 func (*WorkspaceSetting_GeneralSetting) ProtoMessage() {}
 
 func (x *WorkspaceSetting_GeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[1]
+	mi := &file_store_workspace_setting_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,7 +377,7 @@ type WorkspaceSetting_SecuritySetting struct {
 
 func (x *WorkspaceSetting_SecuritySetting) Reset() {
 	*x = WorkspaceSetting_SecuritySetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[2]
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +389,7 @@ func (x *WorkspaceSetting_SecuritySetting) String() string {
 func (*WorkspaceSetting_SecuritySetting) ProtoMessage() {}
 
 func (x *WorkspaceSetting_SecuritySetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[2]
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,16 +420,38 @@ func (x *WorkspaceSetting_SecuritySetting) GetDisallowPasswordAuth() bool {
 }
 
 type WorkspaceSetting_ShortcutRelatedSetting struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DefaultVisibility Visibility             `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=monotreme.store.Visibility" json:"default_visibility,omitempty"`
-	ShortcutPrefix    string                 `protobuf:"bytes,2,opt,name=shortcut_prefix,json=shortcutPrefix,proto3" json:"shortcut_prefix,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DefaultVisibility   Visibility             `protobuf:"varint,1,opt,name=default_visibility,json=defaultVisibility,proto3,enum=monotreme.store.Visibility" json:"default_visibility,omitempty"`
+	ShortcutPrefix      string                 `protobuf:"bytes,2,opt,name=shortcut_prefix,json=shortcutPrefix,proto3" json:"shortcut_prefix,omitempty"`
+	DefaultRedirectMode RedirectMode           `protobuf:"varint,3,opt,name=default_redirect_mode,json=defaultRedirectMode,proto3,enum=monotreme.store.RedirectMode" json:"default_redirect_mode,omitempty"`
+	// The message shown when a shortcut is visited before it becomes valid.
+	PendingMessage string `protobuf:"bytes,4,opt,name=pending_message,json=pendingMessage,proto3" json:"pending_message,omitempty"`
+	// The message shown when an expired shortcut is visited.
+	ExpiredMessage string `protobuf:"bytes,5,opt,name=expired_message,json=expiredMessage,proto3" json:"expired_message,omitempty"`
+	// The number of days after expiry before a shortcut is archived. 0 uses the default of 30 days.
+	ExpiredArchiveDays int32 `protobuf:"varint,6,opt,name=expired_archive_days,json=expiredArchiveDays,proto3" json:"expired_archive_days,omitempty"`
+	// Whether a visit to an unknown shortcut name redirects to the only close match.
+	AutoRedirectNotFound bool `protobuf:"varint,7,opt,name=auto_redirect_not_found,json=autoRedirectNotFound,proto3" json:"auto_redirect_not_found,omitempty"`
+	// How shortcut names are compared. Unset compares names case-insensitively after NFKC normalization.
+	NamePolicy *ShortcutNamePolicy `protobuf:"bytes,8,opt,name=name_policy,json=namePolicy,proto3" json:"name_policy,omitempty"`
+	// The hosts, like "go" or "go.example.com", on which bare paths such as /standup resolve as shortcut names.
+	RedirectHosts []string `protobuf:"bytes,9,rep,name=redirect_hosts,json=redirectHosts,proto3" json:"redirect_hosts,omitempty"`
+	// Former shortcut prefixes, which redirect permanently to the shortcut_prefix URL.
+	LegacyShortcutPrefixes []string `protobuf:"bytes,10,rep,name=legacy_shortcut_prefixes,json=legacyShortcutPrefixes,proto3" json:"legacy_shortcut_prefixes,omitempty"`
+	// The prefix of collection URLs. Empty uses "c".
+	CollectionPrefix string `protobuf:"bytes,11,opt,name=collection_prefix,json=collectionPrefix,proto3" json:"collection_prefix,omitempty"`
+	// Former collection prefixes, which redirect permanently to the collection_prefix URL.
+	LegacyCollectionPrefixes []string `protobuf:"bytes,12,rep,name=legacy_collection_prefixes,json=legacyCollectionPrefixes,proto3" json:"legacy_collection_prefixes,omitempty"`
+	// The number of days deleted shortcuts and collections stay in the trash before they are purged.
+	// 0 uses the default of 30 days.
+	TrashRetentionDays int32 `protobuf:"varint,13,opt,name=trash_retention_days,json=trashRetentionDays,proto3" json:"trash_retention_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *WorkspaceSetting_ShortcutRelatedSetting) Reset() {
 	*x = WorkspaceSetting_ShortcutRelatedSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +463,7 @@ func (x *WorkspaceSetting_ShortcutRelatedSetting) String() string {
 func (*WorkspaceSetting_ShortcutRelatedSetting) ProtoMessage() {}
 
 func (x *WorkspaceSetting_ShortcutRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,6 +493,83 @@ func (x *WorkspaceSetting_ShortcutRelatedSetting) GetShortcutPrefix() string {
 	return ""
 }
 
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetDefaultRedirectMode() RedirectMode {
+	if x != nil {
+		return x.DefaultRedirectMode
+	}
+	return RedirectMode_REDIRECT_MODE_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetPendingMessage() string {
+	if x != nil {
+		return x.PendingMessage
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetExpiredMessage() string {
+	if x != nil {
+		return x.ExpiredMessage
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetExpiredArchiveDays() int32 {
+	if x != nil {
+		return x.ExpiredArchiveDays
+	}
+	return 0
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetAutoRedirectNotFound() bool {
+	if x != nil {
+		return x.AutoRedirectNotFound
+	}
+	return false
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetNamePolicy() *ShortcutNamePolicy {
+	if x != nil {
+		return x.NamePolicy
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetRedirectHosts() []string {
+	if x != nil {
+		return x.RedirectHosts
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetLegacyShortcutPrefixes() []string {
+	if x != nil {
+		return x.LegacyShortcutPrefixes
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetCollectionPrefix() string {
+	if x != nil {
+		return x.CollectionPrefix
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetLegacyCollectionPrefixes() []string {
+	if x != nil {
+		return x.LegacyCollectionPrefixes
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting_ShortcutRelatedSetting) GetTrashRetentionDays() int32 {
+	if x != nil {
+		return x.TrashRetentionDays
+	}
+	return 0
+}
+
 type WorkspaceSetting_IdentityProviderSetting struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	IdentityProviders []*IdentityProvider    `protobuf:"bytes,1,rep,name=identity_providers,json=identityProviders,proto3" json:"identity_providers,omitempty"`
@@ -416,7 +579,7 @@ type WorkspaceSetting_IdentityProviderSetting struct {
 
 func (x *WorkspaceSetting_IdentityProviderSetting) Reset() {
 	*x = WorkspaceSetting_IdentityProviderSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +591,7 @@ func (x *WorkspaceSetting_IdentityProviderSetting) String() string {
 func (*WorkspaceSetting_IdentityProviderSetting) ProtoMessage() {}
 
 func (x *WorkspaceSetting_IdentityProviderSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +618,7 @@ var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 const file_store_workspace_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\x0fmonotreme.store\x1a\x12store/common.proto\x1a\x0fstore/idp.proto\"\x97\b\n" +
+	"\x1dstore/workspace_setting.proto\x12\x0fmonotreme.store\x1a\x12store/common.proto\x1a\x0fstore/idp.proto\"\xe9\f\n" +
 	"\x10WorkspaceSetting\x126\n" +
 	"\x03key\x18\x01 \x01(\x0e2$.monotreme.store.WorkspaceSettingKeyR\x03key\x12\x10\n" +
 	"\x03raw\x18\x02 \x01(\tR\x03raw\x12L\n" +
@@ -472,13 +635,30 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\fcustom_style\x18\x05 \x01(\tR\vcustomStyle\x1a\x85\x01\n" +
 	"\x0fSecuritySetting\x12<\n" +
 	"\x1adisallow_user_registration\x18\x01 \x01(\bR\x18disallowUserRegistration\x124\n" +
-	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x1a\x8d\x01\n" +
+	"\x16disallow_password_auth\x18\x02 \x01(\bR\x14disallowPasswordAuth\x1a\xdf\x05\n" +
 	"\x16ShortcutRelatedSetting\x12J\n" +
 	"\x12default_visibility\x18\x01 \x01(\x0e2\x1b.monotreme.store.VisibilityR\x11defaultVisibility\x12'\n" +
-	"\x0fshortcut_prefix\x18\x02 \x01(\tR\x0eshortcutPrefix\x1ak\n" +
+	"\x0fshortcut_prefix\x18\x02 \x01(\tR\x0eshortcutPrefix\x12Q\n" +
+	"\x15default_redirect_mode\x18\x03 \x01(\x0e2\x1d.monotreme.store.RedirectModeR\x13defaultRedirectMode\x12'\n" +
+	"\x0fpending_message\x18\x04 \x01(\tR\x0ependingMessage\x12'\n" +
+	"\x0fexpired_message\x18\x05 \x01(\tR\x0eexpiredMessage\x120\n" +
+	"\x14expired_archive_days\x18\x06 \x01(\x05R\x12expiredArchiveDays\x125\n" +
+	"\x17auto_redirect_not_found\x18\a \x01(\bR\x14autoRedirectNotFound\x12D\n" +
+	"\vname_policy\x18\b \x01(\v2#.monotreme.store.ShortcutNamePolicyR\n" +
+	"namePolicy\x12%\n" +
+	"\x0eredirect_hosts\x18\t \x03(\tR\rredirectHosts\x128\n" +
+	"\x18legacy_shortcut_prefixes\x18\n" +
+	" \x03(\tR\x16legacyShortcutPrefixes\x12+\n" +
+	"\x11collection_prefix\x18\v \x01(\tR\x10collectionPrefix\x12<\n" +
+	"\x1alegacy_collection_prefixes\x18\f \x03(\tR\x18legacyCollectionPrefixes\x120\n" +
+	"\x14trash_retention_days\x18\r \x01(\x05R\x12trashRetentionDays\x1ak\n" +
 	"\x17IdentityProviderSetting\x12P\n" +
 	"\x12identity_providers\x18\x01 \x03(\v2!.monotreme.store.IdentityProviderR\x11identityProvidersB\a\n" +
-	"\x05value*\xe3\x02\n" +
+	"\x05value\"\xa9\x01\n" +
+	"\x12ShortcutNamePolicy\x12)\n" +
+	"\x10case_insensitive\x18\x01 \x01(\bR\x0fcaseInsensitive\x123\n" +
+	"\x15unicode_normalization\x18\x02 \x01(\bR\x14unicodeNormalization\x123\n" +
+	"\x15equivalent_separators\x18\x03 \x01(\bR\x14equivalentSeparators*\xe3\x02\n" +
 	"\x13WorkspaceSettingKey\x12%\n" +
 	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19WORKSPACE_SETTING_GENERAL\x10\x01\x12\x1e\n" +
@@ -505,30 +685,34 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 }
 
 var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_workspace_setting_proto_goTypes = []any{
 	(WorkspaceSettingKey)(0),                         // 0: monotreme.store.WorkspaceSettingKey
 	(*WorkspaceSetting)(nil),                         // 1: monotreme.store.WorkspaceSetting
-	(*WorkspaceSetting_GeneralSetting)(nil),          // 2: monotreme.store.WorkspaceSetting.GeneralSetting
-	(*WorkspaceSetting_SecuritySetting)(nil),         // 3: monotreme.store.WorkspaceSetting.SecuritySetting
-	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),  // 4: monotreme.store.WorkspaceSetting.ShortcutRelatedSetting
-	(*WorkspaceSetting_IdentityProviderSetting)(nil), // 5: monotreme.store.WorkspaceSetting.IdentityProviderSetting
-	(Visibility)(0),                                  // 6: monotreme.store.Visibility
-	(*IdentityProvider)(nil),                         // 7: monotreme.store.IdentityProvider
+	(*ShortcutNamePolicy)(nil),                       // 2: monotreme.store.ShortcutNamePolicy
+	(*WorkspaceSetting_GeneralSetting)(nil),          // 3: monotreme.store.WorkspaceSetting.GeneralSetting
+	(*WorkspaceSetting_SecuritySetting)(nil),         // 4: monotreme.store.WorkspaceSetting.SecuritySetting
+	(*WorkspaceSetting_ShortcutRelatedSetting)(nil),  // 5: monotreme.store.WorkspaceSetting.ShortcutRelatedSetting
+	(*WorkspaceSetting_IdentityProviderSetting)(nil), // 6: monotreme.store.WorkspaceSetting.IdentityProviderSetting
+	(Visibility)(0),                                  // 7: monotreme.store.Visibility
+	(RedirectMode)(0),                                // 8: monotreme.store.RedirectMode
+	(*IdentityProvider)(nil),                         // 9: monotreme.store.IdentityProvider
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
 	0, // 0: monotreme.store.WorkspaceSetting.key:type_name -> monotreme.store.WorkspaceSettingKey
-	2, // 1: monotreme.store.WorkspaceSetting.general:type_name -> monotreme.store.WorkspaceSetting.GeneralSetting
-	3, // 2: monotreme.store.WorkspaceSetting.security:type_name -> monotreme.store.WorkspaceSetting.SecuritySetting
-	4, // 3: monotreme.store.WorkspaceSetting.shortcut_related:type_name -> monotreme.store.WorkspaceSetting.ShortcutRelatedSetting
-	5, // 4: monotreme.store.WorkspaceSetting.identity_provider:type_name -> monotreme.store.WorkspaceSetting.IdentityProviderSetting
-	6, // 5: monotreme.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> monotreme.store.Visibility
-	7, // 6: monotreme.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> monotreme.store.IdentityProvider
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	3, // 1: monotreme.store.WorkspaceSetting.general:type_name -> monotreme.store.WorkspaceSetting.GeneralSetting
+	4, // 2: monotreme.store.WorkspaceSetting.security:type_name -> monotreme.store.WorkspaceSetting.SecuritySetting
+	5, // 3: monotreme.store.WorkspaceSetting.shortcut_related:type_name -> monotreme.store.WorkspaceSetting.ShortcutRelatedSetting
+	6, // 4: monotreme.store.WorkspaceSetting.identity_provider:type_name -> monotreme.store.WorkspaceSetting.IdentityProviderSetting
+	7, // 5: monotreme.store.WorkspaceSetting.ShortcutRelatedSetting.default_visibility:type_name -> monotreme.store.Visibility
+	8, // 6: monotreme.store.WorkspaceSetting.ShortcutRelatedSetting.default_redirect_mode:type_name -> monotreme.store.RedirectMode
+	2, // 7: monotreme.store.WorkspaceSetting.ShortcutRelatedSetting.name_policy:type_name -> monotreme.store.ShortcutNamePolicy
+	9, // 8: monotreme.store.WorkspaceSetting.IdentityProviderSetting.identity_providers:type_name -> monotreme.store.IdentityProvider
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -550,7 +734,7 @@ func file_store_workspace_setting_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ReservedPaths are the first path segments of app pages and server endpoints. They
+// cannot be used as shortcut or collection prefixes, and a redirect host never resolves
+// them as shortcut names.
+var ReservedPaths = []string{
+	"about",
+	"admin",
+	"api",
+	"api-docs",
+	"assets",
+	"auth",
+	"collections",
+	"export",
+	"healthz",
+	"landing",
+	"monotreme.api.v1",
+	"quick-save",
+	"rss",
+	"setting",
+	"shortcut",
+	"shortcuts",
+	"stats",
+	"tags",
+}
+
+// ShortcutURL returns the URL of the shortcut named name under prefix. An empty baseURL
+// returns the path alone.
+func ShortcutURL(baseURL string, prefix string, name string) string {
+	return baseURL + "/" + prefix + "/" + escapePath(name)
+}
+
+// CollectionURL returns the URL of the collection named name under prefix. An empty
+// baseURL returns the path alone.
+func CollectionURL(baseURL string, prefix string, name string) string {
+	return baseURL + "/" + prefix + "/" + escapePath(name)
+}
+
+// escapePath escapes every segment of a slash-separated name, keeping the slashes.
+func escapePath(name string) string {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,21 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortcutURL(t *testing.T) {
+	require.Equal(t, "/s/standup", ShortcutURL("", "s", "standup"))
+	require.Equal(t, "https://links.example.com/go/standup", ShortcutURL("https://links.example.com", "go", "standup"))
+	// Segments are escaped one by one, so hierarchical names keep their slashes.
+	require.Equal(t, "/s/team/daily%20standup", ShortcutURL("", "s", "team/daily standup"))
+	require.Equal(t, "/s/a%3Fb%23c", ShortcutURL("", "s", "a?b#c"))
+	require.Equal(t, "/s/caf%C3%A9", ShortcutURL("", "s", "café"))
+}
+
+func TestCollectionURL(t *testing.T) {
+	require.Equal(t, "https://links.example.com/col/team", CollectionURL("https://links.example.com", "col", "team"))
+	require.Equal(t, "/c/q%3F%20notes", CollectionURL("", "c", "q? notes"))
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -24,6 +25,18 @@ type Profile struct {
 	Driver string
 	// Version is the current version of server.
 	Version string
+	// ResolverCacheSize is the number of resolved shortcut paths kept in memory. Zero disables the cache.
+	ResolverCacheSize int
+	// ResolverCacheTTL is how long a resolved shortcut path is kept in memory.
+	ResolverCacheTTL time.Duration
+	// ActivityBufferSize is the number of click events buffered before they are written.
+	ActivityBufferSize int
+	// ActivityBatchSize is the number of click events written at once.
+	ActivityBatchSize int
+	// ActivityFlushInterval is how often buffered click events are written.
+	ActivityFlushInterval time.Duration
+	// ActivityBufferPolicy is what happens to click events when the buffer is full, "drop" or "block".
+	ActivityBufferPolicy string
 }
 
 func (p *Profile) IsDev() bool {
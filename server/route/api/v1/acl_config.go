@@ -24,10 +24,16 @@ func isUnauthorizeAllowedMethod(methodName string) bool {
 }
 
 var allowedMethodsOnlyForAdmin = map[string]bool{
-	"/monotreme.api.v1.UserService/CreateUser":                  true,
-	"/monotreme.api.v1.UserService/DeleteUser":                  true,
-	"/monotreme.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
-	"/monotreme.api.v1.SubscriptionService/UpdateSubscription":  true,
+	"/monotreme.api.v1.UserService/CreateUser":                    true,
+	"/monotreme.api.v1.UserService/DeleteUser":                    true,
+	"/monotreme.api.v1.UserService/PreviewDeleteUser":             true,
+	"/monotreme.api.v1.NamespaceService/CreateNamespaceRule":      true,
+	"/monotreme.api.v1.NamespaceService/UpdateNamespaceRule":      true,
+	"/monotreme.api.v1.NamespaceService/DeleteNamespaceRule":      true,
+	"/monotreme.api.v1.NamespaceService/ListNamespaceConflicts":   true,
+	"/monotreme.api.v1.WorkspaceService/UpdateWorkspaceSetting":   true,
+	"/monotreme.api.v1.WorkspaceService/GetWorkspaceRuntimeStats": true,
+	"/monotreme.api.v1.SubscriptionService/UpdateSubscription":    true,
 }
 
 // isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
@@ -27,6 +27,12 @@ func (s *APIV1Service) GetRecentActivity(ctx context.Context, request *v1pb.GetR
 		limit = int(*request.Limit)
 	}
 
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	viewerID := getViewerID(user)
+
 	response := &v1pb.GetRecentActivityResponse{}
 
 	// Get recent users (most recently created)
@@ -37,28 +43,28 @@ func (s *APIV1Service) GetRecentActivity(ctx context.Context, request *v1pb.GetR
 	response.RecentUsers = recentUsers
 
 	// Get recent shortcuts (most recently created)
-	recentShortcuts, err := s.getRecentShortcuts(ctx, limit)
+	recentShortcuts, err := s.getRecentShortcuts(ctx, limit, viewerID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get recent shortcuts: %v", err)
 	}
 	response.RecentShortcuts = recentShortcuts
 
 	// Get recent collections (most recently created)
-	recentCollections, err := s.getRecentCollections(ctx, limit)
+	recentCollections, err := s.getRecentCollections(ctx, limit, viewerID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get recent collections: %v", err)
 	}
 	response.RecentCollections = recentCollections
 
 	// Get recent clicks (most recent shortcut views)
-	recentClicks, err := s.getRecentClicks(ctx, limit)
+	recentClicks, err := s.getRecentClicks(ctx, limit, viewerID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get recent clicks: %v", err)
 	}
 	response.RecentClicks = recentClicks
 
 	// Get most clicked shortcuts
-	mostClickedShortcuts, err := s.getMostClickedShortcuts(ctx, limit)
+	mostClickedShortcuts, err := s.getMostClickedShortcuts(ctx, limit, viewerID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get most clicked shortcuts: %v", err)
 	}
@@ -183,6 +189,12 @@ func (s *APIV1Service) ListActivities(ctx context.Context, request *v1pb.ListAct
 		}
 	}
 
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	viewerID := getViewerID(user)
+
 	// Build activity filter
 	findActivity := &store.FindActivity{}
 
@@ -193,6 +205,18 @@ func (s *APIV1Service) ListActivities(ctx context.Context, request *v1pb.ListAct
 			findActivity.Type = store.ActivityShortcutCreate
 		case v1pb.ActivityType_SHORTCUT_VIEWED:
 			findActivity.Type = store.ActivityShortcutView
+		case v1pb.ActivityType_SHORTCUT_LINK_CHANGED:
+			findActivity.Type = store.ActivityShortcutLinkChange
+		case v1pb.ActivityType_SHORTCUT_FAILED_OVER:
+			findActivity.Type = store.ActivityShortcutFailover
+		case v1pb.ActivityType_SHORTCUT_BOT_VIEWED:
+			findActivity.Type = store.ActivityShortcutBotView
+		case v1pb.ActivityType_SHORTCUT_CHANGE_REQUESTED:
+			findActivity.Type = store.ActivityShortcutChangeRequest
+		case v1pb.ActivityType_SHORTCUT_CHANGE_APPROVED:
+			findActivity.Type = store.ActivityShortcutChangeApprove
+		case v1pb.ActivityType_SHORTCUT_CHANGE_REJECTED:
+			findActivity.Type = store.ActivityShortcutChangeReject
 		}
 	}
 
@@ -224,7 +248,7 @@ func (s *APIV1Service) ListActivities(ctx context.Context, request *v1pb.ListAct
 	// Convert activities to response format
 	activityItems := make([]*v1pb.ActivityItem, len(paginatedActivities))
 	for i, activity := range paginatedActivities {
-		activityItem, err := s.convertActivityToActivityItem(ctx, activity)
+		activityItem, err := s.convertActivityToActivityItem(ctx, activity, viewerID)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert activity: %v", err)
 		}
@@ -284,8 +308,10 @@ func (s *APIV1Service) getRecentUsers(ctx context.Context, limit int) ([]*v1pb.R
 	return recentUsers, nil
 }
 
-func (s *APIV1Service) getRecentShortcuts(ctx context.Context, limit int) ([]*v1pb.RecentShortcut, error) {
-	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+func (s *APIV1Service) getRecentShortcuts(ctx context.Context, limit int, viewerID int32) ([]*v1pb.RecentShortcut, error) {
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &viewerID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -339,8 +365,10 @@ func (s *APIV1Service) getRecentShortcuts(ctx context.Context, limit int) ([]*v1
 	return recentShortcuts, nil
 }
 
-func (s *APIV1Service) getRecentCollections(ctx context.Context, limit int) ([]*v1pb.RecentCollection, error) {
-	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+func (s *APIV1Service) getRecentCollections(ctx context.Context, limit int, viewerID int32) ([]*v1pb.RecentCollection, error) {
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		ViewerID: &viewerID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -390,7 +418,7 @@ func (s *APIV1Service) getRecentCollections(ctx context.Context, limit int) ([]*
 	return recentCollections, nil
 }
 
-func (s *APIV1Service) getRecentClicks(ctx context.Context, limit int) ([]*v1pb.RecentClick, error) {
+func (s *APIV1Service) getRecentClicks(ctx context.Context, limit int, viewerID int32) ([]*v1pb.RecentClick, error) {
 	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
 		Type: store.ActivityShortcutView,
 	})
@@ -418,9 +446,9 @@ func (s *APIV1Service) getRecentClicks(ctx context.Context, limit int) ([]*v1pb.
 			continue // Skip invalid payloads
 		}
 
-		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId})
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId, ViewerID: &viewerID})
 		if err != nil || shortcut == nil {
-			continue // Skip if shortcut not found
+			continue // Skip if shortcut not found or not visible
 		}
 
 		creator, _ := s.Store.GetUser(ctx, &store.FindUser{ID: &shortcut.CreatorId})
@@ -448,8 +476,10 @@ func (s *APIV1Service) getRecentClicks(ctx context.Context, limit int) ([]*v1pb.
 	return recentClicks, nil
 }
 
-func (s *APIV1Service) getMostClickedShortcuts(ctx context.Context, limit int) ([]*v1pb.MostClickedShortcut, error) {
-	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+func (s *APIV1Service) getMostClickedShortcuts(ctx context.Context, limit int, viewerID int32) ([]*v1pb.MostClickedShortcut, error) {
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		ViewerID: &viewerID,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -563,7 +593,7 @@ func (s *APIV1Service) getUserSummary(ctx context.Context, userID int32) (*v1pb.
 	}, nil
 }
 
-func (s *APIV1Service) convertActivityToActivityItem(ctx context.Context, activity *store.Activity) (*v1pb.ActivityItem, error) {
+func (s *APIV1Service) convertActivityToActivityItem(ctx context.Context, activity *store.Activity, viewerID int32) (*v1pb.ActivityItem, error) {
 	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &activity.CreatorID})
 	if err != nil {
 		return nil, err
@@ -591,7 +621,7 @@ func (s *APIV1Service) convertActivityToActivityItem(ctx context.Context, activi
 		// Parse payload to get shortcut data
 		payload := &storepb.ActivityShorcutCreatePayload{}
 		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err == nil {
-			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId})
+			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId, ViewerID: &viewerID})
 			if err == nil && shortcut != nil {
 				activityItem.Data = &v1pb.ActivityItem_ShortcutCreated{
 					ShortcutCreated: &v1pb.ShortcutCreatedData{
@@ -604,12 +634,15 @@ func (s *APIV1Service) convertActivityToActivityItem(ctx context.Context, activi
 			}
 		}
 
-	case store.ActivityShortcutView:
+	case store.ActivityShortcutView, store.ActivityShortcutBotView:
 		activityItem.Type = v1pb.ActivityType_SHORTCUT_VIEWED
+		if activity.Type == store.ActivityShortcutBotView {
+			activityItem.Type = v1pb.ActivityType_SHORTCUT_BOT_VIEWED
+		}
 		// Parse payload to get view data
 		payload := &storepb.ActivityShorcutViewPayload{}
 		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err == nil {
-			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId})
+			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId, ViewerID: &viewerID})
 			if err == nil && shortcut != nil {
 				activityItem.Data = &v1pb.ActivityItem_ShortcutViewed{
 					ShortcutViewed: &v1pb.ShortcutViewedData{
@@ -618,6 +651,69 @@ func (s *APIV1Service) convertActivityToActivityItem(ctx context.Context, activi
 						Title:      shortcut.Title,
 						UserAgent:  payload.UserAgent,
 						Referer:    payload.Referer,
+						Bot:        payload.Bot,
+					},
+				}
+			}
+		}
+
+	case store.ActivityShortcutLinkChange:
+		activityItem.Type = v1pb.ActivityType_SHORTCUT_LINK_CHANGED
+		payload := &storepb.ActivityShortcutLinkChangePayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err == nil {
+			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId, ViewerID: &viewerID})
+			if err == nil && shortcut != nil {
+				activityItem.Data = &v1pb.ActivityItem_ShortcutLinkChanged{
+					ShortcutLinkChanged: &v1pb.ShortcutLinkChangedData{
+						ShortcutId:   shortcut.Id,
+						Name:         shortcut.Name,
+						Title:        shortcut.Title,
+						PreviousLink: payload.PreviousLink,
+						Link:         payload.Link,
+					},
+				}
+			}
+		}
+
+	case store.ActivityShortcutFailover:
+		activityItem.Type = v1pb.ActivityType_SHORTCUT_FAILED_OVER
+		payload := &storepb.ActivityShortcutFailoverPayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err == nil {
+			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId, ViewerID: &viewerID})
+			if err == nil && shortcut != nil {
+				activityItem.Data = &v1pb.ActivityItem_ShortcutFailedOver{
+					ShortcutFailedOver: &v1pb.ShortcutFailedOverData{
+						ShortcutId:   shortcut.Id,
+						Name:         shortcut.Name,
+						Title:        shortcut.Title,
+						PreviousLink: payload.PreviousLink,
+						Link:         payload.Link,
+						Backup:       payload.Backup,
+					},
+				}
+			}
+		}
+
+	case store.ActivityShortcutChangeRequest, store.ActivityShortcutChangeApprove, store.ActivityShortcutChangeReject:
+		switch activity.Type {
+		case store.ActivityShortcutChangeApprove:
+			activityItem.Type = v1pb.ActivityType_SHORTCUT_CHANGE_APPROVED
+		case store.ActivityShortcutChangeReject:
+			activityItem.Type = v1pb.ActivityType_SHORTCUT_CHANGE_REJECTED
+		default:
+			activityItem.Type = v1pb.ActivityType_SHORTCUT_CHANGE_REQUESTED
+		}
+		payload := &storepb.ActivityShortcutChangeRequestPayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err == nil {
+			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &payload.ShortcutId, ViewerID: &viewerID})
+			if err == nil && shortcut != nil {
+				activityItem.Data = &v1pb.ActivityItem_ShortcutChangeRequest{
+					ShortcutChangeRequest: &v1pb.ShortcutChangeRequestData{
+						ShortcutId:      shortcut.Id,
+						Name:            shortcut.Name,
+						Title:           shortcut.Title,
+						ChangeRequestId: payload.ChangeRequestId,
+						Fields:          payload.Fields,
 					},
 				}
 			}
@@ -643,7 +739,8 @@ func timestampFromUnix(ts int64) *timestamppb.Timestamp {
 	return timestamppb.New(time.Unix(ts, 0))
 }
 
-// calculateViewCount calculates view count for a shortcut by counting SHORTCUT_VIEW activities
+// calculateViewCount calculates view count for a shortcut by counting SHORTCUT_VIEW activities.
+// Bot views have a type of their own and are not counted.
 func (s *APIV1Service) calculateViewCount(ctx context.Context, shortcutID int32) (int32, error) {
 	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
 		Type:              store.ActivityShortcutView,
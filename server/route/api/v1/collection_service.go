@@ -20,7 +20,13 @@ import (
 )
 
 func (s *APIV1Service) ListCollections(ctx context.Context, _ *v1pb.ListCollectionsRequest) (*v1pb.ListCollectionsResponse, error) {
-	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{})
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		ViewerID: &user.ID,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get collection list, err: %v", err)
 	}
@@ -54,6 +60,14 @@ func (s *APIV1Service) GetCollection(ctx context.Context, request *v1pb.GetColle
 	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
+	// Private collections of other users, and group ones of groups the user is not in, are not revealed.
+	visible, err := s.Store.IsVisibleTo(ctx, collection, getViewerID(user))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
 	return convertCollectionFromStore(collection), nil
 }
 
@@ -75,6 +89,14 @@ func (s *APIV1Service) GetCollectionByName(ctx context.Context, request *v1pb.Ge
 	if user == nil && collection.Visibility != storepb.Visibility_PUBLIC {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
+	// Private collections of other users, and group ones of groups the user is not in, are not revealed.
+	visible, err := s.Store.IsVisibleTo(ctx, collection, getViewerID(user))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check collection visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
 	return convertCollectionFromStore(collection), nil
 }
 
@@ -98,6 +120,19 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
+	existingCollection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		Name:  &request.Collection.Name,
+		Trash: store.TrashIncluded,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by name: %v", err)
+	}
+	if existingCollection != nil {
+		if existingCollection.DeletedTs != 0 {
+			return nil, status.Errorf(codes.AlreadyExists, "name %q is used by a collection in the trash", request.Collection.Name)
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "name %q is already used by another collection", request.Collection.Name)
+	}
 	collectionCreate := &storepb.Collection{
 		CreatorId:   user.ID,
 		Name:        request.Collection.Name,
@@ -106,6 +141,9 @@ func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.Creat
 		ShortcutIds: request.Collection.ShortcutIds,
 		Visibility:  convertVisibilityToStorepb(request.Collection.Visibility),
 	}
+	if collectionCreate.GroupIds, err = s.getVisibilityGroupIDs(ctx, collectionCreate.Visibility, request.Collection.GroupIds); err != nil {
+		return nil, err
+	}
 	collection, err := s.Store.CreateCollection(ctx, collectionCreate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create collection, err: %v", err)
@@ -132,7 +170,11 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 	if collection == nil {
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
-	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	role, err := s.getPermissionRole(ctx, user, store.PermissionResourceTypeCollection, collection.Id, collection.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Includes(store.PermissionRoleEditor) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
@@ -152,6 +194,24 @@ func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.Updat
 		case "visibility":
 			visibility := convertVisibilityToStorepb(request.Collection.Visibility)
 			update.Visibility = &visibility
+		case "group_ids":
+			update.GroupIDs = request.Collection.GroupIds
+		}
+	}
+	if update.Visibility != nil || update.GroupIDs != nil {
+		// Editors can't change who the collection is shared with.
+		if !role.Includes(store.PermissionRoleOwner) {
+			return nil, status.Errorf(codes.PermissionDenied, "only owners can change the visibility")
+		}
+		visibility, groupIDs := collection.Visibility, collection.GroupIds
+		if update.Visibility != nil {
+			visibility = *update.Visibility
+		}
+		if update.GroupIDs != nil {
+			groupIDs = update.GroupIDs
+		}
+		if update.GroupIDs, err = s.getVisibilityGroupIDs(ctx, visibility, groupIDs); err != nil {
+			return nil, err
 		}
 	}
 	collection, err = s.Store.UpdateCollection(ctx, update)
@@ -176,19 +236,203 @@ func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.Delet
 	if collection == nil {
 		return nil, status.Errorf(codes.NotFound, "collection not found")
 	}
-	if collection.CreatorId != user.ID && user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	if err := s.checkPermission(ctx, user, store.PermissionResourceTypeCollection, collection.Id, collection.CreatorId, store.PermissionRoleOwner); err != nil {
+		return nil, err
 	}
 
-	err = s.Store.DeleteCollection(ctx, &store.DeleteCollection{
-		ID: collection.Id,
+	deletedTs := time.Now().Unix()
+	if _, err := s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		DeletedTs: &deletedTs,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move collection to the trash, err: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListTrashedCollections(ctx context.Context, _ *v1pb.ListTrashedCollectionsRequest) (*v1pb.ListTrashedCollectionsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	find := &store.FindCollection{
+		Trash: store.TrashOnly,
+	}
+	// Admins see every collection in the trash, others only their own.
+	if user.Role != store.RoleAdmin {
+		find.CreatorID = &user.ID
+	}
+	collections, err := s.Store.ListCollections(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections in the trash: %v", err)
+	}
+	retention, err := s.Store.GetTrashRetention(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get trash retention: %v", err)
+	}
+
+	response := &v1pb.ListTrashedCollectionsResponse{
+		Collections: []*v1pb.Collection{},
+	}
+	for _, collection := range collections {
+		convertedCollection := convertCollectionFromStore(collection)
+		convertedCollection.PurgeTime = timestamppb.New(time.Unix(collection.DeletedTs, 0).Add(retention))
+		response.Collections = append(response.Collections, convertedCollection)
+	}
+	return response, nil
+}
+
+// RestoreCollection takes the collection out of the trash and re-links its shortcuts. The
+// shortcuts purged in the meantime are dropped, and the ones still in the trash stay linked
+// so that they show up again once they are restored.
+func (s *APIV1Service) RestoreCollection(ctx context.Context, request *v1pb.RestoreCollectionRequest) (*v1pb.Collection, error) {
+	collection, err := s.getTrashedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	shortcutIDs := []int32{}
+	for _, shortcutID := range collection.ShortcutIds {
+		shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+			ID:    &shortcutID,
+			Trash: store.TrashIncluded,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+		}
+		if shortcut != nil {
+			shortcutIDs = append(shortcutIDs, shortcut.Id)
+		}
+	}
+	deletedTs := int64(0)
+	collection, err = s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:          collection.Id,
+		ShortcutIDs: shortcutIDs,
+		DeletedTs:   &deletedTs,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete collection, err: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to restore collection, err: %v", err)
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+func (s *APIV1Service) PurgeCollection(ctx context.Context, request *v1pb.PurgeCollectionRequest) (*emptypb.Empty, error) {
+	collection, err := s.getTrashedCollection(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteCollection(ctx, &store.DeleteCollection{
+		ID: collection.Id,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge collection, err: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) ListCollectionPermissions(ctx context.Context, request *v1pb.ListCollectionPermissionsRequest) (*v1pb.ListCollectionPermissionsResponse, error) {
+	collection, err := s.getCollectionWithRole(ctx, request.Id, store.PermissionRoleEditor)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := s.listPermissions(ctx, store.PermissionResourceTypeCollection, collection.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListCollectionPermissionsResponse{
+		Permissions: permissions,
+	}, nil
+}
+
+func (s *APIV1Service) GrantCollectionPermission(ctx context.Context, request *v1pb.GrantCollectionPermissionRequest) (*v1pb.Permission, error) {
+	collection, err := s.getCollectionWithRole(ctx, request.Id, store.PermissionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	return s.grantPermission(ctx, store.PermissionResourceTypeCollection, collection.Id, collection.CreatorId, request.Permission)
+}
+
+func (s *APIV1Service) RevokeCollectionPermission(ctx context.Context, request *v1pb.RevokeCollectionPermissionRequest) (*emptypb.Empty, error) {
+	collection, err := s.getCollectionWithRole(ctx, request.Id, store.PermissionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.revokePermission(ctx, store.PermissionResourceTypeCollection, collection.Id, request.UserId, request.GroupId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// TransferCollectionOwnership makes another user the creator of the collection. The previous
+// creator keeps no role on it unless one is granted to them.
+func (s *APIV1Service) TransferCollectionOwnership(ctx context.Context, request *v1pb.TransferCollectionOwnershipRequest) (*v1pb.Collection, error) {
+	collection, err := s.getCollectionWithRole(ctx, request.Id, store.PermissionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	newCreator, err := s.getActiveUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if newCreator.ID == collection.CreatorId {
+		return nil, status.Errorf(codes.InvalidArgument, "the user already owns the collection")
+	}
+
+	collection, err = s.Store.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		CreatorID: &newCreator.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer collection, err: %v", err)
+	}
+	if err := s.deleteUserPermission(ctx, store.PermissionResourceTypeCollection, collection.Id, newCreator.ID); err != nil {
+		return nil, err
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+// getTrashedCollection returns the collection in the trash if the current user is allowed
+// to restore or purge it.
+func (s *APIV1Service) getTrashedCollection(ctx context.Context, id int32) (*storepb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID:    &id,
+		Trash: store.TrashOnly,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found in the trash")
+	}
+	if err := s.checkPermission(ctx, user, store.PermissionResourceTypeCollection, collection.Id, collection.CreatorId, store.PermissionRoleOwner); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
+// getCollectionWithRole returns the collection if the current user has at least the role on it.
+func (s *APIV1Service) getCollectionWithRole(ctx context.Context, id int32, role store.PermissionRole) (*storepb.Collection, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection by id: %v", err)
+	}
+	if collection == nil {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	if err := s.checkPermission(ctx, user, store.PermissionResourceTypeCollection, collection.Id, collection.CreatorId, role); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
+
 func (s *APIV1Service) ImportBookmarks(ctx context.Context, request *v1pb.ImportBookmarksRequest) (*v1pb.ImportBookmarksResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -229,20 +473,42 @@ func (s *APIV1Service) ImportBookmarks(ctx context.Context, request *v1pb.Import
 
 			// Check if a shortcut with this name already exists
 			existingShortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-				Name: &shortcutName,
+				Name:  &shortcutName,
+				Trash: store.TrashIncluded,
 			})
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to check existing shortcut: %v", err)
 			}
 
 			var resultShortcut *storepb.Shortcut
+			requiresApproval := false
 			if existingShortcut != nil {
-				// Update existing shortcut
+				requiresApproval, err = s.shortcutRequiresApproval(ctx, existingShortcut)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if requiresApproval {
+				// The new link of a shortcut that requires approval is requested, not applied.
+				if _, err := s.createShortcutChangeRequest(ctx, user, existingShortcut, &store.UpdateShortcut{
+					ID:          existingShortcut.Id,
+					Link:        &bookmark.URL,
+					Title:       &bookmark.Title,
+					Description: stringPtr("Updated from bookmark import"),
+				}, nil, false); err != nil {
+					return nil, err
+				}
+				resultShortcut = existingShortcut
+			} else if existingShortcut != nil {
+				// Update existing shortcut, restoring it if it is in the trash
+				deletedTs := int64(0)
 				updatedShortcut, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
 					ID:          existingShortcut.Id,
 					Link:        &bookmark.URL,
 					Title:       &bookmark.Title,
 					Description: stringPtr("Updated from bookmark import"),
+					EditorID:    user.ID,
+					DeletedTs:   &deletedTs,
 				})
 				if err != nil {
 					return nil, status.Errorf(codes.Internal, "failed to update existing shortcut: %v", err)
@@ -250,7 +516,10 @@ func (s *APIV1Service) ImportBookmarks(ctx context.Context, request *v1pb.Import
 				resultShortcut = updatedShortcut
 				shortcutsUpdated++
 			} else {
-				// Create new shortcut
+				// Create new shortcut, unless the name is taken under the workspace name policy or by an alias
+				if err := s.checkShortcutNameAvailable(ctx, shortcutName, 0); err != nil {
+					return nil, err
+				}
 				newShortcut := &storepb.Shortcut{
 					CreatorId:   user.ID,
 					Name:        shortcutName,
@@ -278,7 +547,8 @@ func (s *APIV1Service) ImportBookmarks(ctx context.Context, request *v1pb.Import
 
 		// Check if a collection with this name already exists
 		existingCollection, err := s.Store.GetCollection(ctx, &store.FindCollection{
-			Name: &collectionName,
+			Name:  &collectionName,
+			Trash: store.TrashIncluded,
 		})
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to check existing collection: %v", err)
@@ -286,13 +556,15 @@ func (s *APIV1Service) ImportBookmarks(ctx context.Context, request *v1pb.Import
 
 		var resultCollection *storepb.Collection
 		if existingCollection != nil {
-			// Update existing collection by adding new shortcuts to it
+			// Update existing collection by adding new shortcuts to it, restoring it if it is in the trash
 			combinedShortcutIDs := combineShortcutIDs(existingCollection.ShortcutIds, shortcutIDs)
+			deletedTs := int64(0)
 			updatedCollection, err := s.Store.UpdateCollection(ctx, &store.UpdateCollection{
 				ID:          existingCollection.Id,
 				Title:       &collection.Title,
 				Description: stringPtr(fmt.Sprintf("Updated bookmark collection: %s", collection.Title)),
 				ShortcutIDs: combinedShortcutIDs,
+				DeletedTs:   &deletedTs,
 			})
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update existing collection: %v", err)
@@ -676,5 +948,7 @@ func convertCollectionFromStore(collection *storepb.Collection) *v1pb.Collection
 		Description: collection.Description,
 		ShortcutIds: collection.ShortcutIds,
 		Visibility:  convertVisibilityFromStorepb(collection.Visibility),
+		DeletedTime: convertUnixToTimestamp(collection.DeletedTs),
+		GroupIds:    collection.GroupIds,
 	}
 }
@@ -2,6 +2,12 @@ package v1
 
 import (
 	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
 	storepb "github.com/bshort/monotreme/proto/gen/store"
@@ -22,6 +28,36 @@ func getCurrentUser(ctx context.Context, s *store.Store) (*store.User, error) {
 	return user, nil
 }
 
+// getViewerID returns the ID of the user, or 0 for an anonymous visitor, to check visibility with.
+func getViewerID(user *store.User) int32 {
+	if user == nil {
+		return 0
+	}
+	return user.ID
+}
+
+// getVisibilityGroupIDs returns the groups to share a shortcut or collection with. GROUP ones
+// must be shared with existing groups, while the others are shared with none.
+func (s *APIV1Service) getVisibilityGroupIDs(ctx context.Context, visibility storepb.Visibility, groupIDs []int32) ([]int32, error) {
+	if visibility != storepb.Visibility_GROUP {
+		return []int32{}, nil
+	}
+	if len(groupIDs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "group visibility requires at least one group")
+	}
+	groupIDs = slices.Compact(slices.Sorted(slices.Values(groupIDs)))
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{
+		IDList: groupIDs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	if len(groups) != len(groupIDs) {
+		return nil, status.Errorf(codes.InvalidArgument, "group not found")
+	}
+	return groupIDs, nil
+}
+
 func convertStateFromRowStatus(rowStatus storepb.RowStatus) v1pb.State {
 	switch rowStatus {
 	case storepb.RowStatus_NORMAL:
@@ -51,6 +87,10 @@ func convertVisibilityFromStorepb(visibility storepb.Visibility) v1pb.Visibility
 		return v1pb.Visibility_WORKSPACE
 	case storepb.Visibility_PUBLIC:
 		return v1pb.Visibility_PUBLIC
+	case storepb.Visibility_PRIVATE:
+		return v1pb.Visibility_PRIVATE
+	case storepb.Visibility_GROUP:
+		return v1pb.Visibility_GROUP
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -62,7 +102,35 @@ func convertVisibilityToStorepb(visibility v1pb.Visibility) storepb.Visibility {
 		return storepb.Visibility_WORKSPACE
 	case v1pb.Visibility_PUBLIC:
 		return storepb.Visibility_PUBLIC
+	case v1pb.Visibility_PRIVATE:
+		return storepb.Visibility_PRIVATE
+	case v1pb.Visibility_GROUP:
+		return storepb.Visibility_GROUP
 	default:
 		return storepb.Visibility_VISIBILITY_UNSPECIFIED
 	}
 }
+
+func convertRedirectModeFromStorepb(redirectMode storepb.RedirectMode) v1pb.RedirectMode {
+	return v1pb.RedirectMode(v1pb.RedirectMode_value[redirectMode.String()])
+}
+
+func convertRedirectModeToStorepb(redirectMode v1pb.RedirectMode) storepb.RedirectMode {
+	return storepb.RedirectMode(storepb.RedirectMode_value[redirectMode.String()])
+}
+
+// convertTimestampToUnix returns 0 for an unset timestamp.
+func convertTimestampToUnix(timestamp *timestamppb.Timestamp) int64 {
+	if timestamp == nil {
+		return 0
+	}
+	return timestamp.AsTime().Unix()
+}
+
+// convertUnixToTimestamp returns nil for 0, which stores use for an unset time.
+func convertUnixToTimestamp(ts int64) *timestamppb.Timestamp {
+	if ts == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(ts, 0))
+}
@@ -0,0 +1,263 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
+	"github.com/bshort/monotreme/store"
+)
+
+func (s *APIV1Service) ListGroups(ctx context.Context, request *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	find := &store.FindGroup{}
+	if request.JoinedOnly {
+		find.MemberID = &user.ID
+	}
+	groups, err := s.Store.ListGroups(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+
+	response := &v1pb.ListGroupsResponse{
+		Groups: []*v1pb.Group{},
+	}
+	for _, group := range groups {
+		convertedGroup, err := s.convertGroupFromStore(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		response.Groups = append(response.Groups, convertedGroup)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetGroup(ctx context.Context, request *v1pb.GetGroupRequest) (*v1pb.Group, error) {
+	group, err := s.getGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertGroupFromStore(ctx, group)
+}
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.Group, error) {
+	if request.Group == nil || request.Group.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	existingGroup, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		Name: &request.Group.Name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group by name: %v", err)
+	}
+	if existingGroup != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "name %q is already used by another group", request.Group.Name)
+	}
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		CreatorID:   user.ID,
+		Name:        request.Group.Name,
+		Description: request.Group.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	if _, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+	}
+	return s.convertGroupFromStore(ctx, group)
+}
+
+func (s *APIV1Service) UpdateGroup(ctx context.Context, request *v1pb.UpdateGroupRequest) (*v1pb.Group, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+
+	group, err := s.getManagedGroup(ctx, request.Group.Id)
+	if err != nil {
+		return nil, err
+	}
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateGroup{
+		ID:        group.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "name":
+			if request.Group.Name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			existingGroup, err := s.Store.GetGroup(ctx, &store.FindGroup{
+				Name: &request.Group.Name,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get group by name: %v", err)
+			}
+			if existingGroup != nil && existingGroup.ID != group.ID {
+				return nil, status.Errorf(codes.AlreadyExists, "name %q is already used by another group", request.Group.Name)
+			}
+			update.Name = &request.Group.Name
+		case "description":
+			update.Description = &request.Group.Description
+		}
+	}
+	group, err = s.Store.UpdateGroup(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	return s.convertGroupFromStore(ctx, group)
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	group, err := s.getManagedGroup(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteGroup(ctx, &store.DeleteGroup{
+		ID: group.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListGroupMembers(ctx context.Context, request *v1pb.ListGroupMembersRequest) (*v1pb.ListGroupMembersResponse, error) {
+	group, err := s.getGroup(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+
+	response := &v1pb.ListGroupMembersResponse{
+		Members: []*v1pb.GroupMember{},
+	}
+	for _, member := range members {
+		response.Members = append(response.Members, convertGroupMemberFromStore(member))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) AddGroupMember(ctx context.Context, request *v1pb.AddGroupMemberRequest) (*v1pb.GroupMember, error) {
+	group, err := s.getManagedGroup(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.UserId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	member, err := s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+	}
+	return convertGroupMemberFromStore(member), nil
+}
+
+func (s *APIV1Service) RemoveGroupMember(ctx context.Context, request *v1pb.RemoveGroupMemberRequest) (*emptypb.Empty, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	group, err := s.getGroup(ctx, request.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	// Members can always leave a group.
+	if request.UserId != user.ID && group.CreatorID != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if err := s.Store.DeleteGroupMember(ctx, &store.DeleteGroupMember{
+		GroupID: group.ID,
+		UserID:  &request.UserId,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) getGroup(ctx context.Context, id int32) (*store.Group, error) {
+	group, err := s.Store.GetGroup(ctx, &store.FindGroup{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group by id: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return group, nil
+}
+
+// getManagedGroup returns the group if the current user is allowed to change it and its members.
+func (s *APIV1Service) getManagedGroup(ctx context.Context, id int32) (*store.Group, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	group, err := s.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if group.CreatorID != user.ID && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return group, nil
+}
+
+func (s *APIV1Service) convertGroupFromStore(ctx context.Context, group *store.Group) (*v1pb.Group, error) {
+	members, err := s.Store.ListGroupMembers(ctx, &store.FindGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+	return &v1pb.Group{
+		Id:          group.ID,
+		CreatorId:   group.CreatorID,
+		CreatedTime: timestamppb.New(time.Unix(group.CreatedTs, 0)),
+		UpdatedTime: timestamppb.New(time.Unix(group.UpdatedTs, 0)),
+		Name:        group.Name,
+		Description: group.Description,
+		MemberCount: int32(len(members)),
+	}, nil
+}
+
+func convertGroupMemberFromStore(member *store.GroupMember) *v1pb.GroupMember {
+	return &v1pb.GroupMember{
+		GroupId:     member.GroupID,
+		UserId:      member.UserID,
+		CreatedTime: timestamppb.New(time.Unix(member.CreatedTs, 0)),
+	}
+}
@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func (s *APIV1Service) ListNamespaceRules(ctx context.Context, _ *v1pb.ListNamespaceRulesRequest) (*v1pb.ListNamespaceRulesResponse, error) {
+	rules, err := s.Store.ListNamespaceRules(ctx, &store.FindNamespaceRule{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list namespace rules: %v", err)
+	}
+
+	response := &v1pb.ListNamespaceRulesResponse{
+		Rules: []*v1pb.NamespaceRule{},
+	}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, convertNamespaceRuleFromStore(rule))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateNamespaceRule(ctx context.Context, request *v1pb.CreateNamespaceRuleRequest) (*v1pb.NamespaceRule, error) {
+	if request.Rule == nil || request.Rule.Pattern == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "pattern is required")
+	}
+
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkNamespacePatternAvailable(ctx, request.Rule.Pattern, 0); err != nil {
+		return nil, err
+	}
+	if _, err := s.getGroup(ctx, request.Rule.GroupId); err != nil {
+		return nil, err
+	}
+	rule, err := s.Store.CreateNamespaceRule(ctx, &store.NamespaceRule{
+		CreatorID:       user.ID,
+		Pattern:         request.Rule.Pattern,
+		GroupID:         request.Rule.GroupId,
+		RequireApproval: request.Rule.RequireApproval,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create namespace rule: %v", err)
+	}
+	return convertNamespaceRuleFromStore(rule), nil
+}
+
+func (s *APIV1Service) UpdateNamespaceRule(ctx context.Context, request *v1pb.UpdateNamespaceRuleRequest) (*v1pb.NamespaceRule, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "updateMask is required")
+	}
+	if request.Rule == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rule is required")
+	}
+
+	rule, err := s.getNamespaceRule(ctx, request.Rule.Id)
+	if err != nil {
+		return nil, err
+	}
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateNamespaceRule{
+		ID:        rule.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "pattern":
+			if request.Rule.Pattern == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "pattern is required")
+			}
+			if err := s.checkNamespacePatternAvailable(ctx, request.Rule.Pattern, rule.ID); err != nil {
+				return nil, err
+			}
+			update.Pattern = &request.Rule.Pattern
+		case "group_id":
+			if _, err := s.getGroup(ctx, request.Rule.GroupId); err != nil {
+				return nil, err
+			}
+			update.GroupID = &request.Rule.GroupId
+		case "require_approval":
+			update.RequireApproval = &request.Rule.RequireApproval
+		}
+	}
+	rule, err = s.Store.UpdateNamespaceRule(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update namespace rule: %v", err)
+	}
+	return convertNamespaceRuleFromStore(rule), nil
+}
+
+func (s *APIV1Service) DeleteNamespaceRule(ctx context.Context, request *v1pb.DeleteNamespaceRuleRequest) (*emptypb.Empty, error) {
+	rule, err := s.getNamespaceRule(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteNamespaceRule(ctx, &store.DeleteNamespaceRule{
+		ID: rule.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete namespace rule: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListNamespaceConflicts(ctx context.Context, request *v1pb.ListNamespaceConflictsRequest) (*v1pb.ListNamespaceConflictsResponse, error) {
+	find := &store.FindNamespaceRule{}
+	if request.RuleId != 0 {
+		find.ID = &request.RuleId
+	}
+	rules, err := s.Store.ListNamespaceRules(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list namespace rules: %v", err)
+	}
+	if request.RuleId != 0 && len(rules) == 0 {
+		return nil, status.Errorf(codes.NotFound, "namespace rule not found")
+	}
+	conflicts, err := s.Store.ListNamespaceConflicts(ctx, rules)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list namespace conflicts: %v", err)
+	}
+
+	response := &v1pb.ListNamespaceConflictsResponse{
+		Conflicts: []*v1pb.NamespaceConflict{},
+	}
+	shortcuts := []*storepb.Shortcut{}
+	for _, conflict := range conflicts {
+		shortcuts = append(shortcuts, conflict.Shortcut)
+	}
+	composedShortcuts, err := s.convertShortcutsFromStorepb(ctx, shortcuts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcuts: %v", err)
+	}
+	for i, conflict := range conflicts {
+		response.Conflicts = append(response.Conflicts, &v1pb.NamespaceConflict{
+			Rule:     convertNamespaceRuleFromStore(conflict.Rule),
+			Shortcut: composedShortcuts[i],
+		})
+	}
+	return response, nil
+}
+
+// checkShortcutNamespace returns a PermissionDenied error if the shortcut name is in a namespace
+// owned by a group the user is not a member of. Admins may use any namespace.
+func (s *APIV1Service) checkShortcutNamespace(ctx context.Context, user *store.User, name string) error {
+	if user.Role == store.RoleAdmin {
+		return nil
+	}
+	rule, err := s.Store.GetNamespaceRuleByName(ctx, name)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get namespace rule: %v", err)
+	}
+	if rule == nil {
+		return nil
+	}
+	member, err := s.Store.IsNamespaceMember(ctx, rule, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check namespace membership: %v", err)
+	}
+	if !member {
+		return status.Errorf(codes.PermissionDenied, "name %q is in the namespace %q reserved for another group", name, rule.Pattern)
+	}
+	return nil
+}
+
+func (s *APIV1Service) checkNamespacePatternAvailable(ctx context.Context, pattern string, ruleID int32) error {
+	existingRule, err := s.Store.GetNamespaceRule(ctx, &store.FindNamespaceRule{
+		Pattern: &pattern,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get namespace rule by pattern: %v", err)
+	}
+	if existingRule != nil && existingRule.ID != ruleID {
+		return status.Errorf(codes.AlreadyExists, "pattern %q is already used by another namespace rule", pattern)
+	}
+	return nil
+}
+
+func (s *APIV1Service) getNamespaceRule(ctx context.Context, id int32) (*store.NamespaceRule, error) {
+	rule, err := s.Store.GetNamespaceRule(ctx, &store.FindNamespaceRule{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get namespace rule by id: %v", err)
+	}
+	if rule == nil {
+		return nil, status.Errorf(codes.NotFound, "namespace rule not found")
+	}
+	return rule, nil
+}
+
+func convertNamespaceRuleFromStore(rule *store.NamespaceRule) *v1pb.NamespaceRule {
+	return &v1pb.NamespaceRule{
+		Id:              rule.ID,
+		CreatorId:       rule.CreatorID,
+		CreatedTime:     timestamppb.New(time.Unix(rule.CreatedTs, 0)),
+		UpdatedTime:     timestamppb.New(time.Unix(rule.UpdatedTs, 0)),
+		Pattern:         rule.Pattern,
+		GroupId:         rule.GroupID,
+		RequireApproval: rule.RequireApproval,
+	}
+}
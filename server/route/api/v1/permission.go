@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+// getPermissionRole returns the role of the user on a shortcut or collection. Admins and the
+// creator own it, other users have the highest role granted to them or their groups, if any.
+func (s *APIV1Service) getPermissionRole(ctx context.Context, user *store.User, resourceType store.PermissionResourceType, resourceID, creatorID int32) (store.PermissionRole, error) {
+	if user.Role == store.RoleAdmin || user.ID == creatorID {
+		return store.PermissionRoleOwner, nil
+	}
+	role, err := s.Store.GetPermissionRole(ctx, resourceType, resourceID, user.ID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get permission role: %v", err)
+	}
+	return role, nil
+}
+
+// checkPermission returns a PermissionDenied error unless the user has at least the role
+// on the shortcut or collection.
+func (s *APIV1Service) checkPermission(ctx context.Context, user *store.User, resourceType store.PermissionResourceType, resourceID, creatorID int32, role store.PermissionRole) error {
+	userRole, err := s.getPermissionRole(ctx, user, resourceType, resourceID, creatorID)
+	if err != nil {
+		return err
+	}
+	if !userRole.Includes(role) {
+		return status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	return nil
+}
+
+func (s *APIV1Service) listPermissions(ctx context.Context, resourceType store.PermissionResourceType, resourceID int32) ([]*v1pb.Permission, error) {
+	permissions, err := s.Store.ListPermissions(ctx, &store.FindPermission{
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list permissions: %v", err)
+	}
+	convertedPermissions := []*v1pb.Permission{}
+	for _, permission := range permissions {
+		convertedPermissions = append(convertedPermissions, convertPermissionFromStore(permission))
+	}
+	return convertedPermissions, nil
+}
+
+func (s *APIV1Service) grantPermission(ctx context.Context, resourceType store.PermissionResourceType, resourceID, creatorID int32, permission *v1pb.Permission) (*v1pb.Permission, error) {
+	if permission == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "permission is required")
+	}
+	role := convertPermissionRoleToStore(permission.Role)
+	if role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+	principalType, principalID, err := s.getPermissionPrincipal(ctx, permission.UserId, permission.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if principalType == store.PermissionPrincipalTypeUser && principalID == creatorID {
+		return nil, status.Errorf(codes.InvalidArgument, "the creator already owns it")
+	}
+
+	granted, err := s.Store.UpsertPermission(ctx, &store.Permission{
+		ResourceType:  resourceType,
+		ResourceID:    resourceID,
+		PrincipalType: principalType,
+		PrincipalID:   principalID,
+		Role:          role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to grant permission: %v", err)
+	}
+	return convertPermissionFromStore(granted), nil
+}
+
+func (s *APIV1Service) revokePermission(ctx context.Context, resourceType store.PermissionResourceType, resourceID, userID, groupID int32) error {
+	principalType, principalID, err := s.getPermissionPrincipal(ctx, userID, groupID)
+	if err != nil {
+		return err
+	}
+	if err := s.Store.DeletePermission(ctx, &store.DeletePermission{
+		ResourceType:  &resourceType,
+		ResourceID:    &resourceID,
+		PrincipalType: &principalType,
+		PrincipalID:   &principalID,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to revoke permission: %v", err)
+	}
+	return nil
+}
+
+// getPermissionPrincipal returns the principal of a permission, which is either an existing
+// user or an existing group.
+func (s *APIV1Service) getPermissionPrincipal(ctx context.Context, userID, groupID int32) (store.PermissionPrincipalType, int32, error) {
+	if (userID == 0) == (groupID == 0) {
+		return "", 0, status.Errorf(codes.InvalidArgument, "exactly one of user_id and group_id is required")
+	}
+	if groupID != 0 {
+		if _, err := s.getGroup(ctx, groupID); err != nil {
+			return "", 0, err
+		}
+		return store.PermissionPrincipalTypeGroup, groupID, nil
+	}
+	if _, err := s.getActiveUser(ctx, userID); err != nil {
+		return "", 0, err
+	}
+	return store.PermissionPrincipalTypeUser, userID, nil
+}
+
+// getActiveUser returns the user if it exists and is not archived.
+func (s *APIV1Service) getActiveUser(ctx context.Context, id int32) (*store.User, error) {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "user is archived")
+	}
+	return user, nil
+}
+
+// deleteUserPermission deletes the role granted to a user who became the creator of the resource.
+func (s *APIV1Service) deleteUserPermission(ctx context.Context, resourceType store.PermissionResourceType, resourceID, userID int32) error {
+	principalType := store.PermissionPrincipalTypeUser
+	if err := s.Store.DeletePermission(ctx, &store.DeletePermission{
+		ResourceType:  &resourceType,
+		ResourceID:    &resourceID,
+		PrincipalType: &principalType,
+		PrincipalID:   &userID,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete permission: %v", err)
+	}
+	return nil
+}
+
+func convertPermissionFromStore(permission *store.Permission) *v1pb.Permission {
+	convertedPermission := &v1pb.Permission{
+		Role:        convertPermissionRoleFromStore(permission.Role),
+		CreatedTime: timestamppb.New(time.Unix(permission.CreatedTs, 0)),
+	}
+	switch permission.PrincipalType {
+	case store.PermissionPrincipalTypeUser:
+		convertedPermission.UserId = permission.PrincipalID
+	case store.PermissionPrincipalTypeGroup:
+		convertedPermission.GroupId = permission.PrincipalID
+	}
+	return convertedPermission
+}
+
+func convertPermissionRoleFromStore(role store.PermissionRole) v1pb.PermissionRole {
+	switch role {
+	case store.PermissionRoleViewer:
+		return v1pb.PermissionRole_VIEWER
+	case store.PermissionRoleEditor:
+		return v1pb.PermissionRole_EDITOR
+	case store.PermissionRoleOwner:
+		return v1pb.PermissionRole_OWNER
+	default:
+		return v1pb.PermissionRole_PERMISSION_ROLE_UNSPECIFIED
+	}
+}
+
+func convertPermissionRoleToStore(role v1pb.PermissionRole) store.PermissionRole {
+	switch role {
+	case v1pb.PermissionRole_VIEWER:
+		return store.PermissionRoleViewer
+	case v1pb.PermissionRole_EDITOR:
+		return store.PermissionRoleEditor
+	case v1pb.PermissionRole_OWNER:
+		return store.PermissionRoleOwner
+	default:
+		return ""
+	}
+}
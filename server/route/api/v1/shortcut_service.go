@@ -1,8 +1,12 @@
 package v1
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
 	"strings"
 	"time"
 
@@ -16,25 +20,34 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/bshort/monotreme/internal/linktemplate"
+	"github.com/bshort/monotreme/internal/routing"
 	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
 	storepb "github.com/bshort/monotreme/proto/gen/store"
 	"github.com/bshort/monotreme/server/service/license"
 	"github.com/bshort/monotreme/store"
 )
 
-func (s *APIV1Service) ListShortcuts(ctx context.Context, _ *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
-	shortcutList, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{})
+func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShortcutsRequest) (*v1pb.ListShortcutsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	find := &store.FindShortcut{
+		ViewerID: &user.ID,
+	}
+	if request.ExcludeExpired {
+		now := time.Now().Unix()
+		find.NotExpiredAt = &now
+	}
+	shortcutList, err := s.Store.ListShortcuts(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list shortcuts, err: %v", err)
 	}
 
-	shortcutMessageList := []*v1pb.Shortcut{}
-	for _, shortcut := range shortcutList {
-		composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
-		}
-		shortcutMessageList = append(shortcutMessageList, composedShortcut)
+	shortcutMessageList, err := s.convertShortcutsFromStorepb(ctx, shortcutList)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcuts, err: %v", err)
 	}
 
 	response := &v1pb.ListShortcutsResponse{
@@ -61,6 +74,14 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
+	// Private shortcuts of other users, and group ones of groups the user is not in, are not revealed.
+	visible, err := s.Store.IsVisibleTo(ctx, shortcut, getViewerID(user))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 	if err != nil {
@@ -76,6 +97,36 @@ func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetS
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
 	}
+	if shortcut == nil {
+		// Fall back to the shortcut whose name is the same under the workspace name policy.
+		policy, err := s.Store.GetShortcutNamePolicy(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut name policy: %v", err)
+		}
+		shortcut, err = s.Store.GetShortcut(ctx, &store.FindShortcut{
+			NormalizedNameList: []string{store.NormalizeShortcutName(request.Name, policy)},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get shortcut by normalized name: %v", err)
+		}
+	}
+	if shortcut == nil {
+		// Fall back to the shortcut the name is an alias of.
+		alias, err := s.Store.GetShortcutAlias(ctx, &store.FindShortcutAlias{
+			Name: &request.Name,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get alias by name: %v", err)
+		}
+		if alias != nil {
+			shortcut, err = s.Store.GetShortcut(ctx, &store.FindShortcut{
+				ID: &alias.ShortcutId,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+			}
+		}
+	}
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
@@ -87,6 +138,14 @@ func (s *APIV1Service) GetShortcutByName(ctx context.Context, request *v1pb.GetS
 	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
+	// Private shortcuts of other users, and group ones of groups the user is not in, are not revealed.
+	visible, err := s.Store.IsVisibleTo(ctx, shortcut, getViewerID(user))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check shortcut visibility: %v", err)
+	}
+	if !visible {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
 	if err != nil {
@@ -99,22 +158,58 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	if request.Shortcut.Name == "" || request.Shortcut.Link == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "name and link are required")
 	}
-
+	if request.Shortcut.Template {
+		if err := linktemplate.Validate(request.Shortcut.Link); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid link template: %v", err)
+		}
+	}
 
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
 	shortcutCreate := &storepb.Shortcut{
-		CreatorId:   user.ID,
-		Name:        request.Shortcut.Name,
-		Link:        request.Shortcut.Link,
-		Title:       request.Shortcut.Title,
-		Tags:        request.Shortcut.Tags,
-		Description: request.Shortcut.Description,
-		Visibility:  convertVisibilityToStorepb(request.Shortcut.Visibility),
-		OgMetadata:  &storepb.OpenGraphMetadata{},
-		Uuid:        uuid.New().String(),
+		CreatorId:    user.ID,
+		Name:         request.Shortcut.Name,
+		Link:         request.Shortcut.Link,
+		Title:        request.Shortcut.Title,
+		Tags:         request.Shortcut.Tags,
+		Description:  request.Shortcut.Description,
+		Visibility:   convertVisibilityToStorepb(request.Shortcut.Visibility),
+		OgMetadata:   &storepb.OpenGraphMetadata{},
+		Uuid:         uuid.New().String(),
+		Template:     request.Shortcut.Template,
+		ForwardPath:  request.Shortcut.ForwardPath,
+		RedirectMode: convertRedirectModeToStorepb(request.Shortcut.RedirectMode),
+		ValidFrom:    convertTimestampToUnix(request.Shortcut.ValidFrom),
+		ValidUntil:   convertTimestampToUnix(request.Shortcut.ValidUntil),
+		Variants:     convertShortcutVariantsToStorepb(request.Shortcut.Variants, request.Shortcut.StickyVariants),
+		RoutingRules: convertShortcutRoutingRulesToStorepb(request.Shortcut.RoutingRules),
+		Failover:     &storepb.ShortcutFailover{BackupLinks: request.Shortcut.BackupLinks},
+		Protected:    request.Shortcut.Protected,
+	}
+	if err := validateShortcutValidity(shortcutCreate.ValidFrom, shortcutCreate.ValidUntil); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validity window: %v", err)
+	}
+	if err := validateShortcutVariants(shortcutCreate.Variants, shortcutCreate.Template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid variants: %v", err)
+	}
+	if err := validateShortcutRoutingRules(shortcutCreate.RoutingRules, shortcutCreate.Template); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid routing rules: %v", err)
+	}
+	if err := validateShortcutBackupLinks(shortcutCreate.Failover.BackupLinks, shortcutCreate.Link); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid backup links: %v", err)
+	}
+	if err := validateShortcutAliases(request.Shortcut.Aliases, shortcutCreate.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid aliases: %v", err)
+	}
+	for _, name := range append([]string{shortcutCreate.Name}, request.Shortcut.Aliases...) {
+		if err := s.checkShortcutNameAvailable(ctx, name, 0); err != nil {
+			return nil, err
+		}
+		if err := s.checkShortcutNamespace(ctx, user, name); err != nil {
+			return nil, err
+		}
 	}
 	if shortcutCreate.Visibility == storepb.Visibility_VISIBILITY_UNSPECIFIED {
 		workspaceSetting, err := s.GetWorkspaceSetting(ctx, nil)
@@ -127,6 +222,9 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		}
 		shortcutCreate.Visibility = convertVisibilityToStorepb(visibility)
 	}
+	if shortcutCreate.GroupIds, err = s.getVisibilityGroupIDs(ctx, shortcutCreate.Visibility, request.Shortcut.GroupIds); err != nil {
+		return nil, err
+	}
 	if request.Shortcut.OgMetadata != nil {
 		shortcutCreate.OgMetadata = &storepb.OpenGraphMetadata{
 			Title:       request.Shortcut.OgMetadata.Title,
@@ -138,6 +236,9 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create shortcut, err: %v", err)
 	}
+	if err := s.syncShortcutAliases(ctx, shortcut.Id, user.ID, request.Shortcut.Aliases); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create aliases, err: %v", err)
+	}
 	if err := s.createShortcutCreateActivity(ctx, shortcut); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
 	}
@@ -167,13 +268,19 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
+	role, err := s.getPermissionRole(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Includes(store.PermissionRoleEditor) {
 		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
 	}
 
 	update := &store.UpdateShortcut{
 		ID: shortcut.Id,
 	}
+	var aliases []string
+	updateAliases := false
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
 		case "name":
@@ -198,11 +305,179 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 					Image:       request.Shortcut.OgMetadata.Image,
 				}
 			}
+		case "template":
+			update.Template = &request.Shortcut.Template
+		case "forward_path":
+			update.ForwardPath = &request.Shortcut.ForwardPath
+		case "redirect_mode":
+			redirectMode := convertRedirectModeToStorepb(request.Shortcut.RedirectMode)
+			update.RedirectMode = &redirectMode
+		case "valid_from":
+			validFrom := convertTimestampToUnix(request.Shortcut.ValidFrom)
+			update.ValidFrom = &validFrom
+		case "valid_until":
+			validUntil := convertTimestampToUnix(request.Shortcut.ValidUntil)
+			update.ValidUntil = &validUntil
+		case "state":
+			rowStatus := ConvertStateToRowStatus(request.Shortcut.State)
+			if rowStatus == storepb.RowStatus_ROW_STATUS_UNSPECIFIED {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid state")
+			}
+			update.RowStatus = &rowStatus
+		case "variants":
+			update.Variants = convertShortcutVariantsToStorepb(request.Shortcut.Variants, shortcut.Variants.GetSticky())
+		case "sticky_variants":
+			if update.Variants == nil {
+				update.Variants = &storepb.ShortcutVariants{Variants: shortcut.Variants.GetVariants()}
+			}
+			update.Variants.Sticky = request.Shortcut.StickyVariants
+		case "routing_rules":
+			update.RoutingRules = convertShortcutRoutingRulesToStorepb(request.Shortcut.RoutingRules)
+		case "backup_links":
+			update.Failover = &storepb.ShortcutFailover{BackupLinks: request.Shortcut.BackupLinks}
+		case "aliases":
+			aliases, updateAliases = request.Shortcut.Aliases, true
+		case "group_ids":
+			update.GroupIDs = request.Shortcut.GroupIds
+		case "protected":
+			update.Protected = &request.Shortcut.Protected
+		}
+	}
+	if update.Protected != nil && !role.Includes(store.PermissionRoleOwner) {
+		return nil, status.Errorf(codes.PermissionDenied, "only owners can change the protection")
+	}
+	if err := s.checkShortcutSharingUpdate(ctx, user, shortcut, update); err != nil {
+		return nil, err
+	}
+	if update.ValidFrom != nil || update.ValidUntil != nil {
+		validFrom, validUntil := shortcut.ValidFrom, shortcut.ValidUntil
+		if update.ValidFrom != nil {
+			validFrom = *update.ValidFrom
+		}
+		if update.ValidUntil != nil {
+			validUntil = *update.ValidUntil
+		}
+		if err := validateShortcutValidity(validFrom, validUntil); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validity window: %v", err)
+		}
+	}
+	if update.Link != nil || update.Template != nil {
+		link, template := shortcut.Link, shortcut.Template
+		if update.Link != nil {
+			link = *update.Link
+		}
+		if update.Template != nil {
+			template = *update.Template
+		}
+		if template {
+			if err := linktemplate.Validate(link); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid link template: %v", err)
+			}
+		}
+	}
+	if update.Variants != nil || update.Template != nil {
+		variants, template := shortcut.Variants, shortcut.Template
+		if update.Variants != nil {
+			variants = update.Variants
+		}
+		if update.Template != nil {
+			template = *update.Template
+		}
+		if err := validateShortcutVariants(variants, template); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid variants: %v", err)
+		}
+	}
+	if update.RoutingRules != nil || update.Template != nil {
+		routingRules, template := shortcut.RoutingRules, shortcut.Template
+		if update.RoutingRules != nil {
+			routingRules = update.RoutingRules
+		}
+		if update.Template != nil {
+			template = *update.Template
+		}
+		if err := validateShortcutRoutingRules(routingRules, template); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid routing rules: %v", err)
+		}
+	}
+	if update.Failover != nil || update.Link != nil {
+		backupLinks, link := shortcut.Failover.GetBackupLinks(), shortcut.Link
+		if update.Failover != nil {
+			backupLinks = update.Failover.BackupLinks
+		}
+		if update.Link != nil {
+			link = *update.Link
+		}
+		if err := validateShortcutBackupLinks(backupLinks, link); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid backup links: %v", err)
+		}
+	}
+	if (update.Name != nil && *update.Name != shortcut.Name) || updateAliases {
+		currentAliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+			ShortcutID: &shortcut.Id,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list aliases, err: %v", err)
+		}
+		if !updateAliases {
+			for _, alias := range currentAliases {
+				aliases = append(aliases, alias.Name)
+			}
+		}
+		name := shortcut.Name
+		if update.Name != nil && *update.Name != shortcut.Name {
+			name = *update.Name
+			if name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "name is required")
+			}
+			if err := s.checkShortcutNameAvailable(ctx, name, shortcut.Id); err != nil {
+				return nil, err
+			}
+			if err := s.checkShortcutNamespace(ctx, user, name); err != nil {
+				return nil, err
+			}
+			// Renaming a shortcut to one of its aliases turns the alias into the name.
+			aliases = slices.DeleteFunc(slices.Clone(aliases), func(alias string) bool { return alias == name })
+			if request.KeepOldNameAsAlias && !slices.Contains(aliases, shortcut.Name) {
+				aliases = append(aliases, shortcut.Name)
+			}
+			updateAliases = true
+		}
+		if err := validateShortcutAliases(aliases, name); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid aliases: %v", err)
+		}
+		for _, alias := range aliases {
+			if err := s.checkShortcutNameAvailable(ctx, alias, shortcut.Id); err != nil {
+				return nil, err
+			}
+			// Names the shortcut already has stay allowed.
+			if alias == shortcut.Name || slices.ContainsFunc(currentAliases, func(current *storepb.ShortcutAlias) bool { return current.Name == alias }) {
+				continue
+			}
+			if err := s.checkShortcutNamespace(ctx, user, alias); err != nil {
+				return nil, err
+			}
 		}
 	}
-	shortcut, err = s.Store.UpdateShortcut(ctx, update)
+	requiresApproval, err := s.shortcutRequiresApproval(ctx, shortcut)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+		return nil, err
+	}
+	if requiresApproval {
+		// The shortcut is returned unchanged until the change is approved.
+		if _, err := s.createShortcutChangeRequest(ctx, user, shortcut, update, aliases, updateAliases); err != nil {
+			return nil, err
+		}
+	} else if !reflect.DeepEqual(*update, store.UpdateShortcut{ID: shortcut.Id}) {
+		update.EditorID = user.ID
+		shortcut, err = s.Store.UpdateShortcut(ctx, update)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+		}
+	}
+	if updateAliases && !requiresApproval {
+		if err := s.syncShortcutAliases(ctx, shortcut.Id, user.ID, aliases); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update aliases, err: %v", err)
+		}
 	}
 
 	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
@@ -226,22 +501,37 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	if shortcut == nil {
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
-	if shortcut.CreatorId != user.ID && user.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+	if err := s.checkPermission(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId, store.PermissionRoleOwner); err != nil {
+		return nil, err
 	}
-
-	err = s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
-		ID: shortcut.Id,
-	})
+	// Moving the shortcut to the trash would take it down without a review.
+	requiresApproval, err := s.shortcutRequiresApproval(ctx, shortcut)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete shortcut, err: %v", err)
+		return nil, err
+	}
+	if requiresApproval {
+		return nil, status.Errorf(codes.FailedPrecondition, "changes of the shortcut require approval, it can't be moved to the trash until it no longer does")
+	}
+
+	deletedTs := time.Now().Unix()
+	if _, err := s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+		EditorID:  user.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to move shortcut to the trash, err: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
 func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.GetShortcutAnalyticsRequest) (*v1pb.GetShortcutAnalyticsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-		ID: &request.Id,
+		ID:       &request.Id,
+		ViewerID: &user.ID,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
@@ -267,6 +557,7 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 	referenceMap := make(map[string]int32)
 	deviceMap := make(map[string]int32)
 	browserMap := make(map[string]int32)
+	variantMap := make(map[string]int32)
 	for _, activity := range activities {
 		payload := &storepb.ActivityShorcutViewPayload{}
 		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
@@ -291,12 +582,34 @@ func (s *APIV1Service) GetShortcutAnalytics(ctx context.Context, request *v1pb.G
 			browserMap[browserName] = 0
 		}
 		browserMap[browserName]++
+
+		// Clicks from before the shortcut had variants carry no variant name.
+		if payload.Variant != "" {
+			variantMap[payload.Variant]++
+		}
+	}
+
+	// Bot views have a type of their own, so they are counted apart from the views above.
+	activityFind.Type = store.ActivityShortcutBotView
+	botActivities, err := s.Store.ListActivities(ctx, activityFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get bot activities, err: %v", err)
+	}
+	botMap := make(map[string]int32)
+	for _, activity := range botActivities {
+		payload := &storepb.ActivityShorcutViewPayload{}
+		if err := protojson.Unmarshal([]byte(activity.Payload), payload); err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal payload, err: %v", err))
+		}
+		botMap[payload.Bot]++
 	}
 
 	response := &v1pb.GetShortcutAnalyticsResponse{
 		References: mapToAnalyticsSlice(referenceMap),
 		Devices:    mapToAnalyticsSlice(deviceMap),
 		Browsers:   mapToAnalyticsSlice(browserMap),
+		Variants:   mapToAnalyticsSlice(variantMap),
+		Bots:       mapToAnalyticsSlice(botMap),
 	}
 	return response, nil
 }
@@ -315,56 +628,1306 @@ func mapToAnalyticsSlice(m map[string]int32) []*v1pb.GetShortcutAnalyticsRespons
 	return analyticsSlice
 }
 
-func (s *APIV1Service) createShortcutCreateActivity(ctx context.Context, shortcut *storepb.Shortcut) error {
-	payload := &storepb.ActivityShorcutCreatePayload{
-		ShortcutId: shortcut.Id,
+func (s *APIV1Service) ListShortcutLinkChanges(ctx context.Context, request *v1pb.ListShortcutLinkChangesRequest) (*v1pb.ListShortcutLinkChangesResponse, error) {
+	if _, err := s.getEditableShortcut(ctx, request.ShortcutId); err != nil {
+		return nil, err
 	}
-	payloadStr, err := protojson.Marshal(payload)
+
+	find := &store.FindShortcutLinkChange{
+		ShortcutID: &request.ShortcutId,
+	}
+	if request.PendingOnly {
+		find.Pending = &request.PendingOnly
+	}
+	changes, err := s.Store.ListShortcutLinkChanges(ctx, find)
 	if err != nil {
-		return errors.Wrap(err, "Failed to marshal activity payload")
+		return nil, status.Errorf(codes.Internal, "failed to list link changes: %v", err)
 	}
-	activity := &store.Activity{
-		CreatorID: shortcut.CreatorId,
-		Type:      store.ActivityShortcutCreate,
-		Level:     store.ActivityInfo,
-		Payload:   string(payloadStr),
+
+	response := &v1pb.ListShortcutLinkChangesResponse{
+		LinkChanges: []*v1pb.ShortcutLinkChange{},
 	}
-	_, err = s.Store.CreateActivity(ctx, activity)
+	for _, change := range changes {
+		response.LinkChanges = append(response.LinkChanges, convertShortcutLinkChangeFromStore(change))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateShortcutLinkChange(ctx context.Context, request *v1pb.CreateShortcutLinkChangeRequest) (*v1pb.ShortcutLinkChange, error) {
+	linkChange := request.LinkChange
+	if linkChange == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "link change is required")
+	}
+	if linkChange.Link == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "link is required")
+	}
+	effectiveTs := convertTimestampToUnix(linkChange.EffectiveTime)
+	if effectiveTs <= time.Now().Unix() {
+		return nil, status.Errorf(codes.InvalidArgument, "effective time must be in the future")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
-		return errors.Wrap(err, "Failed to create activity")
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	return nil
+	shortcut, err := s.getEditableShortcut(ctx, linkChange.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+	// A scheduled change would skip the review, so the new link has to be requested instead.
+	requiresApproval, err := s.shortcutRequiresApproval(ctx, shortcut)
+	if err != nil {
+		return nil, err
+	}
+	if requiresApproval {
+		return nil, status.Errorf(codes.FailedPrecondition, "changes of the shortcut require approval, request the new link instead of scheduling it")
+	}
+
+	change, err := s.Store.CreateShortcutLinkChange(ctx, &store.ShortcutLinkChange{
+		ShortcutID:  linkChange.ShortcutId,
+		CreatorID:   user.ID,
+		EffectiveTs: effectiveTs,
+		Link:        linkChange.Link,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create link change: %v", err)
+	}
+	return convertShortcutLinkChangeFromStore(change), nil
 }
 
-func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*v1pb.Shortcut, error) {
-	composedShortcut := &v1pb.Shortcut{
-		Id:          shortcut.Id,
-		CreatorId:   shortcut.CreatorId,
-		CreatedTime: timestamppb.New(time.Unix(shortcut.CreatedTs, 0)),
-		UpdatedTime: timestamppb.New(time.Unix(shortcut.UpdatedTs, 0)),
-		Name:        shortcut.Name,
-		Link:        shortcut.Link,
-		Title:       shortcut.Title,
-		Tags:        shortcut.Tags,
-		Description: shortcut.Description,
-		Visibility:  convertVisibilityFromStorepb(shortcut.Visibility),
-		Uuid:        shortcut.Uuid,
-		OgMetadata: &v1pb.Shortcut_OpenGraphMetadata{
-			Title:       shortcut.OgMetadata.Title,
-			Description: shortcut.OgMetadata.Description,
-			Image:       shortcut.OgMetadata.Image,
-		},
+func (s *APIV1Service) CancelShortcutLinkChange(ctx context.Context, request *v1pb.CancelShortcutLinkChangeRequest) (*emptypb.Empty, error) {
+	if _, err := s.getEditableShortcut(ctx, request.ShortcutId); err != nil {
+		return nil, err
+	}
+	change, err := s.Store.GetShortcutLinkChange(ctx, &store.FindShortcutLinkChange{
+		ID:         &request.Id,
+		ShortcutID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get link change: %v", err)
+	}
+	if change == nil {
+		return nil, status.Errorf(codes.NotFound, "link change not found")
+	}
+	if change.AppliedTs != 0 || change.EffectiveTs <= time.Now().Unix() {
+		return nil, status.Errorf(codes.FailedPrecondition, "link change is already in effect")
 	}
 
-	activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
-		Type:              store.ActivityShortcutView,
-		Level:             store.ActivityInfo,
-		PayloadShortcutID: &composedShortcut.Id,
+	if err := s.Store.DeleteShortcutLinkChange(ctx, &store.DeleteShortcutLinkChange{
+		ID: change.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete link change: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) DryRunShortcutRouting(ctx context.Context, request *v1pb.DryRunShortcutRoutingRequest) (*v1pb.DryRunShortcutRoutingResponse, error) {
+	shortcut, err := s.getEditableShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	for key, value := range request.Headers {
+		header.Set(key, value)
+	}
+	routingRequest := routing.NewRequest(header)
+	response := &v1pb.DryRunShortcutRoutingResponse{
+		Device:   routingRequest.Device,
+		Os:       routingRequest.OS,
+		Browser:  routingRequest.Browser,
+		Language: routingRequest.Language,
+	}
+	rules := shortcut.RoutingRules.GetRules()
+	response.MatchedRule = int32(routing.Match(rules, routingRequest))
+	if response.MatchedRule >= 0 {
+		response.Link = rules[response.MatchedRule].Link
+		return response, nil
+	}
+	link, err := s.Store.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, time.Now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get effective shortcut link: %v", err)
+	}
+	response.Link, err = s.Store.GetHealthyShortcutTarget(ctx, shortcut.Id, append([]string{link}, shortcut.Failover.GetBackupLinks()...))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get healthy shortcut target: %v", err)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) ListShortcutRevisions(ctx context.Context, request *v1pb.ListShortcutRevisionsRequest) (*v1pb.ListShortcutRevisionsResponse, error) {
+	if _, err := s.getEditableShortcut(ctx, request.ShortcutId); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.Store.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list revisions: %v", err)
+	}
+
+	response := &v1pb.ListShortcutRevisionsResponse{
+		Revisions: []*v1pb.ShortcutRevision{},
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, convertShortcutRevisionFromStore(revision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreShortcutRevision(ctx context.Context, request *v1pb.RestoreShortcutRevisionRequest) (*v1pb.RestoreShortcutRevisionResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.getEditableShortcut(ctx, request.ShortcutId)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Store.GetShortcutRevision(ctx, &store.FindShortcutRevision{
+		ID:         &request.Id,
+		ShortcutID: &request.ShortcutId,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to list activities")
+		return nil, status.Errorf(codes.Internal, "failed to get revision: %v", err)
 	}
-	composedShortcut.ViewCount = int32(len(activityList))
+	if revision == nil || revision.Payload.Shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "revision not found")
+	}
+
+	target := revision.Payload.Shortcut
+	fields := store.DiffShortcuts(shortcut, target)
+	if slices.Contains(fields, "name") {
+		if err := s.checkShortcutNameAvailable(ctx, target.Name, shortcut.Id); err != nil {
+			return nil, err
+		}
+		if err := s.checkShortcutNamespace(ctx, user, target.Name); err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(fields, "protected") {
+		if err := s.checkPermission(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId, store.PermissionRoleOwner); err != nil {
+			return nil, err
+		}
+	}
+	update := convertShortcutFieldsToUpdate(shortcut.Id, target, fields)
+	update.EditorID = user.ID
+	update.RestoredRevisionID = revision.ID
+	// The groups of an old revision may be gone by now.
+	if err := s.checkShortcutSharingUpdate(ctx, user, shortcut, update); err != nil {
+		return nil, err
+	}
+	requiresApproval, err := s.shortcutRequiresApproval(ctx, shortcut)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.RestoreShortcutRevisionResponse{}
+	if len(fields) != 0 && requiresApproval {
+		// The rollback is applied once it is approved, see ApproveShortcutChangeRequest.
+		if _, err := s.createShortcutChangeRequest(ctx, user, shortcut, update, nil, false); err != nil {
+			return nil, err
+		}
+	} else if len(fields) != 0 {
+		shortcut, err = s.Store.UpdateShortcut(ctx, update)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to restore revision: %v", err)
+		}
+		// Restoring a name that has since become an alias of the shortcut turns the alias into the name.
+		if update.Name != nil {
+			if err := s.Store.DeleteShortcutAlias(ctx, &store.DeleteShortcutAlias{
+				ShortcutID: shortcut.Id,
+				Name:       update.Name,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to delete alias: %v", err)
+			}
+		}
+		limit := 1
+		latest, err := s.Store.GetShortcutRevision(ctx, &store.FindShortcutRevision{
+			ShortcutID: &shortcut.Id,
+			Limit:      &limit,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get revision: %v", err)
+		}
+		if latest != nil && latest.Payload.RestoredRevisionId == revision.ID {
+			response.Revision = convertShortcutRevisionFromStore(latest)
+		}
+	}
+	response.Shortcut, err = s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) ListTrashedShortcuts(ctx context.Context, _ *v1pb.ListTrashedShortcutsRequest) (*v1pb.ListTrashedShortcutsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	find := &store.FindShortcut{
+		Trash: store.TrashOnly,
+	}
+	// Admins see every shortcut in the trash, others the ones they may restore or purge.
+	if user.Role != store.RoleAdmin {
+		find.OwnerID = &user.ID
+	}
+	shortcuts, err := s.Store.ListShortcuts(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts in the trash: %v", err)
+	}
+	retention, err := s.Store.GetTrashRetention(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get trash retention: %v", err)
+	}
+
+	composedShortcuts, err := s.convertShortcutsFromStorepb(ctx, shortcuts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcuts, err: %v", err)
+	}
+	for i, shortcut := range shortcuts {
+		composedShortcuts[i].PurgeTime = timestamppb.New(time.Unix(shortcut.DeletedTs, 0).Add(retention))
+	}
+
+	response := &v1pb.ListTrashedShortcutsResponse{
+		Shortcuts: composedShortcuts,
+	}
+	return response, nil
+}
 
+func (s *APIV1Service) RestoreShortcut(ctx context.Context, request *v1pb.RestoreShortcutRequest) (*v1pb.Shortcut, error) {
+	user, shortcut, err := s.getTrashedShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	deletedTs := int64(0)
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+		EditorID:  user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore shortcut, err: %v", err)
+	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
 	return composedShortcut, nil
 }
+
+func (s *APIV1Service) PurgeShortcut(ctx context.Context, request *v1pb.PurgeShortcutRequest) (*emptypb.Empty, error) {
+	_, shortcut, err := s.getTrashedShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge shortcut, err: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListShortcutPermissions(ctx context.Context, request *v1pb.ListShortcutPermissionsRequest) (*v1pb.ListShortcutPermissionsResponse, error) {
+	shortcut, err := s.getEditableShortcut(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	permissions, err := s.listPermissions(ctx, store.PermissionResourceTypeShortcut, shortcut.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ListShortcutPermissionsResponse{
+		Permissions: permissions,
+	}, nil
+}
+
+func (s *APIV1Service) GrantShortcutPermission(ctx context.Context, request *v1pb.GrantShortcutPermissionRequest) (*v1pb.Permission, error) {
+	shortcut, err := s.getShortcutWithRole(ctx, request.Id, store.PermissionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	return s.grantPermission(ctx, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId, request.Permission)
+}
+
+func (s *APIV1Service) RevokeShortcutPermission(ctx context.Context, request *v1pb.RevokeShortcutPermissionRequest) (*emptypb.Empty, error) {
+	shortcut, err := s.getShortcutWithRole(ctx, request.Id, store.PermissionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.revokePermission(ctx, store.PermissionResourceTypeShortcut, shortcut.Id, request.UserId, request.GroupId); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// TransferShortcutOwnership makes another user the creator of the shortcut. The previous
+// creator keeps no role on it unless one is granted to them.
+func (s *APIV1Service) TransferShortcutOwnership(ctx context.Context, request *v1pb.TransferShortcutOwnershipRequest) (*v1pb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.getShortcutWithRole(ctx, request.Id, store.PermissionRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	newCreator, err := s.getActiveUser(ctx, request.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if newCreator.ID == shortcut.CreatorId {
+		return nil, status.Errorf(codes.InvalidArgument, "the user already owns the shortcut")
+	}
+
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		CreatorID: &newCreator.ID,
+		EditorID:  user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to transfer shortcut, err: %v", err)
+	}
+	if err := s.deleteUserPermission(ctx, store.PermissionResourceTypeShortcut, shortcut.Id, newCreator.ID); err != nil {
+		return nil, err
+	}
+	composedShortcut, err := s.convertShortcutFromStorepb(ctx, shortcut)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcut, err: %v", err)
+	}
+	return composedShortcut, nil
+}
+
+func (s *APIV1Service) ListShortcutChangeRequests(ctx context.Context, request *v1pb.ListShortcutChangeRequestsRequest) (*v1pb.ListShortcutChangeRequestsResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &request.ShortcutId,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	// Approvers who can't edit the shortcut still review its changes.
+	role, err := s.getPermissionRole(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Includes(store.PermissionRoleEditor) {
+		approver, err := s.isShortcutApprover(ctx, user, shortcut)
+		if err != nil {
+			return nil, err
+		}
+		if !approver {
+			return nil, status.Errorf(codes.PermissionDenied, "Permission denied")
+		}
+	}
+
+	changeRequests, err := s.Store.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change requests: %v", err)
+	}
+
+	response := &v1pb.ListShortcutChangeRequestsResponse{
+		ChangeRequests: []*v1pb.ShortcutChangeRequest{},
+	}
+	for _, changeRequest := range changeRequests {
+		response.ChangeRequests = append(response.ChangeRequests, convertShortcutChangeRequestFromStore(changeRequest))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) ApproveShortcutChangeRequest(ctx context.Context, request *v1pb.ApproveShortcutChangeRequestRequest) (*v1pb.ShortcutChangeRequest, error) {
+	user, shortcut, changeRequest, err := s.getReviewableShortcutChangeRequest(ctx, request.ShortcutId, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := changeRequest.Payload
+	target := payload.Shortcut
+	if target == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "change request has no change")
+	}
+	if err := s.checkShortcutChangeRequestUpToDate(ctx, shortcut, changeRequest); err != nil {
+		return nil, err
+	}
+	// Names may have been taken since the change was requested.
+	if slices.Contains(payload.Fields, "name") {
+		if err := s.checkShortcutNameAvailable(ctx, target.Name, shortcut.Id); err != nil {
+			return nil, err
+		}
+	}
+	updateAliases := slices.Contains(payload.Fields, "aliases")
+	if updateAliases {
+		for _, alias := range payload.Aliases {
+			if err := s.checkShortcutNameAvailable(ctx, alias, shortcut.Id); err != nil {
+				return nil, err
+			}
+		}
+	}
+	fields := slices.DeleteFunc(slices.Clone(payload.Fields), func(field string) bool { return field == "aliases" })
+	var update *store.UpdateShortcut
+	if len(fields) != 0 {
+		update = convertShortcutFieldsToUpdate(shortcut.Id, target, fields)
+		update.EditorID = changeRequest.CreatorID
+		update.RestoredRevisionID = payload.RestoredRevisionId
+		// A rollback may change who the shortcut is shared with, which only its owners may do.
+		if update.Visibility != nil || update.GroupIDs != nil {
+			requester, err := s.Store.GetUser(ctx, &store.FindUser{
+				ID: &changeRequest.CreatorID,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+			}
+			if requester == nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "the requester of the change no longer exists")
+			}
+			if err := s.checkShortcutSharingUpdate(ctx, requester, shortcut, update); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The change request is approved before it is applied, so that a concurrent review can't apply it twice.
+	approvedChangeRequest, err := s.reviewShortcutChangeRequest(ctx, user, changeRequest, store.ShortcutChangeRequestApproved)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyShortcutChangeRequest(ctx, shortcut, changeRequest, update); err != nil {
+		// Nothing was applied, or not all of it, so the change is put up for review again.
+		pendingStatus := store.ShortcutChangeRequestPending
+		reviewerID := int32(0)
+		if _, resetErr := s.Store.UpdateShortcutChangeRequest(ctx, &store.UpdateShortcutChangeRequest{
+			ID:         changeRequest.ID,
+			Status:     &pendingStatus,
+			ReviewerID: &reviewerID,
+		}); resetErr != nil {
+			slog.Error("failed to reset change request", slog.Int("id", int(changeRequest.ID)), slog.String("error", resetErr.Error()))
+		}
+		return nil, err
+	}
+	if err := s.createShortcutChangeRequestActivity(ctx, user.ID, store.ActivityShortcutChangeApprove, approvedChangeRequest); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
+	}
+	return convertShortcutChangeRequestFromStore(approvedChangeRequest), nil
+}
+
+func (s *APIV1Service) RejectShortcutChangeRequest(ctx context.Context, request *v1pb.RejectShortcutChangeRequestRequest) (*v1pb.ShortcutChangeRequest, error) {
+	user, _, changeRequest, err := s.getReviewableShortcutChangeRequest(ctx, request.ShortcutId, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	changeRequest, err = s.reviewShortcutChangeRequest(ctx, user, changeRequest, store.ShortcutChangeRequestRejected)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.createShortcutChangeRequestActivity(ctx, user.ID, store.ActivityShortcutChangeReject, changeRequest); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
+	}
+	return convertShortcutChangeRequestFromStore(changeRequest), nil
+}
+
+// shortcutRequiresApproval returns whether edits of the shortcut need another person's approval,
+// because the shortcut is protected or its name is in a namespace that requires approval.
+func (s *APIV1Service) shortcutRequiresApproval(ctx context.Context, shortcut *storepb.Shortcut) (bool, error) {
+	requiresApproval, err := s.Store.ShortcutRequiresApproval(ctx, shortcut)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get namespace rule: %v", err)
+	}
+	return requiresApproval, nil
+}
+
+// isShortcutApprover returns whether the user may approve changes of the shortcut. The owners of
+// the shortcut are approvers, and so are the members of the group owning its namespace if the
+// namespace requires approval.
+func (s *APIV1Service) isShortcutApprover(ctx context.Context, user *store.User, shortcut *storepb.Shortcut) (bool, error) {
+	role, err := s.getPermissionRole(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return false, err
+	}
+	if role.Includes(store.PermissionRoleOwner) {
+		return true, nil
+	}
+	rule, err := s.Store.GetNamespaceRuleByName(ctx, shortcut.Name)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get namespace rule: %v", err)
+	}
+	if rule == nil || !rule.RequireApproval {
+		return false, nil
+	}
+	member, err := s.Store.IsNamespaceMember(ctx, rule, user.ID)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to check namespace membership: %v", err)
+	}
+	return member, nil
+}
+
+// createShortcutChangeRequest records the update of a protected shortcut, and the aliases it is
+// given if updateAliases is set, as a pending change request. Nothing is recorded if the update
+// changes nothing.
+func (s *APIV1Service) createShortcutChangeRequest(ctx context.Context, user *store.User, shortcut *storepb.Shortcut, update *store.UpdateShortcut, aliases []string, updateAliases bool) (*store.ShortcutChangeRequest, error) {
+	target := store.ApplyShortcutUpdate(shortcut, update)
+	fields := store.DiffShortcuts(shortcut, target)
+	currentAliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list aliases, err: %v", err)
+	}
+	previousAliases := []string{}
+	for _, alias := range currentAliases {
+		previousAliases = append(previousAliases, alias.Name)
+	}
+	if updateAliases {
+		sortedAliases, sortedPreviousAliases := slices.Clone(aliases), slices.Clone(previousAliases)
+		slices.Sort(sortedAliases)
+		slices.Sort(sortedPreviousAliases)
+		if !slices.Equal(sortedAliases, sortedPreviousAliases) {
+			fields = append(fields, "aliases")
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	changeRequest, err := s.Store.CreateShortcutChangeRequest(ctx, &store.ShortcutChangeRequest{
+		ShortcutID: shortcut.Id,
+		CreatorID:  user.ID,
+		Payload: &storepb.ShortcutChangeRequestPayload{
+			Fields:             fields,
+			Previous:           shortcut,
+			Shortcut:           target,
+			PreviousAliases:    previousAliases,
+			Aliases:            aliases,
+			RestoredRevisionId: update.RestoredRevisionID,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create change request: %v", err)
+	}
+	if err := s.createShortcutChangeRequestActivity(ctx, user.ID, store.ActivityShortcutChangeRequest, changeRequest); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create activity, err: %v", err)
+	}
+	return changeRequest, nil
+}
+
+// getReviewableShortcutChangeRequest returns the pending change request if the current user may
+// approve or reject it. Nobody reviews their own change.
+func (s *APIV1Service) getReviewableShortcutChangeRequest(ctx context.Context, shortcutID, id int32) (*store.User, *storepb.Shortcut, *store.ShortcutChangeRequest, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcutID,
+	})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	changeRequest, err := s.Store.GetShortcutChangeRequest(ctx, &store.FindShortcutChangeRequest{
+		ID:         &id,
+		ShortcutID: &shortcut.Id,
+	})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get change request: %v", err)
+	}
+	if changeRequest == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "change request not found")
+	}
+	if changeRequest.Status != store.ShortcutChangeRequestPending {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "change request is already %s", strings.ToLower(changeRequest.Status.String()))
+	}
+	if changeRequest.CreatorID == user.ID {
+		return nil, nil, nil, status.Errorf(codes.PermissionDenied, "the change must be reviewed by someone else")
+	}
+	approver, err := s.isShortcutApprover(ctx, user, shortcut)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !approver {
+		return nil, nil, nil, status.Errorf(codes.PermissionDenied, "only approvers can review changes")
+	}
+	return user, shortcut, changeRequest, nil
+}
+
+// reviewShortcutChangeRequest records the outcome of the review of a pending change request. It
+// fails if the change request has been reviewed in the meantime.
+func (s *APIV1Service) reviewShortcutChangeRequest(ctx context.Context, user *store.User, changeRequest *store.ShortcutChangeRequest, changeStatus store.ShortcutChangeRequestStatus) (*store.ShortcutChangeRequest, error) {
+	updatedTs := time.Now().Unix()
+	reviewedChangeRequest, err := s.Store.UpdateShortcutChangeRequest(ctx, &store.UpdateShortcutChangeRequest{
+		ID:          changeRequest.ID,
+		UpdatedTs:   &updatedTs,
+		Status:      &changeStatus,
+		ReviewerID:  &user.ID,
+		PendingOnly: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update change request: %v", err)
+	}
+	if reviewedChangeRequest == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "change request has already been reviewed")
+	}
+	return reviewedChangeRequest, nil
+}
+
+// checkShortcutChangeRequestUpToDate fails if a field the change request changes has changed
+// since it was requested, so that approving it doesn't undo a later edit.
+func (s *APIV1Service) checkShortcutChangeRequestUpToDate(ctx context.Context, shortcut *storepb.Shortcut, changeRequest *store.ShortcutChangeRequest) error {
+	payload := changeRequest.Payload
+	if payload.Previous != nil {
+		changedFields := store.DiffShortcuts(payload.Previous, shortcut)
+		for _, field := range payload.Fields {
+			if slices.Contains(changedFields, field) {
+				return status.Errorf(codes.FailedPrecondition, "the %s of the shortcut has changed since the change was requested", field)
+			}
+		}
+	}
+	if slices.Contains(payload.Fields, "aliases") {
+		currentAliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+			ShortcutID: &shortcut.Id,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list aliases, err: %v", err)
+		}
+		aliases := []string{}
+		for _, alias := range currentAliases {
+			aliases = append(aliases, alias.Name)
+		}
+		previousAliases := slices.Clone(payload.PreviousAliases)
+		slices.Sort(aliases)
+		slices.Sort(previousAliases)
+		if !slices.Equal(aliases, previousAliases) {
+			return status.Errorf(codes.FailedPrecondition, "the aliases of the shortcut have changed since the change was requested")
+		}
+	}
+	return nil
+}
+
+// applyShortcutChangeRequest applies the update of an approved change request, if it changes
+// more than the aliases, and its aliases to the shortcut. The revision of the change is recorded
+// under the requester.
+func (s *APIV1Service) applyShortcutChangeRequest(ctx context.Context, shortcut *storepb.Shortcut, changeRequest *store.ShortcutChangeRequest, update *store.UpdateShortcut) error {
+	payload := changeRequest.Payload
+	if update != nil {
+		if _, err := s.Store.UpdateShortcut(ctx, update); err != nil {
+			return status.Errorf(codes.Internal, "failed to update shortcut, err: %v", err)
+		}
+	}
+	if slices.Contains(payload.Fields, "aliases") {
+		if err := s.syncShortcutAliases(ctx, shortcut.Id, changeRequest.CreatorID, payload.Aliases); err != nil {
+			return status.Errorf(codes.Internal, "failed to update aliases, err: %v", err)
+		}
+	} else if update != nil && update.Name != nil {
+		// A new name that has since become an alias of the shortcut turns the alias into the name.
+		if err := s.Store.DeleteShortcutAlias(ctx, &store.DeleteShortcutAlias{
+			ShortcutID: shortcut.Id,
+			Name:       update.Name,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete alias: %v", err)
+		}
+	}
+	return nil
+}
+
+// checkShortcutSharingUpdate checks that the user may change who the shortcut is shared with, if
+// the update does, and resolves the groups the update shares the shortcut with. Editors can't
+// change the sharing.
+func (s *APIV1Service) checkShortcutSharingUpdate(ctx context.Context, user *store.User, shortcut *storepb.Shortcut, update *store.UpdateShortcut) error {
+	if update.Visibility == nil && update.GroupIDs == nil {
+		return nil
+	}
+	role, err := s.getPermissionRole(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId)
+	if err != nil {
+		return err
+	}
+	if !role.Includes(store.PermissionRoleOwner) {
+		return status.Errorf(codes.PermissionDenied, "only owners can change the visibility")
+	}
+	visibility, groupIDs := shortcut.Visibility, shortcut.GroupIds
+	if update.Visibility != nil {
+		visibility = *update.Visibility
+	}
+	if update.GroupIDs != nil {
+		groupIDs = update.GroupIDs
+	}
+	update.GroupIDs, err = s.getVisibilityGroupIDs(ctx, visibility, groupIDs)
+	return err
+}
+
+// getTrashedShortcut returns the current user and the shortcut in the trash if the user
+// is allowed to restore or purge it.
+func (s *APIV1Service) getTrashedShortcut(ctx context.Context, id int32) (*store.User, *storepb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:    &id,
+		Trash: store.TrashOnly,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "shortcut not found in the trash")
+	}
+	if err := s.checkPermission(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId, store.PermissionRoleOwner); err != nil {
+		return nil, nil, err
+	}
+	return user, shortcut, nil
+}
+
+// getEditableShortcut returns the shortcut if the current user is allowed to change it.
+func (s *APIV1Service) getEditableShortcut(ctx context.Context, id int32) (*storepb.Shortcut, error) {
+	return s.getShortcutWithRole(ctx, id, store.PermissionRoleEditor)
+}
+
+// getShortcutWithRole returns the shortcut if the current user has at least the role on it.
+func (s *APIV1Service) getShortcutWithRole(ctx context.Context, id int32, role store.PermissionRole) (*storepb.Shortcut, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get shortcut by id: %v", err)
+	}
+	if shortcut == nil {
+		return nil, status.Errorf(codes.NotFound, "shortcut not found")
+	}
+	if err := s.checkPermission(ctx, user, store.PermissionResourceTypeShortcut, shortcut.Id, shortcut.CreatorId, role); err != nil {
+		return nil, err
+	}
+	return shortcut, nil
+}
+
+// convertShortcutFieldsToUpdate returns the update that sets the recorded fields of the shortcut
+// to their values in target, see store.DiffShortcuts.
+func convertShortcutFieldsToUpdate(id int32, target *storepb.Shortcut, fields []string) *store.UpdateShortcut {
+	update := &store.UpdateShortcut{
+		ID: id,
+	}
+	for _, field := range fields {
+		switch field {
+		case "name":
+			update.Name = &target.Name
+		case "link":
+			update.Link = &target.Link
+		case "title":
+			update.Title = &target.Title
+		case "description":
+			update.Description = &target.Description
+		case "tags":
+			tag := strings.Join(target.Tags, " ")
+			update.Tag = &tag
+		case "visibility":
+			update.Visibility = &target.Visibility
+		case "og_metadata":
+			update.OpenGraphMetadata = cmp.Or(target.OgMetadata, &storepb.OpenGraphMetadata{})
+		case "template":
+			update.Template = &target.Template
+		case "forward_path":
+			update.ForwardPath = &target.ForwardPath
+		case "redirect_mode":
+			update.RedirectMode = &target.RedirectMode
+		case "valid_from":
+			update.ValidFrom = &target.ValidFrom
+		case "valid_until":
+			update.ValidUntil = &target.ValidUntil
+		case "state":
+			update.RowStatus = &target.RowStatus
+		case "variants":
+			update.Variants = cmp.Or(target.Variants, &storepb.ShortcutVariants{})
+		case "routing_rules":
+			update.RoutingRules = cmp.Or(target.RoutingRules, &storepb.ShortcutRoutingRules{})
+		case "backup_links":
+			update.Failover = cmp.Or(target.Failover, &storepb.ShortcutFailover{})
+		case "group_ids":
+			update.GroupIDs = append([]int32{}, target.GroupIds...)
+		case "protected":
+			update.Protected = &target.Protected
+		}
+	}
+	return update
+}
+
+func convertShortcutLinkChangeFromStore(change *store.ShortcutLinkChange) *v1pb.ShortcutLinkChange {
+	return &v1pb.ShortcutLinkChange{
+		Id:            change.ID,
+		ShortcutId:    change.ShortcutID,
+		CreatorId:     change.CreatorID,
+		CreatedTime:   timestamppb.New(time.Unix(change.CreatedTs, 0)),
+		EffectiveTime: timestamppb.New(time.Unix(change.EffectiveTs, 0)),
+		Link:          change.Link,
+		AppliedTime:   convertUnixToTimestamp(change.AppliedTs),
+	}
+}
+
+func convertShortcutRevisionFromStore(revision *store.ShortcutRevision) *v1pb.ShortcutRevision {
+	shortcutRevision := &v1pb.ShortcutRevision{
+		Id:                 revision.ID,
+		ShortcutId:         revision.ShortcutID,
+		CreatorId:          revision.CreatorID,
+		CreatedTime:        timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		Changes:            []*v1pb.ShortcutRevision_FieldChange{},
+		RestoredRevisionId: revision.Payload.RestoredRevisionId,
+	}
+	for _, field := range revision.Payload.Fields {
+		shortcutRevision.Changes = append(shortcutRevision.Changes, &v1pb.ShortcutRevision_FieldChange{
+			Field:         field,
+			PreviousValue: store.GetShortcutFieldValue(revision.Payload.Previous, field),
+			Value:         store.GetShortcutFieldValue(revision.Payload.Shortcut, field),
+		})
+	}
+	return shortcutRevision
+}
+
+func convertShortcutChangeRequestFromStore(changeRequest *store.ShortcutChangeRequest) *v1pb.ShortcutChangeRequest {
+	shortcutChangeRequest := &v1pb.ShortcutChangeRequest{
+		Id:          changeRequest.ID,
+		ShortcutId:  changeRequest.ShortcutID,
+		CreatorId:   changeRequest.CreatorID,
+		CreatedTime: timestamppb.New(time.Unix(changeRequest.CreatedTs, 0)),
+		UpdatedTime: timestamppb.New(time.Unix(changeRequest.UpdatedTs, 0)),
+		Status:      convertShortcutChangeRequestStatusFromStore(changeRequest.Status),
+		ReviewerId:  changeRequest.ReviewerID,
+		Changes:     []*v1pb.ShortcutRevision_FieldChange{},
+	}
+	payload := changeRequest.Payload
+	for _, field := range payload.Fields {
+		change := &v1pb.ShortcutRevision_FieldChange{
+			Field:         field,
+			PreviousValue: store.GetShortcutFieldValue(payload.Previous, field),
+			Value:         store.GetShortcutFieldValue(payload.Shortcut, field),
+		}
+		if field == "aliases" {
+			change.PreviousValue, change.Value = strings.Join(payload.PreviousAliases, " "), strings.Join(payload.Aliases, " ")
+		}
+		shortcutChangeRequest.Changes = append(shortcutChangeRequest.Changes, change)
+	}
+	return shortcutChangeRequest
+}
+
+func convertShortcutChangeRequestStatusFromStore(changeStatus store.ShortcutChangeRequestStatus) v1pb.ShortcutChangeRequest_Status {
+	switch changeStatus {
+	case store.ShortcutChangeRequestPending:
+		return v1pb.ShortcutChangeRequest_PENDING
+	case store.ShortcutChangeRequestApproved:
+		return v1pb.ShortcutChangeRequest_APPROVED
+	case store.ShortcutChangeRequestRejected:
+		return v1pb.ShortcutChangeRequest_REJECTED
+	default:
+		return v1pb.ShortcutChangeRequest_STATUS_UNSPECIFIED
+	}
+}
+
+func (s *APIV1Service) createShortcutCreateActivity(ctx context.Context, shortcut *storepb.Shortcut) error {
+	payload := &storepb.ActivityShorcutCreatePayload{
+		ShortcutId: shortcut.Id,
+	}
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "Failed to marshal activity payload")
+	}
+	activity := &store.Activity{
+		CreatorID: shortcut.CreatorId,
+		Type:      store.ActivityShortcutCreate,
+		Level:     store.ActivityInfo,
+		Payload:   string(payloadStr),
+	}
+	_, err = s.Store.CreateActivity(ctx, activity)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create activity")
+	}
+	return nil
+}
+
+func (s *APIV1Service) createShortcutChangeRequestActivity(ctx context.Context, creatorID int32, activityType store.ActivityType, changeRequest *store.ShortcutChangeRequest) error {
+	payload := &storepb.ActivityShortcutChangeRequestPayload{
+		ShortcutId:      changeRequest.ShortcutID,
+		ChangeRequestId: changeRequest.ID,
+		Fields:          changeRequest.Payload.Fields,
+	}
+	payloadStr, err := protojson.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "Failed to marshal activity payload")
+	}
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: creatorID,
+		Type:      activityType,
+		Level:     store.ActivityInfo,
+		Payload:   string(payloadStr),
+	}); err != nil {
+		return errors.Wrap(err, "Failed to create activity")
+	}
+	return nil
+}
+
+func (s *APIV1Service) convertShortcutFromStorepb(ctx context.Context, shortcut *storepb.Shortcut) (*v1pb.Shortcut, error) {
+	list, err := s.convertShortcutsFromStorepb(ctx, []*storepb.Shortcut{shortcut})
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// convertShortcutsFromStorepb converts a list of shortcuts, loading their aliases with one query.
+func (s *APIV1Service) convertShortcutsFromStorepb(ctx context.Context, shortcuts []*storepb.Shortcut) ([]*v1pb.Shortcut, error) {
+	list := []*v1pb.Shortcut{}
+	if len(shortcuts) == 0 {
+		return list, nil
+	}
+	shortcutIDs := []int32{}
+	for _, shortcut := range shortcuts {
+		shortcutIDs = append(shortcutIDs, shortcut.Id)
+	}
+	aliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutIDList: shortcutIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list aliases")
+	}
+	aliasMap := map[int32][]string{}
+	for _, alias := range aliases {
+		aliasMap[alias.ShortcutId] = append(aliasMap[alias.ShortcutId], alias.Name)
+	}
+	requiresApproval, err := s.Store.ShortcutsRequiringApproval(ctx, shortcuts)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get namespace rules")
+	}
+	pending := store.ShortcutChangeRequestPending
+	changeRequests, err := s.Store.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutIDList: shortcutIDs,
+		Status:         &pending,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list change requests")
+	}
+	pendingChangeRequestCount := map[int32]int32{}
+	for _, changeRequest := range changeRequests {
+		pendingChangeRequestCount[changeRequest.ShortcutID]++
+	}
+	// Only the targets of shortcuts with backup links are checked.
+	healthShortcutIDs := []int32{}
+	for _, shortcut := range shortcuts {
+		if len(shortcut.Failover.GetBackupLinks()) > 0 {
+			healthShortcutIDs = append(healthShortcutIDs, shortcut.Id)
+		}
+	}
+	healthMap := map[int32][]*v1pb.Shortcut_TargetHealth{}
+	if len(healthShortcutIDs) > 0 {
+		healthList, err := s.Store.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{
+			ShortcutIDList: healthShortcutIDs,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to list target health")
+		}
+		for _, health := range healthList {
+			healthMap[health.ShortcutID] = append(healthMap[health.ShortcutID], &v1pb.Shortcut_TargetHealth{
+				Link:        health.Link,
+				Healthy:     health.Healthy,
+				StatusCode:  health.StatusCode,
+				Error:       health.Error,
+				CheckedTime: timestamppb.New(time.Unix(health.CheckedTs, 0)),
+			})
+		}
+	}
+
+	for _, shortcut := range shortcuts {
+		composedShortcut := &v1pb.Shortcut{
+			Id:             shortcut.Id,
+			CreatorId:      shortcut.CreatorId,
+			CreatedTime:    timestamppb.New(time.Unix(shortcut.CreatedTs, 0)),
+			UpdatedTime:    timestamppb.New(time.Unix(shortcut.UpdatedTs, 0)),
+			Name:           shortcut.Name,
+			Link:           shortcut.Link,
+			Title:          shortcut.Title,
+			Tags:           shortcut.Tags,
+			Description:    shortcut.Description,
+			Visibility:     convertVisibilityFromStorepb(shortcut.Visibility),
+			Uuid:           shortcut.Uuid,
+			Template:       shortcut.Template,
+			ForwardPath:    shortcut.ForwardPath,
+			RedirectMode:   convertRedirectModeFromStorepb(shortcut.RedirectMode),
+			ValidFrom:      convertUnixToTimestamp(shortcut.ValidFrom),
+			ValidUntil:     convertUnixToTimestamp(shortcut.ValidUntil),
+			State:          convertStateFromRowStatus(shortcut.RowStatus),
+			DeletedTime:    convertUnixToTimestamp(shortcut.DeletedTs),
+			GroupIds:       shortcut.GroupIds,
+			Protected:      shortcut.Protected,
+			Variants:       convertShortcutVariantsFromStorepb(shortcut.Variants),
+			StickyVariants: shortcut.Variants.GetSticky(),
+			RoutingRules:   convertShortcutRoutingRulesFromStorepb(shortcut.RoutingRules),
+			BackupLinks:    shortcut.Failover.GetBackupLinks(),
+			TargetHealth:   []*v1pb.Shortcut_TargetHealth{},
+			Aliases:        []string{},
+			OgMetadata: &v1pb.Shortcut_OpenGraphMetadata{
+				Title:       shortcut.OgMetadata.Title,
+				Description: shortcut.OgMetadata.Description,
+				Image:       shortcut.OgMetadata.Image,
+			},
+		}
+
+		activityList, err := s.Store.ListActivities(ctx, &store.FindActivity{
+			Type:              store.ActivityShortcutView,
+			Level:             store.ActivityInfo,
+			PayloadShortcutID: &composedShortcut.Id,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to list activities")
+		}
+		composedShortcut.ViewCount = int32(len(activityList))
+
+		composedShortcut.TargetHealth = append(composedShortcut.TargetHealth, healthMap[shortcut.Id]...)
+
+		composedShortcut.Aliases = append(composedShortcut.Aliases, aliasMap[shortcut.Id]...)
+
+		composedShortcut.RequiresApproval = requiresApproval[shortcut.Id]
+		composedShortcut.PendingChangeRequestCount = pendingChangeRequestCount[shortcut.Id]
+		list = append(list, composedShortcut)
+	}
+	return list, nil
+}
+
+// validateShortcutValidity checks that a validity window, where 0 means unbounded, is not empty.
+func validateShortcutValidity(validFrom, validUntil int64) error {
+	if validFrom < 0 || validUntil < 0 {
+		return errors.New("timestamps must not be negative")
+	}
+	if validFrom != 0 && validUntil != 0 && validFrom >= validUntil {
+		return errors.New("valid_from must be before valid_until")
+	}
+	return nil
+}
+
+func convertShortcutVariantsFromStorepb(variants *storepb.ShortcutVariants) []*v1pb.Shortcut_Variant {
+	list := []*v1pb.Shortcut_Variant{}
+	for _, variant := range variants.GetVariants() {
+		list = append(list, &v1pb.Shortcut_Variant{
+			Name:   variant.Name,
+			Link:   variant.Link,
+			Weight: variant.Weight,
+		})
+	}
+	return list
+}
+
+func convertShortcutVariantsToStorepb(variants []*v1pb.Shortcut_Variant, sticky bool) *storepb.ShortcutVariants {
+	shortcutVariants := &storepb.ShortcutVariants{
+		Sticky: sticky,
+	}
+	for _, variant := range variants {
+		shortcutVariants.Variants = append(shortcutVariants.Variants, &storepb.ShortcutVariant{
+			Name:   variant.Name,
+			Link:   variant.Link,
+			Weight: variant.Weight,
+		})
+	}
+	return shortcutVariants
+}
+
+// validateShortcutVariants checks that every variant can be served and told apart in analytics.
+func validateShortcutVariants(variants *storepb.ShortcutVariants, template bool) error {
+	names := map[string]bool{}
+	for _, variant := range variants.GetVariants() {
+		if variant.Name == "" || variant.Link == "" {
+			return errors.New("name and link are required")
+		}
+		if names[variant.Name] {
+			return errors.Errorf("duplicate variant name %q", variant.Name)
+		}
+		names[variant.Name] = true
+		if variant.Weight <= 0 {
+			return errors.Errorf("weight of variant %q must be positive", variant.Name)
+		}
+		if template {
+			if err := linktemplate.Validate(variant.Link); err != nil {
+				return errors.Wrapf(err, "invalid link template of variant %q", variant.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func convertShortcutRoutingRulesFromStorepb(routingRules *storepb.ShortcutRoutingRules) []*v1pb.Shortcut_RoutingRule {
+	list := []*v1pb.Shortcut_RoutingRule{}
+	for _, rule := range routingRules.GetRules() {
+		routingRule := &v1pb.Shortcut_RoutingRule{
+			Link: rule.Link,
+		}
+		for _, condition := range rule.Conditions {
+			routingRule.Conditions = append(routingRule.Conditions, &v1pb.Shortcut_RoutingCondition{
+				Field:  v1pb.Shortcut_RoutingCondition_Field(condition.Field),
+				Header: condition.Header,
+				Values: condition.Values,
+			})
+		}
+		list = append(list, routingRule)
+	}
+	return list
+}
+
+func convertShortcutRoutingRulesToStorepb(rules []*v1pb.Shortcut_RoutingRule) *storepb.ShortcutRoutingRules {
+	routingRules := &storepb.ShortcutRoutingRules{}
+	for _, rule := range rules {
+		routingRule := &storepb.ShortcutRoutingRule{
+			Link: rule.Link,
+		}
+		for _, condition := range rule.Conditions {
+			routingRule.Conditions = append(routingRule.Conditions, &storepb.ShortcutRoutingCondition{
+				Field:  storepb.ShortcutRoutingCondition_Field(condition.Field),
+				Header: condition.Header,
+				Values: condition.Values,
+			})
+		}
+		routingRules.Rules = append(routingRules.Rules, routingRule)
+	}
+	return routingRules
+}
+
+// validateShortcutRoutingRules checks that every rule can be matched and sends visitors to a valid link.
+func validateShortcutRoutingRules(routingRules *storepb.ShortcutRoutingRules, template bool) error {
+	if err := routing.Validate(routingRules.GetRules()); err != nil {
+		return err
+	}
+	if template {
+		for i, rule := range routingRules.GetRules() {
+			if err := linktemplate.Validate(rule.Link); err != nil {
+				return errors.Wrapf(err, "invalid link template of routing rule %d", i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// validateShortcutBackupLinks checks that backup links are set and differ from each other and the link.
+func validateShortcutBackupLinks(backupLinks []string, link string) error {
+	seen := map[string]bool{link: true}
+	for _, backupLink := range backupLinks {
+		if backupLink == "" {
+			return errors.New("backup links must not be empty")
+		}
+		if seen[backupLink] {
+			return errors.Errorf("duplicate link %q", backupLink)
+		}
+		seen[backupLink] = true
+	}
+	return nil
+}
+
+// validateShortcutAliases checks that aliases are set and differ from each other and the name.
+func validateShortcutAliases(aliases []string, name string) error {
+	seen := map[string]bool{name: true}
+	for _, alias := range aliases {
+		if alias == "" {
+			return errors.New("aliases must not be empty")
+		}
+		if seen[alias] {
+			return errors.Errorf("duplicate name %q", alias)
+		}
+		seen[alias] = true
+	}
+	return nil
+}
+
+// checkShortcutNameAvailable returns an AlreadyExists error if name is the name or an alias
+// of a shortcut other than the one with shortcutID, or the same as the name of such a
+// shortcut under the workspace name policy. Shortcuts in the trash keep their names until
+// they are purged so that they can be restored.
+func (s *APIV1Service) checkShortcutNameAvailable(ctx context.Context, name string, shortcutID int32) error {
+	shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
+		Name:  &name,
+		Trash: store.TrashIncluded,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get shortcut by name: %v", err)
+	}
+	if shortcut != nil && shortcut.Id != shortcutID {
+		if shortcut.DeletedTs != 0 {
+			return status.Errorf(codes.AlreadyExists, "name %q is used by a shortcut in the trash", name)
+		}
+		return status.Errorf(codes.AlreadyExists, "name %q is already used by another shortcut", name)
+	}
+	policy, err := s.Store.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get shortcut name policy: %v", err)
+	}
+	normalizedName := store.NormalizeShortcutName(name, policy)
+	shortcut, err = s.Store.GetShortcut(ctx, &store.FindShortcut{
+		NormalizedNameList: []string{normalizedName},
+		Trash:              store.TrashIncluded,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get shortcut by normalized name: %v", err)
+	}
+	if shortcut != nil && shortcut.Id != shortcutID {
+		return status.Errorf(codes.AlreadyExists, "name %q is the same as %q under the workspace name policy", name, shortcut.Name)
+	}
+	alias, err := s.Store.GetShortcutAlias(ctx, &store.FindShortcutAlias{
+		Name: &name,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get alias by name: %v", err)
+	}
+	if alias != nil && alias.ShortcutId != shortcutID {
+		return status.Errorf(codes.AlreadyExists, "name %q is already an alias of another shortcut", name)
+	}
+	alias, err = s.Store.GetShortcutAlias(ctx, &store.FindShortcutAlias{
+		NormalizedNameList: []string{normalizedName},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get alias by normalized name: %v", err)
+	}
+	if alias != nil && alias.ShortcutId != shortcutID {
+		return status.Errorf(codes.AlreadyExists, "name %q is the same as the alias %q of another shortcut under the workspace name policy", name, alias.Name)
+	}
+	return nil
+}
+
+// syncShortcutAliases creates and deletes aliases of the shortcut so that they match names.
+func (s *APIV1Service) syncShortcutAliases(ctx context.Context, shortcutID int32, creatorID int32, names []string) error {
+	currentAliases, err := s.Store.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &shortcutID,
+	})
+	if err != nil {
+		return err
+	}
+	current := map[string]bool{}
+	for _, alias := range currentAliases {
+		current[alias.Name] = true
+		if !slices.Contains(names, alias.Name) {
+			if err := s.Store.DeleteShortcutAlias(ctx, &store.DeleteShortcutAlias{
+				ShortcutID: shortcutID,
+				Name:       &alias.Name,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	for _, name := range names {
+		if current[name] {
+			continue
+		}
+		if _, err := s.Store.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+			ShortcutId: shortcutID,
+			CreatorId:  creatorID,
+			Name:       name,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
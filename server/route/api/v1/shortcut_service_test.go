@@ -0,0 +1,399 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/store"
+	teststore "github.com/bshort/monotreme/store/test"
+)
+
+func newTestingAPIV1Service(ctx context.Context, t *testing.T) *APIV1Service {
+	return &APIV1Service{
+		Profile: &profile.Profile{},
+		Store:   teststore.NewTestingStore(ctx, t),
+	}
+}
+
+// createTestingUser creates a user and returns it with a context signed in as it.
+func createTestingUser(ctx context.Context, t *testing.T, ts *store.Store, nickname string, role store.Role) (*store.User, context.Context) {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     role,
+		Email:    nickname + "@test.com",
+		Nickname: nickname,
+	})
+	require.NoError(t, err)
+	return user, context.WithValue(ctx, userIDContextKey, user.ID)
+}
+
+func TestProtectedShortcutGates(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingAPIV1Service(ctx, t)
+	owner, ownerCtx := createTestingUser(ctx, t, s.Store, "owner", store.RoleAdmin)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  owner.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Protected:  true,
+	})
+	require.NoError(t, err)
+
+	// Scheduled link changes and the trash would skip the review.
+	_, err = s.CreateShortcutLinkChange(ownerCtx, &v1pb.CreateShortcutLinkChangeRequest{
+		LinkChange: &v1pb.ShortcutLinkChange{
+			ShortcutId:    shortcut.Id,
+			Link:          "https://new.example.com",
+			EffectiveTime: timestamppb.New(time.Now().Add(time.Hour)),
+		},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.DeleteShortcut(ownerCtx, &v1pb.DeleteShortcutRequest{Id: shortcut.Id})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Imported bookmarks request the new link.
+	_, err = s.ImportBookmarks(ownerCtx, &v1pb.ImportBookmarksRequest{
+		HtmlContent: `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<DL><p>
+<DT><H3>Docs</H3>
+<DL><p>
+<DT><A HREF="https://new.example.com">Wiki</A>
+</DL><p>
+</DL><p>`,
+	})
+	require.NoError(t, err)
+	current, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.NotNil(t, current)
+	require.Equal(t, "https://wiki.example.com", current.Link)
+	pending := store.ShortcutChangeRequestPending
+	changeRequests, err := s.Store.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+		Status:     &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changeRequests))
+	require.Equal(t, "https://new.example.com", changeRequests[0].Payload.Shortcut.Link)
+}
+
+func TestShortcutChangeRequestReview(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingAPIV1Service(ctx, t)
+	requester, requesterCtx := createTestingUser(ctx, t, s.Store, "requester", store.RoleAdmin)
+	_, reviewerCtx := createTestingUser(ctx, t, s.Store, "reviewer", store.RoleAdmin)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  requester.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Protected:  true,
+	})
+	require.NoError(t, err)
+
+	requestLink := func(link string) *store.ShortcutChangeRequest {
+		_, err := s.UpdateShortcut(requesterCtx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Link: link},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+		})
+		require.NoError(t, err)
+		changeRequests, err := s.Store.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+			ShortcutID: &shortcut.Id,
+		})
+		require.NoError(t, err)
+		for _, changeRequest := range changeRequests {
+			if changeRequest.Payload.Shortcut.Link == link {
+				return changeRequest
+			}
+		}
+		require.FailNow(t, "change request not found")
+		return nil
+	}
+	first := requestLink("https://first.example.com")
+	second := requestLink("https://second.example.com")
+
+	_, err = s.ApproveShortcutChangeRequest(reviewerCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: second.ID})
+	require.NoError(t, err)
+	current, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://second.example.com", current.Link)
+
+	// A change request is reviewed once.
+	_, err = s.ApproveShortcutChangeRequest(reviewerCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: second.ID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.RejectShortcutChangeRequest(reviewerCtx, &v1pb.RejectShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: second.ID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	reviewer, err := getCurrentUser(reviewerCtx, s.Store)
+	require.NoError(t, err)
+	_, err = s.reviewShortcutChangeRequest(ctx, reviewer, second, store.ShortcutChangeRequestRejected)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The first change would undo the approved one.
+	_, err = s.ApproveShortcutChangeRequest(reviewerCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: first.ID})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	current, err = s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://second.example.com", current.Link)
+	_, err = s.RejectShortcutChangeRequest(reviewerCtx, &v1pb.RejectShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: first.ID})
+	require.NoError(t, err)
+}
+
+func TestShortcutChangeRequestApprovers(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingAPIV1Service(ctx, t)
+	admin, adminCtx := createTestingUser(ctx, t, s.Store, "admin", store.RoleAdmin)
+	member, memberCtx := createTestingUser(ctx, t, s.Store, "member", store.RoleUser)
+	editor, editorCtx := createTestingUser(ctx, t, s.Store, "editor", store.RoleUser)
+	_, outsiderCtx := createTestingUser(ctx, t, s.Store, "outsider", store.RoleUser)
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		CreatorID: admin.ID,
+		Name:      "eng",
+	})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  member.ID,
+	})
+	require.NoError(t, err)
+	rule, err := s.Store.CreateNamespaceRule(ctx, &store.NamespaceRule{
+		CreatorID: admin.ID,
+		Pattern:   "eng-*",
+		GroupID:   group.ID,
+	})
+	require.NoError(t, err)
+	requireApproval := true
+	_, err = s.Store.UpdateNamespaceRule(ctx, &store.UpdateNamespaceRule{
+		ID:              rule.ID,
+		RequireApproval: &requireApproval,
+	})
+	require.NoError(t, err)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  admin.ID,
+		Name:       "eng-wiki",
+		Link:       "https://wiki.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertPermission(ctx, &store.Permission{
+		ResourceType:  store.PermissionResourceTypeShortcut,
+		ResourceID:    shortcut.Id,
+		PrincipalType: store.PermissionPrincipalTypeUser,
+		PrincipalID:   editor.ID,
+		Role:          store.PermissionRoleEditor,
+	})
+	require.NoError(t, err)
+
+	// Only owners change the protection, even of a shortcut they may edit.
+	_, err = s.UpdateShortcut(editorCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Protected: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"protected"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The namespace requires approval of the editor's change.
+	composedShortcut, err := s.UpdateShortcut(editorCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Link: "https://new.example.com"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+	})
+	require.NoError(t, err)
+	require.True(t, composedShortcut.RequiresApproval)
+	require.Equal(t, int32(1), composedShortcut.PendingChangeRequestCount)
+	require.Equal(t, "https://wiki.example.com", composedShortcut.Link)
+	changeRequest, err := s.Store.GetShortcutChangeRequest(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, changeRequest)
+
+	// Nobody reviews their own change, and only owners and members of the namespace group review.
+	_, err = s.ApproveShortcutChangeRequest(editorCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: changeRequest.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ApproveShortcutChangeRequest(outsiderCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: changeRequest.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.ApproveShortcutChangeRequest(memberCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: changeRequest.ID})
+	require.NoError(t, err)
+	current, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, "https://new.example.com", current.Link)
+
+	// Owners are no exception to the review by someone else.
+	_, err = s.UpdateShortcut(adminCtx, &v1pb.UpdateShortcutRequest{
+		Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Link: "https://admin.example.com"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"link"}},
+	})
+	require.NoError(t, err)
+	pending := store.ShortcutChangeRequestPending
+	changeRequest, err = s.Store.GetShortcutChangeRequest(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+		Status:     &pending,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, changeRequest)
+	_, err = s.ApproveShortcutChangeRequest(adminCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: changeRequest.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestListTrashedShortcuts(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingAPIV1Service(ctx, t)
+	creator, creatorCtx := createTestingUser(ctx, t, s.Store, "creator", store.RoleUser)
+	coOwner, coOwnerCtx := createTestingUser(ctx, t, s.Store, "co-owner", store.RoleUser)
+	member, memberCtx := createTestingUser(ctx, t, s.Store, "member", store.RoleUser)
+	editor, editorCtx := createTestingUser(ctx, t, s.Store, "editor", store.RoleUser)
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		CreatorID: creator.ID,
+		Name:      "oncall",
+	})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertGroupMember(ctx, &store.GroupMember{
+		GroupID: group.ID,
+		UserID:  member.ID,
+	})
+	require.NoError(t, err)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  creator.ID,
+		Name:       "runbook",
+		Link:       "https://runbook.example.com",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	for _, permission := range []*store.Permission{
+		{PrincipalType: store.PermissionPrincipalTypeUser, PrincipalID: coOwner.ID, Role: store.PermissionRoleOwner},
+		{PrincipalType: store.PermissionPrincipalTypeGroup, PrincipalID: group.ID, Role: store.PermissionRoleOwner},
+		{PrincipalType: store.PermissionPrincipalTypeUser, PrincipalID: editor.ID, Role: store.PermissionRoleEditor},
+	} {
+		permission.ResourceType = store.PermissionResourceTypeShortcut
+		permission.ResourceID = shortcut.Id
+		_, err := s.Store.UpsertPermission(ctx, permission)
+		require.NoError(t, err)
+	}
+	_, err = s.DeleteShortcut(creatorCtx, &v1pb.DeleteShortcutRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+
+	// Owners see the shortcuts they may restore, whoever created them.
+	for _, ownerCtx := range []context.Context{creatorCtx, coOwnerCtx, memberCtx} {
+		response, err := s.ListTrashedShortcuts(ownerCtx, &v1pb.ListTrashedShortcutsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(response.Shortcuts))
+		require.Equal(t, shortcut.Id, response.Shortcuts[0].Id)
+	}
+	response, err := s.ListTrashedShortcuts(editorCtx, &v1pb.ListTrashedShortcutsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Shortcuts))
+
+	_, err = s.RestoreShortcut(coOwnerCtx, &v1pb.RestoreShortcutRequest{Id: shortcut.Id})
+	require.NoError(t, err)
+	response, err = s.ListTrashedShortcuts(coOwnerCtx, &v1pb.ListTrashedShortcutsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Shortcuts))
+}
+
+func TestRestoreShortcutRevisionSharing(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingAPIV1Service(ctx, t)
+	creator, creatorCtx := createTestingUser(ctx, t, s.Store, "creator", store.RoleUser)
+	editor, editorCtx := createTestingUser(ctx, t, s.Store, "editor", store.RoleUser)
+	_, adminCtx := createTestingUser(ctx, t, s.Store, "admin", store.RoleAdmin)
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		CreatorID: creator.ID,
+		Name:      "oncall",
+	})
+	require.NoError(t, err)
+	shortcut, err := s.Store.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  creator.ID,
+		Name:       "runbook",
+		Link:       "https://runbook.example.com",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = s.Store.UpsertPermission(ctx, &store.Permission{
+		ResourceType:  store.PermissionResourceTypeShortcut,
+		ResourceID:    shortcut.Id,
+		PrincipalType: store.PermissionPrincipalTypeUser,
+		PrincipalID:   editor.ID,
+		Role:          store.PermissionRoleEditor,
+	})
+	require.NoError(t, err)
+	setVisibility := func(visibility v1pb.Visibility, groupIDs []int32) {
+		_, err := s.UpdateShortcut(creatorCtx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Id: shortcut.Id, Visibility: visibility, GroupIds: groupIDs},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility", "group_ids"}},
+		})
+		require.NoError(t, err)
+	}
+	findRevision := func(visibility storepb.Visibility) *store.ShortcutRevision {
+		revisions, err := s.Store.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+			ShortcutID: &shortcut.Id,
+		})
+		require.NoError(t, err)
+		for _, revision := range revisions {
+			if revision.Payload.Shortcut.Visibility == visibility {
+				return revision
+			}
+		}
+		require.FailNow(t, "revision not found")
+		return nil
+	}
+	setVisibility(v1pb.Visibility_WORKSPACE, nil)
+
+	// Editors can't change the sharing by restoring a revision.
+	private := findRevision(storepb.Visibility_PRIVATE)
+	_, err = s.RestoreShortcutRevision(editorCtx, &v1pb.RestoreShortcutRevisionRequest{ShortcutId: shortcut.Id, Id: private.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.RestoreShortcutRevision(creatorCtx, &v1pb.RestoreShortcutRevisionRequest{ShortcutId: shortcut.Id, Id: private.ID})
+	require.NoError(t, err)
+	current, err := s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_PRIVATE, current.Visibility)
+
+	// The groups of a restored revision must still exist.
+	setVisibility(v1pb.Visibility_GROUP, []int32{group.ID})
+	setVisibility(v1pb.Visibility_WORKSPACE, nil)
+	err = s.Store.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID})
+	require.NoError(t, err)
+	_, err = s.RestoreShortcutRevision(creatorCtx, &v1pb.RestoreShortcutRevisionRequest{ShortcutId: shortcut.Id, Id: findRevision(storepb.Visibility_GROUP).ID})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Nor can an editor's change request change the sharing once approved.
+	protected := true
+	shortcut, err = s.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		Protected: &protected,
+	})
+	require.NoError(t, err)
+	visibility := storepb.Visibility_PUBLIC
+	target := store.ApplyShortcutUpdate(shortcut, &store.UpdateShortcut{
+		ID:         shortcut.Id,
+		Visibility: &visibility,
+	})
+	changeRequest, err := s.Store.CreateShortcutChangeRequest(ctx, &store.ShortcutChangeRequest{
+		ShortcutID: shortcut.Id,
+		CreatorID:  editor.ID,
+		Payload: &storepb.ShortcutChangeRequestPayload{
+			Fields:             store.DiffShortcuts(shortcut, target),
+			Previous:           shortcut,
+			Shortcut:           target,
+			RestoredRevisionId: private.ID,
+		},
+	})
+	require.NoError(t, err)
+	_, err = s.ApproveShortcutChangeRequest(adminCtx, &v1pb.ApproveShortcutChangeRequestRequest{ShortcutId: shortcut.Id, Id: changeRequest.ID})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	current, err = s.Store.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id})
+	require.NoError(t, err)
+	require.Equal(t, storepb.Visibility_WORKSPACE, current.Visibility)
+}
@@ -124,13 +124,151 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	if user.ID == request.Id {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot delete yourself")
 	}
+	deletedUser, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if deletedUser == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	shortcuts, collections, err := s.listUserContent(ctx, deletedUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete := &store.DeleteUser{
+		ID: deletedUser.ID,
+	}
+	var transferGroup *store.Group
+	switch request.ContentDisposition {
+	case v1pb.DeleteUserRequest_CONTENT_DISPOSITION_UNSPECIFIED:
+		if len(shortcuts) > 0 || len(collections) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "content disposition is required for a user with shortcuts or collections")
+		}
+		delete.ContentDisposition = store.UserContentDelete
+	case v1pb.DeleteUserRequest_TRANSFER:
+		if (request.TransferUserId == 0) == (request.TransferGroupId == 0) {
+			return nil, status.Errorf(codes.InvalidArgument, "exactly one of transfer_user_id and transfer_group_id is required")
+		}
+		creatorID := request.TransferUserId
+		if request.TransferGroupId != 0 {
+			transferGroup, err = s.getGroup(ctx, request.TransferGroupId)
+			if err != nil {
+				return nil, err
+			}
+			creatorID = transferGroup.CreatorID
+		}
+		if creatorID == deletedUser.ID {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot transfer the content to the deleted user")
+		}
+		if _, err := s.getActiveUser(ctx, creatorID); err != nil {
+			return nil, err
+		}
+		delete.ContentDisposition = store.UserContentTransfer
+		delete.TransferCreatorID = creatorID
+		if transferGroup != nil {
+			delete.TransferGroupID = transferGroup.ID
+		}
+	case v1pb.DeleteUserRequest_ARCHIVE:
+		// Archived users can no longer sign in, but their shortcuts keep resolving.
+		rowStatus := storepb.RowStatus_ARCHIVED
+		if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+			ID:        deletedUser.ID,
+			RowStatus: &rowStatus,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to archive user: %v", err)
+		}
+		return &emptypb.Empty{}, nil
+	case v1pb.DeleteUserRequest_DELETE:
+		delete.ContentDisposition = store.UserContentDelete
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid content disposition %q", request.ContentDisposition)
+	}
 
-	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{ID: request.Id}); err != nil {
+	if delete.ContentDisposition == store.UserContentDelete {
+		// Groups are shared with their members, so they are transferred rather than deleted.
+		groups, err := s.Store.ListGroups(ctx, &store.FindGroup{
+			CreatorID: &deletedUser.ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+		}
+		if len(groups) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "the groups of the user must be transferred before it is deleted")
+		}
+	}
+
+	if err := s.Store.DeleteUser(ctx, delete); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) PreviewDeleteUser(ctx context.Context, request *v1pb.PreviewDeleteUserRequest) (*v1pb.PreviewDeleteUserResponse, error) {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &request.Id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	shortcuts, collections, err := s.listUserContent(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.Store.ListGroups(ctx, &store.FindGroup{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+
+	composedShortcuts, err := s.convertShortcutsFromStorepb(ctx, shortcuts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert shortcuts: %v", err)
+	}
+
+	response := &v1pb.PreviewDeleteUserResponse{
+		Shortcuts:   composedShortcuts,
+		Collections: []*v1pb.Collection{},
+		Groups:      []*v1pb.Group{},
+	}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, convertCollectionFromStore(collection))
+	}
+	for _, group := range groups {
+		convertedGroup, err := s.convertGroupFromStore(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		response.Groups = append(response.Groups, convertedGroup)
+	}
+	return response, nil
+}
+
+// listUserContent returns the shortcuts and collections created by the user, including the ones in the trash.
+func (s *APIV1Service) listUserContent(ctx context.Context, userID int32) ([]*storepb.Shortcut, []*storepb.Collection, error) {
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		CreatorID: &userID,
+		Trash:     store.TrashIncluded,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
+		CreatorID: &userID,
+		Trash:     store.TrashIncluded,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list collections: %v", err)
+	}
+	return shortcuts, collections, nil
+}
+
 func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.ListUserAccessTokensRequest) (*v1pb.ListUserAccessTokensResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
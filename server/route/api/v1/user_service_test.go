@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestDeleteUserOwningGroups(t *testing.T) {
+	ctx := context.Background()
+	s := newTestingAPIV1Service(ctx, t)
+	admin, adminCtx := createTestingUser(ctx, t, s.Store, "admin", store.RoleAdmin)
+	user, _ := createTestingUser(ctx, t, s.Store, "user", store.RoleUser)
+	group, err := s.Store.CreateGroup(ctx, &store.Group{
+		CreatorID: user.ID,
+		Name:      "oncall",
+	})
+	require.NoError(t, err)
+
+	// Groups are shared with their members, so they are not deleted with the user.
+	for _, disposition := range []v1pb.DeleteUserRequest_ContentDisposition{
+		v1pb.DeleteUserRequest_CONTENT_DISPOSITION_UNSPECIFIED,
+		v1pb.DeleteUserRequest_DELETE,
+	} {
+		_, err = s.DeleteUser(adminCtx, &v1pb.DeleteUserRequest{
+			Id:                 user.ID,
+			ContentDisposition: disposition,
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err), disposition)
+	}
+
+	_, err = s.DeleteUser(adminCtx, &v1pb.DeleteUserRequest{
+		Id:                 user.ID,
+		ContentDisposition: v1pb.DeleteUserRequest_TRANSFER,
+		TransferUserId:     admin.ID,
+	})
+	require.NoError(t, err)
+	group, err = s.Store.GetGroup(ctx, &store.FindGroup{ID: &group.ID})
+	require.NoError(t, err)
+	require.NotNil(t, group)
+	require.Equal(t, admin.ID, group.CreatorID)
+}
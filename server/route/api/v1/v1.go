@@ -16,7 +16,9 @@ import (
 
 	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
 	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/common"
 	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/server/service/ingestion"
 	"github.com/bshort/monotreme/server/service/license"
 	"github.com/bshort/monotreme/store"
 )
@@ -30,17 +32,20 @@ type APIV1Service struct {
 	v1pb.UnimplementedShortcutServiceServer
 	v1pb.UnimplementedCollectionServiceServer
 	v1pb.UnimplementedActivityServiceServer
+	v1pb.UnimplementedGroupServiceServer
+	v1pb.UnimplementedNamespaceServiceServer
 
-	Secret         string
-	Profile        *profile.Profile
-	Store          *store.Store
-	LicenseService *license.LicenseService
+	Secret           string
+	Profile          *profile.Profile
+	Store            *store.Store
+	LicenseService   *license.LicenseService
+	IngestionService *ingestion.IngestionService
 
 	grpcServer     *grpc.Server
 	grpcServerPort int
 }
 
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, grpcServerPort int) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, licenseService *license.LicenseService, ingestionService *ingestion.IngestionService, grpcServerPort int) *APIV1Service {
 	authProvider := NewGRPCAuthInterceptor(store, secret)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
@@ -49,12 +54,13 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 		),
 	)
 	apiV1Service := &APIV1Service{
-		Secret:         secret,
-		Profile:        profile,
-		Store:          store,
-		LicenseService: licenseService,
-		grpcServer:     grpcServer,
-		grpcServerPort: grpcServerPort,
+		Secret:           secret,
+		Profile:          profile,
+		Store:            store,
+		LicenseService:   licenseService,
+		IngestionService: ingestionService,
+		grpcServer:       grpcServer,
+		grpcServerPort:   grpcServerPort,
 	}
 
 	v1pb.RegisterSubscriptionServiceServer(grpcServer, apiV1Service)
@@ -65,6 +71,8 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterCollectionServiceServer(grpcServer, apiV1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterGroupServiceServer(grpcServer, apiV1Service)
+	v1pb.RegisterNamespaceServiceServer(grpcServer, apiV1Service)
 	reflection.Register(grpcServer)
 
 	return apiV1Service
@@ -111,6 +119,12 @@ func (s *APIV1Service) RegisterGateway(_ context.Context, e *echo.Echo) error {
 	if err := v1pb.RegisterActivityServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterGroupServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterNamespaceServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
 	e.Any("/api/v1/*", echo.WrapHandler(gwMux))
 
 	// Add QR code endpoint
@@ -156,6 +170,13 @@ func (s *APIV1Service) handleQRCodeEndpoint(c echo.Context) error {
 	if user == nil && shortcut.Visibility != storepb.Visibility_PUBLIC {
 		return c.JSON(http.StatusForbidden, map[string]string{"error": "Access denied"})
 	}
+	visible, err := s.Store.IsVisibleTo(c.Request().Context(), shortcut, getViewerID(user))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to check shortcut visibility"})
+	}
+	if !visible {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Shortcut not found"})
+	}
 
 	// Build the shortcut URL
 	scheme := "https"
@@ -165,8 +186,16 @@ func (s *APIV1Service) handleQRCodeEndpoint(c echo.Context) error {
 		scheme = "http"
 	}
 
-	host := c.Request().Host
-	shortcutURL := fmt.Sprintf("%s://%s/s/%s", scheme, host, shortcut.Name)
+	// Printed codes outlive prefix changes best when they point at the instance URL.
+	baseURL, err := s.Store.GetBaseURL(c.Request().Context(), fmt.Sprintf("%s://%s", scheme, c.Request().Host))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get instance URL"})
+	}
+	prefixes, err := s.Store.GetURLPrefixes(c.Request().Context())
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to get shortcut prefix"})
+	}
+	shortcutURL := common.ShortcutURL(baseURL, prefixes.Shortcut, shortcut.Name)
 
 	// Generate QR code
 	qrCode, err := qrcode.Encode(shortcutURL, qrcode.Medium, 256)
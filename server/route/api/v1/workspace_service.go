@@ -1,8 +1,13 @@
 package v1
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -10,9 +15,12 @@ import (
 
 	v1pb "github.com/bshort/monotreme/proto/gen/api/v1"
 	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/common"
 	"github.com/bshort/monotreme/store"
 )
 
+var urlPrefixPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorkspaceProfileRequest) (*v1pb.WorkspaceProfile, error) {
 	workspaceProfile := &v1pb.WorkspaceProfile{
 		Mode:    s.Profile.Mode,
@@ -55,6 +63,7 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 			generalSetting := v.GetGeneral()
 			workspaceSetting.Branding = generalSetting.GetBranding()
 			workspaceSetting.CustomStyle = generalSetting.GetCustomStyle()
+			workspaceSetting.InstanceUrl = generalSetting.GetInstanceUrl()
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SECURITY {
 			securitySetting := v.GetSecurity()
 			workspaceSetting.DisallowUserRegistration = securitySetting.GetDisallowUserRegistration()
@@ -63,9 +72,18 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 			shortcutRelatedSetting := v.GetShortcutRelated()
 			workspaceSetting.DefaultVisibility = convertVisibilityFromStorepb(shortcutRelatedSetting.GetDefaultVisibility())
 			workspaceSetting.ShortcutPrefix = shortcutRelatedSetting.GetShortcutPrefix()
-			// Set default if empty
-			if workspaceSetting.ShortcutPrefix == "" {
-				workspaceSetting.ShortcutPrefix = "s"
+			workspaceSetting.DefaultRedirectMode = convertRedirectModeFromStorepb(shortcutRelatedSetting.GetDefaultRedirectMode())
+			workspaceSetting.ShortcutPendingMessage = shortcutRelatedSetting.GetPendingMessage()
+			workspaceSetting.ShortcutExpiredMessage = shortcutRelatedSetting.GetExpiredMessage()
+			workspaceSetting.ExpiredShortcutArchiveDays = shortcutRelatedSetting.GetExpiredArchiveDays()
+			workspaceSetting.TrashRetentionDays = shortcutRelatedSetting.GetTrashRetentionDays()
+			workspaceSetting.AutoRedirectNotFound = shortcutRelatedSetting.GetAutoRedirectNotFound()
+			workspaceSetting.RedirectHosts = shortcutRelatedSetting.GetRedirectHosts()
+			workspaceSetting.LegacyShortcutPrefixes = shortcutRelatedSetting.GetLegacyShortcutPrefixes()
+			workspaceSetting.CollectionPrefix = shortcutRelatedSetting.GetCollectionPrefix()
+			workspaceSetting.LegacyCollectionPrefixes = shortcutRelatedSetting.GetLegacyCollectionPrefixes()
+			if shortcutRelatedSetting.GetNamePolicy() != nil {
+				workspaceSetting.ShortcutNamePolicy = convertShortcutNamePolicyFromStorepb(shortcutRelatedSetting.GetNamePolicy())
 			}
 		} else if v.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_IDENTITY_PROVIDER {
 			identityProviderSetting := v.GetIdentityProvider()
@@ -82,6 +100,16 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, _ *v1pb.GetWorks
 			}
 		}
 	}
+	// Set defaults if empty
+	if workspaceSetting.ShortcutPrefix == "" {
+		workspaceSetting.ShortcutPrefix = store.DefaultShortcutPrefix
+	}
+	if workspaceSetting.CollectionPrefix == "" {
+		workspaceSetting.CollectionPrefix = store.DefaultCollectionPrefix
+	}
+	if workspaceSetting.ShortcutNamePolicy == nil {
+		workspaceSetting.ShortcutNamePolicy = convertShortcutNamePolicyFromStorepb(store.DefaultShortcutNamePolicy)
+	}
 	return workspaceSetting, nil
 }
 
@@ -105,6 +133,26 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+		} else if path == "instance_url" {
+			instanceURL := strings.TrimSpace(request.Setting.InstanceUrl)
+			if instanceURL != "" {
+				if u, err := url.Parse(instanceURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid instance url %q", instanceURL)
+				}
+			}
+			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			generalSetting.InstanceUrl = instanceURL
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_GENERAL,
+				Value: &storepb.WorkspaceSetting_General{
+					General: generalSetting,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
 		} else if path == "custom_style" {
 			generalSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
 			if err != nil {
@@ -120,6 +168,10 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
 		} else if path == "default_visibility" {
+			// New shortcuts and collections are not shared with any group by default.
+			if request.Setting.DefaultVisibility == v1pb.Visibility_GROUP {
+				return nil, status.Errorf(codes.InvalidArgument, "group visibility cannot be the default")
+			}
 			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
 			})
@@ -143,7 +195,32 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
-		} else if path == "shortcut_prefix" {
+		} else if path == "default_redirect_mode" {
+			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+			}
+			if shortcutRelatedSetting == nil {
+				shortcutRelatedSetting = &storepb.WorkspaceSetting{
+					Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+					Value: &storepb.WorkspaceSetting_ShortcutRelated{
+						ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{},
+					},
+				}
+			}
+			shortcutRelatedSetting.GetShortcutRelated().DefaultRedirectMode = convertRedirectModeToStorepb(request.Setting.DefaultRedirectMode)
+			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+				Value: &storepb.WorkspaceSetting_ShortcutRelated{
+					ShortcutRelated: shortcutRelatedSetting.GetShortcutRelated(),
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
+			}
+		} else if path == "shortcut_pending_message" || path == "shortcut_expired_message" || path == "expired_shortcut_archive_days" || path == "auto_redirect_not_found" || path == "shortcut_name_policy" || path == "redirect_hosts" ||
+			path == "shortcut_prefix" || path == "legacy_shortcut_prefixes" || path == "collection_prefix" || path == "legacy_collection_prefixes" || path == "trash_retention_days" {
 			shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
 			})
@@ -158,7 +235,59 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 					},
 				}
 			}
-			shortcutRelatedSetting.GetShortcutRelated().ShortcutPrefix = request.Setting.ShortcutPrefix
+			switch path {
+			case "shortcut_pending_message":
+				shortcutRelatedSetting.GetShortcutRelated().PendingMessage = request.Setting.ShortcutPendingMessage
+			case "shortcut_expired_message":
+				shortcutRelatedSetting.GetShortcutRelated().ExpiredMessage = request.Setting.ShortcutExpiredMessage
+			case "expired_shortcut_archive_days":
+				if request.Setting.ExpiredShortcutArchiveDays < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "expired shortcut archive days must not be negative")
+				}
+				shortcutRelatedSetting.GetShortcutRelated().ExpiredArchiveDays = request.Setting.ExpiredShortcutArchiveDays
+			case "trash_retention_days":
+				if request.Setting.TrashRetentionDays < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "trash retention days must not be negative")
+				}
+				shortcutRelatedSetting.GetShortcutRelated().TrashRetentionDays = request.Setting.TrashRetentionDays
+			case "auto_redirect_not_found":
+				shortcutRelatedSetting.GetShortcutRelated().AutoRedirectNotFound = request.Setting.AutoRedirectNotFound
+			case "shortcut_name_policy":
+				namePolicy := convertShortcutNamePolicyToStorepb(request.Setting.ShortcutNamePolicy)
+				collisions, err := s.Store.FindShortcutNameCollisions(ctx, namePolicy)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to find shortcut name collisions: %v", err)
+				}
+				if len(collisions) > 0 {
+					return nil, status.Errorf(codes.FailedPrecondition, "shortcut names would collide under the name policy: %s", formatShortcutNameCollisions(collisions))
+				}
+				shortcutRelatedSetting.GetShortcutRelated().NamePolicy = namePolicy
+			case "redirect_hosts":
+				redirectHosts, err := normalizeRedirectHosts(request.Setting.RedirectHosts)
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid redirect hosts: %v", err)
+				}
+				shortcutRelatedSetting.GetShortcutRelated().RedirectHosts = redirectHosts
+			case "shortcut_prefix":
+				setting := shortcutRelatedSetting.GetShortcutRelated()
+				prefix := cmp.Or(strings.TrimSpace(request.Setting.ShortcutPrefix), store.DefaultShortcutPrefix)
+				// Keep links with the old prefix working.
+				setting.LegacyShortcutPrefixes = replaceCanonicalPrefix(setting.LegacyShortcutPrefixes, cmp.Or(setting.ShortcutPrefix, store.DefaultShortcutPrefix), prefix)
+				setting.ShortcutPrefix = prefix
+			case "legacy_shortcut_prefixes":
+				shortcutRelatedSetting.GetShortcutRelated().LegacyShortcutPrefixes = trimPrefixes(request.Setting.LegacyShortcutPrefixes)
+			case "collection_prefix":
+				setting := shortcutRelatedSetting.GetShortcutRelated()
+				prefix := cmp.Or(strings.TrimSpace(request.Setting.CollectionPrefix), store.DefaultCollectionPrefix)
+				// Keep links with the old prefix working.
+				setting.LegacyCollectionPrefixes = replaceCanonicalPrefix(setting.LegacyCollectionPrefixes, cmp.Or(setting.CollectionPrefix, store.DefaultCollectionPrefix), prefix)
+				setting.CollectionPrefix = prefix
+			case "legacy_collection_prefixes":
+				shortcutRelatedSetting.GetShortcutRelated().LegacyCollectionPrefixes = trimPrefixes(request.Setting.LegacyCollectionPrefixes)
+			}
+			if err := validateURLPrefixes(shortcutRelatedSetting.GetShortcutRelated()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid prefixes: %v", err)
+			}
 			if _, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
 				Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
 				Value: &storepb.WorkspaceSetting_ShortcutRelated{
@@ -167,6 +296,11 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to update workspace setting: %v", err)
 			}
+			if path == "shortcut_name_policy" {
+				if _, err := s.Store.NormalizeShortcutNames(ctx); err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to normalize shortcut names: %v", err)
+				}
+			}
 		} else if path == "identity_providers" {
 			identityProviderSetting := &storepb.WorkspaceSetting_IdentityProviderSetting{}
 			for _, identityProvider := range request.Setting.IdentityProviders {
@@ -244,7 +378,7 @@ func (s *APIV1Service) GetWorkspaceStats(ctx context.Context, _ *v1pb.GetWorkspa
 	}
 	totalCollections := int32(len(collections))
 
-	// Get total hits count (shortcut views)
+	// Get total hits count (shortcut views), which leaves out bot views.
 	activities, err := s.Store.ListActivities(ctx, &store.FindActivity{
 		Type: store.ActivityShortcutView,
 	})
@@ -285,6 +419,30 @@ func (s *APIV1Service) GetWorkspaceStats(ctx context.Context, _ *v1pb.GetWorkspa
 	}, nil
 }
 
+func (s *APIV1Service) GetWorkspaceRuntimeStats(_ context.Context, _ *v1pb.GetWorkspaceRuntimeStatsRequest) (*v1pb.WorkspaceRuntimeStats, error) {
+	resolverCacheStats := s.Store.GetResolverCacheStats()
+	ingestionStats := s.IngestionService.Stats()
+	return &v1pb.WorkspaceRuntimeStats{
+		ResolverCache: &v1pb.ResolverCacheStats{
+			Capacity:     int32(resolverCacheStats.Capacity),
+			Entries:      int32(resolverCacheStats.Entries),
+			Hits:         int64(resolverCacheStats.Hits),
+			NegativeHits: int64(resolverCacheStats.NegativeHits),
+			Misses:       int64(resolverCacheStats.Misses),
+			Evictions:    int64(resolverCacheStats.Evictions),
+		},
+		ActivityIngestion: &v1pb.ActivityIngestionStats{
+			Policy:   string(ingestionStats.Policy),
+			Capacity: int32(ingestionStats.Capacity),
+			Buffered: int32(ingestionStats.Buffered),
+			Enqueued: int64(ingestionStats.Enqueued),
+			Written:  int64(ingestionStats.Written),
+			Dropped:  int64(ingestionStats.Dropped),
+			Failed:   int64(ingestionStats.Failed),
+		},
+	}, nil
+}
+
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
 	if ownerCache != nil {
 		return ownerCache, nil
@@ -305,6 +463,101 @@ func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error)
 	return ownerCache, nil
 }
 
+// replaceCanonicalPrefix returns the legacy prefixes after the canonical prefix changed
+// from oldPrefix to newPrefix: the old one becomes a legacy prefix, the new one no longer is.
+func replaceCanonicalPrefix(legacyPrefixes []string, oldPrefix string, newPrefix string) []string {
+	legacyPrefixes = slices.DeleteFunc(slices.Clone(legacyPrefixes), func(prefix string) bool { return prefix == newPrefix })
+	if oldPrefix != newPrefix && !slices.Contains(legacyPrefixes, oldPrefix) {
+		legacyPrefixes = append(legacyPrefixes, oldPrefix)
+	}
+	return legacyPrefixes
+}
+
+// trimPrefixes trims the prefixes and drops empty ones and duplicates.
+func trimPrefixes(prefixes []string) []string {
+	trimmed := []string{}
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSpace(prefix)
+		if prefix != "" && !slices.Contains(trimmed, prefix) {
+			trimmed = append(trimmed, prefix)
+		}
+	}
+	return trimmed
+}
+
+// validateURLPrefixes checks that every shortcut and collection prefix, canonical or
+// legacy, is a single path segment that is used once and is not an app route.
+func validateURLPrefixes(setting *storepb.WorkspaceSetting_ShortcutRelatedSetting) error {
+	prefixes := []string{cmp.Or(setting.ShortcutPrefix, store.DefaultShortcutPrefix), cmp.Or(setting.CollectionPrefix, store.DefaultCollectionPrefix)}
+	prefixes = append(prefixes, setting.LegacyShortcutPrefixes...)
+	prefixes = append(prefixes, setting.LegacyCollectionPrefixes...)
+	seen := map[string]bool{}
+	for _, prefix := range prefixes {
+		if !urlPrefixPattern.MatchString(prefix) {
+			return errors.Errorf("prefix %q can only contain letters, numbers, hyphens and underscores", prefix)
+		}
+		if slices.Contains(common.ReservedPaths, strings.ToLower(prefix)) {
+			return errors.Errorf("prefix %q conflicts with an existing route", prefix)
+		}
+		if seen[prefix] {
+			return errors.Errorf("prefix %q is used more than once", prefix)
+		}
+		seen[prefix] = true
+	}
+	return nil
+}
+
+// normalizeRedirectHosts lowercases the hosts and drops duplicates. A host is a name like
+// "go" or "go.example.com", optionally with a port, but without a scheme or a path.
+func normalizeRedirectHosts(hosts []string) ([]string, error) {
+	redirectHosts := []string{}
+	for _, host := range hosts {
+		host = strings.ToLower(strings.TrimSpace(host))
+		if host == "" {
+			continue
+		}
+		if u, err := url.Parse("//" + host); err != nil || u.Host != host || u.User != nil {
+			return nil, errors.Errorf("%q is not a host", host)
+		}
+		if !slices.Contains(redirectHosts, host) {
+			redirectHosts = append(redirectHosts, host)
+		}
+	}
+	return redirectHosts, nil
+}
+
+func convertShortcutNamePolicyFromStorepb(policy *storepb.ShortcutNamePolicy) *v1pb.ShortcutNamePolicy {
+	return &v1pb.ShortcutNamePolicy{
+		CaseInsensitive:      policy.CaseInsensitive,
+		UnicodeNormalization: policy.UnicodeNormalization,
+		EquivalentSeparators: policy.EquivalentSeparators,
+	}
+}
+
+func convertShortcutNamePolicyToStorepb(policy *v1pb.ShortcutNamePolicy) *storepb.ShortcutNamePolicy {
+	return &storepb.ShortcutNamePolicy{
+		CaseInsensitive:      policy.GetCaseInsensitive(),
+		UnicodeNormalization: policy.GetUnicodeNormalization(),
+		EquivalentSeparators: policy.GetEquivalentSeparators(),
+	}
+}
+
+// formatShortcutNameCollisions lists the names of each collision, like "Docs, docs; a_b, a-b".
+func formatShortcutNameCollisions(collisions []*store.ShortcutNameCollision) string {
+	groups := []string{}
+	for _, collision := range collisions {
+		names := []string{}
+		for _, shortcut := range collision.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		for _, alias := range collision.Aliases {
+			names = append(names, alias.Name)
+		}
+		groups = append(groups, strings.Join(names, ", "))
+	}
+	return strings.Join(groups, "; ")
+}
+
 func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider) *v1pb.IdentityProvider {
 	if identityProvider == nil {
 		return nil
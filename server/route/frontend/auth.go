@@ -0,0 +1,222 @@
+package frontend
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bshort/monotreme/internal/util"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+const (
+	// Constants from auth.go for JWT token validation
+	KeyID                   = "v1"
+	AccessTokenAudienceName = "user.access-token"
+	AccessTokenCookieName   = "monotreme.access-token"
+
+	// signInPath is the frontend route of the sign-in page.
+	signInPath = "/auth"
+	// continuePath is the endpoint that bounces a signed-in visitor back to the page they came from.
+	continuePath = "/auth/continue"
+	// redirectQueryKey is the query parameter carrying the signed redirect target.
+	redirectQueryKey = "redirect"
+	// redirectTokenTTL is how long a signed redirect target stays valid.
+	redirectTokenTTL = time.Hour
+)
+
+// ClaimsMessage represents JWT claims
+type ClaimsMessage struct {
+	Name string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// getAccessToken extracts the access token from the bearer authorization header or the session cookie.
+func getAccessToken(request *http.Request) (string, error) {
+	if authorizationHeader := request.Header.Get("Authorization"); authorizationHeader != "" {
+		authHeaderParts := strings.Fields(authorizationHeader)
+		if len(authHeaderParts) != 2 || strings.ToLower(authHeaderParts[0]) != "bearer" {
+			return "", errors.Errorf("authorization header format must be Bearer {token}")
+		}
+		return authHeaderParts[1], nil
+	}
+	if cookie, _ := request.Cookie(AccessTokenCookieName); cookie != nil {
+		return cookie.Value, nil
+	}
+	return "", nil
+}
+
+// getCurrentUser returns the user signed in on the request, or nil for anonymous visitors.
+func (s *FrontendService) getCurrentUser(ctx context.Context, request *http.Request) (*store.User, error) {
+	accessToken, err := getAccessToken(request)
+	if err != nil {
+		return nil, err
+	}
+	if accessToken == "" {
+		return nil, nil
+	}
+	return s.authenticateUser(ctx, accessToken)
+}
+
+// getViewerID returns the id of the user signed in on the request, or 0 for anonymous
+// visitors, to filter what the public pages show.
+func (s *FrontendService) getViewerID(c echo.Context) int32 {
+	user, err := s.getCurrentUser(c.Request().Context(), c.Request())
+	if err != nil {
+		slog.Warn("failed to authenticate visitor", slog.String("error", err.Error()))
+	}
+	if user == nil {
+		return 0
+	}
+	return user.ID
+}
+
+func (s *FrontendService) authenticateUser(ctx context.Context, accessToken string) (*store.User, error) {
+	claims := &ClaimsMessage{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		if kid, ok := t.Header["kid"].(string); ok {
+			if kid == KeyID {
+				return []byte(s.Secret), nil
+			}
+		}
+		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid or expired access token")
+	}
+
+	audienceValid := false
+	for _, audience := range claims.Audience {
+		if audience == AccessTokenAudienceName {
+			audienceValid = true
+			break
+		}
+	}
+	if !audienceValid {
+		return nil, errors.Errorf("invalid access token audience")
+	}
+
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed user ID in access token: %s", claims.Subject)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find user with ID: %d", userID)
+	}
+	if user == nil {
+		return nil, errors.Errorf("user not found with ID: %d", userID)
+	}
+	if user.RowStatus == storepb.RowStatus_ARCHIVED {
+		return nil, errors.Errorf("user account has been deactivated")
+	}
+
+	accessTokens, err := s.Store.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user access tokens")
+	}
+	for _, userAccessToken := range accessTokens {
+		if userAccessToken.AccessToken == accessToken {
+			return user, nil
+		}
+	}
+	return nil, errors.New("access token not found in user's token list")
+}
+
+// signRedirect returns a tamper-proof token for a local path, so the sign-in page can
+// only ever send the visitor back to a location the server chose. The token expires after redirectTokenTTL.
+func (s *FrontendService) signRedirect(path string) string {
+	return s.signRedirectUntil(path, time.Now().Add(redirectTokenTTL))
+}
+
+func (s *FrontendService) signRedirectUntil(path string, expiresAt time.Time) string {
+	encodedPath := base64.RawURLEncoding.EncodeToString([]byte(path))
+	expiresTs := strconv.FormatInt(expiresAt.Unix(), 10)
+	return encodedPath + "." + expiresTs + "." + base64.RawURLEncoding.EncodeToString(s.redirectSignature(encodedPath, expiresTs))
+}
+
+func (s *FrontendService) redirectSignature(encodedPath, expiresTs string) []byte {
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write([]byte(encodedPath + "." + expiresTs))
+	return mac.Sum(nil)
+}
+
+// verifyRedirect returns the path carried by an unexpired token created with signRedirect.
+func (s *FrontendService) verifyRedirect(token string) (string, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", false
+	}
+	encodedPath, expiresTs, encodedSignature := parts[0], parts[1], parts[2]
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return "", false
+	}
+	if !hmac.Equal(signature, s.redirectSignature(encodedPath, expiresTs)) {
+		return "", false
+	}
+	expiresAt, err := strconv.ParseInt(expiresTs, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return "", false
+	}
+	path, err := base64.RawURLEncoding.DecodeString(encodedPath)
+	if err != nil {
+		return "", false
+	}
+	if !isLocalPath(string(path)) {
+		return "", false
+	}
+	return string(path), true
+}
+
+// isLocalPath reports whether path stays on the current origin. Browsers treat a backslash like a
+// slash and drop tabs and newlines, so "/\evil.com" and "/\t/evil.com" both mean "//evil.com".
+func isLocalPath(path string) bool {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return false
+	}
+	return !strings.ContainsFunc(path, func(r rune) bool {
+		return r == '\\' || unicode.IsControl(r)
+	})
+}
+
+// redirectToSignIn sends an anonymous visitor to the sign-in page and asks it to return to the current URL afterwards.
+func (s *FrontendService) redirectToSignIn(c echo.Context) error {
+	// A redirect host does not share the session of the main host, so the visitor signs
+	// in there and comes back to the prefixed path.
+	if ctx := c.Request().Context(); s.isRedirectHost(ctx, c.Request().Host) {
+		if instanceURL := s.getInstanceURL(ctx); instanceURL != "" {
+			return c.Redirect(http.StatusFound, instanceURL+s.getPrefixedRequestURI(c))
+		}
+	}
+	target := c.Request().URL.RequestURI()
+	signInURL := signInPath + "?" + url.Values{redirectQueryKey: {s.signRedirect(target)}}.Encode()
+	return c.Redirect(http.StatusFound, signInURL)
+}
+
+// handleContinue handles GET /auth/continue?redirect={token} after the visitor has signed in.
+func (s *FrontendService) handleContinue(c echo.Context) error {
+	target, ok := s.verifyRedirect(c.QueryParam(redirectQueryKey))
+	if !ok {
+		return c.Redirect(http.StatusFound, "/")
+	}
+	return c.Redirect(http.StatusFound, target)
+}
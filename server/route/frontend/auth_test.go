@@ -0,0 +1,90 @@
+package frontend
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsLocalPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/", want: true},
+		{path: "/s/docs", want: true},
+		{path: "/s/docs?q=a%2Fb#top", want: true},
+		{path: "", want: false},
+		{path: "s/docs", want: false},
+		{path: "//evil.com", want: false},
+		{path: "///evil.com", want: false},
+		{path: "/\\evil.com", want: false},
+		{path: "/\t/evil.com", want: false},
+		{path: "/\n/evil.com", want: false},
+		{path: "https://evil.com", want: false},
+		{path: "javascript:alert(1)", want: false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, isLocalPath(test.path), "path %q", test.path)
+	}
+}
+
+func TestVerifyRedirect(t *testing.T) {
+	s := &FrontendService{Secret: "secret"}
+	token := s.signRedirect("/s/docs?q=1")
+
+	tamperedPath := strings.Join(append([]string{base64.RawURLEncoding.EncodeToString([]byte("/s/admin"))}, strings.Split(token, ".")[1:]...), ".")
+	tamperedExpiry := strings.Split(token, ".")
+	tamperedExpiry[1] = "99999999999"
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+		ok    bool
+	}{
+		{name: "round trip", token: token, want: "/s/docs?q=1", ok: true},
+		{name: "other secret", token: (&FrontendService{Secret: "other"}).signRedirect("/s/docs"), ok: false},
+		{name: "tampered path", token: tamperedPath, ok: false},
+		{name: "tampered expiry", token: strings.Join(tamperedExpiry, "."), ok: false},
+		{name: "expired", token: s.signRedirectUntil("/s/docs", time.Now().Add(-time.Minute)), ok: false},
+		{name: "protocol relative", token: s.signRedirect("//evil.com"), ok: false},
+		{name: "backslash", token: s.signRedirect("/\\evil.com"), ok: false},
+		{name: "absolute", token: s.signRedirect("https://evil.com/"), ok: false},
+		{name: "unsigned", token: "/s/docs", ok: false},
+		{name: "empty", token: "", ok: false},
+	}
+	for _, test := range tests {
+		path, ok := s.verifyRedirect(test.token)
+		require.Equal(t, test.ok, ok, test.name)
+		require.Equal(t, test.want, path, test.name)
+	}
+}
+
+func TestHandleContinue(t *testing.T) {
+	s := &FrontendService{Secret: "secret"}
+	tests := []struct {
+		name     string
+		token    string
+		location string
+	}{
+		{name: "signed", token: s.signRedirect("/s/docs"), location: "/s/docs"},
+		{name: "unsigned absolute", token: "https://evil.com", location: "/"},
+		{name: "signed protocol relative", token: s.signRedirect("//evil.com"), location: "/"},
+		{name: "expired", token: s.signRedirectUntil("/s/docs", time.Now().Add(-time.Second)), location: "/"},
+	}
+	for _, test := range tests {
+		request := httptest.NewRequest(http.MethodGet, continuePath+"?"+url.Values{redirectQueryKey: {test.token}}.Encode(), nil)
+		recorder := httptest.NewRecorder()
+		require.NoError(t, s.handleContinue(echo.New().NewContext(request, recorder)), test.name)
+		require.Equal(t, http.StatusFound, recorder.Code, test.name)
+		require.Equal(t, test.location, recorder.Header().Get("Location"), test.name)
+	}
+}
@@ -0,0 +1,84 @@
+package frontend
+
+import (
+	"html"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bshort/monotreme/internal/botdetect"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/common"
+	"github.com/bshort/monotreme/server/profile"
+)
+
+// botSignaturesFile is an optional signature list in the data directory. Its signatures
+// are tried before the embedded ones, so that they can be added or overridden without
+// a new release.
+const botSignaturesFile = "bot_signatures.txt"
+
+func newBotDetector(profile *profile.Profile) *botdetect.Detector {
+	signatures := botdetect.DefaultSignatures()
+	if profile.Data == "" {
+		return botdetect.NewDetector(signatures)
+	}
+	path := filepath.Join(profile.Data, botSignaturesFile)
+	file, err := os.Open(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Warn("failed to open bot signatures", slog.String("path", path), slog.String("error", err.Error()))
+		}
+		return botdetect.NewDetector(signatures)
+	}
+	defer file.Close()
+	customSignatures, err := botdetect.ParseSignatures(file)
+	if err != nil {
+		slog.Warn("failed to parse bot signatures", slog.String("path", path), slog.String("error", err.Error()))
+		return botdetect.NewDetector(signatures)
+	}
+	return botdetect.NewDetector(append(customSignatures, signatures...))
+}
+
+// detectBot returns the signature of the bot that sent request, or nil for other clients.
+func (s *FrontendService) detectBot(request *http.Request) *botdetect.Signature {
+	return s.botDetector.Detect(request.Header.Get("User-Agent"))
+}
+
+// getAbsoluteShortcutURL returns the canonical URL of the shortcut, on the request host
+// if the workspace has not set an instance URL.
+func (s *FrontendService) getAbsoluteShortcutURL(c echo.Context, name string) string {
+	ctx := c.Request().Context()
+	baseURL := s.getInstanceURL(ctx)
+	if baseURL == "" {
+		baseURL = c.Scheme() + "://" + c.Request().Host
+	}
+	return common.ShortcutURL(baseURL, s.getURLPrefixes(ctx).Shortcut, name)
+}
+
+// generateUnfurlHTML returns the preview page served to link unfurlers in place of a
+// redirect. It carries the OpenGraph tags of the shortcut and never reveals its link.
+func generateUnfurlHTML(shortcut *storepb.Shortcut, shortcutURL string) string {
+	metadata := generateShortcutMetadata(shortcut)
+	if metadata.Title == "" {
+		metadata.Title = shortcut.Name
+	}
+	escapedURL := html.EscapeString(shortcutURL)
+	return `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="robots" content="noindex">
+    ` + metadata.String() + `
+    <meta property="og:url" content="` + escapedURL + `" />
+    <link rel="canonical" href="` + escapedURL + `" />
+</head>
+<body>
+    <h1>` + html.EscapeString(metadata.Title) + `</h1>
+    <p>` + html.EscapeString(metadata.Description) + `</p>
+    <p><a href="` + escapedURL + `">` + escapedURL + `</a></p>
+</body>
+</html>`
+}
@@ -0,0 +1,44 @@
+package frontend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bshort/monotreme/internal/botdetect"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/profile"
+)
+
+func TestNewBotDetector(t *testing.T) {
+	data := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(data, botSignaturesFile), []byte("crawler acme-indexer\ncrawler Slackbot\n"), 0644))
+	detector := newBotDetector(&profile.Profile{Data: data})
+
+	// Custom signatures are added and take precedence over the embedded ones.
+	require.Equal(t, botdetect.KindCrawler, detector.Detect("acme-indexer/1.0").Kind)
+	require.Equal(t, botdetect.KindCrawler, detector.Detect("Slackbot-LinkExpanding 1.0").Kind)
+	require.Equal(t, botdetect.KindUnfurl, detector.Detect("facebookexternalhit/1.1").Kind)
+}
+
+func TestGenerateUnfurlHTML(t *testing.T) {
+	shortcut := &storepb.Shortcut{
+		Name:  "docs",
+		Link:  "https://secret.example.com/docs",
+		Title: "Team <docs>",
+		OgMetadata: &storepb.OpenGraphMetadata{
+			Description: "Everything we wrote down",
+		},
+	}
+	page := generateUnfurlHTML(shortcut, "https://go.example.com/s/docs")
+	require.Contains(t, page, `<meta property="og:title" content="Team &lt;docs&gt;" />`)
+	require.Contains(t, page, `<meta property="og:description" content="Everything we wrote down" />`)
+	require.Contains(t, page, `<meta property="og:url" content="https://go.example.com/s/docs" />`)
+	require.NotContains(t, page, shortcut.Link)
+
+	// Shortcuts without a title are previewed by name.
+	page = generateUnfurlHTML(&storepb.Shortcut{Name: "docs"}, "https://go.example.com/s/docs")
+	require.Contains(t, page, `<meta property="og:title" content="docs" />`)
+}
@@ -10,16 +10,21 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/bshort/monotreme/internal/botdetect"
+	"github.com/bshort/monotreme/internal/routing"
 	"github.com/bshort/monotreme/internal/util"
 	storepb "github.com/bshort/monotreme/proto/gen/store"
 	"github.com/bshort/monotreme/server/common"
 	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/server/service/ingestion"
 	"github.com/bshort/monotreme/store"
 )
 
@@ -33,27 +38,43 @@ const (
 type FrontendService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+	Secret  string
+
+	IngestionService *ingestion.IngestionService
+
+	variantBalancer *variantBalancer
+	botDetector     *botdetect.Detector
 }
 
-func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendService {
+func NewFrontendService(profile *profile.Profile, store *store.Store, secret string, ingestionService *ingestion.IngestionService) *FrontendService {
 	return &FrontendService{
 		Profile: profile,
 		Store:   store,
+		Secret:  secret,
+
+		IngestionService: ingestionService,
+
+		variantBalancer: newVariantBalancer(),
+		botDetector:     newBotDetector(profile),
 	}
 }
 
 func (s *FrontendService) getShortcutPrefix(ctx context.Context) string {
-	shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
-		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
-	})
-	if err != nil || shortcutRelatedSetting == nil {
-		return "s" // Default fallback
-	}
-	prefix := shortcutRelatedSetting.GetShortcutRelated().GetShortcutPrefix()
-	if prefix == "" {
-		return "s" // Default fallback
+	return s.getURLPrefixes(ctx).Shortcut
+}
+
+// getURLPrefixes returns the shortcut and collection prefixes, or the defaults if the
+// workspace setting cannot be read.
+func (s *FrontendService) getURLPrefixes(ctx context.Context) *store.URLPrefixes {
+	prefixes, err := s.Store.GetURLPrefixes(ctx)
+	if err != nil {
+		slog.Warn("failed to get url prefixes", slog.String("error", err.Error()))
+		return &store.URLPrefixes{
+			Shortcut:   store.DefaultShortcutPrefix,
+			Collection: store.DefaultCollectionPrefix,
+		}
 	}
-	return prefix
+	return prefixes
 }
 
 func (s *FrontendService) isShortcutRoute(c echo.Context) bool {
@@ -92,6 +113,9 @@ func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) {
 	// Add route for public user collections display
 	e.GET("/:username/collections", s.handlePublicCollections)
 
+	// Add route for returning to a protected shortcut after signing in
+	e.GET(continuePath, s.handleContinue)
+
 	// Add middleware to handle shortcut/collection routes BEFORE static middleware
 	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -119,11 +143,21 @@ func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) {
 				return next(c)
 			}
 
+			// On a redirect host bare paths name shortcuts, while the app stays on the main host.
+			if s.isRedirectHost(c.Request().Context(), c.Request().Host) {
+				if segments := s.getBareShortcutSegments(c); segments != nil {
+					return s.serveShortcut(c, segments)
+				}
+				if redirectURL := s.getMainHostURL(c); redirectURL != "" {
+					return c.Redirect(http.StatusFound, redirectURL)
+				}
+			}
+
 			// Split path into segments
 			segments := strings.Split(strings.Trim(path, "/"), "/")
 			c.Response().Header().Set("X-Debug-Segments", fmt.Sprintf("%d", len(segments)))
-			if len(segments) != 2 {
-				c.Response().Header().Set("X-Debug-Skip", "not-2-segments")
+			if len(segments) < 2 {
+				c.Response().Header().Set("X-Debug-Skip", "not-shortcut-path")
 				return next(c)
 			}
 
@@ -133,55 +167,33 @@ func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) {
 			c.Response().Header().Set("X-Debug-Name", name)
 			ctx := c.Request().Context()
 
+			prefixes := s.getURLPrefixes(ctx)
+			// Links with a former prefix move permanently to the canonical URL.
+			if canonicalPrefix := prefixes.Canonical(prefix); canonicalPrefix != prefix {
+				canonicalPath := "/" + canonicalPrefix + strings.TrimPrefix(strings.TrimPrefix(urlPath, "/"), prefix)
+				return c.Redirect(http.StatusMovedPermanently, appendRawQuery(canonicalPath, c.Request().URL.RawQuery))
+			}
+
 			// Handle collection routes
-			if prefix == "c" {
+			if prefix == prefixes.Collection && len(segments) == 2 {
 				collection, err := s.Store.GetCollection(ctx, &store.FindCollection{
 					Name: &name,
 				})
-				if err == nil && collection != nil {
+				// Private and group collections are not described to visitors that may not see them.
+				if err == nil && collection != nil && collection.Visibility != storepb.Visibility_PRIVATE && collection.Visibility != storepb.Visibility_GROUP {
 					indexHTML := strings.ReplaceAll(rawIndexHTML, headerMetadataPlaceholder, generateCollectionMetadata(collection).String())
 					return c.HTML(http.StatusOK, indexHTML)
 				}
 			}
 
 			// Handle shortcut routes
-			currentPrefix := s.getShortcutPrefix(ctx)
+			currentPrefix := prefixes.Shortcut
 			slog.Info("Checking shortcut middleware", slog.String("current", currentPrefix), slog.String("requested", prefix))
 			c.Response().Header().Set("X-Debug-Current-Prefix", currentPrefix)
 
 			if prefix == currentPrefix {
 				c.Response().Header().Set("X-Debug-Prefix-Match", "true")
-				shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-					Name: &name,
-				})
-				c.Response().Header().Set("X-Debug-Shortcut-Error", fmt.Sprintf("%v", err))
-				if err == nil && shortcut != nil {
-					c.Response().Header().Set("X-Debug-Shortcut-Found", "true")
-					// Create shortcut view activity.
-					if err := s.createShortcutViewActivity(ctx, c.Request(), shortcut); err != nil {
-						slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
-					}
-
-					// Copy query parameters to the target URL
-					targetURL := shortcut.Link
-					if c.Request().URL.RawQuery != "" {
-						separator := "?"
-						if strings.Contains(targetURL, "?") {
-							separator = "&"
-						}
-						targetURL = fmt.Sprintf("%s%s%s", targetURL, separator, c.Request().URL.RawQuery)
-					}
-
-					// Redirect to the shortcut's target URL
-					return c.Redirect(http.StatusFound, targetURL)
-				} else {
-					c.Response().Header().Set("X-Debug-Shortcut-Found", "false")
-					// Log attempted access to non-existent shortcut
-					if err := s.createShortcutNotFoundActivity(ctx, c.Request(), name); err != nil {
-						slog.Warn("failed to create shortcut not found activity", slog.String("error", err.Error()))
-					}
-					slog.Info("Shortcut not found", slog.String("name", name), slog.String("path", path))
-				}
+				return s.serveShortcut(c, segments[1:])
 			} else {
 				c.Response().Header().Set("X-Debug-Prefix-Match", "false")
 			}
@@ -205,10 +217,9 @@ func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) {
 
 			// Skip static serving for potential shortcut/collection routes
 			segments := strings.Split(strings.Trim(path, "/"), "/")
-			if len(segments) == 2 {
-				prefix := segments[0]
-				// Check if this could be a shortcut route (s prefix) or collection route (c prefix)
-				if prefix == "s" || prefix == "c" {
+			if len(segments) >= 2 {
+				// Check if this could be a shortcut or collection route, including a legacy one
+				if s.getURLPrefixes(c.Request().Context()).Contains(segments[0]) {
 					return true
 				}
 			}
@@ -245,6 +256,98 @@ func (s *FrontendService) Serve(ctx context.Context, e *echo.Echo) {
 
 // Routes are now handled by middleware in the Serve method
 
+// serveShortcut redirects to the shortcut named by the path segments that follow the
+// shortcut prefix, or by all segments of a bare path on a redirect host.
+func (s *FrontendService) serveShortcut(c echo.Context, segments []string) error {
+	ctx := c.Request().Context()
+	// The longest matching shortcut name wins; the segments after it are
+	// template arguments or a path to forward to the target.
+	shortcut, args, err := s.Store.ResolveShortcut(ctx, segments)
+	c.Response().Header().Set("X-Debug-Shortcut-Error", fmt.Sprintf("%v", err))
+	found := err == nil && shortcut != nil && (len(args) == 0 || shortcut.Template || shortcut.ForwardPath)
+	if found && shortcut.Visibility != storepb.Visibility_PUBLIC {
+		// Only public shortcuts may be resolved by anonymous visitors, private ones only
+		// by their creator and group ones only by the members of their groups.
+		user, err := s.getCurrentUser(ctx, c.Request())
+		if err != nil {
+			slog.Warn("failed to authenticate shortcut visitor", slog.String("error", err.Error()))
+		}
+		if user == nil {
+			return s.redirectToSignIn(c)
+		}
+		found, err = s.Store.IsVisibleTo(ctx, shortcut, user.ID)
+		if err != nil {
+			slog.Warn("failed to check shortcut visibility", slog.String("error", err.Error()))
+		}
+	}
+	if found {
+		c.Response().Header().Set("X-Debug-Shortcut-Found", "true")
+
+		if written, err := s.renderUnavailableShortcut(c, shortcut); written {
+			return err
+		}
+
+		// Link unfurlers get a preview of the shortcut instead of its target.
+		if bot := s.detectBot(c.Request()); bot != nil && bot.Kind == botdetect.KindUnfurl {
+			if err := s.createShortcutViewActivity(ctx, c.Request(), shortcut, nil); err != nil {
+				slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
+			}
+			return c.HTML(http.StatusOK, generateUnfurlHTML(shortcut, s.getAbsoluteShortcutURL(c, shortcut.Name)))
+		}
+
+		// A scheduled link change takes effect on time, even before the runner applies it.
+		link, err := s.Store.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, time.Now().Unix())
+		if err != nil {
+			slog.Warn("failed to get effective shortcut link", slog.String("error", err.Error()))
+			link = shortcut.Link
+		}
+		// An unhealthy link fails over to the first healthy backup link.
+		if backupLinks := shortcut.Failover.GetBackupLinks(); len(backupLinks) > 0 {
+			target, err := s.Store.GetHealthyShortcutTarget(ctx, shortcut.Id, append([]string{link}, backupLinks...))
+			if err != nil {
+				slog.Warn("failed to get healthy shortcut target", slog.String("error", err.Error()))
+			} else {
+				link = target
+			}
+		}
+		// The first matching routing rule wins; otherwise variants are served instead of the link.
+		var variant *storepb.ShortcutVariant
+		rules := shortcut.RoutingRules.GetRules()
+		if i := routing.Match(rules, routing.NewRequest(c.Request().Header)); i >= 0 {
+			link = rules[i].Link
+		} else if variant = s.selectVariant(c, shortcut); variant != nil {
+			link = variant.Link
+		}
+		if link != shortcut.Link {
+			// Shortcuts are shared with the store cache, so change a copy.
+			shortcut = proto.Clone(shortcut).(*storepb.Shortcut)
+			shortcut.Link = link
+		}
+
+		targetURL, err := buildTargetURL(shortcut, args, c.Request().URL)
+		if err != nil {
+			return c.HTML(http.StatusBadRequest, s.generateTemplateHelpHTML(ctx, shortcut, err))
+		}
+
+		// Create shortcut view activity.
+		if err := s.createShortcutViewActivity(ctx, c.Request(), shortcut, variant); err != nil {
+			slog.Warn("failed to create shortcut view activity", slog.String("error", err.Error()))
+		}
+
+		// Redirect to the shortcut's target URL
+		return s.redirect(c, shortcut, targetURL)
+	} else {
+		c.Response().Header().Set("X-Debug-Shortcut-Found", "false")
+		// Log attempted access to non-existent shortcut
+		name := strings.Join(segments, "/")
+		if err := s.createShortcutNotFoundActivity(ctx, c.Request(), name); err != nil {
+			slog.Warn("failed to create shortcut not found activity", slog.String("error", err.Error()))
+		}
+		slog.Info("Shortcut not found", slog.String("name", name), slog.String("path", c.Request().URL.Path))
+		return s.renderShortcutNotFound(c, name)
+	}
+}
+
 func (s *FrontendService) handlePublicShortcuts(c echo.Context) error {
 	ctx := c.Request().Context()
 	username := c.Param("username")
@@ -273,8 +376,9 @@ func (s *FrontendService) handlePublicShortcuts(c echo.Context) error {
 
 	switch filter {
 	case "private":
+		// Private shortcuts are never listed on public pages, so this shows workspace ones.
 		visibilityList = []storepb.Visibility{storepb.Visibility_WORKSPACE}
-		filterDescription = "Private shortcuts"
+		filterDescription = "Workspace shortcuts"
 	case "all":
 		visibilityList = []storepb.Visibility{storepb.Visibility_PUBLIC, storepb.Visibility_WORKSPACE}
 		filterDescription = "All shortcuts"
@@ -286,10 +390,12 @@ func (s *FrontendService) handlePublicShortcuts(c echo.Context) error {
 		filter = "public" // Normalize default case
 	}
 
-	// Get shortcuts for this user based on filter
+	// Get shortcuts for this user based on filter; anonymous visitors only see public ones.
+	viewerID := s.getViewerID(c)
 	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
 		CreatorID: &user.ID,
 		VisibilityList: visibilityList,
+		ViewerID:       &viewerID,
 	})
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
@@ -515,9 +621,8 @@ func (s *FrontendService) generatePublicShortcutsHTML(username string, shortcuts
 		}
 		htmlContent += `<div class="no-shortcuts">` + emptyMessage + `</div>`
 	} else {
-		shortcutPrefix := s.getShortcutPrefix(ctx)
 		for _, shortcut := range shortcuts {
-			shortcutURL := fmt.Sprintf("/%s/%s", shortcutPrefix, shortcut.Name)
+			shortcutURL := s.getShortcutURL(ctx, shortcut.Name)
 			htmlContent += `<a href="` + html.EscapeString(shortcutURL) + `" target="_blank" class="shortcut-card">
                 <div class="shortcut-favicon">`
 
@@ -617,10 +722,13 @@ func (s *FrontendService) handlePublicCollections(c echo.Context) error {
 	}
 	slog.Info("Found collections for user", "userID", user.ID, "totalCollections", len(allCollections))
 
-	// Get ALL collections for this user (temporarily for debugging)
+	// Private collections are never listed on public pages, and workspace ones only to
+	// signed-in visitors.
+	viewerID := s.getViewerID(c)
 	collections, err := s.Store.ListCollections(ctx, &store.FindCollection{
-		CreatorID: &user.ID,
-		// VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC}, // Commented out for debugging
+		CreatorID:      &user.ID,
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC, storepb.Visibility_WORKSPACE},
+		ViewerID:       &viewerID,
 	})
 	if err != nil {
 		slog.Error("Failed to fetch collections", "error", err, "userID", user.ID)
@@ -639,14 +747,17 @@ func (s *FrontendService) handlePublicCollections(c echo.Context) error {
 		// Get shortcuts by IDs and filter for public ones
 		for _, shortcutID := range collection.ShortcutIds {
 			shortcut, err := s.Store.GetShortcut(ctx, &store.FindShortcut{
-				ID: &shortcutID,
+				ID:       &shortcutID,
+				ViewerID: &viewerID,
 			})
 			if err != nil {
 				slog.Warn("Failed to get shortcut", "shortcutID", shortcutID, "error", err)
 				continue // Skip if shortcut not found
 			}
+			if shortcut == nil || shortcut.Visibility == storepb.Visibility_PRIVATE || shortcut.Visibility == storepb.Visibility_GROUP {
+				continue // Skip shortcuts in the trash or hidden from the visitor, private and group ones
+			}
 			slog.Info("Found shortcut", "shortcutID", shortcutID, "name", shortcut.Name, "visibility", shortcut.Visibility.String())
-			// Include ALL shortcuts for debugging (normally would filter for public only)
 			shortcuts = append(shortcuts, shortcut)
 			slog.Info("Added shortcut to collection", "shortcutName", shortcut.Name, "visibility", shortcut.Visibility.String())
 		}
@@ -854,7 +965,6 @@ func (s *FrontendService) generatePublicCollectionsHTML(username string, collect
 	if len(collections) == 0 {
 		htmlContent += `<div class="no-collections">No collections found for this user</div>`
 	} else {
-		shortcutPrefix := s.getShortcutPrefix(ctx)
 		for _, collectionWithShortcuts := range collections {
 			collection := collectionWithShortcuts.Collection
 			shortcuts := collectionWithShortcuts.Shortcuts
@@ -873,7 +983,7 @@ func (s *FrontendService) generatePublicCollectionsHTML(username string, collect
 				htmlContent += `<div class="no-shortcuts">No public shortcuts in this collection</div>`
 			} else {
 				for _, shortcut := range shortcuts {
-					shortcutURL := fmt.Sprintf("/%s/%s", shortcutPrefix, shortcut.Name)
+					shortcutURL := s.getShortcutURL(ctx, shortcut.Name)
 					htmlContent += `<a href="` + html.EscapeString(shortcutURL) + `" target="_blank" class="shortcut-card">
                         <div class="shortcut-favicon">`
 
@@ -956,7 +1066,7 @@ func (s *FrontendService) getFaviconURL(link string) string {
 	return fmt.Sprintf("https://www.google.com/s2/favicons?domain=%s&sz=32", parsedURL.Host)
 }
 
-func (s *FrontendService) createShortcutViewActivity(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut) error {
+func (s *FrontendService) createShortcutViewActivity(ctx context.Context, request *http.Request, shortcut *storepb.Shortcut, variant *storepb.ShortcutVariant) error {
 	ip := getReadUserIP(request)
 	referer := request.Header.Get("Referer")
 	userAgent := request.Header.Get("User-Agent")
@@ -970,6 +1080,13 @@ func (s *FrontendService) createShortcutViewActivity(ctx context.Context, reques
 		Referer:    referer,
 		UserAgent:  userAgent,
 		Params:     params,
+		Variant:    variant.GetName(),
+	}
+	// Bots are recorded apart so that they do not count as views.
+	activityType := store.ActivityShortcutView
+	if bot := s.detectBot(request); bot != nil {
+		activityType = store.ActivityShortcutBotView
+		payload.Bot = bot.Pattern
 	}
 	payloadStr, err := protojson.Marshal(payload)
 	if err != nil {
@@ -977,14 +1094,12 @@ func (s *FrontendService) createShortcutViewActivity(ctx context.Context, reques
 	}
 	activity := &store.Activity{
 		CreatorID: common.BotID,
-		Type:      store.ActivityShortcutView,
+		Type:      activityType,
 		Level:     store.ActivityInfo,
 		Payload:   string(payloadStr),
 	}
-	_, err = s.Store.CreateActivity(ctx, activity)
-	if err != nil {
-		return errors.Wrap(err, "Failed to create activity")
-	}
+	// The activity is written in the background, and the ingestion service counts the ones it drops.
+	s.IngestionService.Enqueue(ctx, activity)
 	return nil
 }
 
@@ -1006,6 +1121,11 @@ func (s *FrontendService) createShortcutNotFoundActivity(ctx context.Context, re
 		UserAgent:  userAgent,
 		Params:     params,
 	}
+	activityType := store.ActivityShortcutView
+	if bot := s.detectBot(request); bot != nil {
+		activityType = store.ActivityShortcutBotView
+		payload.Bot = bot.Pattern
+	}
 	payloadStr, err := protojson.Marshal(payload)
 	if err != nil {
 		return errors.Wrap(err, "Failed to marshal shortcut not found activity payload")
@@ -1014,14 +1134,11 @@ func (s *FrontendService) createShortcutNotFoundActivity(ctx context.Context, re
 	// Create activity with a custom message indicating the shortcut name that was attempted
 	activity := &store.Activity{
 		CreatorID: common.BotID,
-		Type:      store.ActivityShortcutView, // Reuse same type for consistency
+		Type:      activityType, // Reuse the view types for consistency
 		Level:     store.ActivityWarn,         // Use Warn level to distinguish from successful visits
 		Payload:   string(payloadStr),
 	}
-	_, err = s.Store.CreateActivity(ctx, activity)
-	if err != nil {
-		return errors.Wrap(err, "Failed to create shortcut not found activity")
-	}
+	s.IngestionService.Enqueue(ctx, activity)
 	return nil
 }
 
@@ -1087,17 +1204,18 @@ func getDefaultMetadata() *Metadata {
 }
 
 func (m *Metadata) String() string {
+	title, description, imageURL := html.EscapeString(m.Title), html.EscapeString(m.Description), html.EscapeString(m.ImageURL)
 	metadataList := []string{
-		fmt.Sprintf(`<title>%s</title>`, m.Title),
-		fmt.Sprintf(`<meta name="description" content="%s" />`, m.Description),
-		fmt.Sprintf(`<meta property="og:title" content="%s" />`, m.Title),
-		fmt.Sprintf(`<meta property="og:description" content="%s" />`, m.Description),
-		fmt.Sprintf(`<meta property="og:image" content="%s" />`, m.ImageURL),
+		fmt.Sprintf(`<title>%s</title>`, title),
+		fmt.Sprintf(`<meta name="description" content="%s" />`, description),
+		fmt.Sprintf(`<meta property="og:title" content="%s" />`, title),
+		fmt.Sprintf(`<meta property="og:description" content="%s" />`, description),
+		fmt.Sprintf(`<meta property="og:image" content="%s" />`, imageURL),
 		`<meta property="og:type" content="website" />`,
 		// Twitter related fields.
-		fmt.Sprintf(`<meta property="twitter:title" content="%s" />`, m.Title),
-		fmt.Sprintf(`<meta property="twitter:description" content="%s" />`, m.Description),
-		fmt.Sprintf(`<meta property="twitter:image" content="%s" />`, m.ImageURL),
+		fmt.Sprintf(`<meta property="twitter:title" content="%s" />`, title),
+		fmt.Sprintf(`<meta property="twitter:description" content="%s" />`, description),
+		fmt.Sprintf(`<meta property="twitter:image" content="%s" />`, imageURL),
 	}
 	return strings.Join(metadataList, "\n")
 }
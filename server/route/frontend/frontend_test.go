@@ -0,0 +1,110 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/profile"
+	apiv1 "github.com/bshort/monotreme/server/route/api/v1"
+	"github.com/bshort/monotreme/server/service/ingestion"
+	"github.com/bshort/monotreme/store"
+	teststore "github.com/bshort/monotreme/store/test"
+)
+
+func TestPublicPagesViewer(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "alice",
+	})
+	require.NoError(t, err)
+	public, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "public-docs",
+		Link:       "https://example.com/public",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	workspace, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "workspace-docs",
+		Link:       "https://example.com/workspace",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "public-collection",
+		Title:       "Public collection",
+		ShortcutIds: []int32{public.Id, workspace.Id},
+		Visibility:  storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "workspace-collection",
+		Title:       "Workspace collection",
+		ShortcutIds: []int32{public.Id},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	secret := "secret"
+	accessToken, err := apiv1.GenerateAccessToken(user.Email, user.ID, time.Now().Add(time.Hour), []byte(secret))
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.UserSetting_AccessTokensSetting{
+				AccessTokens: []*storepb.UserSetting_AccessTokensSetting_AccessToken{{AccessToken: accessToken}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	s := NewFrontendService(&profile.Profile{}, ts, secret, ingestion.NewIngestionService(&profile.Profile{}, ts))
+	e := echo.New()
+	s.Serve(ctx, e)
+	get := func(target string, signedIn bool) string {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if signedIn {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, target)
+		return rec.Body.String()
+	}
+
+	// Anonymous visitors only see public shortcuts and collections, whatever the filter.
+	for _, filter := range []string{"public", "private", "all"} {
+		body := get("/alice/shortcuts?filter="+filter, false)
+		require.NotContains(t, body, "workspace-docs", filter)
+	}
+	require.Contains(t, get("/alice/shortcuts?filter=all", false), "public-docs")
+	body := get("/alice/collections", false)
+	require.Contains(t, body, "Public collection")
+	require.Contains(t, body, "public-docs")
+	require.NotContains(t, body, "Workspace collection")
+	require.NotContains(t, body, "workspace-docs")
+
+	// Signed-in visitors also see workspace ones.
+	body = get("/alice/shortcuts?filter=all", true)
+	require.Contains(t, body, "public-docs")
+	require.Contains(t, body, "workspace-docs")
+	body = get("/alice/collections", true)
+	require.Contains(t, body, "Workspace collection")
+	require.Contains(t, body, "workspace-docs")
+}
@@ -0,0 +1,102 @@
+package frontend
+
+import (
+	"context"
+	"io/fs"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bshort/monotreme/server/common"
+)
+
+// isRedirectHost reports whether the request host is one of the redirect hosts of the workspace.
+func (s *FrontendService) isRedirectHost(ctx context.Context, host string) bool {
+	return matchRedirectHost(s.getShortcutRelatedSetting(ctx).GetRedirectHosts(), host)
+}
+
+// matchRedirectHost reports whether host, with or without its port, is one of redirectHosts.
+func matchRedirectHost(redirectHosts []string, host string) bool {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for _, redirectHost := range redirectHosts {
+		if strings.EqualFold(redirectHost, host) || strings.EqualFold(redirectHost, hostname) {
+			return true
+		}
+	}
+	return false
+}
+
+// getBareShortcutSegments returns the segments of a request on a redirect host that name
+// a shortcut without the prefix, or nil if the path belongs to the app or the server.
+func (s *FrontendService) getBareShortcutSegments(c echo.Context) []string {
+	// A matched route, like /:username/shortcuts, is served by its own handler.
+	if c.Path() != "" {
+		return nil
+	}
+	urlPath := strings.Trim(c.Request().URL.Path, "/")
+	if urlPath == "" {
+		return nil
+	}
+	segments := strings.Split(urlPath, "/")
+	if s.getURLPrefixes(c.Request().Context()).Contains(segments[0]) || slices.Contains(common.ReservedPaths, segments[0]) {
+		return nil
+	}
+	// Files at the root of the app, like favicon.ico, are served as they are.
+	if _, err := fs.Stat(embeddedFiles, "dist/"+urlPath); err == nil {
+		return nil
+	}
+	return segments
+}
+
+// getInstanceURL returns the URL of the main host without a trailing slash, or "" if
+// the workspace has not set one.
+func (s *FrontendService) getInstanceURL(ctx context.Context) string {
+	instanceURL, err := s.Store.GetBaseURL(ctx, "")
+	if err != nil {
+		return ""
+	}
+	return instanceURL
+}
+
+// getShortcutURL returns the canonical URL of the shortcut, on the main host if the
+// workspace has set an instance URL.
+func (s *FrontendService) getShortcutURL(ctx context.Context, name string) string {
+	return common.ShortcutURL(s.getInstanceURL(ctx), s.getURLPrefixes(ctx).Shortcut, name)
+}
+
+// getPrefixedRequestURI returns the request URI with the shortcut prefix, turning the
+// bare /standup of a redirect host into /s/standup.
+func (s *FrontendService) getPrefixedRequestURI(c echo.Context) string {
+	requestURI := c.Request().URL.RequestURI()
+	prefix := "/" + s.getShortcutPrefix(c.Request().Context())
+	if strings.HasPrefix(requestURI, prefix+"/") {
+		return requestURI
+	}
+	return prefix + requestURI
+}
+
+// getMainHostURL returns the URL of the current page on the main host for a request to
+// a redirect host that is not a shortcut, or "" if it should be served where it is.
+func (s *FrontendService) getMainHostURL(c echo.Context) string {
+	ctx := c.Request().Context()
+	// Prefixed shortcut paths work on every host.
+	prefixes := s.getURLPrefixes(ctx)
+	if segment, _, ok := strings.Cut(strings.TrimPrefix(c.Request().URL.Path, "/"), "/"); ok && prefixes.Canonical(segment) == prefixes.Shortcut {
+		return ""
+	}
+	instanceURL := s.getInstanceURL(ctx)
+	if instanceURL == "" {
+		return ""
+	}
+	// Avoid a redirect loop when the instance URL points at the redirect host itself.
+	if u, err := url.Parse(instanceURL); err != nil || strings.EqualFold(u.Host, c.Request().Host) {
+		return ""
+	}
+	return instanceURL + c.Request().URL.RequestURI()
+}
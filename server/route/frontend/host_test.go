@@ -0,0 +1,96 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/server/service/ingestion"
+	"github.com/bshort/monotreme/store"
+	teststore "github.com/bshort/monotreme/store/test"
+)
+
+func TestMatchRedirectHost(t *testing.T) {
+	redirectHosts := []string{"go", "go.example.com", "links.local:8080"}
+	require.True(t, matchRedirectHost(redirectHosts, "go"))
+	require.True(t, matchRedirectHost(redirectHosts, "go:80"))
+	require.True(t, matchRedirectHost(redirectHosts, "GO.example.com"))
+	require.True(t, matchRedirectHost(redirectHosts, "links.local:8080"))
+	require.False(t, matchRedirectHost(redirectHosts, "links.local:9090"))
+	require.False(t, matchRedirectHost(redirectHosts, "app.example.com"))
+	require.False(t, matchRedirectHost(nil, "go"))
+}
+
+func TestRedirectHostBarePaths(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				RedirectHosts: []string{"go"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+	// Shortcuts named like reserved paths may exist in older workspaces, but must not
+	// take over the paths of the app and the server.
+	for _, name := range []string{"standup", "api", "auth", "assets", "docs"} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:   user.ID,
+			Name:        name,
+			Link:        "https://example.com/" + name,
+			Visibility:  storepb.Visibility_PUBLIC,
+			ForwardPath: true,
+			OgMetadata:  &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	ingestionService := ingestion.NewIngestionService(&profile.Profile{}, ts)
+	s := NewFrontendService(&profile.Profile{}, ts, "secret", ingestionService)
+	e := echo.New()
+	s.Serve(ctx, e)
+	get := func(host, target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Host = host
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Bare paths name shortcuts on a redirect host, with or without its port.
+	for _, host := range []string{"go", "go:80"} {
+		rec := get(host, "/standup")
+		require.Equal(t, http.StatusFound, rec.Code)
+		require.Equal(t, "https://example.com/standup", rec.Header().Get(echo.HeaderLocation))
+	}
+	// The remaining segments are forwarded to the target.
+	rec := get("go", "/standup/notes")
+	require.Equal(t, "https://example.com/standup/notes", rec.Header().Get(echo.HeaderLocation))
+	// Prefixed paths keep working on a redirect host.
+	rec = get("go", "/s/standup")
+	require.Equal(t, "https://example.com/standup", rec.Header().Get(echo.HeaderLocation))
+	// Bare paths are not shortcuts on other hosts.
+	rec = get("app.example.com", "/standup")
+	require.Empty(t, rec.Header().Get(echo.HeaderLocation))
+
+	// Reserved paths, matched routes and the root are never resolved as shortcuts.
+	for _, target := range []string{"/", "/api", "/api/v1/shortcuts", "/auth", "/auth/callback", "/assets/index.js", "/docs/shortcuts"} {
+		rec := get("go", target)
+		require.Empty(t, rec.Header().Get(echo.HeaderLocation), target)
+		require.NotEqual(t, "true", rec.Header().Get("X-Debug-Shortcut-Found"), target)
+	}
+}
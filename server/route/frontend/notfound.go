@@ -0,0 +1,137 @@
+package frontend
+
+import (
+	"html"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bshort/monotreme/internal/suggest"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/common"
+	"github.com/bshort/monotreme/store"
+)
+
+// maxSuggestions is the number of shortcuts offered on the not found page.
+const maxSuggestions = 5
+
+// renderShortcutNotFound suggests visible shortcuts with a name like the unknown one,
+// or redirects to the only close match when the workspace allows it.
+func (s *FrontendService) renderShortcutNotFound(c echo.Context, name string) error {
+	ctx := c.Request().Context()
+	user, err := s.getCurrentUser(ctx, c.Request())
+	if err != nil {
+		slog.Warn("failed to authenticate shortcut visitor", slog.String("error", err.Error()))
+	}
+	// Anonymous visitors must not learn the names of workspace shortcuts.
+	visibilityList := []storepb.Visibility{storepb.Visibility_PUBLIC}
+	if user != nil {
+		visibilityList = append(visibilityList, storepb.Visibility_WORKSPACE)
+	}
+	normal := storepb.RowStatus_NORMAL
+	now := time.Now().Unix()
+	shortcuts, err := s.Store.ListShortcuts(ctx, &store.FindShortcut{
+		VisibilityList: visibilityList,
+		RowStatus:      &normal,
+		NotExpiredAt:   &now,
+	})
+	if err != nil {
+		return err
+	}
+
+	prefix := s.getShortcutPrefix(ctx)
+	suggestions := suggest.Rank(name, shortcuts)
+	if s.getShortcutRelatedSetting(ctx).GetAutoRedirectNotFound() {
+		if match := suggest.OnlyClose(suggestions); match != nil {
+			return c.Redirect(http.StatusFound, appendRawQuery(common.ShortcutURL("", prefix, match.Shortcut.Name), c.Request().URL.RawQuery))
+		}
+	}
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return c.HTML(http.StatusNotFound, generateNotFoundHTML(prefix, name, suggestions))
+}
+
+func generateNotFoundHTML(prefix string, name string, suggestions []*suggest.Suggestion) string {
+	createURL := "/quick-save?" + url.Values{"name": {name}}.Encode()
+
+	htmlContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <meta name="robots" content="noindex">
+    <title>Shortcut not found</title>
+    <style>
+        body {
+            font-family: system-ui, -apple-system, sans-serif;
+            max-width: 640px;
+            margin: 0 auto;
+            padding: 2rem;
+            background-color: #f8fafc;
+            color: #1e293b;
+        }
+        .card {
+            background: white;
+            border-radius: 8px;
+            padding: 1.5rem;
+            border: 1px solid #e2e8f0;
+        }
+        .suggestions {
+            list-style: none;
+            padding: 0;
+        }
+        .suggestions li {
+            margin-bottom: 0.5rem;
+        }
+        .suggestions a {
+            color: #3b82f6;
+            font-weight: 500;
+            text-decoration: none;
+        }
+        .title {
+            color: #64748b;
+            margin-left: 0.5rem;
+        }
+        .create {
+            display: inline-block;
+            margin-top: 1rem;
+            padding: 0.5rem 1rem;
+            background: #3b82f6;
+            color: white;
+            border-radius: 6px;
+            text-decoration: none;
+            font-weight: 500;
+        }
+    </style>
+</head>
+<body>
+    <div class="card">
+        <h1>Shortcut not found</h1>
+        <p>There is no shortcut named <code>` + html.EscapeString(name) + `</code>.</p>`
+	if len(suggestions) > 0 {
+		htmlContent += `
+        <p>Did you mean:</p>
+        <ul class="suggestions">`
+		for _, suggestion := range suggestions {
+			shortcut := suggestion.Shortcut
+			htmlContent += `
+            <li><a href="` + html.EscapeString(common.ShortcutURL("", prefix, shortcut.Name)) + `">` + html.EscapeString(shortcut.Name) + `</a>`
+			if shortcut.Title != "" {
+				htmlContent += `<span class="title">` + html.EscapeString(shortcut.Title) + `</span>`
+			}
+			htmlContent += `</li>`
+		}
+		htmlContent += `
+        </ul>`
+	}
+	htmlContent += `
+        <a class="create" href="` + html.EscapeString(createURL) + `">Create this shortcut</a>
+    </div>
+</body>
+</html>`
+	return htmlContent
+}
@@ -0,0 +1,65 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bshort/monotreme/internal/suggest"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/server/service/ingestion"
+	"github.com/bshort/monotreme/store"
+	teststore "github.com/bshort/monotreme/store/test"
+)
+
+func TestGenerateNotFoundHTML(t *testing.T) {
+	page := generateNotFoundHTML("s", "cafe", []*suggest.Suggestion{
+		{Shortcut: &storepb.Shortcut{Name: "café menu"}},
+	})
+	require.Contains(t, page, `<a href="/s/caf%C3%A9%20menu">café menu</a>`)
+}
+
+func TestShortcutNotFoundRedirect(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				AutoRedirectNotFound: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "café",
+		Link:       "https://example.com/menu",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	ingestionService := ingestion.NewIngestionService(&profile.Profile{}, ts)
+	s := NewFrontendService(&profile.Profile{}, ts, "secret", ingestionService)
+	e := echo.New()
+	s.Serve(ctx, e)
+	req := httptest.NewRequest(http.MethodGet, "/s/cafe?day=monday", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	// The only close match is redirected to with its name escaped.
+	require.Equal(t, http.StatusFound, rec.Code)
+	require.Equal(t, "/s/caf%C3%A9?day=monday", rec.Header().Get(echo.HeaderLocation))
+}
@@ -0,0 +1,194 @@
+package frontend
+
+import (
+	"context"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/bshort/monotreme/internal/linktemplate"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// buildTargetURL returns the destination for a visit to a shortcut with the given extra
+// path segments. Query parameters that are not consumed by a template are passed on.
+func buildTargetURL(shortcut *storepb.Shortcut, args []string, requestURL *url.URL) (string, error) {
+	if !shortcut.Template {
+		link := shortcut.Link
+		if shortcut.ForwardPath && len(args) > 0 {
+			u, err := url.Parse(link)
+			if err != nil {
+				return "", errors.Wrap(err, "invalid link")
+			}
+			link = u.JoinPath(args...).String()
+		}
+		return appendRawQuery(link, requestURL.RawQuery), nil
+	}
+
+	template, err := linktemplate.Parse(shortcut.Link)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid link template")
+	}
+	link, remainingQuery, err := template.Expand(args, requestURL.Query())
+	if err != nil {
+		return "", err
+	}
+	return appendRawQuery(link, remainingQuery.Encode()), nil
+}
+
+// appendRawQuery copies the visitor's query string to the target URL.
+func appendRawQuery(link string, rawQuery string) string {
+	if rawQuery == "" {
+		return link
+	}
+	separator := "?"
+	if strings.Contains(link, "?") {
+		separator = "&"
+	}
+	return link + separator + rawQuery
+}
+
+func (s *FrontendService) getDefaultRedirectMode(ctx context.Context) storepb.RedirectMode {
+	redirectMode := s.getShortcutRelatedSetting(ctx).GetDefaultRedirectMode()
+	if redirectMode == storepb.RedirectMode_REDIRECT_MODE_UNSPECIFIED {
+		return storepb.RedirectMode_FOUND
+	}
+	return redirectMode
+}
+
+// redirect sends the visitor to targetURL using the shortcut's redirect mode, or the
+// workspace default when the shortcut does not set one.
+func (s *FrontendService) redirect(c echo.Context, shortcut *storepb.Shortcut, targetURL string) error {
+	redirectMode := shortcut.RedirectMode
+	if redirectMode == storepb.RedirectMode_REDIRECT_MODE_UNSPECIFIED {
+		redirectMode = s.getDefaultRedirectMode(c.Request().Context())
+	}
+
+	// HTML modes put the link into the page, so only web links may use them.
+	if redirectMode == storepb.RedirectMode_META_REFRESH || redirectMode == storepb.RedirectMode_INTERSTITIAL {
+		if u, err := url.Parse(targetURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			redirectMode = storepb.RedirectMode_FOUND
+		}
+	}
+
+	switch redirectMode {
+	case storepb.RedirectMode_MOVED_PERMANENTLY:
+		return c.Redirect(http.StatusMovedPermanently, targetURL)
+	case storepb.RedirectMode_TEMPORARY_REDIRECT:
+		return c.Redirect(http.StatusTemporaryRedirect, targetURL)
+	case storepb.RedirectMode_PERMANENT_REDIRECT:
+		return c.Redirect(http.StatusPermanentRedirect, targetURL)
+	case storepb.RedirectMode_META_REFRESH:
+		c.Response().Header().Set("Referrer-Policy", "no-referrer")
+		return c.HTML(http.StatusOK, generateMetaRefreshHTML(targetURL))
+	case storepb.RedirectMode_INTERSTITIAL:
+		c.Response().Header().Set("Referrer-Policy", "no-referrer")
+		return c.HTML(http.StatusOK, generateInterstitialHTML(shortcut, targetURL))
+	default:
+		return c.Redirect(http.StatusFound, targetURL)
+	}
+}
+
+func generateMetaRefreshHTML(targetURL string) string {
+	escapedURL := html.EscapeString(targetURL)
+	return `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="referrer" content="no-referrer">
+    <meta http-equiv="refresh" content="0; url=` + escapedURL + `">
+    <title>Redirecting</title>
+</head>
+<body>
+    <p>Redirecting to <a href="` + escapedURL + `" rel="noreferrer">` + escapedURL + `</a></p>
+</body>
+</html>`
+}
+
+func generateInterstitialHTML(shortcut *storepb.Shortcut, targetURL string) string {
+	metadata := generateShortcutMetadata(shortcut)
+	title := metadata.Title
+	if title == "" {
+		title = shortcut.Name
+	}
+	domain := targetURL
+	if u, err := url.Parse(targetURL); err == nil && u.Host != "" {
+		domain = u.Hostname()
+	}
+	escapedURL := html.EscapeString(targetURL)
+
+	htmlContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <meta name="referrer" content="no-referrer">
+    ` + metadata.String() + `
+    <style>
+        body {
+            font-family: system-ui, -apple-system, sans-serif;
+            max-width: 640px;
+            margin: 0 auto;
+            padding: 2rem;
+            background-color: #f8fafc;
+            color: #1e293b;
+        }
+        .card {
+            background: white;
+            border-radius: 8px;
+            padding: 1.5rem;
+            border: 1px solid #e2e8f0;
+        }
+        .card img {
+            width: 100%;
+            border-radius: 6px;
+            margin-bottom: 1rem;
+        }
+        .description {
+            color: #475569;
+        }
+        .domain {
+            font-weight: 600;
+        }
+        .link {
+            color: #64748b;
+            font-size: 0.85rem;
+            word-break: break-all;
+        }
+        .continue {
+            display: inline-block;
+            margin-top: 1rem;
+            padding: 0.5rem 1rem;
+            background: #3b82f6;
+            color: white;
+            border-radius: 6px;
+            text-decoration: none;
+            font-weight: 500;
+        }
+    </style>
+</head>
+<body>
+    <div class="card">`
+	if metadata.ImageURL != "" {
+		htmlContent += `
+        <img src="` + html.EscapeString(metadata.ImageURL) + `" alt="">`
+	}
+	htmlContent += `
+        <h1>` + html.EscapeString(title) + `</h1>`
+	if metadata.Description != "" {
+		htmlContent += `
+        <p class="description">` + html.EscapeString(metadata.Description) + `</p>`
+	}
+	htmlContent += `
+        <p>You are about to visit <span class="domain">` + html.EscapeString(domain) + `</span></p>
+        <p class="link">` + escapedURL + `</p>
+        <a class="continue" href="` + escapedURL + `" rel="noreferrer">Continue</a>
+    </div>
+</body>
+</html>`
+	return htmlContent
+}
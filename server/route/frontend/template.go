@@ -0,0 +1,135 @@
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bshort/monotreme/internal/linktemplate"
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// generateTemplateHelpHTML renders a page explaining how to call a templated shortcut
+// when the visit did not provide the arguments it needs.
+func (s *FrontendService) generateTemplateHelpHTML(ctx context.Context, shortcut *storepb.Shortcut, expandErr error) string {
+	shortcutPath := fmt.Sprintf("/%s/%s", s.getShortcutPrefix(ctx), shortcut.Name)
+	title := shortcut.Title
+	if title == "" {
+		title = shortcut.Name
+	}
+
+	message := "This shortcut could not be expanded."
+	missingErr := &linktemplate.MissingArgumentsError{}
+	tooManyErr := &linktemplate.TooManyArgumentsError{}
+	if errors.As(expandErr, &missingErr) {
+		keys := []string{}
+		for _, placeholder := range missingErr.Missing {
+			keys = append(keys, placeholder.Key)
+		}
+		message = "Missing required arguments: " + strings.Join(keys, ", ") + "."
+	} else if errors.As(expandErr, &tooManyErr) {
+		message = "Too many arguments: " + strings.Join(tooManyErr.Extra, "/") + "."
+	}
+
+	placeholders := []*linktemplate.Placeholder{}
+	if template, err := linktemplate.Parse(shortcut.Link); err == nil {
+		placeholders = template.Keys()
+	}
+	usage := shortcutPath
+	for _, placeholder := range placeholders {
+		if placeholder.Required() {
+			usage += "/{" + placeholder.Key + "}"
+		}
+	}
+
+	htmlContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>` + html.EscapeString(title) + `</title>
+    <style>
+        body {
+            font-family: system-ui, -apple-system, sans-serif;
+            max-width: 640px;
+            margin: 0 auto;
+            padding: 2rem;
+            background-color: #f8fafc;
+            color: #1e293b;
+        }
+        .card {
+            background: white;
+            border-radius: 8px;
+            padding: 1.5rem;
+            border: 1px solid #e2e8f0;
+        }
+        .message {
+            color: #b91c1c;
+            margin-bottom: 1rem;
+        }
+        code {
+            background: #f1f5f9;
+            padding: 0.125rem 0.375rem;
+            border-radius: 4px;
+            word-break: break-all;
+        }
+        label {
+            display: block;
+            font-weight: 500;
+            margin-top: 0.75rem;
+        }
+        .hint {
+            color: #64748b;
+            font-size: 0.85rem;
+            font-weight: 400;
+        }
+        input {
+            width: 100%;
+            box-sizing: border-box;
+            padding: 0.5rem;
+            margin-top: 0.25rem;
+            border: 1px solid #cbd5e1;
+            border-radius: 4px;
+        }
+        button {
+            margin-top: 1rem;
+            padding: 0.5rem 1rem;
+            background: #3b82f6;
+            color: white;
+            border: none;
+            border-radius: 6px;
+            font-weight: 500;
+            cursor: pointer;
+        }
+    </style>
+</head>
+<body>
+    <div class="card">
+        <h1>` + html.EscapeString(title) + `</h1>
+        <p class="message">` + html.EscapeString(message) + `</p>
+        <p>Usage: <code>` + html.EscapeString(usage) + `</code></p>
+        <p>Template: <code>` + html.EscapeString(shortcut.Link) + `</code></p>
+        <form method="GET" action="` + html.EscapeString(shortcutPath) + `">`
+
+	for _, placeholder := range placeholders {
+		hint := "required"
+		if placeholder.HasDefault {
+			hint = "default: " + placeholder.Default
+		}
+		htmlContent += `
+            <label>` + html.EscapeString(placeholder.Key) + ` <span class="hint">(` + html.EscapeString(hint) + `)</span>
+                <input type="text" name="` + html.EscapeString(placeholder.Key) + `" placeholder="` + html.EscapeString(placeholder.Default) + `">
+            </label>`
+	}
+
+	htmlContent += `
+            <button type="submit">Open</button>
+        </form>
+    </div>
+</body>
+</html>`
+	return htmlContent
+}
@@ -0,0 +1,101 @@
+package frontend
+
+import (
+	"context"
+	"html"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+const (
+	defaultPendingMessage = "This link is not available yet."
+	defaultExpiredMessage = "This link has expired."
+)
+
+func (s *FrontendService) getShortcutRelatedSetting(ctx context.Context) *storepb.WorkspaceSetting_ShortcutRelatedSetting {
+	shortcutRelatedSetting, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil || shortcutRelatedSetting == nil {
+		return &storepb.WorkspaceSetting_ShortcutRelatedSetting{}
+	}
+	return shortcutRelatedSetting.GetShortcutRelated()
+}
+
+// renderUnavailableShortcut writes the pending or expired page when the shortcut is
+// outside its validity window. It reports whether a page was written.
+func (s *FrontendService) renderUnavailableShortcut(c echo.Context, shortcut *storepb.Shortcut) (bool, error) {
+	now := time.Now().Unix()
+	expired := shortcut.RowStatus == storepb.RowStatus_ARCHIVED || (shortcut.ValidUntil != 0 && now >= shortcut.ValidUntil)
+	pending := shortcut.ValidFrom != 0 && now < shortcut.ValidFrom
+	if !expired && !pending {
+		return false, nil
+	}
+
+	setting := s.getShortcutRelatedSetting(c.Request().Context())
+	if expired {
+		message := setting.GetExpiredMessage()
+		if message == "" {
+			message = defaultExpiredMessage
+		}
+		return true, c.HTML(http.StatusGone, generateUnavailableHTML(shortcut, message, ""))
+	}
+	message := setting.GetPendingMessage()
+	if message == "" {
+		message = defaultPendingMessage
+	}
+	availableAt := "Available from " + time.Unix(shortcut.ValidFrom, 0).UTC().Format("2006-01-02 15:04 MST") + "."
+	return true, c.HTML(http.StatusNotFound, generateUnavailableHTML(shortcut, message, availableAt))
+}
+
+func generateUnavailableHTML(shortcut *storepb.Shortcut, message string, detail string) string {
+	title := shortcut.Title
+	if title == "" {
+		title = shortcut.Name
+	}
+
+	htmlContent := `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>` + html.EscapeString(title) + `</title>
+    <style>
+        body {
+            font-family: system-ui, -apple-system, sans-serif;
+            max-width: 640px;
+            margin: 0 auto;
+            padding: 2rem;
+            background-color: #f8fafc;
+            color: #1e293b;
+        }
+        .card {
+            background: white;
+            border-radius: 8px;
+            padding: 1.5rem;
+            border: 1px solid #e2e8f0;
+        }
+        .detail {
+            color: #64748b;
+        }
+    </style>
+</head>
+<body>
+    <div class="card">
+        <h1>` + html.EscapeString(title) + `</h1>
+        <p>` + html.EscapeString(message) + `</p>`
+	if detail != "" {
+		htmlContent += `
+        <p class="detail">` + html.EscapeString(detail) + `</p>`
+	}
+	htmlContent += `
+    </div>
+</body>
+</html>`
+	return htmlContent
+}
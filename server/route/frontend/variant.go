@@ -0,0 +1,135 @@
+package frontend
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+const (
+	// variantCookiePrefix is followed by the shortcut id in the cookie that pins a visitor to a variant.
+	variantCookiePrefix = "monotreme.variant."
+	// variantCookieDuration is how long a sticky visitor keeps being served the same variant.
+	variantCookieDuration = 30 * 24 * time.Hour
+	// variantStateIdleTimeout is how long the balancer keeps the scores of a shortcut nobody visits,
+	// which also drops the scores of deleted shortcuts.
+	variantStateIdleTimeout = time.Hour
+)
+
+// variantBalancer spreads visits across the variants of each shortcut with smooth weighted
+// round-robin, so that any run of visits follows the weights as closely as possible.
+type variantBalancer struct {
+	mu sync.Mutex
+	// states holds the running scores of every shortcut, keyed by shortcut id.
+	states    map[int32]*variantState
+	lastSweep time.Time
+}
+
+type variantState struct {
+	// fingerprint identifies the variants the scores were computed for; editing them starts over.
+	fingerprint string
+	// current holds the running score of every variant, keyed by variant name.
+	current  map[string]int64
+	lastUsed time.Time
+}
+
+func newVariantBalancer() *variantBalancer {
+	return &variantBalancer{
+		states:    map[int32]*variantState{},
+		lastSweep: time.Now(),
+	}
+}
+
+func (b *variantBalancer) next(shortcutID int32, variants []*storepb.ShortcutVariant) *storepb.ShortcutVariant {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.sweep(now)
+	fingerprint := getVariantsFingerprint(variants)
+	state, ok := b.states[shortcutID]
+	if !ok || state.fingerprint != fingerprint {
+		state = &variantState{
+			fingerprint: fingerprint,
+			current:     map[string]int64{},
+		}
+		b.states[shortcutID] = state
+	}
+	state.lastUsed = now
+
+	current := state.current
+	var total int64
+	var selected *storepb.ShortcutVariant
+	for _, variant := range variants {
+		total += int64(variant.Weight)
+		current[variant.Name] += int64(variant.Weight)
+		if selected == nil || current[variant.Name] > current[selected.Name] {
+			selected = variant
+		}
+	}
+	if selected != nil {
+		current[selected.Name] -= total
+	}
+	return selected
+}
+
+// sweep drops the scores of shortcuts that have not been visited for variantStateIdleTimeout.
+func (b *variantBalancer) sweep(now time.Time) {
+	if now.Sub(b.lastSweep) < variantStateIdleTimeout {
+		return
+	}
+	for shortcutID, state := range b.states {
+		if now.Sub(state.lastUsed) >= variantStateIdleTimeout {
+			delete(b.states, shortcutID)
+		}
+	}
+	b.lastSweep = now
+}
+
+func getVariantsFingerprint(variants []*storepb.ShortcutVariant) string {
+	var builder strings.Builder
+	for _, variant := range variants {
+		fmt.Fprintf(&builder, "%q:%d;", variant.Name, variant.Weight)
+	}
+	return builder.String()
+}
+
+// selectVariant returns the variant of the shortcut to serve, or nil if it has none.
+// Sticky shortcuts serve the variant named in the visitor's cookie while it still exists.
+func (s *FrontendService) selectVariant(c echo.Context, shortcut *storepb.Shortcut) *storepb.ShortcutVariant {
+	variants := shortcut.Variants.GetVariants()
+	if len(variants) == 0 {
+		return nil
+	}
+
+	cookieName := fmt.Sprintf("%s%d", variantCookiePrefix, shortcut.Id)
+	if shortcut.Variants.GetSticky() {
+		if cookie, err := c.Cookie(cookieName); err == nil {
+			for _, variant := range variants {
+				if name, err := url.QueryUnescape(cookie.Value); err == nil && variant.Name == name {
+					return variant
+				}
+			}
+		}
+	}
+
+	variant := s.variantBalancer.next(shortcut.Id, variants)
+	if shortcut.Variants.GetSticky() {
+		c.SetCookie(&http.Cookie{
+			Name:     cookieName,
+			Value:    url.QueryEscape(variant.Name),
+			Path:     "/",
+			Expires:  time.Now().Add(variantCookieDuration),
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	return variant
+}
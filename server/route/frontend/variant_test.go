@@ -0,0 +1,47 @@
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+func TestVariantBalancer(t *testing.T) {
+	variants := []*storepb.ShortcutVariant{
+		{Name: "a", Link: "https://a.link", Weight: 3},
+		{Name: "b", Link: "https://b.link", Weight: 1},
+	}
+	balancer := newVariantBalancer()
+
+	// Every run of visits as long as the total weight follows the weights exactly.
+	for round := 0; round < 3; round++ {
+		served := map[string]int{}
+		for i := 0; i < 4; i++ {
+			served[balancer.next(1, variants).Name]++
+		}
+		require.Equal(t, map[string]int{"a": 3, "b": 1}, served)
+	}
+
+	// Shortcuts are balanced independently.
+	require.Equal(t, "a", balancer.next(2, variants).Name)
+	require.Nil(t, balancer.next(3, nil))
+
+	// Editing the variants starts the scores over.
+	balancer.next(1, variants)
+	edited := []*storepb.ShortcutVariant{
+		{Name: "a", Link: "https://a.link", Weight: 1},
+		{Name: "b", Link: "https://b.link", Weight: 1},
+	}
+	require.Equal(t, "a", balancer.next(1, edited).Name)
+	require.Equal(t, "b", balancer.next(1, edited).Name)
+
+	// Shortcuts nobody visits are dropped.
+	balancer.states[2].lastUsed = time.Now().Add(-variantStateIdleTimeout)
+	balancer.lastSweep = time.Now().Add(-variantStateIdleTimeout)
+	balancer.next(1, edited)
+	require.NotContains(t, balancer.states, int32(2))
+	require.Contains(t, balancer.states, int32(1))
+}
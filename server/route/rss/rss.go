@@ -13,6 +13,7 @@ import (
 
 	"github.com/bshort/monotreme/internal/util"
 	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/server/common"
 	"github.com/bshort/monotreme/server/profile"
 	"github.com/bshort/monotreme/store"
 )
@@ -121,7 +122,7 @@ func (rs *RSSService) handleCollectionRSS(c echo.Context) error {
 	}
 
 	// Get all shortcuts in this collection
-	shortcuts, err := rs.getCollectionShortcuts(ctx, collection)
+	shortcuts, err := rs.getCollectionShortcuts(ctx, collection, userID)
 	if err != nil {
 		return errors.Wrap(err, "failed to get collection shortcuts")
 	}
@@ -210,10 +211,9 @@ func (rs *RSSService) authenticateUser(ctx context.Context, accessToken string)
 }
 
 func (rs *RSSService) generateCollectionsRSSXML(collections []*storepb.Collection, userID int32) (string, error) {
-	baseURL := fmt.Sprintf("http://localhost:%d", rs.Profile.Port)
-	if rs.Profile.Mode == "prod" {
-		// In production, you might want to set this from an environment variable
-		baseURL = fmt.Sprintf("http://localhost:%d", rs.Profile.Port)
+	baseURL, prefixes, err := rs.getBaseURL(context.Background())
+	if err != nil {
+		return "", err
 	}
 
 	// Get user info for title
@@ -246,7 +246,8 @@ func (rs *RSSService) generateCollectionsRSSXML(collections []*storepb.Collectio
 	for _, collection := range collections {
 		for _, shortcutID := range collection.ShortcutIds {
 			shortcut, err := rs.Store.GetShortcut(context.Background(), &store.FindShortcut{
-				ID: &shortcutID,
+				ID:       &shortcutID,
+				ViewerID: &userID,
 			})
 			if err == nil && shortcut != nil {
 				allShortcuts = append(allShortcuts, shortcutWithCollection{
@@ -267,7 +268,7 @@ func (rs *RSSService) generateCollectionsRSSXML(collections []*storepb.Collectio
 		shortcut := sc.shortcut
 
 		// Build the Monotreme shortcut URL
-		shortcutURL := fmt.Sprintf("%s/s/%s", baseURL, shortcut.Name)
+		shortcutURL := common.ShortcutURL(baseURL, prefixes.Shortcut, shortcut.Name)
 
 		// Use shortcut UUID as GUID for uniqueness
 		guid := shortcut.Uuid
@@ -305,12 +306,28 @@ func (rs *RSSService) generateCollectionsRSSXML(collections []*storepb.Collectio
 	return rssHeader + items + rssFooter, nil
 }
 
-func (rs *RSSService) getCollectionShortcuts(ctx context.Context, collection *storepb.Collection) ([]*storepb.Shortcut, error) {
+// getBaseURL returns the instance URL, or the local server if the workspace has not set
+// one, and the prefixes of shortcut and collection URLs.
+func (rs *RSSService) getBaseURL(ctx context.Context) (string, *store.URLPrefixes, error) {
+	baseURL, err := rs.Store.GetBaseURL(ctx, fmt.Sprintf("http://localhost:%d", rs.Profile.Port))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get instance url")
+	}
+	prefixes, err := rs.Store.GetURLPrefixes(ctx)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to get url prefixes")
+	}
+	return baseURL, prefixes, nil
+}
+
+// getCollectionShortcuts returns the shortcuts of the collection that the user may see.
+func (rs *RSSService) getCollectionShortcuts(ctx context.Context, collection *storepb.Collection, userID int32) ([]*storepb.Shortcut, error) {
 	var shortcuts []*storepb.Shortcut
 
 	for _, shortcutID := range collection.ShortcutIds {
 		shortcut, err := rs.Store.GetShortcut(ctx, &store.FindShortcut{
-			ID: &shortcutID,
+			ID:       &shortcutID,
+			ViewerID: &userID,
 		})
 		if err == nil && shortcut != nil {
 			shortcuts = append(shortcuts, shortcut)
@@ -326,10 +343,9 @@ func (rs *RSSService) getCollectionShortcuts(ctx context.Context, collection *st
 }
 
 func (rs *RSSService) generateCollectionRSSXML(collection *storepb.Collection, shortcuts []*storepb.Shortcut, userID int32) (string, error) {
-	baseURL := fmt.Sprintf("http://localhost:%d", rs.Profile.Port)
-	if rs.Profile.Mode == "prod" {
-		// In production, you might want to set this from an environment variable
-		baseURL = fmt.Sprintf("http://localhost:%d", rs.Profile.Port)
+	baseURL, prefixes, err := rs.getBaseURL(context.Background())
+	if err != nil {
+		return "", err
 	}
 
 	// Get user info for title
@@ -344,7 +360,7 @@ func (rs *RSSService) generateCollectionRSSXML(collection *storepb.Collection, s
 <rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
 <channel>
     <title>` + user.Nickname + `'s Monotreme Collections - <![CDATA[` + collection.Title + `]]></title>
-    <link>` + baseURL + `/c/<![CDATA[` + collection.Name + `]]></link>
+    <link><![CDATA[` + common.CollectionURL(baseURL, prefixes.Collection, collection.Name) + `]]></link>
     <description>Latest links from <![CDATA[` + collection.Title + `]]> collection from Monotreme</description>
     <language>en-us</language>
     <lastBuildDate>` + time.Now().Format(time.RFC3339) + `</lastBuildDate>
@@ -355,7 +371,7 @@ func (rs *RSSService) generateCollectionRSSXML(collection *storepb.Collection, s
 	items := ""
 	for _, shortcut := range shortcuts {
 		// Build the Monotreme shortcut URL
-		shortcutURL := fmt.Sprintf("%s/s/%s", baseURL, shortcut.Name)
+		shortcutURL := common.ShortcutURL(baseURL, prefixes.Shortcut, shortcut.Name)
 
 		// Use shortcut UUID as GUID for uniqueness
 		guid := shortcut.Uuid
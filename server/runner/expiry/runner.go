@@ -0,0 +1,82 @@
+// Package expiry provides a runner to archive shortcuts some time after they expire.
+package expiry
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule archiving every hour.
+const runnerInterval = time.Hour
+
+// defaultArchiveDays is used when the workspace does not configure how long to keep expired shortcuts active.
+const defaultArchiveDays = 30
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.archiveExpiredShortcuts(ctx); err != nil {
+		slog.Error("failed to archive expired shortcuts", "error", err)
+	}
+}
+
+func (r *Runner) archiveExpiredShortcuts(ctx context.Context) error {
+	archiveDays := int32(defaultArchiveDays)
+	shortcutRelatedSetting, err := r.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return err
+	}
+	if days := shortcutRelatedSetting.GetShortcutRelated().GetExpiredArchiveDays(); days > 0 {
+		archiveDays = days
+	}
+
+	expiredBefore := time.Now().Add(-time.Duration(archiveDays) * 24 * time.Hour).Unix()
+	rowStatus := storepb.RowStatus_NORMAL
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:     &rowStatus,
+		ExpiredBefore: &expiredBefore,
+	})
+	if err != nil {
+		return err
+	}
+
+	archived := storepb.RowStatus_ARCHIVED
+	for _, shortcut := range shortcuts {
+		if _, err := r.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+			ID:        shortcut.Id,
+			RowStatus: &archived,
+		}); err != nil {
+			return err
+		}
+		slog.Info("archived expired shortcut", slog.String("name", shortcut.Name))
+	}
+	return nil
+}
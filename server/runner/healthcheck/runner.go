@@ -0,0 +1,217 @@
+// Package healthcheck provides a runner to probe the links of shortcuts with backup links.
+package healthcheck
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+const (
+	// runnerInterval is how often every target is probed.
+	runnerInterval = time.Minute
+	// checkTimeout bounds a single probe, so that a hanging host counts as unhealthy.
+	checkTimeout = 10 * time.Second
+	// maxConcurrentChecks bounds the number of shortcuts probed at the same time.
+	maxConcurrentChecks = 8
+)
+
+type Runner struct {
+	Store *store.Store
+
+	client *http.Client
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+		client: &http.Client{
+			Timeout: checkTimeout,
+		},
+	}
+}
+
+// Run probes right away, rather than waiting for the first tick, since the redirect path
+// relies on the stored health. It is not run synchronously on startup as probes can be slow.
+func (r *Runner) Run(ctx context.Context) {
+	r.RunOnce(ctx)
+
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.checkShortcuts(ctx); err != nil {
+		slog.Error("failed to check shortcut targets", "error", err)
+	}
+}
+
+func (r *Runner) checkShortcuts(ctx context.Context) error {
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{})
+	if err != nil {
+		return err
+	}
+	healthList, err := r.Store.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{})
+	if err != nil {
+		return err
+	}
+	healthMap := map[int32][]*store.ShortcutTargetHealth{}
+	for _, health := range healthList {
+		healthMap[health.ShortcutID] = append(healthMap[health.ShortcutID], health)
+	}
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrentChecks)
+	checked := map[int32]bool{}
+	for _, shortcut := range shortcuts {
+		// Template links only make sense once their placeholders are filled, so they cannot be probed.
+		if len(shortcut.Failover.GetBackupLinks()) == 0 || shortcut.Template {
+			continue
+		}
+		checked[shortcut.Id] = true
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(shortcut *storepb.Shortcut) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := r.checkShortcut(ctx, shortcut, healthMap[shortcut.Id]); err != nil {
+				slog.Error("failed to check shortcut", slog.String("name", shortcut.Name), slog.String("error", err.Error()))
+			}
+		}(shortcut)
+	}
+	wg.Wait()
+
+	// Forget the health of shortcuts that were deleted or no longer have backup links.
+	for shortcutID := range healthMap {
+		if !checked[shortcutID] {
+			if err := r.Store.DeleteShortcutTargetHealth(ctx, &store.DeleteShortcutTargetHealth{
+				ShortcutID: shortcutID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Runner) checkShortcut(ctx context.Context, shortcut *storepb.Shortcut, previousHealthList []*store.ShortcutTargetHealth) error {
+	// Probe the link the redirect serves, which a scheduled link change may already have replaced.
+	link, err := r.Store.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	targets := append([]string{link}, shortcut.Failover.GetBackupLinks()...)
+	for _, health := range previousHealthList {
+		if !slices.Contains(targets, health.Link) {
+			if err := r.Store.DeleteShortcutTargetHealth(ctx, &store.DeleteShortcutTargetHealth{
+				ShortcutID: shortcut.Id,
+				Link:       &health.Link,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	previousTarget, err := r.Store.GetHealthyShortcutTarget(ctx, shortcut.Id, targets)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		health := r.probe(ctx, target)
+		health.ShortcutID = shortcut.Id
+		if _, err := r.Store.UpsertShortcutTargetHealth(ctx, health); err != nil {
+			return err
+		}
+	}
+	target, err := r.Store.GetHealthyShortcutTarget(ctx, shortcut.Id, targets)
+	if err != nil {
+		return err
+	}
+	if target == previousTarget {
+		return nil
+	}
+	return r.createFailoverActivity(ctx, shortcut, previousTarget, target)
+}
+
+// probe checks that the link responds without a server error. Hosts that do not support
+// HEAD are asked again with GET.
+func (r *Runner) probe(ctx context.Context, link string) *store.ShortcutTargetHealth {
+	health := &store.ShortcutTargetHealth{
+		Link:      link,
+		CheckedTs: time.Now().Unix(),
+	}
+	statusCode, err := r.request(ctx, http.MethodHead, link)
+	if err == nil && (statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented) {
+		statusCode, err = r.request(ctx, http.MethodGet, link)
+	}
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+	health.StatusCode = int32(statusCode)
+	health.Healthy = statusCode < http.StatusInternalServerError
+	if !health.Healthy {
+		health.Error = http.StatusText(statusCode)
+	}
+	return health
+}
+
+func (r *Runner) request(ctx context.Context, method, link string) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	response, err := r.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	return response.StatusCode, nil
+}
+
+func (r *Runner) createFailoverActivity(ctx context.Context, shortcut *storepb.Shortcut, previousLink, link string) error {
+	backup := link != shortcut.Link
+	payload, err := protojson.Marshal(&storepb.ActivityShortcutFailoverPayload{
+		ShortcutId:   shortcut.Id,
+		PreviousLink: previousLink,
+		Link:         link,
+		Backup:       backup,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	level := store.ActivityInfo
+	if backup {
+		level = store.ActivityWarn
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: shortcut.CreatorId,
+		Type:      store.ActivityShortcutFailover,
+		Level:     level,
+		Payload:   string(payload),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	slog.Info("shortcut failed over", slog.String("name", shortcut.Name), slog.String("link", link))
+	return nil
+}
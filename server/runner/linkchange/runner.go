@@ -0,0 +1,175 @@
+// Package linkchange provides a runner to apply scheduled shortcut link changes.
+package linkchange
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule applying every minute. The redirect path already resolves due changes,
+// so this only has to keep the stored link and the activity history up to date.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.applyDueLinkChanges(ctx, time.Now().Unix()); err != nil {
+		slog.Error("failed to apply shortcut link changes", "error", err)
+	}
+}
+
+func (r *Runner) applyDueLinkChanges(ctx context.Context, now int64) error {
+	pending := true
+	changes, err := r.Store.ListShortcutLinkChanges(ctx, &store.FindShortcutLinkChange{
+		Pending:         &pending,
+		EffectiveBefore: &now,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Changes are ordered by effective time, so each shortcut ends up with its latest due link
+	// and every switch in between is recorded.
+	for _, change := range changes {
+		if err := r.applyLinkChange(ctx, change, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) applyLinkChange(ctx context.Context, change *store.ShortcutLinkChange, now int64) error {
+	// Changes also apply to shortcuts in the trash, so that they are up to date when restored.
+	shortcut, err := r.Store.GetShortcut(ctx, &store.FindShortcut{
+		ID:    &change.ShortcutID,
+		Trash: store.TrashIncluded,
+	})
+	if err != nil {
+		return err
+	}
+	if shortcut == nil {
+		// The shortcut has been purged, so the change can never apply.
+		return r.Store.DeleteShortcutLinkChange(ctx, &store.DeleteShortcutLinkChange{
+			ID: change.ID,
+		})
+	}
+
+	requiresApproval, err := r.Store.ShortcutRequiresApproval(ctx, shortcut)
+	if err != nil {
+		return err
+	}
+	if requiresApproval {
+		return r.requestLinkChange(ctx, change, shortcut)
+	}
+
+	previousLink := shortcut.Link
+	if _, err := r.Store.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:       shortcut.Id,
+		Link:     &change.Link,
+		EditorID: change.CreatorID,
+	}); err != nil {
+		return err
+	}
+	if _, err := r.Store.UpdateShortcutLinkChange(ctx, &store.UpdateShortcutLinkChange{
+		ID:        change.ID,
+		AppliedTs: &now,
+	}); err != nil {
+		return err
+	}
+
+	payload, err := protojson.Marshal(&storepb.ActivityShortcutLinkChangePayload{
+		ShortcutId:   shortcut.Id,
+		ChangeId:     change.ID,
+		PreviousLink: previousLink,
+		Link:         change.Link,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal activity payload")
+	}
+	if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: change.CreatorID,
+		Type:      store.ActivityShortcutLinkChange,
+		Level:     store.ActivityInfo,
+		Payload:   string(payload),
+	}); err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	slog.Info("applied shortcut link change", slog.String("name", shortcut.Name))
+	return nil
+}
+
+// requestLinkChange turns a due change of a shortcut that requires approval, such as one
+// scheduled before the shortcut was protected, into a change request of its creator.
+func (r *Runner) requestLinkChange(ctx context.Context, change *store.ShortcutLinkChange, shortcut *storepb.Shortcut) error {
+	target := store.ApplyShortcutUpdate(shortcut, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &change.Link,
+	})
+	if fields := store.DiffShortcuts(shortcut, target); len(fields) != 0 {
+		changeRequest, err := r.Store.CreateShortcutChangeRequest(ctx, &store.ShortcutChangeRequest{
+			ShortcutID: shortcut.Id,
+			CreatorID:  change.CreatorID,
+			Payload: &storepb.ShortcutChangeRequestPayload{
+				Fields:   fields,
+				Previous: shortcut,
+				Shortcut: target,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		payload, err := protojson.Marshal(&storepb.ActivityShortcutChangeRequestPayload{
+			ShortcutId:      shortcut.Id,
+			ChangeRequestId: changeRequest.ID,
+			Fields:          fields,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal activity payload")
+		}
+		if _, err := r.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: change.CreatorID,
+			Type:      store.ActivityShortcutChangeRequest,
+			Level:     store.ActivityInfo,
+			Payload:   string(payload),
+		}); err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+	}
+	// The change request replaces the scheduled change.
+	if err := r.Store.DeleteShortcutLinkChange(ctx, &store.DeleteShortcutLinkChange{
+		ID: change.ID,
+	}); err != nil {
+		return err
+	}
+	slog.Info("requested approval of shortcut link change", slog.String("name", shortcut.Name))
+	return nil
+}
@@ -66,7 +66,7 @@ func (r *Runner) collectStats(ctx context.Context) error {
 	}
 	totalCollections := int32(len(collections))
 
-	// Get total hits count (shortcut views)
+	// Get total hits count (shortcut views), which leaves out bot views.
 	activities, err := r.Store.ListActivities(ctx, &store.FindActivity{
 		Type: store.ActivityShortcutView,
 	})
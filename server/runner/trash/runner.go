@@ -0,0 +1,85 @@
+// Package trash provides a runner to purge shortcuts and collections that have been in the trash
+// for longer than the workspace retention.
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bshort/monotreme/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule purging every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	if err := r.purgeExpiredTrash(ctx); err != nil {
+		slog.Error("failed to purge expired trash", "error", err)
+	}
+}
+
+func (r *Runner) purgeExpiredTrash(ctx context.Context) error {
+	retention, err := r.Store.GetTrashRetention(ctx)
+	if err != nil {
+		return err
+	}
+	deletedBefore := time.Now().Add(-retention).Unix()
+
+	shortcuts, err := r.Store.ListShortcuts(ctx, &store.FindShortcut{
+		Trash:         store.TrashOnly,
+		DeletedBefore: &deletedBefore,
+	})
+	if err != nil {
+		return err
+	}
+	for _, shortcut := range shortcuts {
+		if err := r.Store.DeleteShortcut(ctx, &store.DeleteShortcut{
+			ID: shortcut.Id,
+		}); err != nil {
+			return err
+		}
+		slog.Info("purged shortcut from the trash", slog.String("name", shortcut.Name))
+	}
+
+	collections, err := r.Store.ListCollections(ctx, &store.FindCollection{
+		Trash:         store.TrashOnly,
+		DeletedBefore: &deletedBefore,
+	})
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		if err := r.Store.DeleteCollection(ctx, &store.DeleteCollection{
+			ID: collection.Id,
+		}); err != nil {
+			return err
+		}
+		slog.Info("purged collection from the trash", slog.String("name", collection.Name))
+	}
+	return nil
+}
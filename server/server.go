@@ -21,9 +21,14 @@ import (
 	"github.com/bshort/monotreme/server/route/frontend"
 	"github.com/bshort/monotreme/server/route/rss"
 	"github.com/bshort/monotreme/server/route/swagger"
+	"github.com/bshort/monotreme/server/runner/expiry"
+	"github.com/bshort/monotreme/server/runner/healthcheck"
 	licensern "github.com/bshort/monotreme/server/runner/license"
+	"github.com/bshort/monotreme/server/runner/linkchange"
 	"github.com/bshort/monotreme/server/runner/stats"
+	"github.com/bshort/monotreme/server/runner/trash"
 	"github.com/bshort/monotreme/server/runner/version"
+	"github.com/bshort/monotreme/server/service/ingestion"
 	"github.com/bshort/monotreme/server/service/license"
 	"github.com/bshort/monotreme/store"
 )
@@ -35,7 +40,8 @@ type Server struct {
 	Store   *store.Store
 	Secret  string
 
-	licenseService *license.LicenseService
+	licenseService   *license.LicenseService
+	ingestionService *ingestion.IngestionService
 
 	// API services.
 	apiV1Service *apiv1.APIV1Service
@@ -48,18 +54,16 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	e.HidePort = true
 
 	licenseService := license.NewLicenseService(profile, store)
+	ingestionService := ingestion.NewIngestionService(profile, store)
 
 	s := &Server{
-		e:              e,
-		Profile:        profile,
-		Store:          store,
-		licenseService: licenseService,
+		e:                e,
+		Profile:          profile,
+		Store:            store,
+		licenseService:   licenseService,
+		ingestionService: ingestionService,
 	}
 
-	// Serve frontend.
-	frontendService := frontend.NewFrontendService(profile, store)
-	frontendService.Serve(ctx, e)
-
 	// In dev mode, we'd like to set the const secret key to make signin session persistence.
 	secret := "monotreme"
 	if profile.Mode == "prod" {
@@ -71,6 +75,10 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}
 	s.Secret = secret
 
+	// Serve frontend.
+	frontendService := frontend.NewFrontendService(profile, store, secret, ingestionService)
+	frontendService.Serve(ctx, e)
+
 	// Register healthz endpoint.
 	e.GET("/healthz", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Service ready.")
@@ -93,7 +101,7 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	exportService := export.NewExportService(profile, store, secret)
 	exportService.RegisterRoutes(e)
 
-	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, s.Profile.Port+1)
+	s.apiV1Service = apiv1.NewAPIV1Service(secret, profile, store, licenseService, ingestionService, s.Profile.Port+1)
 	// Register gRPC gateway as api v1.
 	if err := s.apiV1Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -127,6 +135,9 @@ func (s *Server) Shutdown(ctx context.Context) {
 		fmt.Printf("failed to shutdown server, error: %v\n", err)
 	}
 
+	// Write the click events that are still buffered.
+	s.ingestionService.Shutdown(ctx)
+
 	// Close database connection.
 	if err := s.Store.Close(); err != nil {
 		fmt.Printf("failed to close database, error: %v\n", err)
@@ -146,10 +157,22 @@ func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	versionRunner.RunOnce(ctx)
 	statsRunner := stats.NewRunner(s.Store)
 	statsRunner.RunOnce(ctx)
+	expiryRunner := expiry.NewRunner(s.Store)
+	expiryRunner.RunOnce(ctx)
+	linkChangeRunner := linkchange.NewRunner(s.Store)
+	linkChangeRunner.RunOnce(ctx)
+	trashRunner := trash.NewRunner(s.Store)
+	trashRunner.RunOnce(ctx)
+	healthCheckRunner := healthcheck.NewRunner(s.Store)
 
 	go licenseRunner.Run(ctx)
 	go versionRunner.Run(ctx)
 	go statsRunner.Run(ctx)
+	go expiryRunner.Run(ctx)
+	go linkChangeRunner.Run(ctx)
+	go trashRunner.Run(ctx)
+	go healthCheckRunner.Run(ctx)
+	go s.ingestionService.Run(ctx)
 }
 
 func (s *Server) getSecretSession(ctx context.Context) (string, error) {
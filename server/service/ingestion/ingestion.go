@@ -0,0 +1,224 @@
+package ingestion
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/store"
+)
+
+// Policy decides what happens to an event when the buffer is full.
+type Policy string
+
+const (
+	// PolicyDrop drops the event, so requests never wait on the database.
+	PolicyDrop Policy = "drop"
+	// PolicyBlock makes the request wait for room in the buffer, or drops the event once
+	// the request is done.
+	PolicyBlock Policy = "block"
+)
+
+const (
+	// DefaultBufferSize is the number of events kept in memory when the profile does not set it.
+	DefaultBufferSize = 10000
+	// DefaultBatchSize is the number of events written at once when the profile does not set it.
+	DefaultBatchSize = 500
+	// DefaultFlushInterval is how often buffered events are written when the profile does not set it.
+	DefaultFlushInterval = time.Second
+)
+
+// Stats are the counters of the ingestion service.
+type Stats struct {
+	Policy   Policy
+	Capacity int
+	Buffered int
+	Enqueued uint64
+	Written  uint64
+	Dropped  uint64
+	// Failed are the events of batches that could not be written.
+	Failed uint64
+}
+
+// IngestionService writes click events to the activity table in batches, so that redirects
+// do not wait on the database.
+type IngestionService struct {
+	Store *store.Store
+
+	policy        Policy
+	batchSize     int
+	flushInterval time.Duration
+
+	events   chan *store.Activity
+	stop     chan struct{}
+	stopOnce sync.Once
+	running  atomic.Bool
+	done     chan struct{}
+	// sending is held by Enqueue while it checks stop and buffers an event, so that
+	// Shutdown can wait for the events that got past the check before draining.
+	sending sync.RWMutex
+
+	enqueued atomic.Uint64
+	written  atomic.Uint64
+	dropped  atomic.Uint64
+	failed   atomic.Uint64
+}
+
+// NewIngestionService creates a new IngestionService with the buffer settings of profile.
+func NewIngestionService(profile *profile.Profile, storeInstance *store.Store) *IngestionService {
+	bufferSize := profile.ActivityBufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	batchSize := profile.ActivityBatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	flushInterval := profile.ActivityFlushInterval
+	if flushInterval <= 0 {
+		flushInterval = DefaultFlushInterval
+	}
+	policy := Policy(profile.ActivityBufferPolicy)
+	if policy != PolicyBlock {
+		policy = PolicyDrop
+	}
+	return &IngestionService{
+		Store:         storeInstance,
+		policy:        policy,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		events:        make(chan *store.Activity, bufferSize),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+// Enqueue buffers activity to be written by Run. Under PolicyBlock it waits for room
+// until ctx is done. It reports whether the activity was buffered.
+func (s *IngestionService) Enqueue(ctx context.Context, activity *store.Activity) bool {
+	// The event happened now, not when its batch is written.
+	if activity.CreatedTs == 0 {
+		activity.CreatedTs = time.Now().Unix()
+	}
+	s.sending.RLock()
+	defer s.sending.RUnlock()
+	select {
+	case <-s.stop:
+		s.dropped.Add(1)
+		return false
+	default:
+	}
+
+	if s.policy == PolicyBlock {
+		select {
+		case s.events <- activity:
+			s.enqueued.Add(1)
+			return true
+		case <-ctx.Done():
+		case <-s.stop:
+		}
+		s.dropped.Add(1)
+		return false
+	}
+	select {
+	case s.events <- activity:
+		s.enqueued.Add(1)
+		return true
+	default:
+		s.dropped.Add(1)
+		return false
+	}
+}
+
+// Run writes buffered events whenever a batch is full or the flush interval passes,
+// until ctx is done or Shutdown is called.
+func (s *IngestionService) Run(ctx context.Context) {
+	s.running.Store(true)
+	defer close(s.done)
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*store.Activity, 0, s.batchSize)
+	for {
+		select {
+		case activity := <-s.events:
+			batch = append(batch, activity)
+			if len(batch) >= s.batchSize {
+				s.write(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				s.write(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-s.stop:
+			s.write(ctx, batch)
+			return
+		case <-ctx.Done():
+			s.write(context.WithoutCancel(ctx), batch)
+			return
+		}
+	}
+}
+
+// Shutdown stops accepting events and writes the ones still buffered.
+func (s *IngestionService) Shutdown(ctx context.Context) {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	// Once the events in flight are buffered, every later Enqueue sees stop and drops
+	// its event, so nothing is left in the buffer after the drain below.
+	s.sending.Lock()
+	s.sending.Unlock()
+	if s.running.Load() {
+		select {
+		case <-s.done:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	batch := make([]*store.Activity, 0, s.batchSize)
+	for {
+		select {
+		case activity := <-s.events:
+			batch = append(batch, activity)
+			if len(batch) >= s.batchSize {
+				s.write(ctx, batch)
+				batch = batch[:0]
+			}
+		default:
+			s.write(ctx, batch)
+			return
+		}
+	}
+}
+
+// Stats returns the counters of the service.
+func (s *IngestionService) Stats() *Stats {
+	return &Stats{
+		Policy:   s.policy,
+		Capacity: cap(s.events),
+		Buffered: len(s.events),
+		Enqueued: s.enqueued.Load(),
+		Written:  s.written.Load(),
+		Dropped:  s.dropped.Load(),
+		Failed:   s.failed.Load(),
+	}
+}
+
+func (s *IngestionService) write(ctx context.Context, batch []*store.Activity) {
+	if len(batch) == 0 {
+		return
+	}
+	if err := s.Store.CreateActivities(ctx, batch); err != nil {
+		s.failed.Add(uint64(len(batch)))
+		slog.Error("failed to write activities", slog.Int("count", len(batch)), slog.String("error", err.Error()))
+		return
+	}
+	s.written.Add(uint64(len(batch)))
+}
@@ -0,0 +1,95 @@
+package ingestion
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bshort/monotreme/server/profile"
+	"github.com/bshort/monotreme/store"
+	teststore "github.com/bshort/monotreme/store/test"
+)
+
+func TestIngestionService(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+
+	ingestionService := NewIngestionService(&profile.Profile{ActivityBufferSize: 2}, ts)
+	for i := 0; i < 3; i++ {
+		ingestionService.Enqueue(ctx, &store.Activity{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+		})
+	}
+	stats := ingestionService.Stats()
+	require.Equal(t, uint64(2), stats.Enqueued)
+	require.Equal(t, uint64(1), stats.Dropped)
+	require.Equal(t, 2, stats.Buffered)
+	list, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	// Buffered events are written on shutdown, and later ones are dropped.
+	ingestionService.Shutdown(ctx)
+	require.False(t, ingestionService.Enqueue(ctx, &store.Activity{
+		CreatorID: user.ID,
+		Type:      store.ActivityShortcutView,
+		Level:     store.ActivityInfo,
+	}))
+	stats = ingestionService.Stats()
+	require.Equal(t, uint64(2), stats.Written)
+	require.Equal(t, uint64(2), stats.Dropped)
+	require.Equal(t, 0, stats.Buffered)
+	list, err = ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+}
+
+func TestIngestionServiceConcurrentShutdown(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleAdmin,
+		Email:    "test@test.com",
+		Nickname: "test_nickname",
+	})
+	require.NoError(t, err)
+
+	ingestionService := NewIngestionService(&profile.Profile{ActivityBufferPolicy: string(PolicyBlock)}, ts)
+	go ingestionService.Run(ctx)
+	const senders, events = 8, 50
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < events; j++ {
+				ingestionService.Enqueue(ctx, &store.Activity{
+					CreatorID: user.ID,
+					Type:      store.ActivityShortcutView,
+					Level:     store.ActivityInfo,
+				})
+			}
+		}()
+	}
+	ingestionService.Shutdown(ctx)
+	wg.Wait()
+
+	// Every event is either written or dropped, never left behind in the buffer.
+	stats := ingestionService.Stats()
+	require.Equal(t, 0, stats.Buffered)
+	require.Equal(t, stats.Enqueued, stats.Written)
+	require.Equal(t, uint64(senders*events), stats.Enqueued+stats.Dropped)
+	list, err := ts.ListActivities(ctx, &store.FindActivity{})
+	require.NoError(t, err)
+	require.Equal(t, int(stats.Written), len(list))
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 )
 
 type ActivityType string
@@ -11,6 +12,19 @@ const (
 	ActivityShortcutCreate ActivityType = "shortcut.create"
 	// ActivityShortcutView is the activity type of shortcut view.
 	ActivityShortcutView ActivityType = "shortcut.view"
+	// ActivityShortcutBotView is the activity type of a shortcut fetched by a known bot.
+	// It is kept apart from shortcut views so that bots do not count as visitors.
+	ActivityShortcutBotView ActivityType = "shortcut.bot_view"
+	// ActivityShortcutLinkChange is the activity type of a scheduled shortcut link change being applied.
+	ActivityShortcutLinkChange ActivityType = "shortcut.link_change"
+	// ActivityShortcutFailover is the activity type of a shortcut switching to or from a backup link.
+	ActivityShortcutFailover ActivityType = "shortcut.failover"
+	// ActivityShortcutChangeRequest is the activity type of an edit of a protected shortcut submitted for approval.
+	ActivityShortcutChangeRequest ActivityType = "shortcut.change_request"
+	// ActivityShortcutChangeApprove is the activity type of a change request being approved and applied.
+	ActivityShortcutChangeApprove ActivityType = "shortcut.change_approve"
+	// ActivityShortcutChangeReject is the activity type of a change request being rejected.
+	ActivityShortcutChangeReject ActivityType = "shortcut.change_reject"
 )
 
 func (t ActivityType) String() string {
@@ -19,6 +33,18 @@ func (t ActivityType) String() string {
 		return "shortcut.create"
 	case ActivityShortcutView:
 		return "shortcut.view"
+	case ActivityShortcutBotView:
+		return "shortcut.bot_view"
+	case ActivityShortcutLinkChange:
+		return "shortcut.link_change"
+	case ActivityShortcutFailover:
+		return "shortcut.failover"
+	case ActivityShortcutChangeRequest:
+		return "shortcut.change_request"
+	case ActivityShortcutChangeApprove:
+		return "shortcut.change_approve"
+	case ActivityShortcutChangeReject:
+		return "shortcut.change_reject"
 	}
 	return ""
 }
@@ -66,6 +92,28 @@ func (s *Store) CreateActivity(ctx context.Context, create *Activity) (*Activity
 	return s.driver.CreateActivity(ctx, create)
 }
 
+// maxActivityBatchSize keeps a batch insert within the bind parameter limits of the drivers.
+const maxActivityBatchSize = 1000
+
+// CreateActivities inserts activities in batches. Activities without a CreatedTs are
+// stamped with the current time.
+func (s *Store) CreateActivities(ctx context.Context, creates []*Activity) error {
+	now := time.Now().Unix()
+	for _, create := range creates {
+		if create.CreatedTs == 0 {
+			create.CreatedTs = now
+		}
+	}
+	for len(creates) > 0 {
+		n := min(len(creates), maxActivityBatchSize)
+		if err := s.driver.CreateActivities(ctx, creates[:n]); err != nil {
+			return err
+		}
+		creates = creates[n:]
+	}
+	return nil
+}
+
 func (s *Store) ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error) {
 	return s.driver.ListActivities(ctx, find)
 }
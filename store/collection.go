@@ -15,6 +15,12 @@ type UpdateCollection struct {
 	ShortcutIDs []int32
 	Visibility  *storepb.Visibility
 	CustomIcon  *string
+	// CreatorID transfers the ownership of the collection.
+	CreatorID *int32
+	// GroupIDs replaces the groups a GROUP collection is visible to, unless nil.
+	GroupIDs []int32
+	// DeletedTs moves the collection to the trash, or out of it when 0.
+	DeletedTs *int64
 }
 
 type FindCollection struct {
@@ -22,6 +28,12 @@ type FindCollection struct {
 	CreatorID      *int32
 	Name           *string
 	VisibilityList []storepb.Visibility
+	// Trash selects collections by whether they are in the trash. By default they are left out.
+	Trash TrashFilter
+	// DeletedBefore only keeps collections moved to the trash at or before the given time.
+	DeletedBefore *int64
+	// ViewerID only keeps collections the user may see, see IsVisibleTo. 0 is an anonymous visitor.
+	ViewerID *int32
 }
 
 type DeleteCollection struct {
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"context"
+
 	storepb "github.com/bshort/monotreme/proto/gen/store"
 )
 
@@ -13,9 +15,74 @@ func ConvertRowStatusStringToStorepb(status string) storepb.RowStatus {
 }
 
 func ConvertVisibilityStringToStorepb(visibility string) storepb.Visibility {
-	if visibility == "PUBLIC" {
+	switch visibility {
+	case "PUBLIC":
 		return storepb.Visibility_PUBLIC
+	case "PRIVATE":
+		return storepb.Visibility_PRIVATE
+	case "GROUP":
+		return storepb.Visibility_GROUP
 	}
 	// Otherwise, fallback to workspace visibility.
 	return storepb.Visibility_WORKSPACE
 }
+
+// VisibleResource is a shortcut or collection, whose visibility is checked with IsVisibleTo.
+type VisibleResource interface {
+	GetVisibility() storepb.Visibility
+	GetCreatorId() int32
+	GetGroupIds() []int32
+}
+
+// IsVisibleTo reports whether the shortcut or collection may be seen by the viewer,
+// or by an anonymous visitor when viewerID is 0. Any permission granted to the viewer
+// makes the resource visible to them.
+func (s *Store) IsVisibleTo(ctx context.Context, resource VisibleResource, viewerID int32) (bool, error) {
+	visible, err := s.isVisibleByVisibility(ctx, resource, viewerID)
+	if err != nil || visible || viewerID == 0 {
+		return visible, err
+	}
+	resourceType, resourceID, ok := getPermissionResource(resource)
+	if !ok || resourceID == 0 {
+		return false, nil
+	}
+	role, err := s.GetPermissionRole(ctx, resourceType, resourceID, viewerID)
+	if err != nil {
+		return false, err
+	}
+	return role != "", nil
+}
+
+func (s *Store) isVisibleByVisibility(ctx context.Context, resource VisibleResource, viewerID int32) (bool, error) {
+	switch resource.GetVisibility() {
+	case storepb.Visibility_PUBLIC:
+		return true, nil
+	case storepb.Visibility_PRIVATE:
+		return viewerID != 0 && resource.GetCreatorId() == viewerID, nil
+	case storepb.Visibility_GROUP:
+		if viewerID == 0 {
+			return false, nil
+		}
+		if resource.GetCreatorId() == viewerID {
+			return true, nil
+		}
+		if len(resource.GetGroupIds()) == 0 {
+			return false, nil
+		}
+		members, err := s.ListGroupMembers(ctx, &FindGroupMember{
+			GroupIDList: resource.GetGroupIds(),
+			UserID:      &viewerID,
+		})
+		if err != nil {
+			return false, err
+		}
+		return len(members) != 0, nil
+	default:
+		return viewerID != 0, nil
+	}
+}
+
+func ConvertRedirectModeStringToStorepb(redirectMode string) storepb.RedirectMode {
+	// Unknown values fallback to unspecified, which uses the workspace default.
+	return storepb.RedirectMode(storepb.RedirectMode_value[redirectMode])
+}
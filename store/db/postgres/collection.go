@@ -14,8 +14,8 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "custom_icon"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String(), create.CustomIcon}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "custom_icon", "group_ids"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, pq.Array(create.ShortcutIds), create.Visibility.String(), create.CustomIcon, pq.Array(create.GroupIds)}
 
 	stmt := `
 		INSERT INTO collection (` + strings.Join(set, ", ") + `)
@@ -47,12 +47,21 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.ShortcutIDs != nil {
 		set, args = append(set, "shortcut_ids = "+placeholder(len(args)+1)), append(args, pq.Array(update.ShortcutIDs))
 	}
+	if update.CreatorID != nil {
+		set, args = append(set, "creator_id = "+placeholder(len(args)+1)), append(args, *update.CreatorID)
+	}
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, update.Visibility.String())
 	}
 	if update.CustomIcon != nil {
 		set, args = append(set, "custom_icon = "+placeholder(len(args)+1)), append(args, *update.CustomIcon)
 	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = "+placeholder(len(args)+1)), append(args, *update.DeletedTs)
+	}
+	if update.GroupIDs != nil {
+		set, args = append(set, "group_ids = "+placeholder(len(args)+1)), append(args, pq.Array(update.GroupIDs))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -61,11 +70,12 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		UPDATE collection
 		SET ` + strings.Join(set, ", ") + `
 		WHERE id = ` + placeholder(len(args)+1) + `
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, custom_icon
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, custom_icon, deleted_ts, group_ids
 	`
 	args = append(args, update.ID)
 	collection := &storepb.Collection{}
 	var shortcutIDs []sql.NullInt32
+	var groupIDs []sql.NullInt32
 	var visibility string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&collection.Id,
@@ -78,6 +88,8 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		pq.Array(&shortcutIDs),
 		&visibility,
 		&collection.CustomIcon,
+		&collection.DeletedTs,
+		pq.Array(&groupIDs),
 	); err != nil {
 		return nil, err
 	}
@@ -89,6 +101,7 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		}
 	}
 	collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+	collection.GroupIds = convertIDArray(groupIDs)
 	return collection, nil
 }
 
@@ -106,10 +119,26 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
-			list, args = append(list, placeholder(len(args)+1)), append(args, visibility)
+			list, args = append(list, placeholder(len(args)+1)), append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(list, ",")))
 	}
+	switch find.Trash {
+	case store.TrashExcluded:
+		where = append(where, "deleted_ts = 0")
+	case store.TrashOnly:
+		where = append(where, "deleted_ts != 0")
+	}
+	if v := find.DeletedBefore; v != nil {
+		where, args = append(where, "deleted_ts != 0 AND deleted_ts <= "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ViewerID; v != nil {
+		if *v == 0 {
+			where = append(where, "visibility = 'PUBLIC'")
+		} else {
+			where, args = append(where, viewerCondition("collection", placeholder(len(args)+1))), append(args, *v)
+		}
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -122,7 +151,9 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			description,
 			shortcut_ids,
 			visibility,
-			custom_icon
+			custom_icon,
+			deleted_ts,
+			group_ids
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -137,6 +168,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	for rows.Next() {
 		collection := &storepb.Collection{}
 		var shortcutIDs []sql.NullInt32
+		var groupIDs []sql.NullInt32
 		var visibility string
 		if err := rows.Scan(
 			&collection.Id,
@@ -149,6 +181,8 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			pq.Array(&shortcutIDs),
 			&visibility,
 			&collection.CustomIcon,
+			&collection.DeletedTs,
+			pq.Array(&groupIDs),
 		); err != nil {
 			return nil, err
 		}
@@ -160,6 +194,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			}
 		}
 		collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+		collection.GroupIds = convertIDArray(groupIDs)
 		list = append(list, collection)
 	}
 
@@ -173,6 +208,9 @@ func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollectio
 	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = $1`, delete.ID); err != nil {
 		return err
 	}
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM resource_permission WHERE resource_type = 'COLLECTION' AND resource_id = $1`, delete.ID); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -24,3 +25,41 @@ func placeholders(n int) string {
 	}
 	return strings.Join(list, ", ")
 }
+
+// convertIDArray converts an INTEGER ARRAY column, skipping NULL elements.
+func convertIDArray(array []sql.NullInt32) []int32 {
+	var ids []int32
+	for _, id := range array {
+		if id.Valid {
+			ids = append(ids, id.Int32)
+		}
+	}
+	return ids
+}
+
+// viewerCondition keeps the rows of a shortcut or collection table that a signed-in viewer
+// may see, see store.IsVisibleTo. viewerID is the placeholder of the viewer ID.
+func viewerCondition(table, viewerID string) string {
+	return fmt.Sprintf(`(visibility NOT IN ('PRIVATE', 'GROUP') OR creator_id = %[2]s OR (visibility = 'GROUP' AND EXISTS (
+		SELECT 1 FROM user_group_member
+		WHERE user_group_member.user_id = %[2]s AND user_group_member.group_id = ANY(%[1]s.group_ids)
+	)) OR EXISTS (
+		SELECT 1 FROM resource_permission
+		WHERE resource_permission.resource_type = '%[3]s' AND resource_permission.resource_id = %[1]s.id AND (
+			(resource_permission.principal_type = 'USER' AND resource_permission.principal_id = %[2]s)
+			OR (resource_permission.principal_type = 'GROUP' AND resource_permission.principal_id IN (SELECT group_id FROM user_group_member WHERE user_id = %[2]s))
+		)
+	))`, table, viewerID, strings.ToUpper(table))
+}
+
+// ownerCondition keeps the rows of a shortcut or collection table that a user owns, as their
+// creator or through an owner permission. userID is the placeholder of the user ID.
+func ownerCondition(table, userID string) string {
+	return fmt.Sprintf(`(creator_id = %[2]s OR EXISTS (
+		SELECT 1 FROM resource_permission
+		WHERE resource_permission.resource_type = '%[3]s' AND resource_permission.resource_id = %[1]s.id AND resource_permission.role = 'OWNER' AND (
+			(resource_permission.principal_type = 'USER' AND resource_permission.principal_id = %[2]s)
+			OR (resource_permission.principal_type = 'GROUP' AND resource_permission.principal_id IN (SELECT group_id FROM user_group_member WHERE user_id = %[2]s))
+		)
+	))`, table, userID, strings.ToUpper(table))
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bshort/monotreme/store"
+)
+
+func (d *DB) UpsertPermission(ctx context.Context, upsert *store.Permission) (*store.Permission, error) {
+	stmt := `
+		INSERT INTO resource_permission (
+			resource_type,
+			resource_id,
+			principal_type,
+			principal_id,
+			role
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT(resource_type, resource_id, principal_type, principal_id) DO UPDATE SET role = EXCLUDED.role
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt,
+		upsert.ResourceType,
+		upsert.ResourceID,
+		upsert.PrincipalType,
+		upsert.PrincipalID,
+		upsert.Role,
+	).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListPermissions(ctx context.Context, find *store.FindPermission) ([]*store.Permission, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ResourceType; v != nil {
+		where, args = append(where, "resource_type = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.ResourceID; v != nil {
+		where, args = append(where, "resource_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalType; v != nil {
+		where, args = append(where, "principal_type = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalID; v != nil {
+		where, args = append(where, "principal_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			resource_type,
+			resource_id,
+			principal_type,
+			principal_id,
+			role,
+			created_ts
+		FROM resource_permission
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, principal_type ASC, principal_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Permission{}
+	for rows.Next() {
+		permission := &store.Permission{}
+		if err := rows.Scan(
+			&permission.ResourceType,
+			&permission.ResourceID,
+			&permission.PrincipalType,
+			&permission.PrincipalID,
+			&permission.Role,
+			&permission.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, permission)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeletePermission(ctx context.Context, delete *store.DeletePermission) error {
+	where, args := []string{}, []any{}
+	if v := delete.ResourceType; v != nil {
+		where, args = append(where, "resource_type = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.ResourceID; v != nil {
+		where, args = append(where, "resource_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.PrincipalType; v != nil {
+		where, args = append(where, "principal_type = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.PrincipalID; v != nil {
+		where, args = append(where, "principal_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(where) == 0 {
+		return errors.New("no delete condition specified")
+	}
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM resource_permission WHERE `+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumPermission(ctx context.Context, tx *sql.Tx) error {
+	stmt := `
+		DELETE FROM resource_permission
+		WHERE (principal_type = 'USER' AND principal_id NOT IN (SELECT id FROM "user"))
+			OR (resource_type = 'SHORTCUT' AND resource_id NOT IN (SELECT id FROM shortcut))
+			OR (resource_type = 'COLLECTION' AND resource_id NOT IN (SELECT id FROM collection))`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func (d *DB) CreateShortcutChangeRequest(ctx context.Context, create *store.ShortcutChangeRequest) (*store.ShortcutChangeRequest, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+	stmt := `
+		INSERT INTO shortcut_change_request (
+			shortcut_id,
+			creator_id,
+			payload
+		)
+		VALUES (` + placeholders(3) + `)
+		RETURNING id, created_ts, updated_ts, status, reviewer_id
+	`
+	var status string
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.ShortcutID,
+		create.CreatorID,
+		string(payload),
+	).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+		&status,
+		&create.ReviewerID,
+	); err != nil {
+		return nil, err
+	}
+	create.Status = store.ShortcutChangeRequestStatus(status)
+
+	return create, nil
+}
+
+func (d *DB) UpdateShortcutChangeRequest(ctx context.Context, update *store.UpdateShortcutChangeRequest) (*store.ShortcutChangeRequest, error) {
+	set, args := []string{}, []any{}
+	if update.UpdatedTs != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *update.UpdatedTs)
+	}
+	if update.Status != nil {
+		set, args = append(set, "status = "+placeholder(len(args)+1)), append(args, update.Status.String())
+	}
+	if update.ReviewerID != nil {
+		set, args = append(set, "reviewer_id = "+placeholder(len(args)+1)), append(args, *update.ReviewerID)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+
+	args = append(args, update.ID)
+	where := []string{"id = " + placeholder(len(args))}
+	if update.PendingOnly {
+		args = append(args, store.ShortcutChangeRequestPending.String())
+		where = append(where, "status = "+placeholder(len(args)))
+	}
+	stmt := `
+		UPDATE shortcut_change_request
+		SET ` + strings.Join(set, ", ") + `
+		WHERE ` + strings.Join(where, " AND ") + `
+		RETURNING id, shortcut_id, creator_id, created_ts, updated_ts, status, reviewer_id, payload
+	`
+	changeRequest := &store.ShortcutChangeRequest{}
+	var status, payload string
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&changeRequest.ID,
+		&changeRequest.ShortcutID,
+		&changeRequest.CreatorID,
+		&changeRequest.CreatedTs,
+		&changeRequest.UpdatedTs,
+		&status,
+		&changeRequest.ReviewerID,
+		&payload,
+	); err != nil {
+		if update.PendingOnly && errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	changeRequest.Status = store.ShortcutChangeRequestStatus(status)
+	changeRequest.Payload = &storepb.ShortcutChangeRequestPayload{}
+	if err := protojsonUnmarshaler.Unmarshal([]byte(payload), changeRequest.Payload); err != nil {
+		return nil, err
+	}
+
+	return changeRequest, nil
+}
+
+func (d *DB) ListShortcutChangeRequests(ctx context.Context, find *store.FindShortcutChangeRequest) ([]*store.ShortcutChangeRequest, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.ShortcutID != nil {
+		where, args = append(where, "shortcut_id = "+placeholder(len(args)+1)), append(args, *find.ShortcutID)
+	}
+	if v := find.ShortcutIDList; len(v) != 0 {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("shortcut_id IN (%s)", strings.Join(list, ",")))
+	}
+	if find.Status != nil {
+		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, find.Status.String())
+	}
+
+	stmt := `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			status,
+			reviewer_id,
+			payload
+		FROM shortcut_change_request
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id DESC`
+
+	rows, err := d.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutChangeRequest{}
+	for rows.Next() {
+		changeRequest := &store.ShortcutChangeRequest{}
+		var status, payload string
+		if err := rows.Scan(
+			&changeRequest.ID,
+			&changeRequest.ShortcutID,
+			&changeRequest.CreatorID,
+			&changeRequest.CreatedTs,
+			&changeRequest.UpdatedTs,
+			&status,
+			&changeRequest.ReviewerID,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		changeRequest.Status = store.ShortcutChangeRequestStatus(status)
+		changeRequest.Payload = &storepb.ShortcutChangeRequestPayload{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(payload), changeRequest.Payload); err != nil {
+			return nil, err
+		}
+		list = append(list, changeRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bshort/monotreme/store"
+)
+
+func (d *DB) CreateShortcutLinkChange(ctx context.Context, create *store.ShortcutLinkChange) (*store.ShortcutLinkChange, error) {
+	stmt := `
+		INSERT INTO shortcut_link_change (
+			shortcut_id,
+			creator_id,
+			effective_ts,
+			link
+		)
+		VALUES (` + placeholders(4) + `)
+		RETURNING id, created_ts, applied_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.ShortcutID,
+		create.CreatorID,
+		create.EffectiveTs,
+		create.Link,
+	).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.AppliedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListShortcutLinkChanges(ctx context.Context, find *store.FindShortcutLinkChange) ([]*store.ShortcutLinkChange, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.ShortcutID != nil {
+		where, args = append(where, "shortcut_id = "+placeholder(len(args)+1)), append(args, *find.ShortcutID)
+	}
+	if find.Pending != nil {
+		if *find.Pending {
+			where = append(where, "applied_ts = 0")
+		} else {
+			where = append(where, "applied_ts != 0")
+		}
+	}
+	if find.EffectiveBefore != nil {
+		where, args = append(where, "effective_ts <= "+placeholder(len(args)+1)), append(args, *find.EffectiveBefore)
+	}
+
+	order := "ORDER BY effective_ts ASC, id ASC"
+	if find.OrderByEffectiveTsDesc != nil && *find.OrderByEffectiveTsDesc {
+		order = "ORDER BY effective_ts DESC, id DESC"
+	}
+
+	stmt := `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			effective_ts,
+			link,
+			applied_ts
+		FROM shortcut_link_change
+		WHERE ` + strings.Join(where, " AND ") + `
+		` + order
+
+	rows, err := d.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutLinkChange{}
+	for rows.Next() {
+		change := &store.ShortcutLinkChange{}
+		if err := rows.Scan(
+			&change.ID,
+			&change.ShortcutID,
+			&change.CreatorID,
+			&change.CreatedTs,
+			&change.EffectiveTs,
+			&change.Link,
+			&change.AppliedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateShortcutLinkChange(ctx context.Context, update *store.UpdateShortcutLinkChange) (*store.ShortcutLinkChange, error) {
+	set, args := []string{}, []any{}
+	if update.AppliedTs != nil {
+		set, args = append(set, "applied_ts = "+placeholder(len(args)+1)), append(args, *update.AppliedTs)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+
+	stmt := `
+		UPDATE shortcut_link_change
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ` + placeholder(len(args)+1) + `
+		RETURNING id, shortcut_id, creator_id, created_ts, effective_ts, link, applied_ts
+	`
+	args = append(args, update.ID)
+	change := &store.ShortcutLinkChange{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&change.ID,
+		&change.ShortcutID,
+		&change.CreatorID,
+		&change.CreatedTs,
+		&change.EffectiveTs,
+		&change.Link,
+		&change.AppliedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+func (d *DB) DeleteShortcutLinkChange(ctx context.Context, delete *store.DeleteShortcutLinkChange) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_link_change WHERE id = $1`, delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+// createShortcutRevisions records the change from update.Previous to shortcut in tx, the
+// transaction of the update.
+func createShortcutRevisions(ctx context.Context, tx *sql.Tx, update *store.UpdateShortcut, shortcut *storepb.Shortcut) error {
+	if update.Previous == nil {
+		return nil
+	}
+	var hasRevisions bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM shortcut_revision WHERE shortcut_id = $1)`, shortcut.Id).Scan(&hasRevisions); err != nil {
+		return err
+	}
+	for _, revision := range store.NewShortcutRevisions(update.Previous, shortcut, update, hasRevisions) {
+		payload, err := protojson.Marshal(revision.Payload)
+		if err != nil {
+			return err
+		}
+		stmt := `
+			INSERT INTO shortcut_revision (
+				shortcut_id,
+				creator_id,
+				created_ts,
+				payload
+			)
+			VALUES (` + placeholders(4) + `)
+		`
+		if _, err := tx.ExecContext(ctx, stmt,
+			revision.ShortcutID,
+			revision.CreatorID,
+			revision.CreatedTs,
+			string(payload),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) ListShortcutRevisions(ctx context.Context, find *store.FindShortcutRevision) ([]*store.ShortcutRevision, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.ShortcutID != nil {
+		where, args = append(where, "shortcut_id = "+placeholder(len(args)+1)), append(args, *find.ShortcutID)
+	}
+
+	limit := ""
+	if find.Limit != nil {
+		limit = "LIMIT " + placeholder(len(args)+1)
+		args = append(args, *find.Limit)
+	}
+
+	stmt := `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			payload
+		FROM shortcut_revision
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id DESC
+		` + limit
+
+	rows, err := d.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutRevision{}
+	for rows.Next() {
+		revision := &store.ShortcutRevision{}
+		var payload string
+		if err := rows.Scan(
+			&revision.ID,
+			&revision.ShortcutID,
+			&revision.CreatorID,
+			&revision.CreatedTs,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		revision.Payload = &storepb.ShortcutRevisionPayload{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(payload), revision.Payload); err != nil {
+			return nil, err
+		}
+		list = append(list, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -34,6 +34,25 @@ func (d *DB) CreateActivity(ctx context.Context, create *store.Activity) (*store
 	return activity, nil
 }
 
+func (d *DB) CreateActivities(ctx context.Context, creates []*store.Activity) error {
+	values, args := []string{}, []any{}
+	for _, create := range creates {
+		values = append(values, "(?, ?, ?, ?, ?)")
+		args = append(args, create.CreatorID, create.CreatedTs, create.Type.String(), create.Level.String(), create.Payload)
+	}
+	stmt := `
+		INSERT INTO activity (
+			creator_id,
+			created_ts,
+			type,
+			level,
+			payload
+		)
+		VALUES ` + strings.Join(values, ", ")
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
 func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*store.Activity, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.Type != "" {
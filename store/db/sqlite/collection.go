@@ -2,7 +2,6 @@ package sqlite
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
@@ -14,9 +13,9 @@ import (
 )
 
 func (d *DB) CreateCollection(ctx context.Context, create *storepb.Collection) (*storepb.Collection, error) {
-	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "custom_icon"}
-	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String(), create.CustomIcon}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "title", "description", "shortcut_ids", "visibility", "custom_icon", "group_ids"}
+	args := []any{create.CreatorId, create.Name, create.Title, create.Description, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(create.ShortcutIds)), ","), "[]"), create.Visibility.String(), create.CustomIcon, formatIDList(create.GroupIds)}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?"}
 
 	stmt := `
 		INSERT INTO collection (
@@ -50,12 +49,21 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 	if update.ShortcutIDs != nil {
 		set, args = append(set, "shortcut_ids = ?"), append(args, strings.Trim(strings.Join(strings.Fields(fmt.Sprint(update.ShortcutIDs)), ","), "[]"))
 	}
+	if update.CreatorID != nil {
+		set, args = append(set, "creator_id = ?"), append(args, *update.CreatorID)
+	}
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
 	if update.CustomIcon != nil {
 		set, args = append(set, "custom_icon = ?"), append(args, *update.CustomIcon)
 	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = ?"), append(args, *update.DeletedTs)
+	}
+	if update.GroupIDs != nil {
+		set, args = append(set, "group_ids = ?"), append(args, formatIDList(update.GroupIDs))
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -67,10 +75,10 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, custom_icon
+		RETURNING id, creator_id, created_ts, updated_ts, name, title, description, shortcut_ids, visibility, custom_icon, deleted_ts, group_ids
 	`
 	collection := &storepb.Collection{}
-	var shortcutIDs, visibility string
+	var shortcutIDs, visibility, groupIDs string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&collection.Id,
 		&collection.CreatorId,
@@ -82,6 +90,8 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		&shortcutIDs,
 		&visibility,
 		&collection.CustomIcon,
+		&collection.DeletedTs,
+		&groupIDs,
 	); err != nil {
 		return nil, err
 	}
@@ -97,6 +107,11 @@ func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollectio
 		}
 	}
 	collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+	groupIDList, err := parseIDList(groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	collection.GroupIds = groupIDList
 	return collection, nil
 }
 
@@ -115,10 +130,26 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 		list := []string{}
 		for _, visibility := range v {
 			list = append(list, fmt.Sprintf("$%d", len(args)+1))
-			args = append(args, visibility)
+			args = append(args, visibility.String())
 		}
 		where = append(where, fmt.Sprintf("visibility in (%s)", strings.Join(list, ",")))
 	}
+	switch find.Trash {
+	case store.TrashExcluded:
+		where = append(where, "deleted_ts = 0")
+	case store.TrashOnly:
+		where = append(where, "deleted_ts != 0")
+	}
+	if v := find.DeletedBefore; v != nil {
+		where, args = append(where, "deleted_ts != 0 AND deleted_ts <= ?"), append(args, *v)
+	}
+	if v := find.ViewerID; v != nil {
+		if *v == 0 {
+			where = append(where, "visibility = 'PUBLIC'")
+		} else {
+			where, args = append(where, viewerCondition("collection")), append(args, *v, *v, *v, *v)
+		}
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -131,7 +162,9 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			description,
 			shortcut_ids,
 			visibility,
-			custom_icon
+			custom_icon,
+			deleted_ts,
+			group_ids
 		FROM collection
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -145,7 +178,7 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 	list := make([]*storepb.Collection, 0)
 	for rows.Next() {
 		collection := &storepb.Collection{}
-		var shortcutIDs, visibility string
+		var shortcutIDs, visibility, groupIDs string
 		if err := rows.Scan(
 			&collection.Id,
 			&collection.CreatorId,
@@ -157,6 +190,8 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			&shortcutIDs,
 			&visibility,
 			&collection.CustomIcon,
+			&collection.DeletedTs,
+			&groupIDs,
 		); err != nil {
 			return nil, err
 		}
@@ -172,6 +207,11 @@ func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([
 			}
 		}
 		collection.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+		groupIDList, err := parseIDList(groupIDs)
+		if err != nil {
+			return nil, err
+		}
+		collection.GroupIds = groupIDList
 		list = append(list, collection)
 	}
 
@@ -185,15 +225,9 @@ func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollectio
 	if _, err := d.db.ExecContext(ctx, `DELETE FROM collection WHERE id = ?`, delete.ID); err != nil {
 		return err
 	}
-
-	return nil
-}
-
-func vacuumCollection(ctx context.Context, tx *sql.Tx) error {
-	stmt := `DELETE FROM collection WHERE creator_id NOT IN (SELECT id FROM user)`
-	_, err := tx.ExecContext(ctx, stmt)
-	if err != nil {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM resource_permission WHERE resource_type = 'COLLECTION' AND resource_id = ?`, delete.ID); err != nil {
 		return err
 	}
+
 	return nil
 }
@@ -1,9 +1,65 @@
 package sqlite
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bshort/monotreme/internal/util"
+)
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
 )
+
+// formatIDList stores a list of IDs as a comma-separated string.
+func formatIDList(ids []int32) string {
+	return strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ","), "[]")
+}
+
+// parseIDList parses a list of IDs stored by formatIDList.
+func parseIDList(s string) ([]int32, error) {
+	var ids []int32
+	if s == "" {
+		return ids, nil
+	}
+	for _, idStr := range strings.Split(s, ",") {
+		id, err := util.ConvertStringToInt32(idStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert id")
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// viewerCondition keeps the rows of a shortcut or collection table that a signed-in viewer
+// may see, see store.IsVisibleTo. It takes the viewer ID as its four arguments.
+func viewerCondition(table string) string {
+	return `(visibility NOT IN ('PRIVATE', 'GROUP') OR creator_id = ? OR (visibility = 'GROUP' AND EXISTS (
+		SELECT 1 FROM user_group_member
+		WHERE user_group_member.user_id = ? AND instr(',' || ` + table + `.group_ids || ',', ',' || user_group_member.group_id || ',') > 0
+	)) OR EXISTS (
+		SELECT 1 FROM resource_permission
+		WHERE resource_permission.resource_type = '` + strings.ToUpper(table) + `' AND resource_permission.resource_id = ` + table + `.id AND (
+			(resource_permission.principal_type = 'USER' AND resource_permission.principal_id = ?)
+			OR (resource_permission.principal_type = 'GROUP' AND resource_permission.principal_id IN (SELECT group_id FROM user_group_member WHERE user_id = ?))
+		)
+	))`
+}
+
+// ownerCondition keeps the rows of a shortcut or collection table that a user owns, as their
+// creator or through an owner permission. It takes the user ID as its three arguments.
+func ownerCondition(table string) string {
+	return `(creator_id = ? OR EXISTS (
+		SELECT 1 FROM resource_permission
+		WHERE resource_permission.resource_type = '` + strings.ToUpper(table) + `' AND resource_permission.resource_id = ` + table + `.id AND resource_permission.role = 'OWNER' AND (
+			(resource_permission.principal_type = 'USER' AND resource_permission.principal_id = ?)
+			OR (resource_permission.principal_type = 'GROUP' AND resource_permission.principal_id IN (SELECT group_id FROM user_group_member WHERE user_id = ?))
+		)
+	))`
+}
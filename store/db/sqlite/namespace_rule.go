@@ -0,0 +1,138 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bshort/monotreme/store"
+)
+
+func (d *DB) CreateNamespaceRule(ctx context.Context, create *store.NamespaceRule) (*store.NamespaceRule, error) {
+	stmt := `
+		INSERT INTO namespace_rule (
+			creator_id,
+			pattern,
+			group_id,
+			require_approval
+		)
+		VALUES (?, ?, ?, ?)
+		RETURNING id, created_ts, updated_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.CreatorID,
+		create.Pattern,
+		create.GroupID,
+		create.RequireApproval,
+	).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) UpdateNamespaceRule(ctx context.Context, update *store.UpdateNamespaceRule) (*store.NamespaceRule, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = ?"), append(args, *v)
+	}
+	if v := update.Pattern; v != nil {
+		set, args = append(set, "pattern = ?"), append(args, *v)
+	}
+	if v := update.GroupID; v != nil {
+		set, args = append(set, "group_id = ?"), append(args, *v)
+	}
+	if v := update.RequireApproval; v != nil {
+		set, args = append(set, "require_approval = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update specified")
+	}
+	args = append(args, update.ID)
+
+	stmt := `
+		UPDATE namespace_rule
+		SET ` + strings.Join(set, ", ") + `
+		WHERE id = ?
+		RETURNING id, creator_id, created_ts, updated_ts, pattern, group_id, require_approval
+	`
+	rule := &store.NamespaceRule{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&rule.ID,
+		&rule.CreatorID,
+		&rule.CreatedTs,
+		&rule.UpdatedTs,
+		&rule.Pattern,
+		&rule.GroupID,
+		&rule.RequireApproval,
+	); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (d *DB) ListNamespaceRules(ctx context.Context, find *store.FindNamespaceRule) ([]*store.NamespaceRule, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = ?"), append(args, *v)
+	}
+	if v := find.Pattern; v != nil {
+		where, args = append(where, "pattern = ?"), append(args, *v)
+	}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = ?"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			pattern,
+			group_id,
+			require_approval
+		FROM namespace_rule
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY pattern ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.NamespaceRule{}
+	for rows.Next() {
+		rule := &store.NamespaceRule{}
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.CreatorID,
+			&rule.CreatedTs,
+			&rule.UpdatedTs,
+			&rule.Pattern,
+			&rule.GroupID,
+			&rule.RequireApproval,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) DeleteNamespaceRule(ctx context.Context, delete *store.DeleteNamespaceRule) error {
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM namespace_rule WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -14,9 +14,9 @@ import (
 )
 
 func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
-	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "uuid", "custom_icon"}
-	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.Uuid, create.CustomIcon}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	set := []string{"creator_id", "name", "link", "title", "description", "visibility", "tag", "uuid", "custom_icon", "template", "forward_path", "redirect_mode", "valid_from", "valid_until"}
+	args := []any{create.CreatorId, create.Name, create.Link, create.Title, create.Description, create.Visibility.String(), strings.Join(create.Tags, " "), create.Uuid, create.CustomIcon, create.Template, create.ForwardPath, create.RedirectMode.String(), create.ValidFrom, create.ValidUntil}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
 	if create.OgMetadata != nil {
 		set = append(set, "og_metadata")
 		openGraphMetadataBytes, err := protojson.Marshal(create.OgMetadata)
@@ -26,21 +26,63 @@ func (d *DB) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*sto
 		args = append(args, string(openGraphMetadataBytes))
 		placeholder = append(placeholder, "?")
 	}
+	if create.Variants == nil {
+		create.Variants = &storepb.ShortcutVariants{}
+	}
+	set = append(set, "variants")
+	variantsBytes, err := protojson.Marshal(create.Variants)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, string(variantsBytes))
+	placeholder = append(placeholder, "?")
+	if create.RoutingRules == nil {
+		create.RoutingRules = &storepb.ShortcutRoutingRules{}
+	}
+	set = append(set, "routing_rules")
+	routingRulesBytes, err := protojson.Marshal(create.RoutingRules)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, string(routingRulesBytes))
+	placeholder = append(placeholder, "?")
+	if create.Failover == nil {
+		create.Failover = &storepb.ShortcutFailover{}
+	}
+	set = append(set, "failover")
+	failoverBytes, err := protojson.Marshal(create.Failover)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, string(failoverBytes))
+	placeholder = append(placeholder, "?")
+	set = append(set, "group_ids")
+	args = append(args, formatIDList(create.GroupIds))
+	placeholder = append(placeholder, "?")
+	set = append(set, "protected")
+	args = append(args, create.Protected)
+	placeholder = append(placeholder, "?")
+	set = append(set, "normalized_name")
+	args = append(args, sql.NullString{String: create.NormalizedName, Valid: create.NormalizedName != ""})
+	placeholder = append(placeholder, "?")
 
 	stmt := `
 		INSERT INTO shortcut (
 			` + strings.Join(set, ", ") + `
 		)
 		VALUES (` + strings.Join(placeholder, ",") + `)
-		RETURNING id, created_ts, updated_ts
+		RETURNING id, created_ts, updated_ts, row_status
 	`
+	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
 		&create.Id,
 		&create.CreatedTs,
 		&create.UpdatedTs,
+		&rowStatus,
 	); err != nil {
 		return nil, err
 	}
+	create.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut := create
 	return shortcut, nil
 }
@@ -59,6 +101,9 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 	if update.Description != nil {
 		set, args = append(set, "description = ?"), append(args, *update.Description)
 	}
+	if update.CreatorID != nil {
+		set, args = append(set, "creator_id = ?"), append(args, *update.CreatorID)
+	}
 	if update.Visibility != nil {
 		set, args = append(set, "visibility = ?"), append(args, update.Visibility.String())
 	}
@@ -75,6 +120,58 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 	if update.CustomIcon != nil {
 		set, args = append(set, "custom_icon = ?"), append(args, *update.CustomIcon)
 	}
+	if update.Template != nil {
+		set, args = append(set, "template = ?"), append(args, *update.Template)
+	}
+	if update.ForwardPath != nil {
+		set, args = append(set, "forward_path = ?"), append(args, *update.ForwardPath)
+	}
+	if update.RedirectMode != nil {
+		set, args = append(set, "redirect_mode = ?"), append(args, update.RedirectMode.String())
+	}
+	if update.ValidFrom != nil {
+		set, args = append(set, "valid_from = ?"), append(args, *update.ValidFrom)
+	}
+	if update.ValidUntil != nil {
+		set, args = append(set, "valid_until = ?"), append(args, *update.ValidUntil)
+	}
+	if update.RowStatus != nil {
+		set, args = append(set, "row_status = ?"), append(args, update.RowStatus.String())
+	}
+	if update.Variants != nil {
+		variantsBytes, err := protojson.Marshal(update.Variants)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal variants")
+		}
+		set, args = append(set, "variants = ?"), append(args, string(variantsBytes))
+	}
+	if update.RoutingRules != nil {
+		routingRulesBytes, err := protojson.Marshal(update.RoutingRules)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal routing rules")
+		}
+		set, args = append(set, "routing_rules = ?"), append(args, string(routingRulesBytes))
+	}
+	if update.Failover != nil {
+		failoverBytes, err := protojson.Marshal(update.Failover)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to marshal failover")
+		}
+		set, args = append(set, "failover = ?"), append(args, string(failoverBytes))
+	}
+	if update.NormalizedName != nil {
+		// Shortcuts that lose a name collision are stored without a normalized name.
+		set, args = append(set, "normalized_name = ?"), append(args, sql.NullString{String: *update.NormalizedName, Valid: *update.NormalizedName != ""})
+	}
+	if update.DeletedTs != nil {
+		set, args = append(set, "deleted_ts = ?"), append(args, *update.DeletedTs)
+	}
+	if update.GroupIDs != nil {
+		set, args = append(set, "group_ids = ?"), append(args, formatIDList(update.GroupIDs))
+	}
+	if update.Protected != nil {
+		set, args = append(set, "protected = ?"), append(args, *update.Protected)
+	}
 	if len(set) == 0 {
 		return nil, errors.New("no update specified")
 	}
@@ -86,11 +183,17 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 			` + strings.Join(set, ", ") + `
 		WHERE
 			id = ?
-		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata, uuid, custom_icon
+		RETURNING id, creator_id, created_ts, updated_ts, name, link, title, description, visibility, tag, og_metadata, uuid, custom_icon, template, forward_path, redirect_mode, valid_from, valid_until, row_status, variants, routing_rules, failover, normalized_name, deleted_ts, group_ids, protected
 	`
 	shortcut := &storepb.Shortcut{}
-	var visibility, tags, openGraphMetadataString string
-	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+	var visibility, tags, openGraphMetadataString, redirectMode, rowStatus, variantsString, routingRulesString, failoverString, groupIDs string
+	var normalizedName sql.NullString
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	if err := tx.QueryRowContext(ctx, stmt, args...).Scan(
 		&shortcut.Id,
 		&shortcut.CreatorId,
 		&shortcut.CreatedTs,
@@ -104,16 +207,59 @@ func (d *DB) UpdateShortcut(ctx context.Context, update *store.UpdateShortcut) (
 		&openGraphMetadataString,
 		&shortcut.Uuid,
 		&shortcut.CustomIcon,
+		&shortcut.Template,
+		&shortcut.ForwardPath,
+		&redirectMode,
+		&shortcut.ValidFrom,
+		&shortcut.ValidUntil,
+		&rowStatus,
+		&variantsString,
+		&routingRulesString,
+		&failoverString,
+		&normalizedName,
+		&shortcut.DeletedTs,
+		&groupIDs,
+		&shortcut.Protected,
 	); err != nil {
 		return nil, err
 	}
 	shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+	shortcut.RedirectMode = store.ConvertRedirectModeStringToStorepb(redirectMode)
+	shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 	shortcut.Tags = filterTags(strings.Split(tags, " "))
 	var ogMetadata storepb.OpenGraphMetadata
 	if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
 		return nil, err
 	}
 	shortcut.OgMetadata = &ogMetadata
+	var variants storepb.ShortcutVariants
+	if err := protojson.Unmarshal([]byte(variantsString), &variants); err != nil {
+		return nil, err
+	}
+	shortcut.Variants = &variants
+	var routingRules storepb.ShortcutRoutingRules
+	if err := protojson.Unmarshal([]byte(routingRulesString), &routingRules); err != nil {
+		return nil, err
+	}
+	shortcut.RoutingRules = &routingRules
+	var failover storepb.ShortcutFailover
+	if err := protojson.Unmarshal([]byte(failoverString), &failover); err != nil {
+		return nil, err
+	}
+	shortcut.Failover = &failover
+	shortcut.NormalizedName = normalizedName.String
+	groupIDList, err := parseIDList(groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	shortcut.GroupIds = groupIDList
+
+	if err := createShortcutRevisions(ctx, tx, update, shortcut); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return shortcut, nil
 }
 
@@ -128,6 +274,22 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	if v := find.Name; v != nil {
 		where, args = append(where, "name = ?"), append(args, *v)
 	}
+	if v := find.NameList; len(v) != 0 {
+		list := []string{}
+		for _, name := range v {
+			list = append(list, "?")
+			args = append(args, name)
+		}
+		where = append(where, fmt.Sprintf("name IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.NormalizedNameList; len(v) != 0 {
+		list := []string{}
+		for _, name := range v {
+			list = append(list, "?")
+			args = append(args, name)
+		}
+		where = append(where, fmt.Sprintf("normalized_name IN (%s)", strings.Join(list, ",")))
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		list := []string{}
 		for _, visibility := range v {
@@ -139,6 +301,34 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	if v := find.Tag; v != nil {
 		where, args = append(where, "tag LIKE ?"), append(args, "%"+*v+"%")
 	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "row_status = ?"), append(args, v.String())
+	}
+	if v := find.NotExpiredAt; v != nil {
+		where, args = append(where, "(valid_until = 0 OR valid_until > ?)"), append(args, *v)
+	}
+	if v := find.ExpiredBefore; v != nil {
+		where, args = append(where, "valid_until != 0 AND valid_until <= ?"), append(args, *v)
+	}
+	switch find.Trash {
+	case store.TrashExcluded:
+		where = append(where, "deleted_ts = 0")
+	case store.TrashOnly:
+		where = append(where, "deleted_ts != 0")
+	}
+	if v := find.DeletedBefore; v != nil {
+		where, args = append(where, "deleted_ts != 0 AND deleted_ts <= ?"), append(args, *v)
+	}
+	if v := find.ViewerID; v != nil {
+		if *v == 0 {
+			where = append(where, "visibility = 'PUBLIC'")
+		} else {
+			where, args = append(where, viewerCondition("shortcut")), append(args, *v, *v, *v, *v)
+		}
+	}
+	if v := find.OwnerID; v != nil {
+		where, args = append(where, ownerCondition("shortcut")), append(args, *v, *v, *v)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -154,7 +344,20 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			tag,
 			og_metadata,
 			uuid,
-			custom_icon
+			custom_icon,
+			template,
+			forward_path,
+			redirect_mode,
+			valid_from,
+			valid_until,
+			row_status,
+			variants,
+			routing_rules,
+			failover,
+			normalized_name,
+			deleted_ts,
+			group_ids,
+			protected
 		FROM shortcut
 		WHERE `+strings.Join(where, " AND ")+`
 		ORDER BY created_ts DESC`,
@@ -168,7 +371,8 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	list := make([]*storepb.Shortcut, 0)
 	for rows.Next() {
 		shortcut := &storepb.Shortcut{}
-		var visibility, tags, openGraphMetadataString string
+		var visibility, tags, openGraphMetadataString, redirectMode, rowStatus, variantsString, routingRulesString, failoverString, groupIDs string
+		var normalizedName sql.NullString
 		if err := rows.Scan(
 			&shortcut.Id,
 			&shortcut.CreatorId,
@@ -183,16 +387,52 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 			&openGraphMetadataString,
 			&shortcut.Uuid,
 			&shortcut.CustomIcon,
+			&shortcut.Template,
+			&shortcut.ForwardPath,
+			&redirectMode,
+			&shortcut.ValidFrom,
+			&shortcut.ValidUntil,
+			&rowStatus,
+			&variantsString,
+			&routingRulesString,
+			&failoverString,
+			&normalizedName,
+			&shortcut.DeletedTs,
+			&groupIDs,
+			&shortcut.Protected,
 		); err != nil {
 			return nil, err
 		}
 		shortcut.Visibility = store.ConvertVisibilityStringToStorepb(visibility)
+		shortcut.RedirectMode = store.ConvertRedirectModeStringToStorepb(redirectMode)
+		shortcut.RowStatus = store.ConvertRowStatusStringToStorepb(rowStatus)
 		shortcut.Tags = filterTags(strings.Split(tags, " "))
 		var ogMetadata storepb.OpenGraphMetadata
 		if err := protojson.Unmarshal([]byte(openGraphMetadataString), &ogMetadata); err != nil {
 			return nil, err
 		}
 		shortcut.OgMetadata = &ogMetadata
+		var variants storepb.ShortcutVariants
+		if err := protojson.Unmarshal([]byte(variantsString), &variants); err != nil {
+			return nil, err
+		}
+		shortcut.Variants = &variants
+		var routingRules storepb.ShortcutRoutingRules
+		if err := protojson.Unmarshal([]byte(routingRulesString), &routingRules); err != nil {
+			return nil, err
+		}
+		shortcut.RoutingRules = &routingRules
+		var failover storepb.ShortcutFailover
+		if err := protojson.Unmarshal([]byte(failoverString), &failover); err != nil {
+			return nil, err
+		}
+		shortcut.Failover = &failover
+		shortcut.NormalizedName = normalizedName.String
+		groupIDList, err := parseIDList(groupIDs)
+		if err != nil {
+			return nil, err
+		}
+		shortcut.GroupIds = groupIDList
 		list = append(list, shortcut)
 	}
 
@@ -202,22 +442,79 @@ func (d *DB) ListShortcuts(ctx context.Context, find *store.FindShortcut) ([]*st
 	return list, nil
 }
 
+// shortcutTables are the tables whose rows belong to a shortcut through their shortcut_id. The
+// rows are deleted with the shortcut.
+var shortcutTables = []string{
+	// Aliases of a deleted shortcut would otherwise keep their names taken.
+	"shortcut_alias",
+	"shortcut_revision",
+	"shortcut_change_request",
+	// Scheduled link changes would otherwise be picked up for a shortcut that is gone.
+	"shortcut_link_change",
+	"shortcut_target_health",
+}
+
 func (d *DB) DeleteShortcut(ctx context.Context, delete *store.DeleteShortcut) error {
-	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return nil
+	if _, err := tx.ExecContext(ctx, `DELETE FROM shortcut WHERE id = ?`, delete.ID); err != nil {
+		return err
+	}
+	for _, table := range shortcutTables {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE shortcut_id = ?`, delete.ID); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM resource_permission WHERE resource_type = 'SHORTCUT' AND resource_id = ?`, delete.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
+// vacuumShortcut deletes the rows left behind by deleted shortcuts. The shortcuts of a deleted
+// user are transferred or deleted beforehand, see disposeUserContent.
 func vacuumShortcut(ctx context.Context, tx *sql.Tx) error {
-	stmt := `DELETE FROM shortcut WHERE creator_id NOT IN (SELECT id FROM user)`
-	_, err := tx.ExecContext(ctx, stmt)
+	for _, table := range shortcutTables {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE shortcut_id NOT IN (SELECT id FROM shortcut)`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) UpdateShortcutNormalizedNames(ctx context.Context, normalizedNames, aliasNormalizedNames map[int32]string) error {
+	tx, err := d.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	return nil
+	// Clear the names first, so that two shortcuts or aliases can swap them without tripping the unique indexes.
+	tables := map[string]map[int32]string{"shortcut": normalizedNames, "shortcut_alias": aliasNormalizedNames}
+	for table, names := range tables {
+		for id := range names {
+			if _, err := tx.ExecContext(ctx, `UPDATE `+table+` SET normalized_name = NULL WHERE id = ?`, id); err != nil {
+				return err
+			}
+		}
+	}
+	for table, names := range tables {
+		for id, normalizedName := range names {
+			if normalizedName == "" {
+				continue
+			}
+			if _, err := tx.ExecContext(ctx, `UPDATE `+table+` SET normalized_name = ? WHERE id = ?`, normalizedName, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
 }
 
 func filterTags(tags []string) []string {
@@ -0,0 +1,123 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func (d *DB) CreateShortcutAlias(ctx context.Context, create *storepb.ShortcutAlias) (*storepb.ShortcutAlias, error) {
+	stmt := `
+		INSERT INTO shortcut_alias (
+			shortcut_id,
+			creator_id,
+			name,
+			normalized_name
+		)
+		VALUES (?, ?, ?, ?)
+		RETURNING id, created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt,
+		create.ShortcutId,
+		create.CreatorId,
+		create.Name,
+		sql.NullString{String: create.NormalizedName, Valid: create.NormalizedName != ""},
+	).Scan(
+		&create.Id,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListShortcutAliases(ctx context.Context, find *store.FindShortcutAlias) ([]*storepb.ShortcutAlias, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ShortcutID; v != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *v)
+	}
+	if v := find.ShortcutIDList; len(v) != 0 {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("shortcut_id IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = ?"), append(args, *v)
+	}
+	if v := find.NameList; len(v) != 0 {
+		list := []string{}
+		for _, name := range v {
+			list = append(list, "?")
+			args = append(args, name)
+		}
+		where = append(where, fmt.Sprintf("name IN (%s)", strings.Join(list, ",")))
+	}
+	if v := find.NormalizedNameList; len(v) != 0 {
+		list := []string{}
+		for _, name := range v {
+			list = append(list, "?")
+			args = append(args, name)
+		}
+		where = append(where, fmt.Sprintf("normalized_name IN (%s)", strings.Join(list, ",")))
+	}
+
+	stmt := `
+		SELECT
+			id,
+			shortcut_id,
+			creator_id,
+			created_ts,
+			name,
+			normalized_name
+		FROM shortcut_alias
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY created_ts ASC, id ASC`
+	rows, err := d.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*storepb.ShortcutAlias{}
+	for rows.Next() {
+		alias := &storepb.ShortcutAlias{}
+		var normalizedName sql.NullString
+		if err := rows.Scan(
+			&alias.Id,
+			&alias.ShortcutId,
+			&alias.CreatorId,
+			&alias.CreatedTs,
+			&alias.Name,
+			&normalizedName,
+		); err != nil {
+			return nil, err
+		}
+		alias.NormalizedName = normalizedName.String
+		list = append(list, alias)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutAlias(ctx context.Context, delete *store.DeleteShortcutAlias) error {
+	where, args := []string{"shortcut_id = ?"}, []any{delete.ShortcutID}
+	if delete.Name != nil {
+		where, args = append(where, "name = ?"), append(args, *delete.Name)
+	}
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_alias WHERE `+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bshort/monotreme/store"
+)
+
+func (d *DB) UpsertShortcutTargetHealth(ctx context.Context, upsert *store.ShortcutTargetHealth) (*store.ShortcutTargetHealth, error) {
+	stmt := `
+		INSERT INTO shortcut_target_health (
+			shortcut_id,
+			link,
+			healthy,
+			status_code,
+			error,
+			checked_ts
+		)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(shortcut_id, link) DO UPDATE
+		SET healthy = EXCLUDED.healthy, status_code = EXCLUDED.status_code, error = EXCLUDED.error, checked_ts = EXCLUDED.checked_ts
+	`
+	if _, err := d.db.ExecContext(ctx, stmt,
+		upsert.ShortcutID,
+		upsert.Link,
+		upsert.Healthy,
+		upsert.StatusCode,
+		upsert.Error,
+		upsert.CheckedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return upsert, nil
+}
+
+func (d *DB) ListShortcutTargetHealth(ctx context.Context, find *store.FindShortcutTargetHealth) ([]*store.ShortcutTargetHealth, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ShortcutID != nil {
+		where, args = append(where, "shortcut_id = ?"), append(args, *find.ShortcutID)
+	}
+
+	if v := find.ShortcutIDList; len(v) != 0 {
+		list := []string{}
+		for _, id := range v {
+			list = append(list, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("shortcut_id IN (%s)", strings.Join(list, ",")))
+	}
+	stmt := `
+		SELECT
+			shortcut_id,
+			link,
+			healthy,
+			status_code,
+			error,
+			checked_ts
+		FROM shortcut_target_health
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY shortcut_id ASC, link ASC`
+	rows, err := d.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ShortcutTargetHealth{}
+	for rows.Next() {
+		health := &store.ShortcutTargetHealth{}
+		if err := rows.Scan(
+			&health.ShortcutID,
+			&health.Link,
+			&health.Healthy,
+			&health.StatusCode,
+			&health.Error,
+			&health.CheckedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, health)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteShortcutTargetHealth(ctx context.Context, delete *store.DeleteShortcutTargetHealth) error {
+	where, args := []string{"shortcut_id = ?"}, []any{delete.ShortcutID}
+	if delete.Link != nil {
+		where, args = append(where, "link = ?"), append(args, *delete.Link)
+	}
+	if _, err := d.db.ExecContext(ctx, `DELETE FROM shortcut_target_health WHERE `+strings.Join(where, " AND "), args...); err != nil {
+		return err
+	}
+	return nil
+}
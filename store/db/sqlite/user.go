@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
 
@@ -207,6 +208,9 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	}
 	defer tx.Rollback()
 
+	if err := disposeUserContent(ctx, tx, delete); err != nil {
+		return err
+	}
 	if _, err := tx.ExecContext(ctx, `
 		DELETE FROM user WHERE id = ?
 	`, delete.ID); err != nil {
@@ -219,9 +223,60 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	if err := vacuumShortcut(ctx, tx); err != nil {
 		return err
 	}
-	if err := vacuumCollection(ctx, tx); err != nil {
+	if err := vacuumGroupMember(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumPermission(ctx, tx); err != nil {
 		return err
 	}
 
 	return tx.Commit()
 }
+
+// disposeUserContent transfers or deletes the shortcuts and collections of a deleted user.
+func disposeUserContent(ctx context.Context, tx *sql.Tx, delete *store.DeleteUser) error {
+	switch delete.ContentDisposition {
+	case store.UserContentTransfer:
+		if delete.TransferCreatorID == 0 || delete.TransferCreatorID == delete.ID {
+			return errors.New("invalid transfer creator")
+		}
+		if delete.TransferGroupID != 0 {
+			// The members of the group own the transferred content along with its creator.
+			for resourceType, table := range map[store.PermissionResourceType]string{
+				store.PermissionResourceTypeShortcut:   "shortcut",
+				store.PermissionResourceTypeCollection: "collection",
+			} {
+				if _, err := tx.ExecContext(ctx, `
+					INSERT INTO resource_permission (resource_type, resource_id, principal_type, principal_id, role)
+					SELECT ?, id, ?, ?, ? FROM `+table+` WHERE creator_id = ?
+					ON CONFLICT(resource_type, resource_id, principal_type, principal_id) DO UPDATE SET role = EXCLUDED.role
+				`, resourceType, store.PermissionPrincipalTypeGroup, delete.TransferGroupID, store.PermissionRoleOwner, delete.ID); err != nil {
+					return err
+				}
+			}
+		}
+		for _, table := range []string{"shortcut", "collection", "user_group"} {
+			if _, err := tx.ExecContext(ctx, `UPDATE `+table+` SET creator_id = ? WHERE creator_id = ?`, delete.TransferCreatorID, delete.ID); err != nil {
+				return err
+			}
+		}
+	case store.UserContentDelete:
+		// Groups are shared with their members, so they are transferred rather than deleted.
+		var ownsGroups bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM user_group WHERE creator_id = ?)`, delete.ID).Scan(&ownsGroups); err != nil {
+			return err
+		}
+		if ownsGroups {
+			return errors.New("the groups of the user must be transferred")
+		}
+		// The content skips the trash, since nobody would be left to restore it.
+		for _, table := range []string{"shortcut", "collection"} {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE creator_id = ?`, delete.ID); err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.New("invalid content disposition")
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ type Driver interface {
 
 	// Activity model related methods.
 	CreateActivity(ctx context.Context, create *Activity) (*Activity, error)
+	// CreateActivities inserts creates in a single statement, keeping their CreatedTs.
+	CreateActivities(ctx context.Context, creates []*Activity) error
 	ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error)
 
 	// Collection model related methods.
@@ -27,11 +29,58 @@ type Driver interface {
 	ListCollections(ctx context.Context, find *FindCollection) ([]*storepb.Collection, error)
 	DeleteCollection(ctx context.Context, delete *DeleteCollection) error
 
+	// Group model related methods.
+	CreateGroup(ctx context.Context, create *Group) (*Group, error)
+	UpdateGroup(ctx context.Context, update *UpdateGroup) (*Group, error)
+	ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error)
+	DeleteGroup(ctx context.Context, delete *DeleteGroup) error
+
+	// GroupMember model related methods.
+	UpsertGroupMember(ctx context.Context, upsert *GroupMember) (*GroupMember, error)
+	ListGroupMembers(ctx context.Context, find *FindGroupMember) ([]*GroupMember, error)
+	DeleteGroupMember(ctx context.Context, delete *DeleteGroupMember) error
+
+	// NamespaceRule model related methods.
+	CreateNamespaceRule(ctx context.Context, create *NamespaceRule) (*NamespaceRule, error)
+	UpdateNamespaceRule(ctx context.Context, update *UpdateNamespaceRule) (*NamespaceRule, error)
+	ListNamespaceRules(ctx context.Context, find *FindNamespaceRule) ([]*NamespaceRule, error)
+	DeleteNamespaceRule(ctx context.Context, delete *DeleteNamespaceRule) error
+
+	// Permission model related methods.
+	UpsertPermission(ctx context.Context, upsert *Permission) (*Permission, error)
+	ListPermissions(ctx context.Context, find *FindPermission) ([]*Permission, error)
+	DeletePermission(ctx context.Context, delete *DeletePermission) error
+
 	// Shortcut model related methods.
 	CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error)
 	UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error)
 	ListShortcuts(ctx context.Context, find *FindShortcut) ([]*storepb.Shortcut, error)
 	DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error
+	UpdateShortcutNormalizedNames(ctx context.Context, normalizedNames, aliasNormalizedNames map[int32]string) error
+
+	// ShortcutAlias model related methods.
+	CreateShortcutAlias(ctx context.Context, create *storepb.ShortcutAlias) (*storepb.ShortcutAlias, error)
+	ListShortcutAliases(ctx context.Context, find *FindShortcutAlias) ([]*storepb.ShortcutAlias, error)
+	DeleteShortcutAlias(ctx context.Context, delete *DeleteShortcutAlias) error
+
+	// ShortcutChangeRequest model related methods.
+	CreateShortcutChangeRequest(ctx context.Context, create *ShortcutChangeRequest) (*ShortcutChangeRequest, error)
+	UpdateShortcutChangeRequest(ctx context.Context, update *UpdateShortcutChangeRequest) (*ShortcutChangeRequest, error)
+	ListShortcutChangeRequests(ctx context.Context, find *FindShortcutChangeRequest) ([]*ShortcutChangeRequest, error)
+
+	// ShortcutLinkChange model related methods.
+	CreateShortcutLinkChange(ctx context.Context, create *ShortcutLinkChange) (*ShortcutLinkChange, error)
+	ListShortcutLinkChanges(ctx context.Context, find *FindShortcutLinkChange) ([]*ShortcutLinkChange, error)
+	UpdateShortcutLinkChange(ctx context.Context, update *UpdateShortcutLinkChange) (*ShortcutLinkChange, error)
+	DeleteShortcutLinkChange(ctx context.Context, delete *DeleteShortcutLinkChange) error
+
+	// ShortcutRevision model related methods.
+	ListShortcutRevisions(ctx context.Context, find *FindShortcutRevision) ([]*ShortcutRevision, error)
+
+	// ShortcutTargetHealth model related methods.
+	UpsertShortcutTargetHealth(ctx context.Context, upsert *ShortcutTargetHealth) (*ShortcutTargetHealth, error)
+	ListShortcutTargetHealth(ctx context.Context, find *FindShortcutTargetHealth) ([]*ShortcutTargetHealth, error)
+	DeleteShortcutTargetHealth(ctx context.Context, delete *DeleteShortcutTargetHealth) error
 
 	// User model related methods.
 	CreateUser(ctx context.Context, create *User) (*User, error)
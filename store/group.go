@@ -0,0 +1,108 @@
+package store
+
+import (
+	"context"
+)
+
+// Group is a team of users that shortcuts and collections with GROUP visibility are shared with.
+type Group struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	Name        string
+	Description string
+}
+
+type UpdateGroup struct {
+	ID int32
+
+	UpdatedTs   *int64
+	Name        *string
+	Description *string
+}
+
+type FindGroup struct {
+	ID        *int32
+	IDList    []int32
+	CreatorID *int32
+	Name      *string
+	// MemberID only keeps the groups the user is a member of.
+	MemberID *int32
+}
+
+type DeleteGroup struct {
+	ID int32
+}
+
+// GroupMember is the membership of a user in a group.
+type GroupMember struct {
+	GroupID   int32
+	UserID    int32
+	CreatedTs int64
+}
+
+type FindGroupMember struct {
+	GroupID     *int32
+	GroupIDList []int32
+	UserID      *int32
+}
+
+type DeleteGroupMember struct {
+	GroupID int32
+	// UserID restricts the deletion to a single member, otherwise all members of the group are deleted.
+	UserID *int32
+}
+
+func (s *Store) CreateGroup(ctx context.Context, create *Group) (*Group, error) {
+	return s.driver.CreateGroup(ctx, create)
+}
+
+func (s *Store) UpdateGroup(ctx context.Context, update *UpdateGroup) (*Group, error) {
+	return s.driver.UpdateGroup(ctx, update)
+}
+
+func (s *Store) ListGroups(ctx context.Context, find *FindGroup) ([]*Group, error) {
+	return s.driver.ListGroups(ctx, find)
+}
+
+func (s *Store) GetGroup(ctx context.Context, find *FindGroup) (*Group, error) {
+	list, err := s.ListGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteGroup deletes the group, its memberships and the permissions granted to it. Shortcuts
+// and collections keep the ID of the deleted group, which no longer grants access to anyone.
+// Namespace rules keep it too, so their namespaces stay reserved until an admin reassigns them.
+func (s *Store) DeleteGroup(ctx context.Context, delete *DeleteGroup) error {
+	if err := s.driver.DeleteGroupMember(ctx, &DeleteGroupMember{GroupID: delete.ID}); err != nil {
+		return err
+	}
+	principalType := PermissionPrincipalTypeGroup
+	if err := s.driver.DeletePermission(ctx, &DeletePermission{
+		PrincipalType: &principalType,
+		PrincipalID:   &delete.ID,
+	}); err != nil {
+		return err
+	}
+	return s.driver.DeleteGroup(ctx, delete)
+}
+
+func (s *Store) UpsertGroupMember(ctx context.Context, upsert *GroupMember) (*GroupMember, error) {
+	return s.driver.UpsertGroupMember(ctx, upsert)
+}
+
+func (s *Store) ListGroupMembers(ctx context.Context, find *FindGroupMember) ([]*GroupMember, error) {
+	return s.driver.ListGroupMembers(ctx, find)
+}
+
+func (s *Store) DeleteGroupMember(ctx context.Context, delete *DeleteGroupMember) error {
+	return s.driver.DeleteGroupMember(ctx, delete)
+}
@@ -0,0 +1,2 @@
+-- Add forward_path column to shortcut table
+ALTER TABLE shortcut ADD COLUMN forward_path BOOLEAN NOT NULL DEFAULT false;
\ No newline at end of file
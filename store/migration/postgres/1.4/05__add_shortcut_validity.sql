@@ -0,0 +1,3 @@
+-- Add valid_from and valid_until columns to shortcut table
+ALTER TABLE shortcut ADD COLUMN valid_from BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE shortcut ADD COLUMN valid_until BIGINT NOT NULL DEFAULT 0;
\ No newline at end of file
@@ -0,0 +1,2 @@
+-- Add variants column to shortcut table
+ALTER TABLE shortcut ADD COLUMN variants TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+-- Add routing_rules column to shortcut table
+ALTER TABLE shortcut ADD COLUMN routing_rules TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,13 @@
+-- Add failover column to shortcut table
+ALTER TABLE shortcut ADD COLUMN failover TEXT NOT NULL DEFAULT '{}';
+
+-- shortcut_target_health table for the last health check of each shortcut target
+CREATE TABLE shortcut_target_health (
+  shortcut_id INTEGER NOT NULL,
+  link TEXT NOT NULL,
+  healthy BOOLEAN NOT NULL DEFAULT true,
+  status_code INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  checked_ts BIGINT NOT NULL,
+  PRIMARY KEY (shortcut_id, link)
+);
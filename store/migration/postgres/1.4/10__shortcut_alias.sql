@@ -0,0 +1,10 @@
+-- shortcut_alias table for other names that resolve to a shortcut
+CREATE TABLE shortcut_alias (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
@@ -0,0 +1,10 @@
+-- shortcut_revision table for the change history of shortcuts
+CREATE TABLE shortcut_revision (
+  id SERIAL PRIMARY KEY,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_revision_shortcut_id ON shortcut_revision(shortcut_id);
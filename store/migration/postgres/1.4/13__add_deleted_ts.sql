@@ -0,0 +1,3 @@
+-- Add deleted_ts columns to shortcut and collection tables for the trash
+ALTER TABLE shortcut ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE collection ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
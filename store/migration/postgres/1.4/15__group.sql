@@ -0,0 +1,28 @@
+-- user_group table for teams that shortcuts and collections can be shared with
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member table for the members of a group
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- Add group_ids columns and allow GROUP visibility on shortcut and collection tables
+ALTER TABLE shortcut ADD COLUMN group_ids INTEGER ARRAY NOT NULL DEFAULT '{}';
+ALTER TABLE shortcut DROP CONSTRAINT IF EXISTS shortcut_visibility_check;
+ALTER TABLE shortcut ADD CONSTRAINT shortcut_visibility_check CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP'));
+
+ALTER TABLE collection ADD COLUMN group_ids INTEGER ARRAY NOT NULL DEFAULT '{}';
+ALTER TABLE collection DROP CONSTRAINT IF EXISTS collection_visibility_check;
+ALTER TABLE collection ADD CONSTRAINT collection_visibility_check CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP'));
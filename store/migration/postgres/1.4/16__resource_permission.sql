@@ -0,0 +1,12 @@
+-- resource_permission table for the roles granted to users and groups on shortcuts and collections
+CREATE TABLE resource_permission (
+  resource_type TEXT NOT NULL CHECK (resource_type IN ('SHORTCUT', 'COLLECTION')),
+  resource_id INTEGER NOT NULL,
+  principal_type TEXT NOT NULL CHECK (principal_type IN ('USER', 'GROUP')),
+  principal_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR', 'OWNER')),
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  PRIMARY KEY (resource_type, resource_id, principal_type, principal_id)
+);
+
+CREATE INDEX idx_resource_permission_principal ON resource_permission(principal_type, principal_id);
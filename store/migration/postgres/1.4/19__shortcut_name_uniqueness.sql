@@ -0,0 +1,22 @@
+-- Shortcut names and aliases share one namespace: the unique indexes cover each table,
+-- this trigger covers a name used by one shortcut and an alias of another. The advisory
+-- lock makes concurrent writers check one after the other.
+CREATE FUNCTION check_shortcut_name() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_advisory_xact_lock(hashtext('shortcut_name'));
+  IF TG_TABLE_NAME = 'shortcut' THEN
+    IF EXISTS (SELECT 1 FROM shortcut_alias WHERE name = NEW.name AND shortcut_id <> NEW.id) THEN
+      RAISE EXCEPTION 'shortcut name % is an alias of another shortcut', NEW.name USING ERRCODE = 'unique_violation';
+    END IF;
+  ELSIF EXISTS (SELECT 1 FROM shortcut WHERE name = NEW.name AND id <> NEW.shortcut_id) THEN
+    RAISE EXCEPTION 'shortcut alias % is the name of another shortcut', NEW.name USING ERRCODE = 'unique_violation';
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_shortcut_name BEFORE INSERT OR UPDATE OF name ON shortcut
+FOR EACH ROW EXECUTE FUNCTION check_shortcut_name();
+
+CREATE TRIGGER trg_shortcut_alias_name BEFORE INSERT OR UPDATE OF name ON shortcut_alias
+FOR EACH ROW EXECUTE FUNCTION check_shortcut_name();
@@ -0,0 +1,28 @@
+-- Add normalized_name column to shortcut_alias table, backfilled on startup
+ALTER TABLE shortcut_alias ADD COLUMN normalized_name TEXT;
+
+CREATE UNIQUE INDEX idx_shortcut_alias_normalized_name ON shortcut_alias(normalized_name);
+
+-- Normalized names are shared between shortcuts and aliases too.
+CREATE OR REPLACE FUNCTION check_shortcut_name() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_advisory_xact_lock(hashtext('shortcut_name'));
+  IF TG_TABLE_NAME = 'shortcut' THEN
+    IF EXISTS (SELECT 1 FROM shortcut_alias WHERE (name = NEW.name OR normalized_name = NEW.normalized_name) AND shortcut_id <> NEW.id) THEN
+      RAISE EXCEPTION 'shortcut name % is an alias of another shortcut', NEW.name USING ERRCODE = 'unique_violation';
+    END IF;
+  ELSIF EXISTS (SELECT 1 FROM shortcut WHERE (name = NEW.name OR normalized_name = NEW.normalized_name) AND id <> NEW.shortcut_id) THEN
+    RAISE EXCEPTION 'shortcut alias % is the name of another shortcut', NEW.name USING ERRCODE = 'unique_violation';
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER trg_shortcut_name ON shortcut;
+DROP TRIGGER trg_shortcut_alias_name ON shortcut_alias;
+
+CREATE TRIGGER trg_shortcut_name BEFORE INSERT OR UPDATE OF name, normalized_name ON shortcut
+FOR EACH ROW EXECUTE FUNCTION check_shortcut_name();
+
+CREATE TRIGGER trg_shortcut_alias_name BEFORE INSERT OR UPDATE OF name, normalized_name ON shortcut_alias
+FOR EACH ROW EXECUTE FUNCTION check_shortcut_name();
@@ -0,0 +1,2 @@
+-- Add template column to shortcut table
+ALTER TABLE shortcut ADD COLUMN template BOOLEAN NOT NULL DEFAULT false;
\ No newline at end of file
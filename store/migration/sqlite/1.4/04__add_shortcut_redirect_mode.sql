@@ -0,0 +1,2 @@
+-- Add redirect_mode column to shortcut table
+ALTER TABLE shortcut ADD COLUMN redirect_mode TEXT NOT NULL DEFAULT 'REDIRECT_MODE_UNSPECIFIED';
\ No newline at end of file
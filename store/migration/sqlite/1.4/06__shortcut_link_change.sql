@@ -0,0 +1,12 @@
+-- shortcut_link_change table for scheduled changes of a shortcut's link
+CREATE TABLE shortcut_link_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  effective_ts BIGINT NOT NULL,
+  link TEXT NOT NULL,
+  applied_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_link_change_shortcut_id ON shortcut_link_change(shortcut_id, effective_ts);
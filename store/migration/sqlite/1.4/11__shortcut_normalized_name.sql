@@ -0,0 +1,4 @@
+-- Add normalized_name column to shortcut table, backfilled on startup
+ALTER TABLE shortcut ADD COLUMN normalized_name TEXT;
+
+CREATE UNIQUE INDEX idx_shortcut_normalized_name ON shortcut(normalized_name);
@@ -0,0 +1,4 @@
+-- Rows created with the column default were served as workspace shortcuts before PRIVATE
+-- was supported, so keep them that way.
+UPDATE shortcut SET visibility = 'WORKSPACE' WHERE visibility = 'PRIVATE';
+UPDATE collection SET visibility = 'WORKSPACE' WHERE visibility = 'PRIVATE';
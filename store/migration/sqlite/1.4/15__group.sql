@@ -0,0 +1,159 @@
+-- user_group table for teams that shortcuts and collections can be shared with
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member table for the members of a group
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- Rebuild the shortcut and collection tables with a group_ids column, as SQLite cannot
+-- change the visibility CHECK constraint of databases created from LATEST.sql.
+ALTER TABLE shortcut RENAME TO shortcut_old;
+
+CREATE TABLE shortcut (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
+  name TEXT NOT NULL UNIQUE,
+  link TEXT NOT NULL,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'WORKSPACE',
+  tag TEXT NOT NULL DEFAULT '',
+  og_metadata TEXT NOT NULL DEFAULT '{}',
+  uuid TEXT NOT NULL DEFAULT '',
+  custom_icon TEXT NOT NULL DEFAULT '',
+  template BOOLEAN NOT NULL DEFAULT false,
+  forward_path BOOLEAN NOT NULL DEFAULT false,
+  redirect_mode TEXT NOT NULL DEFAULT 'REDIRECT_MODE_UNSPECIFIED',
+  valid_from BIGINT NOT NULL DEFAULT 0,
+  valid_until BIGINT NOT NULL DEFAULT 0,
+  variants TEXT NOT NULL DEFAULT '{}',
+  routing_rules TEXT NOT NULL DEFAULT '{}',
+  failover TEXT NOT NULL DEFAULT '{}',
+  normalized_name TEXT,
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO shortcut (
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  uuid,
+  custom_icon,
+  template,
+  forward_path,
+  redirect_mode,
+  valid_from,
+  valid_until,
+  variants,
+  routing_rules,
+  failover,
+  normalized_name,
+  deleted_ts
+)
+SELECT
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  row_status,
+  name,
+  link,
+  title,
+  description,
+  visibility,
+  tag,
+  og_metadata,
+  uuid,
+  custom_icon,
+  template,
+  forward_path,
+  redirect_mode,
+  valid_from,
+  valid_until,
+  variants,
+  routing_rules,
+  failover,
+  normalized_name,
+  deleted_ts
+FROM shortcut_old;
+
+DROP TABLE shortcut_old;
+
+CREATE INDEX idx_shortcut_name ON shortcut(name);
+CREATE INDEX idx_shortcut_uuid ON shortcut(uuid);
+
+CREATE UNIQUE INDEX idx_shortcut_normalized_name ON shortcut(normalized_name);
+
+ALTER TABLE collection RENAME TO collection_old;
+
+CREATE TABLE collection (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  title TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  shortcut_ids INTEGER[] NOT NULL,
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'WORKSPACE',
+  custom_icon TEXT NOT NULL DEFAULT '',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids TEXT NOT NULL DEFAULT ''
+);
+
+INSERT INTO collection (
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  name,
+  title,
+  description,
+  shortcut_ids,
+  visibility,
+  custom_icon,
+  deleted_ts
+)
+SELECT
+  id,
+  creator_id,
+  created_ts,
+  updated_ts,
+  name,
+  title,
+  description,
+  shortcut_ids,
+  visibility,
+  custom_icon,
+  deleted_ts
+FROM collection_old;
+
+DROP TABLE collection_old;
+
+CREATE INDEX idx_collection_name ON collection(name);
@@ -0,0 +1,10 @@
+-- namespace_rule table for shortcut names reserved for the members of a group
+CREATE TABLE namespace_rule (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  pattern TEXT NOT NULL UNIQUE,
+  group_id INTEGER NOT NULL,
+  require_approval BOOLEAN NOT NULL DEFAULT false
+);
@@ -0,0 +1,16 @@
+-- Add protected column to shortcut table
+ALTER TABLE shortcut ADD COLUMN protected BOOLEAN NOT NULL DEFAULT false;
+
+-- shortcut_change_request table for the pending edits of protected shortcuts
+CREATE TABLE shortcut_change_request (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')) DEFAULT 'PENDING',
+  reviewer_id INTEGER NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_change_request_shortcut_id ON shortcut_change_request(shortcut_id);
@@ -0,0 +1,19 @@
+-- Shortcut names and aliases share one namespace: the unique indexes cover each table,
+-- these triggers cover a name used by one shortcut and an alias of another.
+CREATE TRIGGER trg_shortcut_name_insert BEFORE INSERT ON shortcut
+WHEN EXISTS (SELECT 1 FROM shortcut_alias WHERE name = NEW.name)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut.name is an alias of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_name_update BEFORE UPDATE OF name ON shortcut
+WHEN EXISTS (SELECT 1 FROM shortcut_alias WHERE name = NEW.name AND shortcut_id != NEW.id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut.name is an alias of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_alias_name_insert BEFORE INSERT ON shortcut_alias
+WHEN EXISTS (SELECT 1 FROM shortcut WHERE name = NEW.name AND id != NEW.shortcut_id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut_alias.name is the name of another shortcut');
+END;
@@ -0,0 +1,33 @@
+-- Add normalized_name column to shortcut_alias table, backfilled on startup
+ALTER TABLE shortcut_alias ADD COLUMN normalized_name TEXT;
+
+CREATE UNIQUE INDEX idx_shortcut_alias_normalized_name ON shortcut_alias(normalized_name);
+
+-- Normalized names are shared between shortcuts and aliases too.
+DROP TRIGGER trg_shortcut_name_insert;
+DROP TRIGGER trg_shortcut_name_update;
+DROP TRIGGER trg_shortcut_alias_name_insert;
+
+CREATE TRIGGER trg_shortcut_name_insert BEFORE INSERT ON shortcut
+WHEN EXISTS (SELECT 1 FROM shortcut_alias WHERE name = NEW.name OR normalized_name = NEW.normalized_name)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut.name is an alias of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_name_update BEFORE UPDATE OF name, normalized_name ON shortcut
+WHEN EXISTS (SELECT 1 FROM shortcut_alias WHERE (name = NEW.name OR normalized_name = NEW.normalized_name) AND shortcut_id != NEW.id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut.name is an alias of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_alias_name_insert BEFORE INSERT ON shortcut_alias
+WHEN EXISTS (SELECT 1 FROM shortcut WHERE (name = NEW.name OR normalized_name = NEW.normalized_name) AND id != NEW.shortcut_id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut_alias.name is the name of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_alias_name_update BEFORE UPDATE OF normalized_name ON shortcut_alias
+WHEN EXISTS (SELECT 1 FROM shortcut WHERE normalized_name = NEW.normalized_name AND id != NEW.shortcut_id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut_alias.normalized_name is the name of another shortcut');
+END;
@@ -49,16 +49,30 @@ CREATE TABLE shortcut (
   link TEXT NOT NULL,
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
   tag TEXT NOT NULL DEFAULT '',
   og_metadata TEXT NOT NULL DEFAULT '{}',
   uuid TEXT NOT NULL DEFAULT '',
-  custom_icon TEXT NOT NULL DEFAULT ''
+  custom_icon TEXT NOT NULL DEFAULT '',
+  template BOOLEAN NOT NULL DEFAULT false,
+  forward_path BOOLEAN NOT NULL DEFAULT false,
+  redirect_mode TEXT NOT NULL DEFAULT 'REDIRECT_MODE_UNSPECIFIED',
+  valid_from BIGINT NOT NULL DEFAULT 0,
+  valid_until BIGINT NOT NULL DEFAULT 0,
+  variants TEXT NOT NULL DEFAULT '{}',
+  routing_rules TEXT NOT NULL DEFAULT '{}',
+  failover TEXT NOT NULL DEFAULT '{}',
+  normalized_name TEXT,
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids TEXT NOT NULL DEFAULT '',
+  protected BOOLEAN NOT NULL DEFAULT false
 );
 
 CREATE INDEX idx_shortcut_name ON shortcut(name);
 CREATE INDEX idx_shortcut_uuid ON shortcut(uuid);
 
+CREATE UNIQUE INDEX idx_shortcut_normalized_name ON shortcut(normalized_name);
+
 -- activity
 CREATE TABLE activity (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -79,8 +93,10 @@ CREATE TABLE collection (
   title TEXT NOT NULL DEFAULT '',
   description TEXT NOT NULL DEFAULT '',
   shortcut_ids INTEGER[] NOT NULL,
-  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC')) DEFAULT 'PRIVATE',
-  custom_icon TEXT NOT NULL DEFAULT ''
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE', 'PUBLIC', 'GROUP')) DEFAULT 'PRIVATE',
+  custom_icon TEXT NOT NULL DEFAULT '',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  group_ids TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_collection_name ON collection(name);
@@ -96,3 +112,137 @@ CREATE TABLE stats_measurement (
 );
 
 CREATE INDEX idx_stats_measurement_measured_ts ON stats_measurement(measured_ts);
+
+
+-- shortcut_link_change
+CREATE TABLE shortcut_link_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  effective_ts BIGINT NOT NULL,
+  link TEXT NOT NULL,
+  applied_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_shortcut_link_change_shortcut_id ON shortcut_link_change(shortcut_id, effective_ts);
+
+-- shortcut_target_health
+CREATE TABLE shortcut_target_health (
+  shortcut_id INTEGER NOT NULL,
+  link TEXT NOT NULL,
+  healthy BOOLEAN NOT NULL DEFAULT true,
+  status_code INTEGER NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  checked_ts BIGINT NOT NULL,
+  PRIMARY KEY (shortcut_id, link)
+);
+
+-- shortcut_alias
+CREATE TABLE shortcut_alias (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  normalized_name TEXT
+);
+
+CREATE INDEX idx_shortcut_alias_shortcut_id ON shortcut_alias(shortcut_id);
+
+CREATE UNIQUE INDEX idx_shortcut_alias_normalized_name ON shortcut_alias(normalized_name);
+
+-- Shortcut names and aliases share one namespace: the unique indexes cover each table,
+-- these triggers cover a name used by one shortcut and an alias of another.
+CREATE TRIGGER trg_shortcut_name_insert BEFORE INSERT ON shortcut
+WHEN EXISTS (SELECT 1 FROM shortcut_alias WHERE name = NEW.name OR normalized_name = NEW.normalized_name)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut.name is an alias of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_name_update BEFORE UPDATE OF name, normalized_name ON shortcut
+WHEN EXISTS (SELECT 1 FROM shortcut_alias WHERE (name = NEW.name OR normalized_name = NEW.normalized_name) AND shortcut_id != NEW.id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut.name is an alias of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_alias_name_insert BEFORE INSERT ON shortcut_alias
+WHEN EXISTS (SELECT 1 FROM shortcut WHERE (name = NEW.name OR normalized_name = NEW.normalized_name) AND id != NEW.shortcut_id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut_alias.name is the name of another shortcut');
+END;
+
+CREATE TRIGGER trg_shortcut_alias_name_update BEFORE UPDATE OF normalized_name ON shortcut_alias
+WHEN EXISTS (SELECT 1 FROM shortcut WHERE normalized_name = NEW.normalized_name AND id != NEW.shortcut_id)
+BEGIN
+  SELECT RAISE(ABORT, 'UNIQUE constraint failed: shortcut_alias.normalized_name is the name of another shortcut');
+END;
+
+-- shortcut_revision
+CREATE TABLE shortcut_revision (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_revision_shortcut_id ON shortcut_revision(shortcut_id);
+
+-- user_group
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member(user_id);
+
+-- resource_permission
+CREATE TABLE resource_permission (
+  resource_type TEXT NOT NULL CHECK (resource_type IN ('SHORTCUT', 'COLLECTION')),
+  resource_id INTEGER NOT NULL,
+  principal_type TEXT NOT NULL CHECK (principal_type IN ('USER', 'GROUP')),
+  principal_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'EDITOR', 'OWNER')),
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  PRIMARY KEY (resource_type, resource_id, principal_type, principal_id)
+);
+
+CREATE INDEX idx_resource_permission_principal ON resource_permission(principal_type, principal_id);
+
+-- namespace_rule
+CREATE TABLE namespace_rule (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  pattern TEXT NOT NULL UNIQUE,
+  group_id INTEGER NOT NULL,
+  require_approval BOOLEAN NOT NULL DEFAULT false
+);
+
+-- shortcut_change_request
+CREATE TABLE shortcut_change_request (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shortcut_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED')) DEFAULT 'PENDING',
+  reviewer_id INTEGER NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_shortcut_change_request_shortcut_id ON shortcut_change_request(shortcut_id);
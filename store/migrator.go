@@ -112,6 +112,22 @@ func (s *Store) Migrate(ctx context.Context) error {
 		return errors.Wrap(err, "failed to migrate workspace settings")
 	}
 
+	// Backfill normalized shortcut and alias names, reporting the names that collide.
+	collisions, err := s.NormalizeShortcutNames(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to normalize shortcut names")
+	}
+	for _, collision := range collisions {
+		names := []string{}
+		for _, shortcut := range collision.Shortcuts {
+			names = append(names, shortcut.Name)
+		}
+		for _, alias := range collision.Aliases {
+			names = append(names, alias.Name)
+		}
+		slog.Warn("shortcut names collide under the name policy, only the oldest is matched by its normalized name", slog.String("normalizedName", collision.NormalizedName), slog.Any("names", names))
+	}
+
 	return nil
 }
 
@@ -0,0 +1,192 @@
+package store
+
+import (
+	"context"
+	"strings"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// NamespaceRule reserves the shortcut names matching a pattern for the members of a group.
+type NamespaceRule struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	// Pattern matches shortcut names, where * stands for any characters, e.g. "eng-*" or "eng/*".
+	Pattern string
+	// GroupID is the group that owns the namespace.
+	GroupID int32
+	// RequireApproval marks changes to the shortcuts in the namespace as needing an approval.
+	RequireApproval bool
+}
+
+type UpdateNamespaceRule struct {
+	ID int32
+
+	UpdatedTs       *int64
+	Pattern         *string
+	GroupID         *int32
+	RequireApproval *bool
+}
+
+type FindNamespaceRule struct {
+	ID      *int32
+	Pattern *string
+	GroupID *int32
+}
+
+type DeleteNamespaceRule struct {
+	ID int32
+}
+
+// NamespaceConflict is a shortcut whose creator is not a member of the group that owns its namespace.
+type NamespaceConflict struct {
+	Rule     *NamespaceRule
+	Shortcut *storepb.Shortcut
+}
+
+func (s *Store) CreateNamespaceRule(ctx context.Context, create *NamespaceRule) (*NamespaceRule, error) {
+	return s.driver.CreateNamespaceRule(ctx, create)
+}
+
+func (s *Store) UpdateNamespaceRule(ctx context.Context, update *UpdateNamespaceRule) (*NamespaceRule, error) {
+	return s.driver.UpdateNamespaceRule(ctx, update)
+}
+
+func (s *Store) ListNamespaceRules(ctx context.Context, find *FindNamespaceRule) ([]*NamespaceRule, error) {
+	return s.driver.ListNamespaceRules(ctx, find)
+}
+
+func (s *Store) GetNamespaceRule(ctx context.Context, find *FindNamespaceRule) (*NamespaceRule, error) {
+	list, err := s.ListNamespaceRules(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteNamespaceRule(ctx context.Context, delete *DeleteNamespaceRule) error {
+	return s.driver.DeleteNamespaceRule(ctx, delete)
+}
+
+// GetNamespaceRuleByName returns the rule of the namespace the shortcut name is in, or nil
+// if the name is not reserved.
+func (s *Store) GetNamespaceRuleByName(ctx context.Context, name string) (*NamespaceRule, error) {
+	rules, err := s.ListNamespaceRules(ctx, &FindNamespaceRule{})
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MatchNamespaceRule(rules, name, policy), nil
+}
+
+// IsNamespaceMember reports whether the user is a member of the group that owns the namespace.
+func (s *Store) IsNamespaceMember(ctx context.Context, rule *NamespaceRule, userID int32) (bool, error) {
+	members, err := s.ListGroupMembers(ctx, &FindGroupMember{
+		GroupID: &rule.GroupID,
+		UserID:  &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(members) > 0, nil
+}
+
+// ListNamespaceConflicts returns the shortcuts, outside the trash, that are in the namespace
+// of one of the rules but were not created by a member of its group, ordered by shortcut.
+// Shortcuts created by admins are not conflicts, as admins may use any namespace.
+func (s *Store) ListNamespaceConflicts(ctx context.Context, rules []*NamespaceRule) ([]*NamespaceConflict, error) {
+	conflicts := []*NamespaceConflict{}
+	if len(rules) == 0 {
+		return conflicts, nil
+	}
+	// The rule of a shortcut is always the most specific one, so all rules are considered.
+	allRules, err := s.ListNamespaceRules(ctx, &FindNamespaceRule{})
+	if err != nil {
+		return nil, err
+	}
+	ruleIDs := map[int32]bool{}
+	for _, rule := range rules {
+		ruleIDs[rule.ID] = true
+	}
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shortcuts, err := s.ListShortcuts(ctx, &FindShortcut{})
+	if err != nil {
+		return nil, err
+	}
+	for _, shortcut := range shortcuts {
+		rule := MatchNamespaceRule(allRules, shortcut.Name, policy)
+		if rule == nil || !ruleIDs[rule.ID] {
+			continue
+		}
+		creator, err := s.GetUser(ctx, &FindUser{
+			ID: &shortcut.CreatorId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if creator != nil && creator.Role == RoleAdmin {
+			continue
+		}
+		member, err := s.IsNamespaceMember(ctx, rule, shortcut.CreatorId)
+		if err != nil {
+			return nil, err
+		}
+		if !member {
+			conflicts = append(conflicts, &NamespaceConflict{
+				Rule:     rule,
+				Shortcut: shortcut,
+			})
+		}
+	}
+	return conflicts, nil
+}
+
+// MatchNamespaceRule returns the most specific rule whose pattern matches the shortcut name
+// under policy, that is the one with the longest pattern, or nil if none matches.
+func MatchNamespaceRule(rules []*NamespaceRule, name string, policy *storepb.ShortcutNamePolicy) *NamespaceRule {
+	name = NormalizeShortcutName(name, policy)
+	var matched *NamespaceRule
+	for _, rule := range rules {
+		if !matchNamespacePattern(NormalizeShortcutName(rule.Pattern, policy), name) {
+			continue
+		}
+		if matched == nil || len(rule.Pattern) > len(matched.Pattern) {
+			matched = rule
+		}
+	}
+	return matched
+}
+
+// matchNamespacePattern reports whether name matches pattern, where * matches any sequence
+// of characters, slashes included.
+func matchNamespacePattern(pattern, name string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == name
+	}
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(name, part)
+		if i < 0 {
+			return false
+		}
+		name = name[i+len(part):]
+	}
+	return strings.HasSuffix(name, last)
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// PermissionResourceType is the type of resource a permission is granted on.
+type PermissionResourceType string
+
+const (
+	PermissionResourceTypeShortcut   PermissionResourceType = "SHORTCUT"
+	PermissionResourceTypeCollection PermissionResourceType = "COLLECTION"
+)
+
+// PermissionPrincipalType is the type of principal a permission is granted to.
+type PermissionPrincipalType string
+
+const (
+	PermissionPrincipalTypeUser  PermissionPrincipalType = "USER"
+	PermissionPrincipalTypeGroup PermissionPrincipalType = "GROUP"
+)
+
+// PermissionRole is the role a permission grants. Each role includes the ones before it.
+type PermissionRole string
+
+const (
+	// PermissionRoleViewer can see the resource whatever its visibility.
+	PermissionRoleViewer PermissionRole = "VIEWER"
+	// PermissionRoleEditor can also change the resource, except for who it is shared with.
+	PermissionRoleEditor PermissionRole = "EDITOR"
+	// PermissionRoleOwner can do everything the creator of the resource can.
+	PermissionRoleOwner PermissionRole = "OWNER"
+)
+
+var permissionRoleRanks = map[PermissionRole]int{
+	PermissionRoleViewer: 1,
+	PermissionRoleEditor: 2,
+	PermissionRoleOwner:  3,
+}
+
+// Includes reports whether the role grants at least the given role.
+func (r PermissionRole) Includes(role PermissionRole) bool {
+	return permissionRoleRanks[r] != 0 && permissionRoleRanks[r] >= permissionRoleRanks[role]
+}
+
+// Permission is a role granted to a user, or to the members of a group, on a shortcut or collection.
+type Permission struct {
+	ResourceType  PermissionResourceType
+	ResourceID    int32
+	PrincipalType PermissionPrincipalType
+	PrincipalID   int32
+	Role          PermissionRole
+	CreatedTs     int64
+}
+
+type FindPermission struct {
+	ResourceType  *PermissionResourceType
+	ResourceID    *int32
+	PrincipalType *PermissionPrincipalType
+	PrincipalID   *int32
+}
+
+// DeletePermission deletes the permissions matching all of its set fields.
+type DeletePermission struct {
+	ResourceType  *PermissionResourceType
+	ResourceID    *int32
+	PrincipalType *PermissionPrincipalType
+	PrincipalID   *int32
+}
+
+func (s *Store) UpsertPermission(ctx context.Context, upsert *Permission) (*Permission, error) {
+	return s.driver.UpsertPermission(ctx, upsert)
+}
+
+func (s *Store) ListPermissions(ctx context.Context, find *FindPermission) ([]*Permission, error) {
+	return s.driver.ListPermissions(ctx, find)
+}
+
+func (s *Store) DeletePermission(ctx context.Context, delete *DeletePermission) error {
+	return s.driver.DeletePermission(ctx, delete)
+}
+
+// GetPermissionRole returns the highest role granted to the user on the resource, directly
+// or through the groups they are a member of, or an empty role when there is none.
+func (s *Store) GetPermissionRole(ctx context.Context, resourceType PermissionResourceType, resourceID, userID int32) (PermissionRole, error) {
+	permissions, err := s.ListPermissions(ctx, &FindPermission{
+		ResourceType: &resourceType,
+		ResourceID:   &resourceID,
+	})
+	if err != nil || len(permissions) == 0 {
+		return "", err
+	}
+	members, err := s.ListGroupMembers(ctx, &FindGroupMember{
+		UserID: &userID,
+	})
+	if err != nil {
+		return "", err
+	}
+	groupIDs := map[int32]bool{}
+	for _, member := range members {
+		groupIDs[member.GroupID] = true
+	}
+
+	var role PermissionRole
+	for _, permission := range permissions {
+		granted := false
+		switch permission.PrincipalType {
+		case PermissionPrincipalTypeUser:
+			granted = permission.PrincipalID == userID
+		case PermissionPrincipalTypeGroup:
+			granted = groupIDs[permission.PrincipalID]
+		}
+		if granted && !role.Includes(permission.Role) {
+			role = permission.Role
+		}
+	}
+	return role, nil
+}
+
+// getPermissionResource returns the permission resource type and ID of a shortcut or collection.
+func getPermissionResource(resource VisibleResource) (PermissionResourceType, int32, bool) {
+	switch resource := resource.(type) {
+	case *storepb.Shortcut:
+		return PermissionResourceTypeShortcut, resource.Id, true
+	case *storepb.Collection:
+		return PermissionResourceTypeCollection, resource.Id, true
+	default:
+		return "", 0, false
+	}
+}
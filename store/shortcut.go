@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"strings"
 
 	storepb "github.com/bshort/monotreme/proto/gen/store"
 )
@@ -17,14 +18,57 @@ type UpdateShortcut struct {
 	Tag               *string
 	OpenGraphMetadata *storepb.OpenGraphMetadata
 	CustomIcon        *string
+	Template          *bool
+	ForwardPath       *bool
+	RedirectMode      *storepb.RedirectMode
+	ValidFrom         *int64
+	ValidUntil        *int64
+	RowStatus         *storepb.RowStatus
+	Variants          *storepb.ShortcutVariants
+	RoutingRules      *storepb.ShortcutRoutingRules
+	Failover          *storepb.ShortcutFailover
+	// CreatorID transfers the ownership of the shortcut.
+	CreatorID *int32
+	// GroupIDs replaces the groups a GROUP shortcut is visible to, unless nil.
+	GroupIDs []int32
+	// NormalizedName is set by the store whenever Name is.
+	NormalizedName *string
+	// DeletedTs moves the shortcut to the trash, or out of it when 0.
+	DeletedTs *int64
+	// Protected sets whether changes of the shortcut need approval.
+	Protected *bool
+
+	// Previous is the shortcut before the change. The driver records the change from it
+	// as a revision in the same transaction, see NewShortcutRevisions. It is set by the store.
+	Previous *storepb.Shortcut
+	// EditorID is the user making the change, recorded in its revision.
+	EditorID int32
+	// RestoredRevisionID is the revision restored by the change, if it is a rollback.
+	RestoredRevisionID int32
 }
 
 type FindShortcut struct {
-	ID             *int32
-	CreatorID      *int32
-	Name           *string
-	VisibilityList []storepb.Visibility
-	Tag            *string
+	ID        *int32
+	CreatorID *int32
+	Name      *string
+	NameList  []string
+	// NormalizedNameList matches shortcuts by their normalized name.
+	NormalizedNameList []string
+	VisibilityList     []storepb.Visibility
+	Tag                *string
+	RowStatus          *storepb.RowStatus
+	// NotExpiredAt leaves out shortcuts whose valid_until is at or before the given time.
+	NotExpiredAt *int64
+	// ExpiredBefore only keeps shortcuts whose valid_until is at or before the given time.
+	ExpiredBefore *int64
+	// Trash selects shortcuts by whether they are in the trash. By default they are left out.
+	Trash TrashFilter
+	// DeletedBefore only keeps shortcuts moved to the trash at or before the given time.
+	DeletedBefore *int64
+	// ViewerID only keeps shortcuts the user may see, see IsVisibleTo. 0 is an anonymous visitor.
+	ViewerID *int32
+	// OwnerID only keeps shortcuts the user owns, as their creator or through an owner permission.
+	OwnerID *int32
 }
 
 type DeleteShortcut struct {
@@ -32,20 +76,47 @@ type DeleteShortcut struct {
 }
 
 func (s *Store) CreateShortcut(ctx context.Context, create *storepb.Shortcut) (*storepb.Shortcut, error) {
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	create.NormalizedName = NormalizeShortcutName(create.Name, policy)
 	shortcut, err := s.driver.CreateShortcut(ctx, create)
 	if err != nil {
 		return nil, err
 	}
 	s.shortcutCache.Store(shortcut.Id, shortcut)
+	s.resolverCache.purge()
 	return shortcut, nil
 }
 
 func (s *Store) UpdateShortcut(ctx context.Context, update *UpdateShortcut) (*storepb.Shortcut, error) {
+	if update.Name != nil {
+		policy, err := s.GetShortcutNamePolicy(ctx)
+		if err != nil {
+			return nil, err
+		}
+		normalizedName := NormalizeShortcutName(*update.Name, policy)
+		update.NormalizedName = &normalizedName
+	}
+	previous, err := s.GetShortcut(ctx, &FindShortcut{
+		ID:    &update.ID,
+		Trash: TrashIncluded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	update.Previous = previous
 	shortcut, err := s.driver.UpdateShortcut(ctx, update)
 	if err != nil {
 		return nil, err
 	}
 	s.shortcutCache.Store(shortcut.Id, shortcut)
+	// Resolved paths only keep the shortcut id, so only a new name or moving the shortcut
+	// in or out of the trash changes them.
+	if update.Name != nil || update.DeletedTs != nil {
+		s.resolverCache.purge()
+	}
 	return shortcut, nil
 }
 
@@ -64,6 +135,18 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	if find.ID != nil {
 		if cache, ok := s.shortcutCache.Load(*find.ID); ok {
 			if shortcut, ok := cache.(*storepb.Shortcut); ok {
+				if !find.Trash.Matches(shortcut.DeletedTs) {
+					return nil, nil
+				}
+				if find.ViewerID != nil {
+					visible, err := s.IsVisibleTo(ctx, shortcut, *find.ViewerID)
+					if err != nil {
+						return nil, err
+					}
+					if !visible {
+						return nil, nil
+					}
+				}
 				return shortcut, nil
 			}
 		}
@@ -83,11 +166,141 @@ func (s *Store) GetShortcut(ctx context.Context, find *FindShortcut) (*storepb.S
 	return shortcut, nil
 }
 
+// ResolveShortcut finds the shortcut with the longest name or alias that matches a prefix
+// of the given path segments, so "docs/api" wins over "docs" for docs/api/v2. It returns
+// the shortcut and the segments that follow the matched name, or nil if nothing matches.
+// Names and aliases are also compared under the name policy of the workspace, with an
+// exact match winning over a normalized one of the same length. An alias resolves to the
+// shortcut it belongs to. Resolutions are kept in the resolver cache.
+func (s *Store) ResolveShortcut(ctx context.Context, segments []string) (*storepb.Shortcut, []string, error) {
+	if len(segments) == 0 {
+		return nil, nil, nil
+	}
+	if !s.resolverCache.enabled() {
+		shortcut, length, err := s.resolveShortcut(ctx, segments)
+		if err != nil || shortcut == nil {
+			return nil, nil, err
+		}
+		return shortcut, segments[length:], nil
+	}
+
+	path := strings.Join(segments, "/")
+	entry, generation := s.resolverCache.get(path)
+	if entry != nil {
+		if entry.shortcutID == 0 {
+			return nil, nil, nil
+		}
+		shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+			ID: &entry.shortcutID,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if shortcut != nil {
+			return shortcut, segments[entry.length:], nil
+		}
+		// The shortcut is gone without the cache being purged, so resolve the path again.
+	}
+
+	shortcut, length, err := s.resolveShortcut(ctx, segments)
+	if err != nil {
+		return nil, nil, err
+	}
+	if shortcut == nil {
+		s.resolverCache.put(generation, path, 0, 0)
+		return nil, nil, nil
+	}
+	s.resolverCache.put(generation, path, shortcut.Id, length)
+	return shortcut, segments[length:], nil
+}
+
+// resolveShortcut resolves segments against the database. It returns the matched
+// shortcut and the number of segments its name takes. Exact names and aliases are
+// matched before normalized ones, so a normalized match only wins if it is longer.
+func (s *Store) resolveShortcut(ctx context.Context, segments []string) (*storepb.Shortcut, int, error) {
+	names := make([]string, 0, len(segments))
+	for i := range segments {
+		names = append(names, strings.Join(segments[:i+1], "/"))
+	}
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Normalizing may turn other characters into slashes, so lengths are taken from the
+	// segments that were normalized rather than from the stored name.
+	normalizedNames, normalizedLengths := make([]string, 0, len(names)), map[string]int{}
+	for i, name := range names {
+		normalizedName := NormalizeShortcutName(name, policy)
+		normalizedNames = append(normalizedNames, normalizedName)
+		normalizedLengths[normalizedName] = i + 1
+	}
+
+	var matched *storepb.Shortcut
+	matchedLength := 0
+	matchShortcuts := func(find *FindShortcut, getLength func(*storepb.Shortcut) int) error {
+		shortcuts, err := s.ListShortcuts(ctx, find)
+		if err != nil {
+			return err
+		}
+		for _, shortcut := range shortcuts {
+			if length := getLength(shortcut); length > matchedLength {
+				matched, matchedLength = shortcut, length
+			}
+		}
+		return nil
+	}
+	matchAliases := func(find *FindShortcutAlias, getLength func(*storepb.ShortcutAlias) int) error {
+		aliases, err := s.ListShortcutAliases(ctx, find)
+		if err != nil {
+			return err
+		}
+		for _, alias := range aliases {
+			length := getLength(alias)
+			if length <= matchedLength {
+				continue
+			}
+			shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+				ID: &alias.ShortcutId,
+			})
+			if err != nil {
+				return err
+			}
+			if shortcut != nil {
+				matched, matchedLength = shortcut, length
+			}
+		}
+		return nil
+	}
+
+	if err := matchShortcuts(&FindShortcut{NameList: names}, func(shortcut *storepb.Shortcut) int {
+		return strings.Count(shortcut.Name, "/") + 1
+	}); err != nil {
+		return nil, 0, err
+	}
+	if err := matchAliases(&FindShortcutAlias{NameList: names}, func(alias *storepb.ShortcutAlias) int {
+		return strings.Count(alias.Name, "/") + 1
+	}); err != nil {
+		return nil, 0, err
+	}
+	if err := matchShortcuts(&FindShortcut{NormalizedNameList: normalizedNames}, func(shortcut *storepb.Shortcut) int {
+		return normalizedLengths[shortcut.NormalizedName]
+	}); err != nil {
+		return nil, 0, err
+	}
+	if err := matchAliases(&FindShortcutAlias{NormalizedNameList: normalizedNames}, func(alias *storepb.ShortcutAlias) int {
+		return normalizedLengths[alias.NormalizedName]
+	}); err != nil {
+		return nil, 0, err
+	}
+	return matched, matchedLength, nil
+}
+
 func (s *Store) DeleteShortcut(ctx context.Context, delete *DeleteShortcut) error {
 	if err := s.driver.DeleteShortcut(ctx, delete); err != nil {
 		return err
 	}
 
 	s.shortcutCache.Delete(delete.ID)
+	s.resolverCache.purge()
 	return nil
 }
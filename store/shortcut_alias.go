@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+type FindShortcutAlias struct {
+	ShortcutID     *int32
+	ShortcutIDList []int32
+	Name           *string
+	NameList       []string
+	// NormalizedNameList matches aliases by their normalized name.
+	NormalizedNameList []string
+}
+
+type DeleteShortcutAlias struct {
+	ShortcutID int32
+	// Name restricts the deletion to a single alias, otherwise all aliases of the shortcut are deleted.
+	Name *string
+}
+
+func (s *Store) CreateShortcutAlias(ctx context.Context, create *storepb.ShortcutAlias) (*storepb.ShortcutAlias, error) {
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	create.NormalizedName = NormalizeShortcutName(create.Name, policy)
+	// An alias that normalizes like another name of its own shortcut resolves the same way,
+	// so it is only matched by its exact name.
+	shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+		ID:    &create.ShortcutId,
+		Trash: TrashIncluded,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shortcut != nil && shortcut.NormalizedName == create.NormalizedName {
+		create.NormalizedName = ""
+	} else {
+		existing, err := s.GetShortcutAlias(ctx, &FindShortcutAlias{
+			ShortcutID:         &create.ShortcutId,
+			NormalizedNameList: []string{create.NormalizedName},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			create.NormalizedName = ""
+		}
+	}
+	alias, err := s.driver.CreateShortcutAlias(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	s.resolverCache.purge()
+	return alias, nil
+}
+
+func (s *Store) ListShortcutAliases(ctx context.Context, find *FindShortcutAlias) ([]*storepb.ShortcutAlias, error) {
+	return s.driver.ListShortcutAliases(ctx, find)
+}
+
+func (s *Store) GetShortcutAlias(ctx context.Context, find *FindShortcutAlias) (*storepb.ShortcutAlias, error) {
+	list, err := s.ListShortcutAliases(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteShortcutAlias(ctx context.Context, delete *DeleteShortcutAlias) error {
+	if err := s.driver.DeleteShortcutAlias(ctx, delete); err != nil {
+		return err
+	}
+	s.resolverCache.purge()
+	return nil
+}
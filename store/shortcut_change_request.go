@@ -0,0 +1,198 @@
+package store
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+type ShortcutChangeRequestStatus string
+
+const (
+	// ShortcutChangeRequestPending is the status of a change request waiting for review.
+	ShortcutChangeRequestPending ShortcutChangeRequestStatus = "PENDING"
+	// ShortcutChangeRequestApproved is the status of a change request that was applied.
+	ShortcutChangeRequestApproved ShortcutChangeRequestStatus = "APPROVED"
+	// ShortcutChangeRequestRejected is the status of a change request that was turned down.
+	ShortcutChangeRequestRejected ShortcutChangeRequestStatus = "REJECTED"
+)
+
+func (s ShortcutChangeRequestStatus) String() string {
+	switch s {
+	case ShortcutChangeRequestPending:
+		return "PENDING"
+	case ShortcutChangeRequestApproved:
+		return "APPROVED"
+	case ShortcutChangeRequestRejected:
+		return "REJECTED"
+	}
+	return ""
+}
+
+// ShortcutChangeRequest is an edit of a protected shortcut that is applied once another person approves it.
+type ShortcutChangeRequest struct {
+	ID         int32
+	ShortcutID int32
+	CreatorID  int32
+	CreatedTs  int64
+	UpdatedTs  int64
+	Status     ShortcutChangeRequestStatus
+	// ReviewerID is the user who approved or rejected the change, or 0 while pending.
+	ReviewerID int32
+	Payload    *storepb.ShortcutChangeRequestPayload
+}
+
+type UpdateShortcutChangeRequest struct {
+	ID int32
+
+	UpdatedTs  *int64
+	Status     *ShortcutChangeRequestStatus
+	ReviewerID *int32
+	// PendingOnly only updates the change request while it is pending, so that it is
+	// reviewed once. Nothing is returned if it is no longer pending.
+	PendingOnly bool
+}
+
+type FindShortcutChangeRequest struct {
+	ID             *int32
+	ShortcutID     *int32
+	ShortcutIDList []int32
+	Status         *ShortcutChangeRequestStatus
+}
+
+func (s *Store) CreateShortcutChangeRequest(ctx context.Context, create *ShortcutChangeRequest) (*ShortcutChangeRequest, error) {
+	return s.driver.CreateShortcutChangeRequest(ctx, create)
+}
+
+func (s *Store) UpdateShortcutChangeRequest(ctx context.Context, update *UpdateShortcutChangeRequest) (*ShortcutChangeRequest, error) {
+	return s.driver.UpdateShortcutChangeRequest(ctx, update)
+}
+
+func (s *Store) ListShortcutChangeRequests(ctx context.Context, find *FindShortcutChangeRequest) ([]*ShortcutChangeRequest, error) {
+	return s.driver.ListShortcutChangeRequests(ctx, find)
+}
+
+func (s *Store) GetShortcutChangeRequest(ctx context.Context, find *FindShortcutChangeRequest) (*ShortcutChangeRequest, error) {
+	list, err := s.ListShortcutChangeRequests(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// ShortcutRequiresApproval reports whether edits of the shortcut need another person's approval,
+// because the shortcut is protected or its name is in a namespace that requires approval.
+func (s *Store) ShortcutRequiresApproval(ctx context.Context, shortcut *storepb.Shortcut) (bool, error) {
+	if shortcut.Protected {
+		return true, nil
+	}
+	requiresApproval, err := s.ShortcutsRequiringApproval(ctx, []*storepb.Shortcut{shortcut})
+	if err != nil {
+		return false, err
+	}
+	return requiresApproval[shortcut.Id], nil
+}
+
+// ShortcutsRequiringApproval is ShortcutRequiresApproval for many shortcuts at once. It returns
+// the IDs of the shortcuts whose edits need approval, loading the namespace rules once.
+func (s *Store) ShortcutsRequiringApproval(ctx context.Context, shortcuts []*storepb.Shortcut) (map[int32]bool, error) {
+	requiresApproval := map[int32]bool{}
+	for _, shortcut := range shortcuts {
+		if shortcut.Protected {
+			requiresApproval[shortcut.Id] = true
+		}
+	}
+	if len(requiresApproval) == len(shortcuts) {
+		return requiresApproval, nil
+	}
+	rules, err := s.ListNamespaceRules(ctx, &FindNamespaceRule{})
+	if err != nil || len(rules) == 0 {
+		return requiresApproval, err
+	}
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, shortcut := range shortcuts {
+		if rule := MatchNamespaceRule(rules, shortcut.Name, policy); rule != nil && rule.RequireApproval {
+			requiresApproval[shortcut.Id] = true
+		}
+	}
+	return requiresApproval, nil
+}
+
+// ApplyShortcutUpdate returns a copy of the shortcut with the update applied, as the store
+// would write it. It is used to record the outcome of a change request before it is approved.
+func ApplyShortcutUpdate(shortcut *storepb.Shortcut, update *UpdateShortcut) *storepb.Shortcut {
+	target := proto.Clone(shortcut).(*storepb.Shortcut)
+	if update.Name != nil {
+		target.Name = *update.Name
+	}
+	if update.Link != nil {
+		target.Link = *update.Link
+	}
+	if update.Title != nil {
+		target.Title = *update.Title
+	}
+	if update.Description != nil {
+		target.Description = *update.Description
+	}
+	if update.Visibility != nil {
+		target.Visibility = *update.Visibility
+	}
+	if update.Tag != nil {
+		target.Tags = strings.Fields(*update.Tag)
+	}
+	if update.OpenGraphMetadata != nil {
+		target.OgMetadata = update.OpenGraphMetadata
+	}
+	if update.CustomIcon != nil {
+		target.CustomIcon = *update.CustomIcon
+	}
+	if update.Template != nil {
+		target.Template = *update.Template
+	}
+	if update.ForwardPath != nil {
+		target.ForwardPath = *update.ForwardPath
+	}
+	if update.RedirectMode != nil {
+		target.RedirectMode = *update.RedirectMode
+	}
+	if update.ValidFrom != nil {
+		target.ValidFrom = *update.ValidFrom
+	}
+	if update.ValidUntil != nil {
+		target.ValidUntil = *update.ValidUntil
+	}
+	if update.RowStatus != nil {
+		target.RowStatus = *update.RowStatus
+	}
+	if update.Variants != nil {
+		target.Variants = update.Variants
+	}
+	if update.RoutingRules != nil {
+		target.RoutingRules = update.RoutingRules
+	}
+	if update.Failover != nil {
+		target.Failover = update.Failover
+	}
+	if update.CreatorID != nil {
+		target.CreatorId = *update.CreatorID
+	}
+	if update.GroupIDs != nil {
+		target.GroupIds = update.GroupIDs
+	}
+	if update.DeletedTs != nil {
+		target.DeletedTs = *update.DeletedTs
+	}
+	if update.Protected != nil {
+		target.Protected = *update.Protected
+	}
+	return target
+}
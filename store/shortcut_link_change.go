@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+)
+
+// ShortcutLinkChange is a scheduled change of a shortcut's link.
+type ShortcutLinkChange struct {
+	ID          int32
+	ShortcutID  int32
+	CreatorID   int32
+	CreatedTs   int64
+	EffectiveTs int64
+	Link        string
+	// AppliedTs is the time the change was applied to the shortcut, or 0 while pending.
+	AppliedTs int64
+}
+
+type FindShortcutLinkChange struct {
+	ID         *int32
+	ShortcutID *int32
+	// Pending restricts the result to changes that have not been applied yet.
+	Pending *bool
+	// EffectiveBefore restricts the result to changes effective at or before the given time.
+	EffectiveBefore *int64
+	// If true, order by effective_ts DESC, else ASC.
+	OrderByEffectiveTsDesc *bool
+}
+
+type UpdateShortcutLinkChange struct {
+	ID        int32
+	AppliedTs *int64
+}
+
+type DeleteShortcutLinkChange struct {
+	ID int32
+}
+
+func (s *Store) CreateShortcutLinkChange(ctx context.Context, create *ShortcutLinkChange) (*ShortcutLinkChange, error) {
+	return s.driver.CreateShortcutLinkChange(ctx, create)
+}
+
+func (s *Store) ListShortcutLinkChanges(ctx context.Context, find *FindShortcutLinkChange) ([]*ShortcutLinkChange, error) {
+	return s.driver.ListShortcutLinkChanges(ctx, find)
+}
+
+func (s *Store) GetShortcutLinkChange(ctx context.Context, find *FindShortcutLinkChange) (*ShortcutLinkChange, error) {
+	list, err := s.ListShortcutLinkChanges(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateShortcutLinkChange(ctx context.Context, update *UpdateShortcutLinkChange) (*ShortcutLinkChange, error) {
+	return s.driver.UpdateShortcutLinkChange(ctx, update)
+}
+
+func (s *Store) DeleteShortcutLinkChange(ctx context.Context, delete *DeleteShortcutLinkChange) error {
+	return s.driver.DeleteShortcutLinkChange(ctx, delete)
+}
+
+// GetEffectiveShortcutLink returns the link of the shortcut in effect at the given time.
+// A pending change whose effective time has passed takes precedence over the stored link,
+// so the switch happens on time even before the change is applied, unless the shortcut
+// requires approval and the change has to be reviewed first.
+func (s *Store) GetEffectiveShortcutLink(ctx context.Context, shortcutID int32, link string, ts int64) (string, error) {
+	pending, desc := true, true
+	change, err := s.GetShortcutLinkChange(ctx, &FindShortcutLinkChange{
+		ShortcutID:             &shortcutID,
+		Pending:                &pending,
+		EffectiveBefore:        &ts,
+		OrderByEffectiveTsDesc: &desc,
+	})
+	if err != nil {
+		return "", err
+	}
+	if change == nil {
+		return link, nil
+	}
+	shortcut, err := s.GetShortcut(ctx, &FindShortcut{
+		ID:    &shortcutID,
+		Trash: TrashIncluded,
+	})
+	if err != nil {
+		return "", err
+	}
+	if shortcut != nil {
+		requiresApproval, err := s.ShortcutRequiresApproval(ctx, shortcut)
+		if err != nil {
+			return "", err
+		}
+		if requiresApproval {
+			return link, nil
+		}
+	}
+	return change.Link, nil
+}
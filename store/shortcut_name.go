@@ -0,0 +1,171 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// DefaultShortcutNamePolicy is used by workspaces that have not set a name policy.
+var DefaultShortcutNamePolicy = &storepb.ShortcutNamePolicy{
+	CaseInsensitive:      true,
+	UnicodeNormalization: true,
+}
+
+// ShortcutNameCollision is a set of shortcuts and aliases whose names normalize to the
+// same name, oldest first.
+type ShortcutNameCollision struct {
+	NormalizedName string
+	Shortcuts      []*storepb.Shortcut
+	// Aliases are the aliases that normalize to the name, leaving out those of the shortcut that keeps it.
+	Aliases []*storepb.ShortcutAlias
+}
+
+// shortcutID returns the shortcut that keeps the normalized name.
+func (c *ShortcutNameCollision) shortcutID() int32 {
+	if len(c.Shortcuts) > 0 {
+		return c.Shortcuts[0].Id
+	}
+	return c.Aliases[0].ShortcutId
+}
+
+// NormalizeShortcutName returns the name that shortcut names are compared by under policy.
+// Slashes are kept, so normalized names still split into the same path segments.
+func NormalizeShortcutName(name string, policy *storepb.ShortcutNamePolicy) string {
+	if policy.GetUnicodeNormalization() {
+		name = norm.NFKC.String(name)
+	}
+	if policy.GetCaseInsensitive() {
+		// A Caser keeps state, so it must not be shared between goroutines.
+		name = cases.Fold().String(name)
+		// Folding may leave some characters in a non-normalized form.
+		if policy.GetUnicodeNormalization() {
+			name = norm.NFKC.String(name)
+		}
+	}
+	if policy.GetEquivalentSeparators() {
+		name = strings.Map(func(r rune) rune {
+			if r == '_' || r == '.' {
+				return '-'
+			}
+			return r
+		}, name)
+	}
+	return name
+}
+
+// GetShortcutNamePolicy returns the name policy of the workspace.
+func (s *Store) GetShortcutNamePolicy(ctx context.Context) (*storepb.ShortcutNamePolicy, error) {
+	shortcutRelatedSetting, err := s.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if shortcutRelatedSetting.NamePolicy == nil {
+		return DefaultShortcutNamePolicy, nil
+	}
+	return shortcutRelatedSetting.NamePolicy, nil
+}
+
+// FindShortcutNameCollisions returns the shortcuts and aliases whose names would be equal under policy.
+func (s *Store) FindShortcutNameCollisions(ctx context.Context, policy *storepb.ShortcutNamePolicy) ([]*ShortcutNameCollision, error) {
+	_, _, collisions, err := s.computeNormalizedNames(ctx, policy)
+	return collisions, err
+}
+
+// NormalizeShortcutNames brings the normalized name of every shortcut and alias in line
+// with the name policy of the workspace. When names collide the oldest shortcut keeps the
+// normalized name, shortcuts win over aliases, and the others are only found by their
+// exact name. The collisions are returned so that they can be reported.
+func (s *Store) NormalizeShortcutNames(ctx context.Context) ([]*ShortcutNameCollision, error) {
+	policy, err := s.GetShortcutNamePolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	normalizedNames, aliasNormalizedNames, collisions, err := s.computeNormalizedNames(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	if len(normalizedNames) == 0 && len(aliasNormalizedNames) == 0 {
+		return collisions, nil
+	}
+	if err := s.driver.UpdateShortcutNormalizedNames(ctx, normalizedNames, aliasNormalizedNames); err != nil {
+		return nil, err
+	}
+	s.shortcutCache.Clear()
+	s.resolverCache.purge()
+	return collisions, nil
+}
+
+// computeNormalizedNames returns the normalized names that differ from the stored ones,
+// keyed by shortcut id and by alias id, and the collisions under policy.
+func (s *Store) computeNormalizedNames(ctx context.Context, policy *storepb.ShortcutNamePolicy) (map[int32]string, map[int32]string, []*ShortcutNameCollision, error) {
+	// Shortcuts in the trash keep their names reserved, so they are normalized too.
+	shortcuts, err := s.driver.ListShortcuts(ctx, &FindShortcut{
+		Trash: TrashIncluded,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sort.Slice(shortcuts, func(i, j int) bool {
+		return shortcuts[i].Id < shortcuts[j].Id
+	})
+
+	normalizedNames := map[int32]string{}
+	collisionMap := map[string]*ShortcutNameCollision{}
+	collisions := []*ShortcutNameCollision{}
+	for _, shortcut := range shortcuts {
+		normalizedName := NormalizeShortcutName(shortcut.Name, policy)
+		if collision, ok := collisionMap[normalizedName]; ok {
+			if len(collision.Shortcuts) == 1 {
+				collisions = append(collisions, collision)
+			}
+			collision.Shortcuts = append(collision.Shortcuts, shortcut)
+			normalizedName = ""
+		} else {
+			collisionMap[normalizedName] = &ShortcutNameCollision{
+				NormalizedName: normalizedName,
+				Shortcuts:      []*storepb.Shortcut{shortcut},
+			}
+		}
+		if normalizedName != shortcut.NormalizedName {
+			normalizedNames[shortcut.Id] = normalizedName
+		}
+	}
+
+	aliases, err := s.driver.ListShortcutAliases(ctx, &FindShortcutAlias{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sort.Slice(aliases, func(i, j int) bool {
+		return aliases[i].Id < aliases[j].Id
+	})
+	aliasNormalizedNames := map[int32]string{}
+	for _, alias := range aliases {
+		normalizedName := NormalizeShortcutName(alias.Name, policy)
+		if collision, ok := collisionMap[normalizedName]; ok {
+			// An alias that normalizes like another name of its own shortcut resolves the same way.
+			if collision.shortcutID() != alias.ShortcutId {
+				if !slices.Contains(collisions, collision) {
+					collisions = append(collisions, collision)
+				}
+				collision.Aliases = append(collision.Aliases, alias)
+			}
+			normalizedName = ""
+		} else {
+			collisionMap[normalizedName] = &ShortcutNameCollision{
+				NormalizedName: normalizedName,
+				Aliases:        []*storepb.ShortcutAlias{alias},
+			}
+		}
+		if normalizedName != alias.NormalizedName {
+			aliasNormalizedNames[alias.Id] = normalizedName
+		}
+	}
+	return normalizedNames, aliasNormalizedNames, collisions, nil
+}
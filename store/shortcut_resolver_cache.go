@@ -0,0 +1,152 @@
+package store
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultResolverCacheSize is the number of resolved paths kept when the profile does not set one.
+	DefaultResolverCacheSize = 10000
+	// DefaultResolverCacheTTL is how long a resolved path is kept when the profile does not set it.
+	DefaultResolverCacheTTL = 5 * time.Minute
+)
+
+// ResolverCacheStats are the counters of the resolver cache.
+type ResolverCacheStats struct {
+	Capacity int
+	Entries  int
+	Hits     uint64
+	// NegativeHits are the hits for paths that name no shortcut. They are included in Hits.
+	NegativeHits uint64
+	Misses       uint64
+	Evictions    uint64
+}
+
+// resolverCache is an LRU cache from a requested shortcut path to the shortcut it
+// resolves to. Paths that name no shortcut are cached as well, so that unknown names
+// do not reach the database on every request.
+//
+// Any change to a shortcut, an alias or the name policy can change how every path
+// resolves, so writes purge the whole cache. A generation counter keeps a lookup that
+// started before a purge from storing its stale result after it.
+type resolverCache struct {
+	capacity int
+	ttl      time.Duration
+
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List
+	generation uint64
+
+	hits         atomic.Uint64
+	negativeHits atomic.Uint64
+	misses       atomic.Uint64
+	evictions    atomic.Uint64
+}
+
+type resolverCacheEntry struct {
+	path string
+	// shortcutID is 0 for a path that names no shortcut.
+	shortcutID int32
+	// length is the number of segments of the path taken by the shortcut name.
+	length    int
+	expiresAt time.Time
+}
+
+func newResolverCache(capacity int, ttl time.Duration) *resolverCache {
+	return &resolverCache{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+func (c *resolverCache) enabled() bool {
+	return c.capacity > 0 && c.ttl > 0
+}
+
+// get returns the cached entry of path and the current generation, which must be
+// passed to put when the entry is missing.
+func (c *resolverCache) get(path string) (*resolverCacheEntry, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[path]
+	if !ok {
+		c.misses.Add(1)
+		return nil, c.generation
+	}
+	entry := element.Value.(*resolverCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.lru.Remove(element)
+		delete(c.entries, path)
+		c.misses.Add(1)
+		return nil, c.generation
+	}
+	c.lru.MoveToFront(element)
+	c.hits.Add(1)
+	if entry.shortcutID == 0 {
+		c.negativeHits.Add(1)
+	}
+	return entry, c.generation
+}
+
+// put caches the resolution of path unless the cache was purged since generation.
+func (c *resolverCache) put(generation uint64, path string, shortcutID int32, length int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	entry := &resolverCacheEntry{
+		path:       path,
+		shortcutID: shortcutID,
+		length:     length,
+		expiresAt:  time.Now().Add(c.ttl),
+	}
+	if element, ok := c.entries[path]; ok {
+		element.Value = entry
+		c.lru.MoveToFront(element)
+		return
+	}
+	c.entries[path] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*resolverCacheEntry).path)
+		c.evictions.Add(1)
+	}
+}
+
+func (c *resolverCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if len(c.entries) == 0 {
+		return
+	}
+	c.entries = map[string]*list.Element{}
+	c.lru.Init()
+}
+
+func (c *resolverCache) stats() *ResolverCacheStats {
+	c.mu.Lock()
+	entries := len(c.entries)
+	c.mu.Unlock()
+	return &ResolverCacheStats{
+		Capacity:     c.capacity,
+		Entries:      entries,
+		Hits:         c.hits.Load(),
+		NegativeHits: c.negativeHits.Load(),
+		Misses:       c.misses.Load(),
+		Evictions:    c.evictions.Load(),
+	}
+}
+
+// GetResolverCacheStats returns the counters of the cache used by ResolveShortcut.
+func (s *Store) GetResolverCacheStats() *ResolverCacheStats {
+	return s.resolverCache.stats()
+}
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// ShortcutRevision is a recorded change of a shortcut.
+type ShortcutRevision struct {
+	ID         int32
+	ShortcutID int32
+	CreatorID  int32
+	CreatedTs  int64
+	Payload    *storepb.ShortcutRevisionPayload
+}
+
+type FindShortcutRevision struct {
+	ID         *int32
+	ShortcutID *int32
+	// Limit caps the number of revisions returned, newest first.
+	Limit *int
+}
+
+// shortcutRevisionField is a field of a shortcut that is recorded in revisions.
+type shortcutRevisionField struct {
+	// name is the field name in the UpdateShortcut update mask.
+	name string
+	// value formats the field for comparison and display.
+	value func(shortcut *storepb.Shortcut) string
+}
+
+var shortcutRevisionFields = []shortcutRevisionField{
+	{"name", func(shortcut *storepb.Shortcut) string { return shortcut.Name }},
+	{"link", func(shortcut *storepb.Shortcut) string { return shortcut.Link }},
+	{"title", func(shortcut *storepb.Shortcut) string { return shortcut.Title }},
+	{"description", func(shortcut *storepb.Shortcut) string { return shortcut.Description }},
+	{"tags", func(shortcut *storepb.Shortcut) string { return strings.Join(shortcut.Tags, " ") }},
+	{"visibility", func(shortcut *storepb.Shortcut) string { return shortcut.Visibility.String() }},
+	{"og_metadata", func(shortcut *storepb.Shortcut) string { return formatRevisionMessage(shortcut.OgMetadata) }},
+	{"template", func(shortcut *storepb.Shortcut) string { return strconv.FormatBool(shortcut.Template) }},
+	{"forward_path", func(shortcut *storepb.Shortcut) string { return strconv.FormatBool(shortcut.ForwardPath) }},
+	{"redirect_mode", func(shortcut *storepb.Shortcut) string { return shortcut.RedirectMode.String() }},
+	{"valid_from", func(shortcut *storepb.Shortcut) string { return formatRevisionTime(shortcut.ValidFrom) }},
+	{"valid_until", func(shortcut *storepb.Shortcut) string { return formatRevisionTime(shortcut.ValidUntil) }},
+	{"state", func(shortcut *storepb.Shortcut) string { return shortcut.RowStatus.String() }},
+	{"variants", func(shortcut *storepb.Shortcut) string { return formatRevisionMessage(shortcut.Variants) }},
+	{"routing_rules", func(shortcut *storepb.Shortcut) string { return formatRevisionMessage(shortcut.RoutingRules) }},
+	{"backup_links", func(shortcut *storepb.Shortcut) string {
+		return strings.Join(shortcut.Failover.GetBackupLinks(), " ")
+	}},
+	{"group_ids", func(shortcut *storepb.Shortcut) string {
+		return strings.Trim(fmt.Sprint(shortcut.GroupIds), "[]")
+	}},
+	{"protected", func(shortcut *storepb.Shortcut) string { return strconv.FormatBool(shortcut.Protected) }},
+}
+
+// DiffShortcuts returns the recorded fields that differ between two versions of a shortcut.
+func DiffShortcuts(previous, shortcut *storepb.Shortcut) []string {
+	fields := []string{}
+	for _, field := range shortcutRevisionFields {
+		if field.value(previous) != field.value(shortcut) {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
+
+// GetShortcutFieldValue returns the recorded field of shortcut formatted for display,
+// or "" if the field is not recorded.
+func GetShortcutFieldValue(shortcut *storepb.Shortcut, name string) string {
+	for _, field := range shortcutRevisionFields {
+		if field.name == name {
+			return field.value(shortcut)
+		}
+	}
+	return ""
+}
+
+func formatRevisionMessage(message proto.Message) string {
+	if message == nil || proto.Size(message) == 0 {
+		return ""
+	}
+	bytes, err := protojson.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	return string(bytes)
+}
+
+func formatRevisionTime(ts int64) string {
+	if ts == 0 {
+		return ""
+	}
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
+
+func (s *Store) ListShortcutRevisions(ctx context.Context, find *FindShortcutRevision) ([]*ShortcutRevision, error) {
+	return s.driver.ListShortcutRevisions(ctx, find)
+}
+
+func (s *Store) GetShortcutRevision(ctx context.Context, find *FindShortcutRevision) (*ShortcutRevision, error) {
+	list, err := s.ListShortcutRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// NewShortcutRevisions returns the revisions that record the change from previous to
+// shortcut, or none if no recorded field changed. A shortcut without revisions, such as
+// one created before revisions were recorded, first gets a baseline revision of its
+// previous state so that the change can be rolled back. Drivers write them in the same
+// transaction as the update.
+func NewShortcutRevisions(previous, shortcut *storepb.Shortcut, update *UpdateShortcut, hasRevisions bool) []*ShortcutRevision {
+	fields := DiffShortcuts(previous, shortcut)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	revisions := []*ShortcutRevision{}
+	if !hasRevisions {
+		revisions = append(revisions, &ShortcutRevision{
+			ShortcutID: shortcut.Id,
+			CreatorID:  previous.CreatorId,
+			CreatedTs:  previous.UpdatedTs,
+			Payload: &storepb.ShortcutRevisionPayload{
+				Shortcut: previous,
+			},
+		})
+	}
+	return append(revisions, &ShortcutRevision{
+		ShortcutID: shortcut.Id,
+		CreatorID:  update.EditorID,
+		CreatedTs:  time.Now().Unix(),
+		Payload: &storepb.ShortcutRevisionPayload{
+			Fields:             fields,
+			Previous:           previous,
+			Shortcut:           shortcut,
+			RestoredRevisionId: update.RestoredRevisionID,
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+)
+
+// ShortcutTargetHealth is the result of the last health check of one of a shortcut's links.
+type ShortcutTargetHealth struct {
+	ShortcutID int32
+	Link       string
+	Healthy    bool
+	// StatusCode is the HTTP status of the check, or 0 if the request failed.
+	StatusCode int32
+	Error      string
+	CheckedTs  int64
+}
+
+type FindShortcutTargetHealth struct {
+	ShortcutID     *int32
+	ShortcutIDList []int32
+}
+
+type DeleteShortcutTargetHealth struct {
+	ShortcutID int32
+	// Link restricts the deletion to a single target, otherwise all targets of the shortcut are deleted.
+	Link *string
+}
+
+func (s *Store) UpsertShortcutTargetHealth(ctx context.Context, upsert *ShortcutTargetHealth) (*ShortcutTargetHealth, error) {
+	return s.driver.UpsertShortcutTargetHealth(ctx, upsert)
+}
+
+func (s *Store) ListShortcutTargetHealth(ctx context.Context, find *FindShortcutTargetHealth) ([]*ShortcutTargetHealth, error) {
+	return s.driver.ListShortcutTargetHealth(ctx, find)
+}
+
+func (s *Store) DeleteShortcutTargetHealth(ctx context.Context, delete *DeleteShortcutTargetHealth) error {
+	return s.driver.DeleteShortcutTargetHealth(ctx, delete)
+}
+
+// GetHealthyShortcutTarget returns the first of the targets that is not known to be unhealthy.
+// Targets that have not been checked yet count as healthy. If every target is unhealthy, the
+// first one is returned, since there is nothing better to serve.
+func (s *Store) GetHealthyShortcutTarget(ctx context.Context, shortcutID int32, targets []string) (string, error) {
+	if len(targets) == 0 {
+		return "", nil
+	}
+	if len(targets) == 1 {
+		return targets[0], nil
+	}
+	list, err := s.ListShortcutTargetHealth(ctx, &FindShortcutTargetHealth{
+		ShortcutID: &shortcutID,
+	})
+	if err != nil {
+		return "", err
+	}
+	unhealthy := map[string]bool{}
+	for _, health := range list {
+		unhealthy[health.Link] = !health.Healthy
+	}
+	for _, target := range targets {
+		if !unhealthy[target] {
+			return target, nil
+		}
+	}
+	return targets[0], nil
+}
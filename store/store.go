@@ -15,13 +15,15 @@ type Store struct {
 	userCache             sync.Map // map[int]*User
 	userSettingCache      sync.Map // map[string]*UserSetting
 	shortcutCache         sync.Map // map[int]*Shortcut
+	resolverCache         *resolverCache
 }
 
 // New creates a new instance of Store.
 func New(driver Driver, profile *profile.Profile) *Store {
 	return &Store{
-		driver:  driver,
-		profile: profile,
+		driver:        driver,
+		profile:       profile,
+		resolverCache: newResolverCache(profile.ResolverCacheSize, profile.ResolverCacheTTL),
 	}
 }
 
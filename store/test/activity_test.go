@@ -29,3 +29,32 @@ func TestActivityStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	require.Equal(t, activity, list[0])
 }
+
+func TestCreateActivities(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.CreateActivities(ctx, []*store.Activity{
+		{
+			CreatorID: user.ID,
+			CreatedTs: 1700000000,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityInfo,
+		},
+		{
+			CreatorID: user.ID,
+			Type:      store.ActivityShortcutView,
+			Level:     store.ActivityWarn,
+		},
+	})
+	require.NoError(t, err)
+	list, err := ts.ListActivities(ctx, &store.FindActivity{
+		Type: store.ActivityShortcutView,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	createdTsList := []int64{list[0].CreatedTs, list[1].CreatedTs}
+	require.Contains(t, createdTsList, int64(1700000000))
+	require.NotContains(t, createdTsList, int64(0))
+}
@@ -40,6 +40,16 @@ func TestCollectionStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, newTitle, updatedCollection.Title)
 	require.Equal(t, newShortcutIDs, updatedCollection.ShortcutIds)
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{
+		VisibilityList: []storepb.Visibility{storepb.Visibility_WORKSPACE},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{
+		VisibilityList: []storepb.Visibility{storepb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collections))
 	err = ts.DeleteCollection(ctx, &store.DeleteCollection{
 		ID: collection.Id,
 	})
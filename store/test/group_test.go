@@ -0,0 +1,126 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestGroupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{
+		CreatorID: user.ID,
+		Name:      "sre",
+	})
+	require.NoError(t, err)
+	description := "On-call engineers"
+	group, err = ts.UpdateGroup(ctx, &store.UpdateGroup{
+		ID:          group.ID,
+		Description: &description,
+	})
+	require.NoError(t, err)
+	require.Equal(t, description, group.Description)
+
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: user.ID})
+	require.NoError(t, err)
+	// Adding a member twice keeps a single membership.
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: user.ID})
+	require.NoError(t, err)
+	members, err := ts.ListGroupMembers(ctx, &store.FindGroupMember{GroupID: &group.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(members))
+	groups, err := ts.ListGroups(ctx, &store.FindGroup{MemberID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groups))
+
+	err = ts.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID})
+	require.NoError(t, err)
+	groups, err = ts.ListGroups(ctx, &store.FindGroup{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groups))
+	members, err = ts.ListGroupMembers(ctx, &store.FindGroupMember{GroupID: &group.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(members))
+}
+
+func TestGroupVisibility(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+		Nickname: "member",
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{CreatorID: user.ID, Name: "sre"})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "runbook",
+		Link:       "https://runbook.link",
+		Visibility: storepb.Visibility_GROUP,
+		GroupIds:   []int32{group.ID},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "oncall",
+		ShortcutIds: []int32{shortcut.Id},
+		Visibility:  storepb.Visibility_GROUP,
+		GroupIds:    []int32{group.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int32{group.ID}, collection.GroupIds)
+
+	anonymous := int32(0)
+	for viewerID, count := range map[int32]int{user.ID: 1, member.ID: 1, other.ID: 0, anonymous: 0} {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &viewerID})
+		require.NoError(t, err)
+		require.Equal(t, count, len(shortcuts))
+		collections, err := ts.ListCollections(ctx, &store.FindCollection{ViewerID: &viewerID})
+		require.NoError(t, err)
+		require.Equal(t, count, len(collections))
+		visible, err := ts.IsVisibleTo(ctx, shortcut, viewerID)
+		require.NoError(t, err)
+		require.Equal(t, count == 1, visible)
+	}
+
+	// Members removed from the group lose access.
+	err = ts.DeleteGroupMember(ctx, &store.DeleteGroupMember{GroupID: group.ID, UserID: &member.ID})
+	require.NoError(t, err)
+	found, err := ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id, ViewerID: &member.ID})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+
+	// Group IDs of unrelated groups, like 11 against 1, don't grant access.
+	groupIDs := []int32{group.ID + 10}
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{ID: shortcut.Id, GroupIDs: groupIDs})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
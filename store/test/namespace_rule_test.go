@@ -0,0 +1,109 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestMatchNamespaceRule(t *testing.T) {
+	eng := &store.NamespaceRule{ID: 1, Pattern: "eng-*"}
+	engSlash := &store.NamespaceRule{ID: 2, Pattern: "eng/*"}
+	infra := &store.NamespaceRule{ID: 3, Pattern: "eng-infra-*"}
+	handbook := &store.NamespaceRule{ID: 4, Pattern: "hr-*-handbook"}
+	rules := []*store.NamespaceRule{eng, engSlash, infra, handbook}
+	tests := []struct {
+		name string
+		want *store.NamespaceRule
+	}{
+		{name: "eng-docs", want: eng},
+		{name: "ENG-Docs", want: eng},
+		{name: "eng/docs/onboarding", want: engSlash},
+		{name: "eng-infra-oncall", want: infra},
+		{name: "hr-2024-handbook", want: handbook},
+		{name: "hr-2024-handbook-draft", want: nil},
+		{name: "engineering", want: nil},
+		{name: "docs", want: nil},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, store.MatchNamespaceRule(rules, test.name, store.DefaultShortcutNamePolicy), test.name)
+	}
+}
+
+func TestNamespaceRuleStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+		Nickname: "member",
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{CreatorID: admin.ID, Name: "eng"})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+	for _, shortcut := range []*storepb.Shortcut{
+		{CreatorId: member.ID, Name: "eng-docs", Link: "https://docs.link"},
+		{CreatorId: other.ID, Name: "eng-wiki", Link: "https://wiki.link"},
+		{CreatorId: admin.ID, Name: "eng-admin", Link: "https://admin.link"},
+		{CreatorId: other.ID, Name: "hr-benefits", Link: "https://benefits.link"},
+	} {
+		shortcut.Visibility = storepb.Visibility_WORKSPACE
+		shortcut.OgMetadata = &storepb.OpenGraphMetadata{}
+		_, err = ts.CreateShortcut(ctx, shortcut)
+		require.NoError(t, err)
+	}
+
+	rule, err := ts.CreateNamespaceRule(ctx, &store.NamespaceRule{
+		CreatorID: admin.ID,
+		Pattern:   "eng-*",
+		GroupID:   group.ID,
+	})
+	require.NoError(t, err)
+	requireApproval := true
+	rule, err = ts.UpdateNamespaceRule(ctx, &store.UpdateNamespaceRule{
+		ID:              rule.ID,
+		RequireApproval: &requireApproval,
+	})
+	require.NoError(t, err)
+	require.True(t, rule.RequireApproval)
+	matched, err := ts.GetNamespaceRuleByName(ctx, "eng-oncall")
+	require.NoError(t, err)
+	require.Equal(t, rule, matched)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	requiresApproval, err := ts.ShortcutsRequiringApproval(ctx, shortcuts)
+	require.NoError(t, err)
+	for _, shortcut := range shortcuts {
+		require.Equal(t, shortcut.Name != "hr-benefits", requiresApproval[shortcut.Id], shortcut.Name)
+	}
+	isMember, err := ts.IsNamespaceMember(ctx, rule, member.ID)
+	require.NoError(t, err)
+	require.True(t, isMember)
+
+	// Only the shortcut created by a non-member outside the admins conflicts.
+	conflicts, err := ts.ListNamespaceConflicts(ctx, []*store.NamespaceRule{rule})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(conflicts))
+	require.Equal(t, "eng-wiki", conflicts[0].Shortcut.Name)
+	require.Equal(t, rule.ID, conflicts[0].Rule.ID)
+
+	err = ts.DeleteNamespaceRule(ctx, &store.DeleteNamespaceRule{ID: rule.ID})
+	require.NoError(t, err)
+	rules, err := ts.ListNamespaceRules(ctx, &store.FindNamespaceRule{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rules))
+}
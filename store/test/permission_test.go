@@ -0,0 +1,155 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestPermissionRoleIncludes(t *testing.T) {
+	require.True(t, store.PermissionRoleOwner.Includes(store.PermissionRoleEditor))
+	require.True(t, store.PermissionRoleEditor.Includes(store.PermissionRoleEditor))
+	require.False(t, store.PermissionRoleViewer.Includes(store.PermissionRoleEditor))
+	require.False(t, store.PermissionRole("").Includes(store.PermissionRoleViewer))
+}
+
+func TestPermissionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	editor, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "editor@test.com",
+		Nickname: "editor",
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "oncall",
+		Link:       "https://oncall.link",
+		Visibility: storepb.Visibility_PRIVATE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.UpsertPermission(ctx, &store.Permission{
+		ResourceType:  store.PermissionResourceTypeShortcut,
+		ResourceID:    shortcut.Id,
+		PrincipalType: store.PermissionPrincipalTypeUser,
+		PrincipalID:   editor.ID,
+		Role:          store.PermissionRoleViewer,
+	})
+	require.NoError(t, err)
+	// Granting again replaces the role.
+	_, err = ts.UpsertPermission(ctx, &store.Permission{
+		ResourceType:  store.PermissionResourceTypeShortcut,
+		ResourceID:    shortcut.Id,
+		PrincipalType: store.PermissionPrincipalTypeUser,
+		PrincipalID:   editor.ID,
+		Role:          store.PermissionRoleEditor,
+	})
+	require.NoError(t, err)
+	resourceType := store.PermissionResourceTypeShortcut
+	permissions, err := ts.ListPermissions(ctx, &store.FindPermission{
+		ResourceType: &resourceType,
+		ResourceID:   &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(permissions))
+	role, err := ts.GetPermissionRole(ctx, store.PermissionResourceTypeShortcut, shortcut.Id, editor.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.PermissionRoleEditor, role)
+
+	// Private shortcuts are visible to the users they are shared with.
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &editor.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{ViewerID: &other.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+	visible, err := ts.IsVisibleTo(ctx, shortcut, editor.ID)
+	require.NoError(t, err)
+	require.True(t, visible)
+
+	// Purging the shortcut deletes its permissions.
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id})
+	require.NoError(t, err)
+	permissions, err = ts.ListPermissions(ctx, &store.FindPermission{
+		ResourceType: &resourceType,
+		ResourceID:   &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(permissions))
+}
+
+func TestGroupPermission(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+		Nickname: "member",
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{CreatorID: user.ID, Name: "sre"})
+	require.NoError(t, err)
+	_, err = ts.UpsertGroupMember(ctx, &store.GroupMember{GroupID: group.ID, UserID: member.ID})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "runbooks",
+		Title:      "Runbooks",
+		Visibility: storepb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+
+	for _, role := range []store.PermissionRole{store.PermissionRoleOwner, store.PermissionRoleViewer} {
+		_, err = ts.UpsertPermission(ctx, &store.Permission{
+			ResourceType:  store.PermissionResourceTypeCollection,
+			ResourceID:    collection.Id,
+			PrincipalType: store.PermissionPrincipalTypeGroup,
+			PrincipalID:   group.ID,
+			Role:          role,
+		})
+		require.NoError(t, err)
+	}
+	_, err = ts.UpsertPermission(ctx, &store.Permission{
+		ResourceType:  store.PermissionResourceTypeCollection,
+		ResourceID:    collection.Id,
+		PrincipalType: store.PermissionPrincipalTypeUser,
+		PrincipalID:   member.ID,
+		Role:          store.PermissionRoleEditor,
+	})
+	require.NoError(t, err)
+	// The highest of the roles granted directly and through groups wins.
+	role, err := ts.GetPermissionRole(ctx, store.PermissionResourceTypeCollection, collection.Id, member.ID)
+	require.NoError(t, err)
+	require.Equal(t, store.PermissionRoleEditor, role)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{ViewerID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+
+	// Deleting the group deletes the permissions granted to it.
+	err = ts.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID})
+	require.NoError(t, err)
+	principalType := store.PermissionPrincipalTypeGroup
+	permissions, err := ts.ListPermissions(ctx, &store.FindPermission{
+		PrincipalType: &principalType,
+		PrincipalID:   &group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(permissions))
+}
@@ -0,0 +1,128 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestShortcutAliasStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:   user.ID,
+		Name:        "handbook",
+		Link:        "https://handbook.link",
+		ForwardPath: true,
+		Visibility:  storepb.Visibility_WORKSPACE,
+		OgMetadata:  &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	alias, err := ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: shortcut.Id,
+		CreatorId:  user.ID,
+		Name:       "docs/handbook",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, alias.Id)
+	_, err = ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: shortcut.Id,
+		CreatorId:  user.ID,
+		Name:       "docs/handbook",
+	})
+	require.Error(t, err)
+
+	resolved, rest, err := ts.ResolveShortcut(ctx, []string{"docs", "handbook", "onboarding"})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, resolved.Id)
+	require.Equal(t, []string{"onboarding"}, rest)
+
+	aliases, err := ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(aliases))
+	require.Equal(t, "docs/handbook", aliases[0].Name)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	aliases, err = ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Empty(t, aliases)
+}
+
+func TestShortcutAliasNameUniqueness(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	handbook, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "handbook",
+		Link:       "https://handbook.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	wiki, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: handbook.Id,
+		CreatorId:  user.ID,
+		Name:       "docs",
+	})
+	require.NoError(t, err)
+
+	// An alias can't take the name of another shortcut, and the other way around.
+	_, err = ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: handbook.Id,
+		CreatorId:  user.ID,
+		Name:       "wiki",
+	})
+	require.Error(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs",
+		Link:       "https://docs.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.Error(t, err)
+	name := "docs"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   wiki.Id,
+		Name: &name,
+	})
+	require.Error(t, err)
+
+	// A shortcut can be renamed to its own alias before the alias is removed.
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   handbook.Id,
+		Name: &name,
+	})
+	require.NoError(t, err)
+
+	aliases, err := ts.ListShortcutAliases(ctx, &store.FindShortcutAlias{
+		ShortcutIDList: []int32{handbook.Id, wiki.Id},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(aliases))
+	require.Equal(t, handbook.Id, aliases[0].ShortcutId)
+}
@@ -0,0 +1,135 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestApplyShortcutUpdate(t *testing.T) {
+	shortcut := &storepb.Shortcut{
+		Id:         1,
+		Name:       "vpn",
+		Link:       "https://vpn.link",
+		Tags:       []string{"it"},
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Protected:  true,
+	}
+	link, tag := "https://new-vpn.link", "it network"
+	target := store.ApplyShortcutUpdate(shortcut, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &link,
+		Tag:  &tag,
+	})
+	require.Equal(t, []string{"link", "tags"}, store.DiffShortcuts(shortcut, target))
+	require.Equal(t, []string{"it", "network"}, target.Tags)
+	// The shortcut itself is left untouched.
+	require.Equal(t, "https://vpn.link", shortcut.Link)
+
+	protected := false
+	target = store.ApplyShortcutUpdate(shortcut, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		Protected: &protected,
+	})
+	require.Equal(t, []string{"protected"}, store.DiffShortcuts(shortcut, target))
+}
+
+func TestShortcutChangeRequestStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "benefits",
+		Link:       "https://benefits.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Protected:  true,
+	})
+	require.NoError(t, err)
+	require.True(t, shortcut.Protected)
+
+	link := "https://new-benefits.link"
+	target := store.ApplyShortcutUpdate(shortcut, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &link,
+	})
+	changeRequest, err := ts.CreateShortcutChangeRequest(ctx, &store.ShortcutChangeRequest{
+		ShortcutID: shortcut.Id,
+		CreatorID:  user.ID,
+		Payload: &storepb.ShortcutChangeRequestPayload{
+			Fields:   store.DiffShortcuts(shortcut, target),
+			Previous: shortcut,
+			Shortcut: target,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ShortcutChangeRequestPending, changeRequest.Status)
+	require.Equal(t, int32(0), changeRequest.ReviewerID)
+
+	pending := store.ShortcutChangeRequestPending
+	changeRequests, err := ts.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+		Status:     &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changeRequests))
+	require.Equal(t, []string{"link"}, changeRequests[0].Payload.Fields)
+	require.Equal(t, link, changeRequests[0].Payload.Shortcut.Link)
+	changeRequests, err = ts.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutIDList: []int32{shortcut.Id, shortcut.Id + 1},
+		Status:         &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changeRequests))
+
+	updatedTs, approved := time.Now().Unix(), store.ShortcutChangeRequestApproved
+	changeRequest, err = ts.UpdateShortcutChangeRequest(ctx, &store.UpdateShortcutChangeRequest{
+		ID:         changeRequest.ID,
+		UpdatedTs:  &updatedTs,
+		Status:     &approved,
+		ReviewerID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.ShortcutChangeRequestApproved, changeRequest.Status)
+	require.Equal(t, user.ID, changeRequest.ReviewerID)
+	changeRequests, err = ts.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+		Status:     &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changeRequests))
+
+	// Unprotecting the shortcut is recorded in its revisions.
+	protected := false
+	shortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		Protected: &protected,
+		EditorID:  user.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, shortcut.Protected)
+	limit := 1
+	revision, err := ts.GetShortcutRevision(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+		Limit:      &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"protected"}, revision.Payload.Fields)
+
+	// Purging the shortcut deletes its change requests.
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id})
+	require.NoError(t, err)
+	changeRequests, err = ts.ListShortcutChangeRequests(ctx, &store.FindShortcutChangeRequest{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changeRequests))
+}
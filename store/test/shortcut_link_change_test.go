@@ -0,0 +1,122 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestShortcutLinkChangeStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "standup",
+		Link:       "https://meet.example.com/a",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	past, err := ts.CreateShortcutLinkChange(ctx, &store.ShortcutLinkChange{
+		ShortcutID:  shortcut.Id,
+		CreatorID:   user.ID,
+		EffectiveTs: now - 60,
+		Link:        "https://meet.example.com/b",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), past.AppliedTs)
+	future, err := ts.CreateShortcutLinkChange(ctx, &store.ShortcutLinkChange{
+		ShortcutID:  shortcut.Id,
+		CreatorID:   user.ID,
+		EffectiveTs: now + 3600,
+		Link:        "https://meet.example.com/c",
+	})
+	require.NoError(t, err)
+
+	changes, err := ts.ListShortcutLinkChanges(ctx, &store.FindShortcutLinkChange{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.ShortcutLinkChange{past, future}, changes)
+
+	// The due change is in effect, the future one is not yet.
+	link, err := ts.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, now)
+	require.NoError(t, err)
+	require.Equal(t, past.Link, link)
+	link, err = ts.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, now+3600)
+	require.NoError(t, err)
+	require.Equal(t, future.Link, link)
+
+	// Changes of shortcuts that require approval wait for their review.
+	protected := true
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		Protected: &protected,
+	})
+	require.NoError(t, err)
+	link, err = ts.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, now)
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Link, link)
+	protected = false
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		Protected: &protected,
+	})
+	require.NoError(t, err)
+
+	// Applied changes no longer override the stored link.
+	applied, err := ts.UpdateShortcutLinkChange(ctx, &store.UpdateShortcutLinkChange{
+		ID:        past.ID,
+		AppliedTs: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, now, applied.AppliedTs)
+	link, err = ts.GetEffectiveShortcutLink(ctx, shortcut.Id, shortcut.Link, now)
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Link, link)
+
+	pending := true
+	changes, err = ts.ListShortcutLinkChanges(ctx, &store.FindShortcutLinkChange{
+		ShortcutID: &shortcut.Id,
+		Pending:    &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, future.ID, changes[0].ID)
+
+	err = ts.DeleteShortcutLinkChange(ctx, &store.DeleteShortcutLinkChange{
+		ID: future.ID,
+	})
+	require.NoError(t, err)
+	changes, err = ts.ListShortcutLinkChanges(ctx, &store.FindShortcutLinkChange{
+		ShortcutID: &shortcut.Id,
+		Pending:    &pending,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+
+	// Purging the shortcut deletes its scheduled changes.
+	_, err = ts.CreateShortcutLinkChange(ctx, &store.ShortcutLinkChange{
+		ShortcutID:  shortcut.Id,
+		CreatorID:   user.ID,
+		EffectiveTs: now + 3600,
+		Link:        "https://meet.example.com/d",
+	})
+	require.NoError(t, err)
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id})
+	require.NoError(t, err)
+	changes, err = ts.ListShortcutLinkChanges(ctx, &store.FindShortcutLinkChange{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes))
+}
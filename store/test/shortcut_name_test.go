@@ -0,0 +1,204 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestNormalizeShortcutName(t *testing.T) {
+	policy := store.DefaultShortcutNamePolicy
+	require.Equal(t, "docs/api", store.NormalizeShortcutName("Docs/API", policy))
+	require.Equal(t, "docs", store.NormalizeShortcutName("ｄｏｃｓ", policy))
+	require.Equal(t, "strasse", store.NormalizeShortcutName("STRASSE", policy))
+	require.Equal(t, "team_docs", store.NormalizeShortcutName("Team_Docs", policy))
+
+	policy = &storepb.ShortcutNamePolicy{EquivalentSeparators: true}
+	require.Equal(t, "Team-Docs-v2", store.NormalizeShortcutName("Team_Docs.v2", policy))
+	require.Equal(t, "ｄｏｃｓ", store.NormalizeShortcutName("ｄｏｃｓ", policy))
+}
+
+func TestShortcutNameNormalization(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "Team_Docs",
+		Link:       "https://docs.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "team_docs", shortcut.NormalizedName)
+
+	resolved, rest, err := ts.ResolveShortcut(ctx, []string{"TEAM_DOCS", "handbook"})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, resolved.Id)
+	require.Equal(t, []string{"handbook"}, rest)
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"team-docs"})
+	require.NoError(t, err)
+	require.Nil(t, resolved)
+
+	other, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "team-docs",
+		Link:       "https://other.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	policy := &storepb.ShortcutNamePolicy{CaseInsensitive: true, EquivalentSeparators: true}
+	collisions, err := ts.FindShortcutNameCollisions(ctx, policy)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collisions))
+	require.Equal(t, "team-docs", collisions[0].NormalizedName)
+	require.Equal(t, []int32{shortcut.Id, other.Id}, []int32{collisions[0].Shortcuts[0].Id, collisions[0].Shortcuts[1].Id})
+
+	// Under the new policy the older shortcut keeps the normalized name.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				NamePolicy: policy,
+			},
+		},
+	})
+	require.NoError(t, err)
+	collisions, err = ts.NormalizeShortcutNames(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collisions))
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"Team.Docs"})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, resolved.Id)
+	// An exact name still wins over a normalized one.
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"team-docs"})
+	require.NoError(t, err)
+	require.Equal(t, other.Id, resolved.Id)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	collisions, err = ts.NormalizeShortcutNames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, collisions)
+	other, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		ID: &other.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "team-docs", other.NormalizedName)
+}
+
+func TestShortcutAliasNameNormalization(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	handbook, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "handbook",
+		Link:       "https://handbook.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	wiki, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "Wiki",
+		Link:       "https://wiki.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	alias, err := ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: handbook.Id,
+		CreatorId:  user.ID,
+		Name:       "Old-Name",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "old-name", alias.NormalizedName)
+
+	resolved, rest, err := ts.ResolveShortcut(ctx, []string{"OLD-NAME", "onboarding"})
+	require.NoError(t, err)
+	require.Equal(t, handbook.Id, resolved.Id)
+	require.Equal(t, []string{"onboarding"}, rest)
+
+	// Names that only differ in case collide with aliases and shortcuts of other shortcuts.
+	_, err = ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: wiki.Id,
+		CreatorId:  user.ID,
+		Name:       "old-name",
+	})
+	require.Error(t, err)
+	_, err = ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: handbook.Id,
+		CreatorId:  user.ID,
+		Name:       "wiki",
+	})
+	require.Error(t, err)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "OLD-name",
+		Link:       "https://other.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.Error(t, err)
+
+	// Another name of the same shortcut is only matched exactly.
+	sameAlias, err := ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: handbook.Id,
+		CreatorId:  user.ID,
+		Name:       "old-name",
+	})
+	require.NoError(t, err)
+	require.Empty(t, sameAlias.NormalizedName)
+
+	// Under a stricter policy the alias collides with the other shortcut.
+	_, err = ts.CreateShortcutAlias(ctx, &storepb.ShortcutAlias{
+		ShortcutId: handbook.Id,
+		CreatorId:  user.ID,
+		Name:       "team_docs",
+	})
+	require.NoError(t, err)
+	teamDocs, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "team-docs",
+		Link:       "https://docs.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	policy := &storepb.ShortcutNamePolicy{CaseInsensitive: true, EquivalentSeparators: true}
+	collisions, err := ts.FindShortcutNameCollisions(ctx, policy)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collisions))
+	require.Equal(t, "team-docs", collisions[0].NormalizedName)
+	require.Equal(t, teamDocs.Id, collisions[0].Shortcuts[0].Id)
+	require.Equal(t, "team_docs", collisions[0].Aliases[0].Name)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				NamePolicy: policy,
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.NormalizeShortcutNames(ctx)
+	require.NoError(t, err)
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"Team.Docs"})
+	require.NoError(t, err)
+	require.Equal(t, teamDocs.Id, resolved.Id)
+	// An exact alias still wins over a normalized name.
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"team_docs"})
+	require.NoError(t, err)
+	require.Equal(t, handbook.Id, resolved.Id)
+}
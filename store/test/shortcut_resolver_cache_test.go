@@ -0,0 +1,139 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestResolverCache(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs",
+		Link:       "https://docs.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		resolved, rest, err := ts.ResolveShortcut(ctx, []string{"docs", "api"})
+		require.NoError(t, err)
+		require.Equal(t, shortcut.Id, resolved.Id)
+		require.Equal(t, []string{"api"}, rest)
+	}
+	stats := ts.GetResolverCacheStats()
+	require.Equal(t, uint64(1), stats.Hits)
+	require.Equal(t, uint64(1), stats.Misses)
+	require.Equal(t, 1, stats.Entries)
+
+	// Unknown names are cached until a shortcut takes them.
+	for i := 0; i < 2; i++ {
+		resolved, _, err := ts.ResolveShortcut(ctx, []string{"handbook"})
+		require.NoError(t, err)
+		require.Nil(t, resolved)
+	}
+	require.Equal(t, uint64(1), ts.GetResolverCacheStats().NegativeHits)
+	handbook, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "handbook",
+		Link:       "https://handbook.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, ts.GetResolverCacheStats().Entries)
+	resolved, _, err := ts.ResolveShortcut(ctx, []string{"handbook"})
+	require.NoError(t, err)
+	require.Equal(t, handbook.Id, resolved.Id)
+
+	// A longer name takes over the paths it matches.
+	docsAPI, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "docs/api",
+		Link:       "https://api.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	resolved, rest, err := ts.ResolveShortcut(ctx, []string{"docs", "api"})
+	require.NoError(t, err)
+	require.Equal(t, docsAPI.Id, resolved.Id)
+	require.Empty(t, rest)
+
+	// A new link is served without purging, while a new name is not.
+	link := "https://new.docs.link"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &link,
+	})
+	require.NoError(t, err)
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"docs"})
+	require.NoError(t, err)
+	require.Equal(t, link, resolved.Link)
+	name := "guides"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Name: &name,
+	})
+	require.NoError(t, err)
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"docs"})
+	require.NoError(t, err)
+	require.Nil(t, resolved)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: handbook.Id,
+	})
+	require.NoError(t, err)
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"handbook"})
+	require.NoError(t, err)
+	require.Nil(t, resolved)
+}
+
+// BenchmarkResolveShortcut compares redirect lookups with and without the resolver cache.
+// Run it against Postgres with DRIVER=postgres and DSN set.
+func BenchmarkResolveShortcut(b *testing.B) {
+	ctx := context.Background()
+	for _, cacheSize := range []int{0, store.DefaultResolverCacheSize} {
+		name := "NoCache"
+		if cacheSize > 0 {
+			name = "Cache"
+		}
+		b.Run(name, func(b *testing.B) {
+			profile := getTestingProfile(b)
+			profile.ResolverCacheSize = cacheSize
+			ts := newTestingStoreWithProfile(ctx, profile)
+			defer ts.Close()
+			user, err := createTestingAdminUser(ctx, ts)
+			require.NoError(b, err)
+			for i := 0; i < 100; i++ {
+				_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+					CreatorId:  user.ID,
+					Name:       fmt.Sprintf("shortcut-%d", i),
+					Link:       fmt.Sprintf("https://%d.link", i),
+					Visibility: storepb.Visibility_WORKSPACE,
+					OgMetadata: &storepb.OpenGraphMetadata{},
+				})
+				require.NoError(b, err)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// One in ten lookups is for a name that does not exist.
+				segments := []string{fmt.Sprintf("shortcut-%d", i%110), "path"}
+				if _, _, err := ts.ResolveShortcut(ctx, segments); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
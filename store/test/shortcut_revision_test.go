@@ -0,0 +1,90 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestShortcutRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	// The first change writes a baseline revision of the shortcut before it.
+	link, title := "https://wrong.link", "Wiki"
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:       shortcut.Id,
+		Link:     &link,
+		Title:    &title,
+		EditorID: user.ID,
+	})
+	require.NoError(t, err)
+	revisions, err := ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(revisions))
+	latest, baseline := revisions[0], revisions[1]
+	require.Empty(t, baseline.Payload.Fields)
+	require.Equal(t, "https://wiki.link", baseline.Payload.Shortcut.Link)
+	require.Equal(t, []string{"link", "title"}, latest.Payload.Fields)
+	require.Equal(t, user.ID, latest.CreatorID)
+	require.Equal(t, "https://wiki.link", store.GetShortcutFieldValue(latest.Payload.Previous, "link"))
+	require.Equal(t, "https://wrong.link", store.GetShortcutFieldValue(latest.Payload.Shortcut, "link"))
+
+	// Updates that change nothing are not recorded.
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:   shortcut.Id,
+		Link: &link,
+	})
+	require.NoError(t, err)
+	limit := 1
+	revision, err := ts.GetShortcutRevision(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+		Limit:      &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, latest.ID, revision.ID)
+
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{
+		ID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	revisions, err = ts.ListShortcutRevisions(ctx, &store.FindShortcutRevision{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(revisions))
+}
+
+func TestDiffShortcuts(t *testing.T) {
+	previous := &storepb.Shortcut{
+		Name:       "wiki",
+		Tags:       []string{"docs"},
+		ValidUntil: 1700000000,
+	}
+	shortcut := &storepb.Shortcut{
+		Name:       "wiki",
+		Tags:       []string{"docs", "team"},
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Failover:   &storepb.ShortcutFailover{BackupLinks: []string{"https://mirror.link"}},
+	}
+	// An empty message is the same as an unset one.
+	require.Equal(t, []string{"tags", "valid_until", "backup_links"}, store.DiffShortcuts(previous, shortcut))
+	require.Equal(t, "2023-11-14T22:13:20Z", store.GetShortcutFieldValue(previous, "valid_until"))
+	require.Equal(t, "docs team", store.GetShortcutFieldValue(shortcut, "tags"))
+}
@@ -0,0 +1,107 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestShortcutTargetHealthStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "dashboard",
+		Link:       "https://a.example.com",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+		Failover: &storepb.ShortcutFailover{
+			BackupLinks: []string{"https://b.example.com", "https://c.example.com"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://b.example.com", "https://c.example.com"}, shortcut.Failover.BackupLinks)
+	targets := append([]string{shortcut.Link}, shortcut.Failover.BackupLinks...)
+
+	// Unchecked targets count as healthy.
+	target, err := ts.GetHealthyShortcutTarget(ctx, shortcut.Id, targets)
+	require.NoError(t, err)
+	require.Equal(t, "https://a.example.com", target)
+
+	now := time.Now().Unix()
+	for _, health := range []*store.ShortcutTargetHealth{
+		{ShortcutID: shortcut.Id, Link: "https://a.example.com", Healthy: true, StatusCode: 200, CheckedTs: now},
+		{ShortcutID: shortcut.Id, Link: "https://a.example.com", Healthy: false, Error: "connection refused", CheckedTs: now + 60},
+		{ShortcutID: shortcut.Id, Link: "https://b.example.com", Healthy: false, StatusCode: 503, Error: "Service Unavailable", CheckedTs: now + 60},
+	} {
+		_, err := ts.UpsertShortcutTargetHealth(ctx, health)
+		require.NoError(t, err)
+	}
+	healthList, err := ts.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{
+		ShortcutID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(healthList))
+	require.Equal(t, "connection refused", healthList[0].Error)
+	require.Equal(t, now+60, healthList[0].CheckedTs)
+	target, err = ts.GetHealthyShortcutTarget(ctx, shortcut.Id, targets)
+	require.NoError(t, err)
+	require.Equal(t, "https://c.example.com", target)
+
+	// When every target is unhealthy, the link is served.
+	_, err = ts.UpsertShortcutTargetHealth(ctx, &store.ShortcutTargetHealth{
+		ShortcutID: shortcut.Id,
+		Link:       "https://c.example.com",
+		Healthy:    false,
+		CheckedTs:  now + 60,
+	})
+	require.NoError(t, err)
+	target, err = ts.GetHealthyShortcutTarget(ctx, shortcut.Id, targets)
+	require.NoError(t, err)
+	require.Equal(t, "https://a.example.com", target)
+
+	link := "https://c.example.com"
+	err = ts.DeleteShortcutTargetHealth(ctx, &store.DeleteShortcutTargetHealth{
+		ShortcutID: shortcut.Id,
+		Link:       &link,
+	})
+	require.NoError(t, err)
+	target, err = ts.GetHealthyShortcutTarget(ctx, shortcut.Id, targets)
+	require.NoError(t, err)
+	require.Equal(t, "https://c.example.com", target)
+
+	err = ts.DeleteShortcutTargetHealth(ctx, &store.DeleteShortcutTargetHealth{
+		ShortcutID: shortcut.Id,
+	})
+	require.NoError(t, err)
+	healthList, err = ts.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(healthList))
+
+	// Purging the shortcut deletes its health.
+	_, err = ts.UpsertShortcutTargetHealth(ctx, &store.ShortcutTargetHealth{
+		ShortcutID: shortcut.Id,
+		Link:       "https://b.example.com",
+		Healthy:    true,
+		StatusCode: 200,
+		CheckedTs:  now + 120,
+	})
+	require.NoError(t, err)
+	healthList, err = ts.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{
+		ShortcutIDList: []int32{shortcut.Id, shortcut.Id + 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(healthList))
+	err = ts.DeleteShortcut(ctx, &store.DeleteShortcut{ID: shortcut.Id})
+	require.NoError(t, err)
+	healthList, err = ts.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(healthList))
+}
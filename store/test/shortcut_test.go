@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/bshort/monotreme/proto/gen/store"
 	"github.com/bshort/monotreme/store"
@@ -38,6 +39,13 @@ func TestShortcutStore(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, newLink, updatedShortcut.Link)
+	redirectMode := storepb.RedirectMode_INTERSTITIAL
+	updatedShortcut, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:           shortcut.Id,
+		RedirectMode: &redirectMode,
+	})
+	require.NoError(t, err)
+	require.Equal(t, redirectMode, updatedShortcut.RedirectMode)
 	tag := "test"
 	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{
 		Tag: &tag,
@@ -53,3 +61,173 @@ func TestShortcutStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(shortcuts))
 }
+
+func TestResolveShortcut(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	for _, name := range []string{"docs", "docs/api"} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:   user.ID,
+			Name:        name,
+			Link:        "https://docs.example.com",
+			ForwardPath: true,
+			Visibility:  storepb.Visibility_PUBLIC,
+			OgMetadata:  &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	shortcut, rest, err := ts.ResolveShortcut(ctx, []string{"docs", "api", "v2", "auth"})
+	require.NoError(t, err)
+	require.Equal(t, "docs/api", shortcut.Name)
+	require.True(t, shortcut.ForwardPath)
+	require.Equal(t, []string{"v2", "auth"}, rest)
+
+	shortcut, rest, err = ts.ResolveShortcut(ctx, []string{"docs", "guide"})
+	require.NoError(t, err)
+	require.Equal(t, "docs", shortcut.Name)
+	require.Equal(t, []string{"guide"}, rest)
+
+	shortcut, _, err = ts.ResolveShortcut(ctx, []string{"unknown", "docs"})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+}
+
+func TestShortcutValidity(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	expired, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "expired",
+		Link:       "https://expired.link",
+		ValidUntil: 1000,
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, storepb.RowStatus_NORMAL, expired.RowStatus)
+	_, err = ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "forever",
+		Link:       "https://forever.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	now := int64(2000)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		NotExpiredAt: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, "forever", shortcuts[0].Name)
+
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		ExpiredBefore: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, expired.Id, shortcuts[0].Id)
+
+	archived := storepb.RowStatus_ARCHIVED
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        expired.Id,
+		RowStatus: &archived,
+	})
+	require.NoError(t, err)
+	normal := storepb.RowStatus_NORMAL
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		RowStatus:     &normal,
+		ExpiredBefore: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+}
+
+func TestShortcutVariants(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "landing",
+		Link:       "https://landing.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Empty(t, shortcut.Variants.Variants)
+
+	variants := &storepb.ShortcutVariants{
+		Variants: []*storepb.ShortcutVariant{
+			{Name: "current", Link: "https://landing.link", Weight: 80},
+			{Name: "new", Link: "https://new.landing.link", Weight: 20},
+		},
+		Sticky: true,
+	}
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:       shortcut.Id,
+		Variants: variants,
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(variants, updatedShortcut.Variants))
+
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.True(t, proto.Equal(variants, shortcuts[0].Variants))
+}
+
+func TestShortcutRoutingRules(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "app",
+		Link:       "https://app.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	require.Empty(t, shortcut.RoutingRules.Rules)
+
+	routingRules := &storepb.ShortcutRoutingRules{
+		Rules: []*storepb.ShortcutRoutingRule{
+			{
+				Conditions: []*storepb.ShortcutRoutingCondition{
+					{Field: storepb.ShortcutRoutingCondition_OS, Values: []string{"ios"}},
+				},
+				Link: "https://apps.apple.com/app",
+			},
+			{
+				Conditions: []*storepb.ShortcutRoutingCondition{
+					{Field: storepb.ShortcutRoutingCondition_HEADER, Header: "X-Beta"},
+				},
+				Link: "https://beta.app.link",
+			},
+		},
+	}
+	updatedShortcut, err := ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:           shortcut.Id,
+		RoutingRules: routingRules,
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(routingRules, updatedShortcut.RoutingRules))
+
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.True(t, proto.Equal(routingRules, shortcuts[0].RoutingRules))
+}
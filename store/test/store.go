@@ -15,8 +15,11 @@ import (
 	"github.com/bshort/monotreme/store/db"
 )
 
-func NewTestingStore(ctx context.Context, t *testing.T) *store.Store {
-	profile := getTestingProfile(t)
+func NewTestingStore(ctx context.Context, t testing.TB) *store.Store {
+	return newTestingStoreWithProfile(ctx, getTestingProfile(t))
+}
+
+func newTestingStoreWithProfile(ctx context.Context, profile *profile.Profile) *store.Store {
 	dbDriver, err := db.NewDBDriver(profile)
 	if err != nil {
 		fmt.Printf("failed to create db driver, error: %+v\n", err)
@@ -59,7 +62,7 @@ func getUnusedPort() int {
 	return port
 }
 
-func getTestingProfile(t *testing.T) *profile.Profile {
+func getTestingProfile(t testing.TB) *profile.Profile {
 	if err := godotenv.Load(".env"); err != nil {
 		t.Log("failed to load .env file, but it's ok")
 	}
@@ -80,6 +83,9 @@ func getTestingProfile(t *testing.T) *profile.Profile {
 		DSN:     dsn,
 		Driver:  driver,
 		Version: common.GetCurrentVersion(mode),
+
+		ResolverCacheSize: store.DefaultResolverCacheSize,
+		ResolverCacheTTL:  store.DefaultResolverCacheTTL,
 	}
 }
 
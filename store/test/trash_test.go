@@ -0,0 +1,138 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestShortcutTrash(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.link",
+		Visibility: storepb.Visibility_WORKSPACE,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+
+	deletedTs := time.Now().Add(-48 * time.Hour).Unix()
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+
+	// Shortcuts in the trash are left out unless asked for, including from the cache.
+	found, err := ts.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.Nil(t, found)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		Trash: store.TrashOnly,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, deletedTs, shortcuts[0].DeletedTs)
+
+	// The name stays reserved.
+	found, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		Name:  &shortcut.Name,
+		Trash: store.TrashIncluded,
+	})
+	require.NoError(t, err)
+	require.Equal(t, shortcut.Id, found.Id)
+
+	deletedBefore := time.Now().Add(-72 * time.Hour).Unix()
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		Trash:         store.TrashOnly,
+		DeletedBefore: &deletedBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shortcuts))
+	deletedBefore = time.Now().Add(-24 * time.Hour).Unix()
+	shortcuts, err = ts.ListShortcuts(ctx, &store.FindShortcut{
+		Trash:         store.TrashOnly,
+		DeletedBefore: &deletedBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+
+	deletedTs = 0
+	_, err = ts.UpdateShortcut(ctx, &store.UpdateShortcut{
+		ID:        shortcut.Id,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+	found, err = ts.GetShortcut(ctx, &store.FindShortcut{
+		ID: &shortcut.Id,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+}
+
+func TestCollectionTrash(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:   user.ID,
+		Name:        "test",
+		Title:       "My collection",
+		ShortcutIds: []int32{101, 102},
+		Visibility:  storepb.Visibility_WORKSPACE,
+	})
+	require.NoError(t, err)
+
+	deletedTs := time.Now().Unix()
+	_, err = ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:        collection.Id,
+		DeletedTs: &deletedTs,
+	})
+	require.NoError(t, err)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{
+		Trash: store.TrashOnly,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, []int32{101, 102}, collections[0].ShortcutIds)
+	require.Equal(t, deletedTs, collections[0].DeletedTs)
+}
+
+func TestGetTrashRetention(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	retention, err := ts.GetTrashRetention(ctx)
+	require.NoError(t, err)
+	require.Equal(t, store.DefaultTrashRetentionDays*24*time.Hour, retention)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				TrashRetentionDays: 7,
+			},
+		},
+	})
+	require.NoError(t, err)
+	retention, err = ts.GetTrashRetention(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 7*24*time.Hour, retention)
+}
@@ -0,0 +1,40 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+func TestURLPrefixes(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	prefixes, err := ts.GetURLPrefixes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "s", prefixes.Shortcut)
+	require.Equal(t, "c", prefixes.Collection)
+
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+		Value: &storepb.WorkspaceSetting_ShortcutRelated{
+			ShortcutRelated: &storepb.WorkspaceSetting_ShortcutRelatedSetting{
+				ShortcutPrefix:           "go",
+				LegacyShortcutPrefixes:   []string{"s"},
+				CollectionPrefix:         "col",
+				LegacyCollectionPrefixes: []string{"c"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	prefixes, err = ts.GetURLPrefixes(ctx)
+	require.NoError(t, err)
+	require.True(t, prefixes.Contains("s"))
+	require.True(t, prefixes.Contains("col"))
+	require.False(t, prefixes.Contains("tags"))
+	require.Equal(t, "go", prefixes.Canonical("s"))
+	require.Equal(t, "col", prefixes.Canonical("c"))
+	require.Equal(t, "tags", prefixes.Canonical("tags"))
+}
@@ -3,10 +3,12 @@ package teststore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/bshort/monotreme/proto/gen/store"
 	"github.com/bshort/monotreme/store"
 )
 
@@ -28,7 +30,8 @@ func TestUserStore(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, userPatchNickname, user.Nickname)
 	err = ts.DeleteUser(ctx, &store.DeleteUser{
-		ID: user.ID,
+		ID:                 user.ID,
+		ContentDisposition: store.UserContentDelete,
 	})
 	require.NoError(t, err)
 	users, err = ts.ListUsers(ctx, &store.FindUser{})
@@ -36,6 +39,189 @@ func TestUserStore(t *testing.T) {
 	require.Equal(t, 0, len(users))
 }
 
+func TestDeleteUserContent(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "oncall",
+		Link:       "https://oncall.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "runbooks",
+		Title:      "Runbooks",
+		Visibility: storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+
+	// Archived users keep their shortcuts resolving.
+	rowStatus := storepb.RowStatus_ARCHIVED
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{
+		ID:        user.ID,
+		RowStatus: &rowStatus,
+	})
+	require.NoError(t, err)
+	resolved, _, err := ts.ResolveShortcut(ctx, []string{"oncall"})
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+	require.Equal(t, shortcut.Id, resolved.Id)
+
+	// A disposition is required.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{ID: user.ID})
+	require.Error(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:                 user.ID,
+		ContentDisposition: store.UserContentTransfer,
+		TransferCreatorID:  admin.ID,
+	})
+	require.NoError(t, err)
+	resolved, _, err = ts.ResolveShortcut(ctx, []string{"oncall"})
+	require.NoError(t, err)
+	require.NotNil(t, resolved)
+	require.Equal(t, admin.ID, resolved.CreatorId)
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{CreatorID: &admin.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	wiki, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  other.ID,
+		Name:       "wiki",
+		Link:       "https://wiki.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateShortcutLinkChange(ctx, &store.ShortcutLinkChange{
+		ShortcutID:  wiki.Id,
+		CreatorID:   other.ID,
+		EffectiveTs: time.Now().Unix() + 3600,
+		Link:        "https://new-wiki.link",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertShortcutTargetHealth(ctx, &store.ShortcutTargetHealth{
+		ShortcutID: wiki.Id,
+		Link:       wiki.Link,
+		Healthy:    true,
+		CheckedTs:  time.Now().Unix(),
+	})
+	require.NoError(t, err)
+	// The groups of the user are not deleted with it.
+	group, err := ts.CreateGroup(ctx, &store.Group{CreatorID: other.ID, Name: "wiki-editors"})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:                 other.ID,
+		ContentDisposition: store.UserContentDelete,
+	})
+	require.Error(t, err)
+	deletedUser, err := ts.GetUser(ctx, &store.FindUser{ID: &other.ID})
+	require.NoError(t, err)
+	require.NotNil(t, deletedUser)
+	err = ts.DeleteGroup(ctx, &store.DeleteGroup{ID: group.ID})
+	require.NoError(t, err)
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:                 other.ID,
+		ContentDisposition: store.UserContentDelete,
+	})
+	require.NoError(t, err)
+	shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shortcuts))
+	require.Equal(t, shortcut.Id, shortcuts[0].Id)
+	linkChanges, err := ts.ListShortcutLinkChanges(ctx, &store.FindShortcutLinkChange{
+		ShortcutID: &wiki.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(linkChanges))
+	healthList, err := ts.ListShortcutTargetHealth(ctx, &store.FindShortcutTargetHealth{
+		ShortcutID: &wiki.Id,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(healthList))
+}
+
+func TestDeleteUserTransferToGroup(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	admin, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	user, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "user@test.com",
+		Nickname: "user",
+	})
+	require.NoError(t, err)
+	shortcut, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+		CreatorId:  user.ID,
+		Name:       "oncall",
+		Link:       "https://oncall.link",
+		Visibility: storepb.Visibility_PUBLIC,
+		OgMetadata: &storepb.OpenGraphMetadata{},
+	})
+	require.NoError(t, err)
+	collection, err := ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "runbooks",
+		Title:      "Runbooks",
+		Visibility: storepb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateGroup(ctx, &store.Group{CreatorID: admin.ID, Name: "sre"})
+	require.NoError(t, err)
+	groupPrincipal := store.PermissionPrincipalTypeGroup
+
+	// A failed deletion grants nothing.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:                 user.ID,
+		ContentDisposition: store.UserContentTransfer,
+		TransferCreatorID:  user.ID,
+		TransferGroupID:    group.ID,
+	})
+	require.Error(t, err)
+	permissions, err := ts.ListPermissions(ctx, &store.FindPermission{PrincipalType: &groupPrincipal})
+	require.NoError(t, err)
+	require.Empty(t, permissions)
+
+	// The members of the group own the transferred content.
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:                 user.ID,
+		ContentDisposition: store.UserContentTransfer,
+		TransferCreatorID:  admin.ID,
+		TransferGroupID:    group.ID,
+	})
+	require.NoError(t, err)
+	permissions, err = ts.ListPermissions(ctx, &store.FindPermission{PrincipalType: &groupPrincipal})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(permissions))
+	for _, permission := range permissions {
+		require.Equal(t, group.ID, permission.PrincipalID)
+		require.Equal(t, store.PermissionRoleOwner, permission.Role)
+		switch permission.ResourceType {
+		case store.PermissionResourceTypeShortcut:
+			require.Equal(t, shortcut.Id, permission.ResourceID)
+		case store.PermissionResourceTypeCollection:
+			require.Equal(t, collection.Id, permission.ResourceID)
+		}
+	}
+}
+
 // createTestingAdminUser creates a testing admin user.
 func createTestingAdminUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{
@@ -0,0 +1,107 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+	"github.com/bshort/monotreme/store"
+)
+
+func TestIsVisibleTo(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	tests := []struct {
+		visibility storepb.Visibility
+		viewerID   int32
+		want       bool
+	}{
+		{storepb.Visibility_PUBLIC, 0, true},
+		{storepb.Visibility_PUBLIC, 2, true},
+		{storepb.Visibility_WORKSPACE, 0, false},
+		{storepb.Visibility_WORKSPACE, 2, true},
+		{storepb.Visibility_PRIVATE, 0, false},
+		{storepb.Visibility_PRIVATE, 1, true},
+		{storepb.Visibility_PRIVATE, 2, false},
+		{storepb.Visibility_GROUP, 1, true},
+		{storepb.Visibility_GROUP, 2, false},
+	}
+	for _, test := range tests {
+		visible, err := ts.IsVisibleTo(ctx, &storepb.Shortcut{Visibility: test.visibility, CreatorId: 1}, test.viewerID)
+		require.NoError(t, err)
+		require.Equal(t, test.want, visible, "%s to %d", test.visibility, test.viewerID)
+	}
+}
+
+func TestShortcutViewer(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	for name, visibility := range map[string]storepb.Visibility{
+		"public":    storepb.Visibility_PUBLIC,
+		"workspace": storepb.Visibility_WORKSPACE,
+		"private":   storepb.Visibility_PRIVATE,
+	} {
+		_, err := ts.CreateShortcut(ctx, &storepb.Shortcut{
+			CreatorId:  user.ID,
+			Name:       name,
+			Link:       "https://" + name + ".link",
+			Visibility: visibility,
+			OgMetadata: &storepb.OpenGraphMetadata{},
+		})
+		require.NoError(t, err)
+	}
+
+	anonymous := int32(0)
+	for viewerID, count := range map[int32]int{user.ID: 3, other.ID: 2, anonymous: 1} {
+		shortcuts, err := ts.ListShortcuts(ctx, &store.FindShortcut{
+			ViewerID: &viewerID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, count, len(shortcuts))
+	}
+
+	// The cached shortcut is filtered the same way.
+	name := "private"
+	shortcut, err := ts.GetShortcut(ctx, &store.FindShortcut{Name: &name})
+	require.NoError(t, err)
+	shortcut, err = ts.GetShortcut(ctx, &store.FindShortcut{ID: &shortcut.Id, ViewerID: &other.ID})
+	require.NoError(t, err)
+	require.Nil(t, shortcut)
+}
+
+func TestCollectionViewer(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingAdminUser(ctx, ts)
+	require.NoError(t, err)
+	other, err := ts.CreateUser(ctx, &store.User{
+		Role:     store.RoleUser,
+		Email:    "other@test.com",
+		Nickname: "other",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &storepb.Collection{
+		CreatorId:  user.ID,
+		Name:       "drafts",
+		Title:      "Drafts",
+		Visibility: storepb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{ViewerID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{ViewerID: &other.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collections))
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	storepb "github.com/bshort/monotreme/proto/gen/store"
+)
+
+// DefaultTrashRetentionDays is how long deleted shortcuts and collections stay in the trash
+// when the workspace does not configure it.
+const DefaultTrashRetentionDays = 30
+
+// TrashFilter selects shortcuts and collections by whether they are in the trash.
+type TrashFilter int
+
+const (
+	// TrashExcluded leaves out the ones in the trash. It is the default.
+	TrashExcluded TrashFilter = iota
+	// TrashOnly keeps only the ones in the trash.
+	TrashOnly
+	// TrashIncluded keeps them whether or not they are in the trash, such as to check
+	// whether a name is taken.
+	TrashIncluded
+)
+
+// Matches reports whether a row moved to the trash at deletedTs, or 0, passes the filter.
+func (f TrashFilter) Matches(deletedTs int64) bool {
+	switch f {
+	case TrashOnly:
+		return deletedTs != 0
+	case TrashIncluded:
+		return true
+	default:
+		return deletedTs == 0
+	}
+}
+
+// GetTrashRetention returns how long deleted shortcuts and collections stay in the trash.
+func (s *Store) GetTrashRetention(ctx context.Context) (time.Duration, error) {
+	shortcutRelatedSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return 0, err
+	}
+	days := int32(DefaultTrashRetentionDays)
+	if value := shortcutRelatedSetting.GetShortcutRelated().GetTrashRetentionDays(); value > 0 {
+		days = value
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
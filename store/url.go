@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+const (
+	// DefaultShortcutPrefix is the shortcut prefix of workspaces that have not set one.
+	DefaultShortcutPrefix = "s"
+	// DefaultCollectionPrefix is the collection prefix of workspaces that have not set one.
+	DefaultCollectionPrefix = "c"
+)
+
+// URLPrefixes are the first path segments of shortcut and collection URLs. Links are
+// always built with the canonical prefixes, while the legacy ones keep older links working.
+type URLPrefixes struct {
+	Shortcut         string
+	LegacyShortcut   []string
+	Collection       string
+	LegacyCollection []string
+}
+
+// GetURLPrefixes returns the shortcut and collection prefixes of the workspace.
+func (s *Store) GetURLPrefixes(ctx context.Context) (*URLPrefixes, error) {
+	shortcutRelatedSetting, err := s.GetWorkspaceShortcutRelatedSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefixes := &URLPrefixes{
+		Shortcut:         shortcutRelatedSetting.ShortcutPrefix,
+		LegacyShortcut:   shortcutRelatedSetting.LegacyShortcutPrefixes,
+		Collection:       shortcutRelatedSetting.CollectionPrefix,
+		LegacyCollection: shortcutRelatedSetting.LegacyCollectionPrefixes,
+	}
+	if prefixes.Shortcut == "" {
+		prefixes.Shortcut = DefaultShortcutPrefix
+	}
+	if prefixes.Collection == "" {
+		prefixes.Collection = DefaultCollectionPrefix
+	}
+	return prefixes, nil
+}
+
+// Contains reports whether segment is a canonical or legacy prefix.
+func (p *URLPrefixes) Contains(segment string) bool {
+	return segment == p.Shortcut || segment == p.Collection || slices.Contains(p.LegacyShortcut, segment) || slices.Contains(p.LegacyCollection, segment)
+}
+
+// Canonical returns the canonical prefix that a legacy prefix stands for, or segment
+// itself if it is not a legacy prefix.
+func (p *URLPrefixes) Canonical(segment string) string {
+	if slices.Contains(p.LegacyShortcut, segment) {
+		return p.Shortcut
+	}
+	if slices.Contains(p.LegacyCollection, segment) {
+		return p.Collection
+	}
+	return segment
+}
+
+// GetBaseURL returns the instance URL of the workspace without a trailing slash, or
+// fallback if it has not set one.
+func (s *Store) GetBaseURL(ctx context.Context, fallback string) (string, error) {
+	generalSetting, err := s.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	if instanceURL := strings.TrimSuffix(generalSetting.InstanceUrl, "/"); instanceURL != "" {
+		return instanceURL, nil
+	}
+	return fallback, nil
+}
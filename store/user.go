@@ -65,8 +65,26 @@ type FindUser struct {
 	Role      *Role
 }
 
+// UserContentDisposition decides what happens to the shortcuts and collections of a deleted user.
+type UserContentDisposition string
+
+const (
+	// UserContentTransfer makes another user the creator of the content and of the user's groups.
+	UserContentTransfer UserContentDisposition = "TRANSFER"
+	// UserContentDelete deletes the content with the user. It is deleted for good, without
+	// going through the trash, along with its aliases, revisions and permissions. Users who
+	// still own groups can't be deleted this way.
+	UserContentDelete UserContentDisposition = "DELETE"
+)
+
 type DeleteUser struct {
 	ID int32
+
+	ContentDisposition UserContentDisposition
+	// TransferCreatorID is the user who becomes the creator of the content when it is transferred.
+	TransferCreatorID int32
+	// TransferGroupID is the group whose members become owners of the transferred content, if any.
+	TransferGroupID int32
 }
 
 func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
@@ -125,5 +143,8 @@ func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
 	}
 
 	s.userCache.Delete(delete.ID)
+	// Deleting a user also deletes or transfers their shortcuts.
+	s.shortcutCache.Clear()
+	s.resolverCache.purge()
 	return nil
 }
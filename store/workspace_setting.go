@@ -18,6 +18,10 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 		return nil, err
 	}
 	s.workspaceSettingCache.Store(workspaceSetting.Key, workspaceSetting)
+	// The name policy decides which shortcut a path resolves to.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED {
+		s.resolverCache.purge()
+	}
 	return workspaceSetting, nil
 }
 
@@ -92,3 +96,17 @@ func (s *Store) GetWorkspaceSecuritySetting(ctx context.Context) (*storepb.Works
 	}
 	return securitySetting, nil
 }
+
+func (s *Store) GetWorkspaceShortcutRelatedSetting(ctx context.Context) (*storepb.WorkspaceSetting_ShortcutRelatedSetting, error) {
+	setting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SHORTCUT_RELATED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shortcutRelatedSetting := &storepb.WorkspaceSetting_ShortcutRelatedSetting{}
+	if setting != nil && setting.GetShortcutRelated() != nil {
+		shortcutRelatedSetting = setting.GetShortcutRelated()
+	}
+	return shortcutRelatedSetting, nil
+}